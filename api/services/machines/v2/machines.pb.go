@@ -449,8 +449,9 @@ type StartRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name         string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	WaitInterval uint32 `protobuf:"varint,2,opt,name=wait_interval,json=waitInterval,proto3" json:"wait_interval,omitempty"`
+	Name              string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	WaitInterval      uint32 `protobuf:"varint,2,opt,name=wait_interval,json=waitInterval,proto3" json:"wait_interval,omitempty"`
+	DiscardSavedState bool   `protobuf:"varint,3,opt,name=discard_saved_state,json=discardSavedState,proto3" json:"discard_saved_state,omitempty"`
 }
 
 func (x *StartRequest) Reset() {
@@ -499,6 +500,13 @@ func (x *StartRequest) GetWaitInterval() uint32 {
 	return 0
 }
 
+func (x *StartRequest) GetDiscardSavedState() bool {
+	if x != nil {
+		return x.DiscardSavedState
+	}
+	return false
+}
+
 type StopRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -672,6 +680,194 @@ func (x *ResetRequest) GetWait() bool {
 	return false
 }
 
+type PauseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *PauseRequest) Reset() {
+	*x = PauseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_machines_v2_machines_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PauseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseRequest) ProtoMessage() {}
+
+func (x *PauseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseRequest.ProtoReflect.Descriptor instead.
+func (*PauseRequest) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PauseRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ResumeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *ResumeRequest) Reset() {
+	*x = ResumeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_machines_v2_machines_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeRequest) ProtoMessage() {}
+
+func (x *ResumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeRequest.ProtoReflect.Descriptor instead.
+func (*ResumeRequest) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ResumeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type StartSuspendRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *StartSuspendRequest) Reset() {
+	*x = StartSuspendRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_machines_v2_machines_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartSuspendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartSuspendRequest) ProtoMessage() {}
+
+func (x *StartSuspendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartSuspendRequest.ProtoReflect.Descriptor instead.
+func (*StartSuspendRequest) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *StartSuspendRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type StartSuspendResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TaskKey string `protobuf:"bytes,1,opt,name=task_key,json=taskKey,proto3" json:"task_key,omitempty"`
+}
+
+func (x *StartSuspendResponse) Reset() {
+	*x = StartSuspendResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_machines_v2_machines_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartSuspendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartSuspendResponse) ProtoMessage() {}
+
+func (x *StartSuspendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartSuspendResponse.ProtoReflect.Descriptor instead.
+func (*StartSuspendResponse) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *StartSuspendResponse) GetTaskKey() string {
+	if x != nil {
+		return x.TaskKey
+	}
+	return ""
+}
+
 type ListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -683,7 +879,7 @@ type ListRequest struct {
 func (x *ListRequest) Reset() {
 	*x = ListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[12]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -696,7 +892,7 @@ func (x *ListRequest) String() string {
 func (*ListRequest) ProtoMessage() {}
 
 func (x *ListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[12]
+	mi := &file_services_machines_v2_machines_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -709,7 +905,7 @@ func (x *ListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRequest.ProtoReflect.Descriptor instead.
 func (*ListRequest) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{12}
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ListRequest) GetNames() []string {
@@ -730,7 +926,7 @@ type ListResponse struct {
 func (x *ListResponse) Reset() {
 	*x = ListResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[13]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -743,7 +939,7 @@ func (x *ListResponse) String() string {
 func (*ListResponse) ProtoMessage() {}
 
 func (x *ListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[13]
+	mi := &file_services_machines_v2_machines_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -756,7 +952,7 @@ func (x *ListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListResponse.ProtoReflect.Descriptor instead.
 func (*ListResponse) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{13}
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ListResponse) GetMachines() []*v2.Machine {
@@ -777,7 +973,7 @@ type ListNamesRequest struct {
 func (x *ListNamesRequest) Reset() {
 	*x = ListNamesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[14]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -790,7 +986,7 @@ func (x *ListNamesRequest) String() string {
 func (*ListNamesRequest) ProtoMessage() {}
 
 func (x *ListNamesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[14]
+	mi := &file_services_machines_v2_machines_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -803,7 +999,7 @@ func (x *ListNamesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListNamesRequest.ProtoReflect.Descriptor instead.
 func (*ListNamesRequest) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{14}
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ListNamesRequest) GetNames() []string {
@@ -824,7 +1020,7 @@ type ListNamesResponse struct {
 func (x *ListNamesResponse) Reset() {
 	*x = ListNamesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[15]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -837,7 +1033,7 @@ func (x *ListNamesResponse) String() string {
 func (*ListNamesResponse) ProtoMessage() {}
 
 func (x *ListNamesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[15]
+	mi := &file_services_machines_v2_machines_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -850,7 +1046,7 @@ func (x *ListNamesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListNamesResponse.ProtoReflect.Descriptor instead.
 func (*ListNamesResponse) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{15}
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ListNamesResponse) GetMachines() []string {
@@ -874,7 +1070,7 @@ type FirmwareSetRequest struct {
 func (x *FirmwareSetRequest) Reset() {
 	*x = FirmwareSetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[16]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -887,7 +1083,7 @@ func (x *FirmwareSetRequest) String() string {
 func (*FirmwareSetRequest) ProtoMessage() {}
 
 func (x *FirmwareSetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[16]
+	mi := &file_services_machines_v2_machines_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -900,7 +1096,7 @@ func (x *FirmwareSetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FirmwareSetRequest.ProtoReflect.Descriptor instead.
 func (*FirmwareSetRequest) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{16}
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *FirmwareSetRequest) GetName() string {
@@ -942,7 +1138,7 @@ type FirmwareRemoveRequest struct {
 func (x *FirmwareRemoveRequest) Reset() {
 	*x = FirmwareRemoveRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[17]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -955,7 +1151,7 @@ func (x *FirmwareRemoveRequest) String() string {
 func (*FirmwareRemoveRequest) ProtoMessage() {}
 
 func (x *FirmwareRemoveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[17]
+	mi := &file_services_machines_v2_machines_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -968,7 +1164,7 @@ func (x *FirmwareRemoveRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FirmwareRemoveRequest.ProtoReflect.Descriptor instead.
 func (*FirmwareRemoveRequest) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{17}
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *FirmwareRemoveRequest) GetName() string {
@@ -992,7 +1188,7 @@ type MemorySetLimitsRequest struct {
 func (x *MemorySetLimitsRequest) Reset() {
 	*x = MemorySetLimitsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[18]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1005,7 +1201,7 @@ func (x *MemorySetLimitsRequest) String() string {
 func (*MemorySetLimitsRequest) ProtoMessage() {}
 
 func (x *MemorySetLimitsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[18]
+	mi := &file_services_machines_v2_machines_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1018,7 +1214,7 @@ func (x *MemorySetLimitsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MemorySetLimitsRequest.ProtoReflect.Descriptor instead.
 func (*MemorySetLimitsRequest) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{18}
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *MemorySetLimitsRequest) GetName() string {
@@ -1063,7 +1259,7 @@ type CPUSetLimitsRequest struct {
 func (x *CPUSetLimitsRequest) Reset() {
 	*x = CPUSetLimitsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[19]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1076,7 +1272,7 @@ func (x *CPUSetLimitsRequest) String() string {
 func (*CPUSetLimitsRequest) ProtoMessage() {}
 
 func (x *CPUSetLimitsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[19]
+	mi := &file_services_machines_v2_machines_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1089,7 +1285,7 @@ func (x *CPUSetLimitsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CPUSetLimitsRequest.ProtoReflect.Descriptor instead.
 func (*CPUSetLimitsRequest) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{19}
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *CPUSetLimitsRequest) GetName() string {
@@ -1132,7 +1328,7 @@ type CPUSetSocketsRequest struct {
 func (x *CPUSetSocketsRequest) Reset() {
 	*x = CPUSetSocketsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[20]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1145,7 +1341,7 @@ func (x *CPUSetSocketsRequest) String() string {
 func (*CPUSetSocketsRequest) ProtoMessage() {}
 
 func (x *CPUSetSocketsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[20]
+	mi := &file_services_machines_v2_machines_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1158,7 +1354,7 @@ func (x *CPUSetSocketsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CPUSetSocketsRequest.ProtoReflect.Descriptor instead.
 func (*CPUSetSocketsRequest) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{20}
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *CPUSetSocketsRequest) GetName() string {
@@ -1188,7 +1384,7 @@ type CPUSetQuotaRequest struct {
 func (x *CPUSetQuotaRequest) Reset() {
 	*x = CPUSetQuotaRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[21]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1201,7 +1397,7 @@ func (x *CPUSetQuotaRequest) String() string {
 func (*CPUSetQuotaRequest) ProtoMessage() {}
 
 func (x *CPUSetQuotaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[21]
+	mi := &file_services_machines_v2_machines_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1214,7 +1410,7 @@ func (x *CPUSetQuotaRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CPUSetQuotaRequest.ProtoReflect.Descriptor instead.
 func (*CPUSetQuotaRequest) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{21}
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *CPUSetQuotaRequest) GetName() string {
@@ -1250,7 +1446,7 @@ type CPUSetModelRequest struct {
 func (x *CPUSetModelRequest) Reset() {
 	*x = CPUSetModelRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[22]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1263,7 +1459,7 @@ func (x *CPUSetModelRequest) String() string {
 func (*CPUSetModelRequest) ProtoMessage() {}
 
 func (x *CPUSetModelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[22]
+	mi := &file_services_machines_v2_machines_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1276,7 +1472,7 @@ func (x *CPUSetModelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CPUSetModelRequest.ProtoReflect.Descriptor instead.
 func (*CPUSetModelRequest) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{22}
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *CPUSetModelRequest) GetName() string {
@@ -1303,12 +1499,13 @@ type HostDeviceAttachRequest struct {
 	Multifunction bool   `protobuf:"varint,3,opt,name=multifunction,proto3" json:"multifunction,omitempty"`
 	PrimaryGPU    bool   `protobuf:"varint,4,opt,name=primary_gpu,json=primaryGpu,proto3" json:"primary_gpu,omitempty"`
 	StrictMode    bool   `protobuf:"varint,90,opt,name=strict_mode,json=strictMode,proto3" json:"strict_mode,omitempty"`
+	Live          bool   `protobuf:"varint,100,opt,name=live,proto3" json:"live,omitempty"`
 }
 
 func (x *HostDeviceAttachRequest) Reset() {
 	*x = HostDeviceAttachRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[23]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1321,7 +1518,7 @@ func (x *HostDeviceAttachRequest) String() string {
 func (*HostDeviceAttachRequest) ProtoMessage() {}
 
 func (x *HostDeviceAttachRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[23]
+	mi := &file_services_machines_v2_machines_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1334,7 +1531,7 @@ func (x *HostDeviceAttachRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HostDeviceAttachRequest.ProtoReflect.Descriptor instead.
 func (*HostDeviceAttachRequest) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{23}
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *HostDeviceAttachRequest) GetName() string {
@@ -1372,6 +1569,13 @@ func (x *HostDeviceAttachRequest) GetStrictMode() bool {
 	return false
 }
 
+func (x *HostDeviceAttachRequest) GetLive() bool {
+	if x != nil {
+		return x.Live
+	}
+	return false
+}
+
 type HostDeviceDetachRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1379,12 +1583,13 @@ type HostDeviceDetachRequest struct {
 
 	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	PCIAddr string `protobuf:"bytes,2,opt,name=pci_addr,json=pciAddr,proto3" json:"pci_addr,omitempty"`
+	Live    bool   `protobuf:"varint,100,opt,name=live,proto3" json:"live,omitempty"`
 }
 
 func (x *HostDeviceDetachRequest) Reset() {
 	*x = HostDeviceDetachRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[24]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1397,7 +1602,7 @@ func (x *HostDeviceDetachRequest) String() string {
 func (*HostDeviceDetachRequest) ProtoMessage() {}
 
 func (x *HostDeviceDetachRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[24]
+	mi := &file_services_machines_v2_machines_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1410,7 +1615,7 @@ func (x *HostDeviceDetachRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HostDeviceDetachRequest.ProtoReflect.Descriptor instead.
 func (*HostDeviceDetachRequest) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{24}
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *HostDeviceDetachRequest) GetName() string {
@@ -1427,6 +1632,13 @@ func (x *HostDeviceDetachRequest) GetPCIAddr() string {
 	return ""
 }
 
+func (x *HostDeviceDetachRequest) GetLive() bool {
+	if x != nil {
+		return x.Live
+	}
+	return false
+}
+
 type HostDeviceSetMultifunctionOptionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1440,7 +1652,7 @@ type HostDeviceSetMultifunctionOptionRequest struct {
 func (x *HostDeviceSetMultifunctionOptionRequest) Reset() {
 	*x = HostDeviceSetMultifunctionOptionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[25]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1453,7 +1665,7 @@ func (x *HostDeviceSetMultifunctionOptionRequest) String() string {
 func (*HostDeviceSetMultifunctionOptionRequest) ProtoMessage() {}
 
 func (x *HostDeviceSetMultifunctionOptionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[25]
+	mi := &file_services_machines_v2_machines_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1466,7 +1678,7 @@ func (x *HostDeviceSetMultifunctionOptionRequest) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use HostDeviceSetMultifunctionOptionRequest.ProtoReflect.Descriptor instead.
 func (*HostDeviceSetMultifunctionOptionRequest) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{25}
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *HostDeviceSetMultifunctionOptionRequest) GetName() string {
@@ -1503,7 +1715,7 @@ type HostDeviceSetPrimaryGPUOptionRequest struct {
 func (x *HostDeviceSetPrimaryGPUOptionRequest) Reset() {
 	*x = HostDeviceSetPrimaryGPUOptionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[26]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1516,7 +1728,7 @@ func (x *HostDeviceSetPrimaryGPUOptionRequest) String() string {
 func (*HostDeviceSetPrimaryGPUOptionRequest) ProtoMessage() {}
 
 func (x *HostDeviceSetPrimaryGPUOptionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[26]
+	mi := &file_services_machines_v2_machines_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1529,7 +1741,7 @@ func (x *HostDeviceSetPrimaryGPUOptionRequest) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use HostDeviceSetPrimaryGPUOptionRequest.ProtoReflect.Descriptor instead.
 func (*HostDeviceSetPrimaryGPUOptionRequest) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{26}
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *HostDeviceSetPrimaryGPUOptionRequest) GetName() string {
@@ -1565,7 +1777,7 @@ type VNCActivateRequest struct {
 func (x *VNCActivateRequest) Reset() {
 	*x = VNCActivateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[27]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1578,7 +1790,7 @@ func (x *VNCActivateRequest) String() string {
 func (*VNCActivateRequest) ProtoMessage() {}
 
 func (x *VNCActivateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[27]
+	mi := &file_services_machines_v2_machines_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1591,7 +1803,7 @@ func (x *VNCActivateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VNCActivateRequest.ProtoReflect.Descriptor instead.
 func (*VNCActivateRequest) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{27}
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *VNCActivateRequest) GetName() string {
@@ -1619,7 +1831,7 @@ type VNCActivateResponse struct {
 func (x *VNCActivateResponse) Reset() {
 	*x = VNCActivateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[28]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1632,7 +1844,7 @@ func (x *VNCActivateResponse) String() string {
 func (*VNCActivateResponse) ProtoMessage() {}
 
 func (x *VNCActivateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[28]
+	mi := &file_services_machines_v2_machines_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1645,7 +1857,7 @@ func (x *VNCActivateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VNCActivateResponse.ProtoReflect.Descriptor instead.
 func (*VNCActivateResponse) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{28}
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *VNCActivateResponse) GetRequisites() *v2.VNCRequisites {
@@ -1667,7 +1879,7 @@ type InputDeviceAttachRequest struct {
 func (x *InputDeviceAttachRequest) Reset() {
 	*x = InputDeviceAttachRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[29]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1680,7 +1892,7 @@ func (x *InputDeviceAttachRequest) String() string {
 func (*InputDeviceAttachRequest) ProtoMessage() {}
 
 func (x *InputDeviceAttachRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[29]
+	mi := &file_services_machines_v2_machines_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1693,7 +1905,7 @@ func (x *InputDeviceAttachRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InputDeviceAttachRequest.ProtoReflect.Descriptor instead.
 func (*InputDeviceAttachRequest) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{29}
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *InputDeviceAttachRequest) GetName() string {
@@ -1722,7 +1934,7 @@ type InputDeviceDetachRequest struct {
 func (x *InputDeviceDetachRequest) Reset() {
 	*x = InputDeviceDetachRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[30]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1735,7 +1947,7 @@ func (x *InputDeviceDetachRequest) String() string {
 func (*InputDeviceDetachRequest) ProtoMessage() {}
 
 func (x *InputDeviceDetachRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[30]
+	mi := &file_services_machines_v2_machines_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1748,7 +1960,7 @@ func (x *InputDeviceDetachRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InputDeviceDetachRequest.ProtoReflect.Descriptor instead.
 func (*InputDeviceDetachRequest) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{30}
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *InputDeviceDetachRequest) GetName() string {
@@ -1783,7 +1995,7 @@ type CdromAttachRequest struct {
 func (x *CdromAttachRequest) Reset() {
 	*x = CdromAttachRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[31]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1796,7 +2008,7 @@ func (x *CdromAttachRequest) String() string {
 func (*CdromAttachRequest) ProtoMessage() {}
 
 func (x *CdromAttachRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[31]
+	mi := &file_services_machines_v2_machines_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1809,7 +2021,7 @@ func (x *CdromAttachRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CdromAttachRequest.ProtoReflect.Descriptor instead.
 func (*CdromAttachRequest) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{31}
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *CdromAttachRequest) GetName() string {
@@ -1881,7 +2093,7 @@ type CdromDetachRequest struct {
 func (x *CdromDetachRequest) Reset() {
 	*x = CdromDetachRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[32]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1894,7 +2106,7 @@ func (x *CdromDetachRequest) String() string {
 func (*CdromDetachRequest) ProtoMessage() {}
 
 func (x *CdromDetachRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[32]
+	mi := &file_services_machines_v2_machines_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1907,7 +2119,7 @@ func (x *CdromDetachRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CdromDetachRequest.ProtoReflect.Descriptor instead.
 func (*CdromDetachRequest) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{32}
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *CdromDetachRequest) GetName() string {
@@ -1945,7 +2157,7 @@ type CdromChangeMediaRequest struct {
 func (x *CdromChangeMediaRequest) Reset() {
 	*x = CdromChangeMediaRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[33]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1958,7 +2170,7 @@ func (x *CdromChangeMediaRequest) String() string {
 func (*CdromChangeMediaRequest) ProtoMessage() {}
 
 func (x *CdromChangeMediaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[33]
+	mi := &file_services_machines_v2_machines_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1971,7 +2183,7 @@ func (x *CdromChangeMediaRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CdromChangeMediaRequest.ProtoReflect.Descriptor instead.
 func (*CdromChangeMediaRequest) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{33}
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *CdromChangeMediaRequest) GetName() string {
@@ -2015,7 +2227,7 @@ type CdromRemoveMediaRequest struct {
 func (x *CdromRemoveMediaRequest) Reset() {
 	*x = CdromRemoveMediaRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[34]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2028,7 +2240,7 @@ func (x *CdromRemoveMediaRequest) String() string {
 func (*CdromRemoveMediaRequest) ProtoMessage() {}
 
 func (x *CdromRemoveMediaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[34]
+	mi := &file_services_machines_v2_machines_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2041,7 +2253,7 @@ func (x *CdromRemoveMediaRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CdromRemoveMediaRequest.ProtoReflect.Descriptor instead.
 func (*CdromRemoveMediaRequest) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{34}
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *CdromRemoveMediaRequest) GetName() string {
@@ -2077,13 +2289,14 @@ type DiskAttachRequest struct {
 	IopsWr    uint32        `protobuf:"varint,5,opt,name=iops_wr,json=iopsWr,proto3" json:"iops_wr,omitempty"`
 	Bootindex int32         `protobuf:"varint,6,opt,name=bootindex,proto3" json:"bootindex,omitempty"`
 	Position  int32         `protobuf:"varint,7,opt,name=position,proto3" json:"position,omitempty"`
+	Format    string        `protobuf:"bytes,8,opt,name=format,proto3" json:"format,omitempty"`
 	Live      bool          `protobuf:"varint,100,opt,name=live,proto3" json:"live,omitempty"`
 }
 
 func (x *DiskAttachRequest) Reset() {
 	*x = DiskAttachRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[35]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2096,7 +2309,7 @@ func (x *DiskAttachRequest) String() string {
 func (*DiskAttachRequest) ProtoMessage() {}
 
 func (x *DiskAttachRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[35]
+	mi := &file_services_machines_v2_machines_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2109,7 +2322,7 @@ func (x *DiskAttachRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiskAttachRequest.ProtoReflect.Descriptor instead.
 func (*DiskAttachRequest) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{35}
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *DiskAttachRequest) GetName() string {
@@ -2161,6 +2374,13 @@ func (x *DiskAttachRequest) GetPosition() int32 {
 	return 0
 }
 
+func (x *DiskAttachRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
 func (x *DiskAttachRequest) GetLive() bool {
 	if x != nil {
 		return x.Live
@@ -2181,7 +2401,7 @@ type DiskDetachRequest struct {
 func (x *DiskDetachRequest) Reset() {
 	*x = DiskDetachRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[36]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2194,7 +2414,7 @@ func (x *DiskDetachRequest) String() string {
 func (*DiskDetachRequest) ProtoMessage() {}
 
 func (x *DiskDetachRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[36]
+	mi := &file_services_machines_v2_machines_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2207,7 +2427,7 @@ func (x *DiskDetachRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiskDetachRequest.ProtoReflect.Descriptor instead.
 func (*DiskDetachRequest) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{36}
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *DiskDetachRequest) GetName() string {
@@ -2245,7 +2465,7 @@ type DiskSetIOLimitRequest struct {
 func (x *DiskSetIOLimitRequest) Reset() {
 	*x = DiskSetIOLimitRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[37]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2258,7 +2478,7 @@ func (x *DiskSetIOLimitRequest) String() string {
 func (*DiskSetIOLimitRequest) ProtoMessage() {}
 
 func (x *DiskSetIOLimitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[37]
+	mi := &file_services_machines_v2_machines_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2271,7 +2491,7 @@ func (x *DiskSetIOLimitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiskSetIOLimitRequest.ProtoReflect.Descriptor instead.
 func (*DiskSetIOLimitRequest) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{37}
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *DiskSetIOLimitRequest) GetName() string {
@@ -2314,7 +2534,7 @@ type DiskRemoveQemuBitmapRequest struct {
 func (x *DiskRemoveQemuBitmapRequest) Reset() {
 	*x = DiskRemoveQemuBitmapRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[38]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2327,7 +2547,7 @@ func (x *DiskRemoveQemuBitmapRequest) String() string {
 func (*DiskRemoveQemuBitmapRequest) ProtoMessage() {}
 
 func (x *DiskRemoveQemuBitmapRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[38]
+	mi := &file_services_machines_v2_machines_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2340,7 +2560,7 @@ func (x *DiskRemoveQemuBitmapRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiskRemoveQemuBitmapRequest.ProtoReflect.Descriptor instead.
 func (*DiskRemoveQemuBitmapRequest) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{38}
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *DiskRemoveQemuBitmapRequest) GetName() string {
@@ -2369,7 +2589,7 @@ type DiskResizeQemuBlockdevRequest struct {
 func (x *DiskResizeQemuBlockdevRequest) Reset() {
 	*x = DiskResizeQemuBlockdevRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[39]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2382,7 +2602,7 @@ func (x *DiskResizeQemuBlockdevRequest) String() string {
 func (*DiskResizeQemuBlockdevRequest) ProtoMessage() {}
 
 func (x *DiskResizeQemuBlockdevRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[39]
+	mi := &file_services_machines_v2_machines_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2395,7 +2615,7 @@ func (x *DiskResizeQemuBlockdevRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiskResizeQemuBlockdevRequest.ProtoReflect.Descriptor instead.
 func (*DiskResizeQemuBlockdevRequest) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{39}
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *DiskResizeQemuBlockdevRequest) GetName() string {
@@ -2412,38 +2632,33 @@ func (x *DiskResizeQemuBlockdevRequest) GetDiskName() string {
 	return ""
 }
 
-type NetIfaceAttachRequest struct {
+type DiskGrowRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name         string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Ifname       string            `protobuf:"bytes,2,opt,name=ifname,proto3" json:"ifname,omitempty"`
-	Driver       v2.NetIfaceDriver `protobuf:"varint,3,opt,name=driver,proto3,enum=kvmrun.api.types.v2.NetIfaceDriver" json:"driver,omitempty"`
-	HwAddr       string            `protobuf:"bytes,4,opt,name=hw_addr,json=hwAddr,proto3" json:"hw_addr,omitempty"`
-	IfupScript   string            `protobuf:"bytes,5,opt,name=ifup_script,json=ifupScript,proto3" json:"ifup_script,omitempty"`
-	IfdownScript string            `protobuf:"bytes,6,opt,name=ifdown_script,json=ifdownScript,proto3" json:"ifdown_script,omitempty"`
-	Queues       uint32            `protobuf:"varint,7,opt,name=queues,proto3" json:"queues,omitempty"`
-	Live         bool              `protobuf:"varint,100,opt,name=live,proto3" json:"live,omitempty"`
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DiskName string `protobuf:"bytes,2,opt,name=disk_name,json=diskName,proto3" json:"disk_name,omitempty"`
+	Size     uint64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
 }
 
-func (x *NetIfaceAttachRequest) Reset() {
-	*x = NetIfaceAttachRequest{}
+func (x *DiskGrowRequest) Reset() {
+	*x = DiskGrowRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[40]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NetIfaceAttachRequest) String() string {
+func (x *DiskGrowRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NetIfaceAttachRequest) ProtoMessage() {}
+func (*DiskGrowRequest) ProtoMessage() {}
 
-func (x *NetIfaceAttachRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[40]
+func (x *DiskGrowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2454,94 +2669,106 @@ func (x *NetIfaceAttachRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NetIfaceAttachRequest.ProtoReflect.Descriptor instead.
-func (*NetIfaceAttachRequest) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use DiskGrowRequest.ProtoReflect.Descriptor instead.
+func (*DiskGrowRequest) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *NetIfaceAttachRequest) GetName() string {
+func (x *DiskGrowRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *NetIfaceAttachRequest) GetIfname() string {
+func (x *DiskGrowRequest) GetDiskName() string {
 	if x != nil {
-		return x.Ifname
+		return x.DiskName
 	}
 	return ""
 }
 
-func (x *NetIfaceAttachRequest) GetDriver() v2.NetIfaceDriver {
+func (x *DiskGrowRequest) GetSize() uint64 {
 	if x != nil {
-		return x.Driver
+		return x.Size
 	}
-	return v2.NetIfaceDriver(0)
+	return 0
 }
 
-func (x *NetIfaceAttachRequest) GetHwAddr() string {
-	if x != nil {
-		return x.HwAddr
-	}
-	return ""
+type DiskGrowResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Size uint64 `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
 }
 
-func (x *NetIfaceAttachRequest) GetIfupScript() string {
-	if x != nil {
-		return x.IfupScript
+func (x *DiskGrowResponse) Reset() {
+	*x = DiskGrowResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_machines_v2_machines_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *NetIfaceAttachRequest) GetIfdownScript() string {
-	if x != nil {
-		return x.IfdownScript
-	}
-	return ""
+func (x *DiskGrowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *NetIfaceAttachRequest) GetQueues() uint32 {
-	if x != nil {
-		return x.Queues
+func (*DiskGrowResponse) ProtoMessage() {}
+
+func (x *DiskGrowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *NetIfaceAttachRequest) GetLive() bool {
+// Deprecated: Use DiskGrowResponse.ProtoReflect.Descriptor instead.
+func (*DiskGrowResponse) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *DiskGrowResponse) GetSize() uint64 {
 	if x != nil {
-		return x.Live
+		return x.Size
 	}
-	return false
+	return 0
 }
 
-type NetIfaceDetachRequest struct {
+type DiskSnapshotCreateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Ifname string `protobuf:"bytes,2,opt,name=ifname,proto3" json:"ifname,omitempty"`
-	Live   bool   `protobuf:"varint,100,opt,name=live,proto3" json:"live,omitempty"`
+	Name         string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	SnapshotName string   `protobuf:"bytes,2,opt,name=snapshot_name,json=snapshotName,proto3" json:"snapshot_name,omitempty"`
+	Disks        []string `protobuf:"bytes,3,rep,name=disks,proto3" json:"disks,omitempty"`
 }
 
-func (x *NetIfaceDetachRequest) Reset() {
-	*x = NetIfaceDetachRequest{}
+func (x *DiskSnapshotCreateRequest) Reset() {
+	*x = DiskSnapshotCreateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[41]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NetIfaceDetachRequest) String() string {
+func (x *DiskSnapshotCreateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NetIfaceDetachRequest) ProtoMessage() {}
+func (*DiskSnapshotCreateRequest) ProtoMessage() {}
 
-func (x *NetIfaceDetachRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[41]
+func (x *DiskSnapshotCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2552,59 +2779,57 @@ func (x *NetIfaceDetachRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NetIfaceDetachRequest.ProtoReflect.Descriptor instead.
-func (*NetIfaceDetachRequest) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use DiskSnapshotCreateRequest.ProtoReflect.Descriptor instead.
+func (*DiskSnapshotCreateRequest) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *NetIfaceDetachRequest) GetName() string {
+func (x *DiskSnapshotCreateRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *NetIfaceDetachRequest) GetIfname() string {
+func (x *DiskSnapshotCreateRequest) GetSnapshotName() string {
 	if x != nil {
-		return x.Ifname
+		return x.SnapshotName
 	}
 	return ""
 }
 
-func (x *NetIfaceDetachRequest) GetLive() bool {
+func (x *DiskSnapshotCreateRequest) GetDisks() []string {
 	if x != nil {
-		return x.Live
+		return x.Disks
 	}
-	return false
+	return nil
 }
 
-type NetIfaceSetScriptRequest struct {
+type DiskSnapshotListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Ifname string `protobuf:"bytes,2,opt,name=ifname,proto3" json:"ifname,omitempty"`
-	Path   string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *NetIfaceSetScriptRequest) Reset() {
-	*x = NetIfaceSetScriptRequest{}
+func (x *DiskSnapshotListRequest) Reset() {
+	*x = DiskSnapshotListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[42]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NetIfaceSetScriptRequest) String() string {
+func (x *DiskSnapshotListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NetIfaceSetScriptRequest) ProtoMessage() {}
+func (*DiskSnapshotListRequest) ProtoMessage() {}
 
-func (x *NetIfaceSetScriptRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[42]
+func (x *DiskSnapshotListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2615,59 +2840,43 @@ func (x *NetIfaceSetScriptRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NetIfaceSetScriptRequest.ProtoReflect.Descriptor instead.
-func (*NetIfaceSetScriptRequest) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use DiskSnapshotListRequest.ProtoReflect.Descriptor instead.
+func (*DiskSnapshotListRequest) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *NetIfaceSetScriptRequest) GetName() string {
+func (x *DiskSnapshotListRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *NetIfaceSetScriptRequest) GetIfname() string {
-	if x != nil {
-		return x.Ifname
-	}
-	return ""
-}
-
-func (x *NetIfaceSetScriptRequest) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
-}
-
-type NetIfaceSetLinkStateRequest struct {
+type DiskSnapshotListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name   string               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Ifname string               `protobuf:"bytes,2,opt,name=ifname,proto3" json:"ifname,omitempty"`
-	State  v2.NetIfaceLinkState `protobuf:"varint,3,opt,name=state,proto3,enum=kvmrun.api.types.v2.NetIfaceLinkState" json:"state,omitempty"`
+	Snapshots []*v2.DiskSnapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
 }
 
-func (x *NetIfaceSetLinkStateRequest) Reset() {
-	*x = NetIfaceSetLinkStateRequest{}
+func (x *DiskSnapshotListResponse) Reset() {
+	*x = DiskSnapshotListResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[43]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NetIfaceSetLinkStateRequest) String() string {
+func (x *DiskSnapshotListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NetIfaceSetLinkStateRequest) ProtoMessage() {}
+func (*DiskSnapshotListResponse) ProtoMessage() {}
 
-func (x *NetIfaceSetLinkStateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[43]
+func (x *DiskSnapshotListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2678,59 +2887,45 @@ func (x *NetIfaceSetLinkStateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NetIfaceSetLinkStateRequest.ProtoReflect.Descriptor instead.
-func (*NetIfaceSetLinkStateRequest) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{43}
-}
-
-func (x *NetIfaceSetLinkStateRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *NetIfaceSetLinkStateRequest) GetIfname() string {
-	if x != nil {
-		return x.Ifname
-	}
-	return ""
+// Deprecated: Use DiskSnapshotListResponse.ProtoReflect.Descriptor instead.
+func (*DiskSnapshotListResponse) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *NetIfaceSetLinkStateRequest) GetState() v2.NetIfaceLinkState {
+func (x *DiskSnapshotListResponse) GetSnapshots() []*v2.DiskSnapshot {
 	if x != nil {
-		return x.State
+		return x.Snapshots
 	}
-	return v2.NetIfaceLinkState(0)
+	return nil
 }
 
-type NetIfaceSetQueuesRequest struct {
+type DiskSnapshotDeleteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Ifname string `protobuf:"bytes,2,opt,name=ifname,proto3" json:"ifname,omitempty"`
-	Queues uint32 `protobuf:"varint,3,opt,name=queues,proto3" json:"queues,omitempty"`
+	Name         string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	SnapshotName string   `protobuf:"bytes,2,opt,name=snapshot_name,json=snapshotName,proto3" json:"snapshot_name,omitempty"`
+	Disks        []string `protobuf:"bytes,3,rep,name=disks,proto3" json:"disks,omitempty"`
 }
 
-func (x *NetIfaceSetQueuesRequest) Reset() {
-	*x = NetIfaceSetQueuesRequest{}
+func (x *DiskSnapshotDeleteRequest) Reset() {
+	*x = DiskSnapshotDeleteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[44]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NetIfaceSetQueuesRequest) String() string {
+func (x *DiskSnapshotDeleteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NetIfaceSetQueuesRequest) ProtoMessage() {}
+func (*DiskSnapshotDeleteRequest) ProtoMessage() {}
 
-func (x *NetIfaceSetQueuesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[44]
+func (x *DiskSnapshotDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2741,63 +2936,58 @@ func (x *NetIfaceSetQueuesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NetIfaceSetQueuesRequest.ProtoReflect.Descriptor instead.
-func (*NetIfaceSetQueuesRequest) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use DiskSnapshotDeleteRequest.ProtoReflect.Descriptor instead.
+func (*DiskSnapshotDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *NetIfaceSetQueuesRequest) GetName() string {
+func (x *DiskSnapshotDeleteRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *NetIfaceSetQueuesRequest) GetIfname() string {
+func (x *DiskSnapshotDeleteRequest) GetSnapshotName() string {
 	if x != nil {
-		return x.Ifname
+		return x.SnapshotName
 	}
 	return ""
 }
 
-func (x *NetIfaceSetQueuesRequest) GetQueues() uint32 {
+func (x *DiskSnapshotDeleteRequest) GetDisks() []string {
 	if x != nil {
-		return x.Queues
+		return x.Disks
 	}
-	return 0
+	return nil
 }
 
-type ChannelAttachRequest struct {
+type DiskSnapshotRevertRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Types that are assignable to Channel:
-	//
-	//	*ChannelAttachRequest_Vsock
-	//	*ChannelAttachRequest_SerialPort
-	Channel isChannelAttachRequest_Channel `protobuf_oneof:"channel"`
-	Live    bool                           `protobuf:"varint,100,opt,name=live,proto3" json:"live,omitempty"`
+	Name         string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	SnapshotName string `protobuf:"bytes,2,opt,name=snapshot_name,json=snapshotName,proto3" json:"snapshot_name,omitempty"`
 }
 
-func (x *ChannelAttachRequest) Reset() {
-	*x = ChannelAttachRequest{}
+func (x *DiskSnapshotRevertRequest) Reset() {
+	*x = DiskSnapshotRevertRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[45]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChannelAttachRequest) String() string {
+func (x *DiskSnapshotRevertRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelAttachRequest) ProtoMessage() {}
+func (*DiskSnapshotRevertRequest) ProtoMessage() {}
 
-func (x *ChannelAttachRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[45]
+func (x *DiskSnapshotRevertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2808,93 +2998,57 @@ func (x *ChannelAttachRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelAttachRequest.ProtoReflect.Descriptor instead.
-func (*ChannelAttachRequest) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use DiskSnapshotRevertRequest.ProtoReflect.Descriptor instead.
+func (*DiskSnapshotRevertRequest) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *ChannelAttachRequest) GetName() string {
+func (x *DiskSnapshotRevertRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (m *ChannelAttachRequest) GetChannel() isChannelAttachRequest_Channel {
-	if m != nil {
-		return m.Channel
-	}
-	return nil
-}
-
-func (x *ChannelAttachRequest) GetVsock() *ChannelAttachRequest_VirtioVSock {
-	if x, ok := x.GetChannel().(*ChannelAttachRequest_Vsock); ok {
-		return x.Vsock
-	}
-	return nil
-}
-
-func (x *ChannelAttachRequest) GetSerialPort() *ChannelAttachRequest_VirtioSerialPort {
-	if x, ok := x.GetChannel().(*ChannelAttachRequest_SerialPort); ok {
-		return x.SerialPort
-	}
-	return nil
-}
-
-func (x *ChannelAttachRequest) GetLive() bool {
+func (x *DiskSnapshotRevertRequest) GetSnapshotName() string {
 	if x != nil {
-		return x.Live
+		return x.SnapshotName
 	}
-	return false
-}
-
-type isChannelAttachRequest_Channel interface {
-	isChannelAttachRequest_Channel()
-}
-
-type ChannelAttachRequest_Vsock struct {
-	Vsock *ChannelAttachRequest_VirtioVSock `protobuf:"bytes,2,opt,name=vsock,proto3,oneof"`
-}
-
-type ChannelAttachRequest_SerialPort struct {
-	SerialPort *ChannelAttachRequest_VirtioSerialPort `protobuf:"bytes,3,opt,name=serial_port,json=serialPort,proto3,oneof"`
+	return ""
 }
 
-func (*ChannelAttachRequest_Vsock) isChannelAttachRequest_Channel() {}
-
-func (*ChannelAttachRequest_SerialPort) isChannelAttachRequest_Channel() {}
-
-type ChannelDetachRequest struct {
+type NetIfaceAttachRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Types that are assignable to Channel:
-	//
-	//	*ChannelDetachRequest_Vsock
-	//	*ChannelDetachRequest_SerialPort
-	Channel isChannelDetachRequest_Channel `protobuf_oneof:"channel"`
-	Live    bool                           `protobuf:"varint,100,opt,name=live,proto3" json:"live,omitempty"`
+	Name         string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Ifname       string            `protobuf:"bytes,2,opt,name=ifname,proto3" json:"ifname,omitempty"`
+	Driver       v2.NetIfaceDriver `protobuf:"varint,3,opt,name=driver,proto3,enum=kvmrun.api.types.v2.NetIfaceDriver" json:"driver,omitempty"`
+	HwAddr       string            `protobuf:"bytes,4,opt,name=hw_addr,json=hwAddr,proto3" json:"hw_addr,omitempty"`
+	IfupScript   string            `protobuf:"bytes,5,opt,name=ifup_script,json=ifupScript,proto3" json:"ifup_script,omitempty"`
+	IfdownScript string            `protobuf:"bytes,6,opt,name=ifdown_script,json=ifdownScript,proto3" json:"ifdown_script,omitempty"`
+	Queues       uint32            `protobuf:"varint,7,opt,name=queues,proto3" json:"queues,omitempty"`
+	Live         bool              `protobuf:"varint,100,opt,name=live,proto3" json:"live,omitempty"`
 }
 
-func (x *ChannelDetachRequest) Reset() {
-	*x = ChannelDetachRequest{}
+func (x *NetIfaceAttachRequest) Reset() {
+	*x = NetIfaceAttachRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[46]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChannelDetachRequest) String() string {
+func (x *NetIfaceAttachRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelDetachRequest) ProtoMessage() {}
+func (*NetIfaceAttachRequest) ProtoMessage() {}
 
-func (x *ChannelDetachRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[46]
+func (x *NetIfaceAttachRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2905,89 +3059,94 @@ func (x *ChannelDetachRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelDetachRequest.ProtoReflect.Descriptor instead.
-func (*ChannelDetachRequest) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use NetIfaceAttachRequest.ProtoReflect.Descriptor instead.
+func (*NetIfaceAttachRequest) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *ChannelDetachRequest) GetName() string {
+func (x *NetIfaceAttachRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (m *ChannelDetachRequest) GetChannel() isChannelDetachRequest_Channel {
-	if m != nil {
-		return m.Channel
+func (x *NetIfaceAttachRequest) GetIfname() string {
+	if x != nil {
+		return x.Ifname
 	}
-	return nil
+	return ""
 }
 
-func (x *ChannelDetachRequest) GetVsock() *ChannelDetachRequest_VirtioVSock {
-	if x, ok := x.GetChannel().(*ChannelDetachRequest_Vsock); ok {
-		return x.Vsock
+func (x *NetIfaceAttachRequest) GetDriver() v2.NetIfaceDriver {
+	if x != nil {
+		return x.Driver
 	}
-	return nil
+	return v2.NetIfaceDriver(0)
 }
 
-func (x *ChannelDetachRequest) GetSerialPort() *ChannelDetachRequest_VirtioSerialPort {
-	if x, ok := x.GetChannel().(*ChannelDetachRequest_SerialPort); ok {
-		return x.SerialPort
+func (x *NetIfaceAttachRequest) GetHwAddr() string {
+	if x != nil {
+		return x.HwAddr
 	}
-	return nil
+	return ""
 }
 
-func (x *ChannelDetachRequest) GetLive() bool {
+func (x *NetIfaceAttachRequest) GetIfupScript() string {
 	if x != nil {
-		return x.Live
+		return x.IfupScript
 	}
-	return false
+	return ""
 }
 
-type isChannelDetachRequest_Channel interface {
-	isChannelDetachRequest_Channel()
+func (x *NetIfaceAttachRequest) GetIfdownScript() string {
+	if x != nil {
+		return x.IfdownScript
+	}
+	return ""
 }
 
-type ChannelDetachRequest_Vsock struct {
-	Vsock *ChannelDetachRequest_VirtioVSock `protobuf:"bytes,2,opt,name=vsock,proto3,oneof"`
+func (x *NetIfaceAttachRequest) GetQueues() uint32 {
+	if x != nil {
+		return x.Queues
+	}
+	return 0
 }
 
-type ChannelDetachRequest_SerialPort struct {
-	SerialPort *ChannelDetachRequest_VirtioSerialPort `protobuf:"bytes,3,opt,name=serial_port,json=serialPort,proto3,oneof"`
+func (x *NetIfaceAttachRequest) GetLive() bool {
+	if x != nil {
+		return x.Live
+	}
+	return false
 }
 
-func (*ChannelDetachRequest_Vsock) isChannelDetachRequest_Channel() {}
-
-func (*ChannelDetachRequest_SerialPort) isChannelDetachRequest_Channel() {}
-
-type CloudInitDriveAttachRequest struct {
+type NetIfaceDetachRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name   string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Media  string             `protobuf:"bytes,2,opt,name=media,proto3" json:"media,omitempty"`
-	Driver v2.CloudInitDriver `protobuf:"varint,3,opt,name=driver,proto3,enum=kvmrun.api.types.v2.CloudInitDriver" json:"driver,omitempty"`
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Ifname string `protobuf:"bytes,2,opt,name=ifname,proto3" json:"ifname,omitempty"`
+	Live   bool   `protobuf:"varint,100,opt,name=live,proto3" json:"live,omitempty"`
 }
 
-func (x *CloudInitDriveAttachRequest) Reset() {
-	*x = CloudInitDriveAttachRequest{}
+func (x *NetIfaceDetachRequest) Reset() {
+	*x = NetIfaceDetachRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[47]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CloudInitDriveAttachRequest) String() string {
+func (x *NetIfaceDetachRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloudInitDriveAttachRequest) ProtoMessage() {}
+func (*NetIfaceDetachRequest) ProtoMessage() {}
 
-func (x *CloudInitDriveAttachRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[47]
+func (x *NetIfaceDetachRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2998,57 +3157,59 @@ func (x *CloudInitDriveAttachRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloudInitDriveAttachRequest.ProtoReflect.Descriptor instead.
-func (*CloudInitDriveAttachRequest) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use NetIfaceDetachRequest.ProtoReflect.Descriptor instead.
+func (*NetIfaceDetachRequest) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *CloudInitDriveAttachRequest) GetName() string {
+func (x *NetIfaceDetachRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *CloudInitDriveAttachRequest) GetMedia() string {
+func (x *NetIfaceDetachRequest) GetIfname() string {
 	if x != nil {
-		return x.Media
+		return x.Ifname
 	}
 	return ""
 }
 
-func (x *CloudInitDriveAttachRequest) GetDriver() v2.CloudInitDriver {
+func (x *NetIfaceDetachRequest) GetLive() bool {
 	if x != nil {
-		return x.Driver
+		return x.Live
 	}
-	return v2.CloudInitDriver(0)
+	return false
 }
 
-type CloudInitDriveDetachRequest struct {
+type NetIfaceSetScriptRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Ifname string `protobuf:"bytes,2,opt,name=ifname,proto3" json:"ifname,omitempty"`
+	Path   string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
 }
 
-func (x *CloudInitDriveDetachRequest) Reset() {
-	*x = CloudInitDriveDetachRequest{}
+func (x *NetIfaceSetScriptRequest) Reset() {
+	*x = NetIfaceSetScriptRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[48]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CloudInitDriveDetachRequest) String() string {
+func (x *NetIfaceSetScriptRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloudInitDriveDetachRequest) ProtoMessage() {}
+func (*NetIfaceSetScriptRequest) ProtoMessage() {}
 
-func (x *CloudInitDriveDetachRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[48]
+func (x *NetIfaceSetScriptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3059,45 +3220,59 @@ func (x *CloudInitDriveDetachRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloudInitDriveDetachRequest.ProtoReflect.Descriptor instead.
-func (*CloudInitDriveDetachRequest) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use NetIfaceSetScriptRequest.ProtoReflect.Descriptor instead.
+func (*NetIfaceSetScriptRequest) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *CloudInitDriveDetachRequest) GetName() string {
+func (x *NetIfaceSetScriptRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-type CloudInitDriveChangeMediaRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Media string `protobuf:"bytes,2,opt,name=media,proto3" json:"media,omitempty"`
-	Live  bool   `protobuf:"varint,100,opt,name=live,proto3" json:"live,omitempty"`
+func (x *NetIfaceSetScriptRequest) GetIfname() string {
+	if x != nil {
+		return x.Ifname
+	}
+	return ""
 }
 
-func (x *CloudInitDriveChangeMediaRequest) Reset() {
-	*x = CloudInitDriveChangeMediaRequest{}
+func (x *NetIfaceSetScriptRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type NetIfaceSetLinkStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Ifname string               `protobuf:"bytes,2,opt,name=ifname,proto3" json:"ifname,omitempty"`
+	State  v2.NetIfaceLinkState `protobuf:"varint,3,opt,name=state,proto3,enum=kvmrun.api.types.v2.NetIfaceLinkState" json:"state,omitempty"`
+}
+
+func (x *NetIfaceSetLinkStateRequest) Reset() {
+	*x = NetIfaceSetLinkStateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[49]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CloudInitDriveChangeMediaRequest) String() string {
+func (x *NetIfaceSetLinkStateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloudInitDriveChangeMediaRequest) ProtoMessage() {}
+func (*NetIfaceSetLinkStateRequest) ProtoMessage() {}
 
-func (x *CloudInitDriveChangeMediaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[49]
+func (x *NetIfaceSetLinkStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3108,61 +3283,59 @@ func (x *CloudInitDriveChangeMediaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloudInitDriveChangeMediaRequest.ProtoReflect.Descriptor instead.
-func (*CloudInitDriveChangeMediaRequest) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use NetIfaceSetLinkStateRequest.ProtoReflect.Descriptor instead.
+func (*NetIfaceSetLinkStateRequest) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *CloudInitDriveChangeMediaRequest) GetName() string {
+func (x *NetIfaceSetLinkStateRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *CloudInitDriveChangeMediaRequest) GetMedia() string {
+func (x *NetIfaceSetLinkStateRequest) GetIfname() string {
 	if x != nil {
-		return x.Media
+		return x.Ifname
 	}
 	return ""
 }
 
-func (x *CloudInitDriveChangeMediaRequest) GetLive() bool {
+func (x *NetIfaceSetLinkStateRequest) GetState() v2.NetIfaceLinkState {
 	if x != nil {
-		return x.Live
+		return x.State
 	}
-	return false
+	return v2.NetIfaceLinkState(0)
 }
 
-type StartDiskBackupRequest struct {
+type NetIfaceSetQueuesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	DiskName    string `protobuf:"bytes,2,opt,name=disk_name,json=diskName,proto3" json:"disk_name,omitempty"`
-	Target      string `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
-	Incremental bool   `protobuf:"varint,4,opt,name=incremental,proto3" json:"incremental,omitempty"`
-	ClearBitmap bool   `protobuf:"varint,5,opt,name=clear_bitmap,json=clearBitmap,proto3" json:"clear_bitmap,omitempty"`
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Ifname string `protobuf:"bytes,2,opt,name=ifname,proto3" json:"ifname,omitempty"`
+	Queues uint32 `protobuf:"varint,3,opt,name=queues,proto3" json:"queues,omitempty"`
 }
 
-func (x *StartDiskBackupRequest) Reset() {
-	*x = StartDiskBackupRequest{}
+func (x *NetIfaceSetQueuesRequest) Reset() {
+	*x = NetIfaceSetQueuesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[50]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StartDiskBackupRequest) String() string {
+func (x *NetIfaceSetQueuesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartDiskBackupRequest) ProtoMessage() {}
+func (*NetIfaceSetQueuesRequest) ProtoMessage() {}
 
-func (x *StartDiskBackupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[50]
+func (x *NetIfaceSetQueuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3173,71 +3346,63 @@ func (x *StartDiskBackupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartDiskBackupRequest.ProtoReflect.Descriptor instead.
-func (*StartDiskBackupRequest) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use NetIfaceSetQueuesRequest.ProtoReflect.Descriptor instead.
+func (*NetIfaceSetQueuesRequest) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *StartDiskBackupRequest) GetName() string {
+func (x *NetIfaceSetQueuesRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *StartDiskBackupRequest) GetDiskName() string {
-	if x != nil {
-		return x.DiskName
-	}
-	return ""
-}
-
-func (x *StartDiskBackupRequest) GetTarget() string {
+func (x *NetIfaceSetQueuesRequest) GetIfname() string {
 	if x != nil {
-		return x.Target
+		return x.Ifname
 	}
 	return ""
 }
 
-func (x *StartDiskBackupRequest) GetIncremental() bool {
-	if x != nil {
-		return x.Incremental
-	}
-	return false
-}
-
-func (x *StartDiskBackupRequest) GetClearBitmap() bool {
+func (x *NetIfaceSetQueuesRequest) GetQueues() uint32 {
 	if x != nil {
-		return x.ClearBitmap
+		return x.Queues
 	}
-	return false
+	return 0
 }
 
-type StartDiskBackupResponse struct {
+type ChannelAttachRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TaskKey string `protobuf:"bytes,1,opt,name=task_key,json=taskKey,proto3" json:"task_key,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Types that are assignable to Channel:
+	//
+	//	*ChannelAttachRequest_Vsock
+	//	*ChannelAttachRequest_SerialPort
+	Channel isChannelAttachRequest_Channel `protobuf_oneof:"channel"`
+	Live    bool                           `protobuf:"varint,100,opt,name=live,proto3" json:"live,omitempty"`
 }
 
-func (x *StartDiskBackupResponse) Reset() {
-	*x = StartDiskBackupResponse{}
+func (x *ChannelAttachRequest) Reset() {
+	*x = ChannelAttachRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[51]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StartDiskBackupResponse) String() string {
+func (x *ChannelAttachRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartDiskBackupResponse) ProtoMessage() {}
+func (*ChannelAttachRequest) ProtoMessage() {}
 
-func (x *StartDiskBackupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[51]
+func (x *ChannelAttachRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3248,48 +3413,93 @@ func (x *StartDiskBackupResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartDiskBackupResponse.ProtoReflect.Descriptor instead.
-func (*StartDiskBackupResponse) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use ChannelAttachRequest.ProtoReflect.Descriptor instead.
+func (*ChannelAttachRequest) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *StartDiskBackupResponse) GetTaskKey() string {
+func (x *ChannelAttachRequest) GetName() string {
 	if x != nil {
-		return x.TaskKey
+		return x.Name
 	}
 	return ""
 }
 
-type StartMigrationRequest struct {
+func (m *ChannelAttachRequest) GetChannel() isChannelAttachRequest_Channel {
+	if m != nil {
+		return m.Channel
+	}
+	return nil
+}
+
+func (x *ChannelAttachRequest) GetVsock() *ChannelAttachRequest_VirtioVSock {
+	if x, ok := x.GetChannel().(*ChannelAttachRequest_Vsock); ok {
+		return x.Vsock
+	}
+	return nil
+}
+
+func (x *ChannelAttachRequest) GetSerialPort() *ChannelAttachRequest_VirtioSerialPort {
+	if x, ok := x.GetChannel().(*ChannelAttachRequest_SerialPort); ok {
+		return x.SerialPort
+	}
+	return nil
+}
+
+func (x *ChannelAttachRequest) GetLive() bool {
+	if x != nil {
+		return x.Live
+	}
+	return false
+}
+
+type isChannelAttachRequest_Channel interface {
+	isChannelAttachRequest_Channel()
+}
+
+type ChannelAttachRequest_Vsock struct {
+	Vsock *ChannelAttachRequest_VirtioVSock `protobuf:"bytes,2,opt,name=vsock,proto3,oneof"`
+}
+
+type ChannelAttachRequest_SerialPort struct {
+	SerialPort *ChannelAttachRequest_VirtioSerialPort `protobuf:"bytes,3,opt,name=serial_port,json=serialPort,proto3,oneof"`
+}
+
+func (*ChannelAttachRequest_Vsock) isChannelAttachRequest_Channel() {}
+
+func (*ChannelAttachRequest_SerialPort) isChannelAttachRequest_Channel() {}
+
+type ChannelDetachRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	DstServer   string                 `protobuf:"bytes,2,opt,name=dst_server,json=dstServer,proto3" json:"dst_server,omitempty"`
-	Disks       []string               `protobuf:"bytes,3,rep,name=disks,proto3" json:"disks,omitempty"`
-	Overrides   *v2.MigrationOverrides `protobuf:"bytes,4,opt,name=overrides,proto3" json:"overrides,omitempty"`
-	CreateDisks bool                   `protobuf:"varint,5,opt,name=create_disks,json=createDisks,proto3" json:"create_disks,omitempty"`
-	RemoveAfter bool                   `protobuf:"varint,6,opt,name=remove_after,json=removeAfter,proto3" json:"remove_after,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Types that are assignable to Channel:
+	//
+	//	*ChannelDetachRequest_Vsock
+	//	*ChannelDetachRequest_SerialPort
+	Channel isChannelDetachRequest_Channel `protobuf_oneof:"channel"`
+	Live    bool                           `protobuf:"varint,100,opt,name=live,proto3" json:"live,omitempty"`
 }
 
-func (x *StartMigrationRequest) Reset() {
-	*x = StartMigrationRequest{}
+func (x *ChannelDetachRequest) Reset() {
+	*x = ChannelDetachRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[52]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StartMigrationRequest) String() string {
+func (x *ChannelDetachRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartMigrationRequest) ProtoMessage() {}
+func (*ChannelDetachRequest) ProtoMessage() {}
 
-func (x *StartMigrationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[52]
+func (x *ChannelDetachRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3300,78 +3510,89 @@ func (x *StartMigrationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartMigrationRequest.ProtoReflect.Descriptor instead.
-func (*StartMigrationRequest) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use ChannelDetachRequest.ProtoReflect.Descriptor instead.
+func (*ChannelDetachRequest) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *StartMigrationRequest) GetName() string {
+func (x *ChannelDetachRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *StartMigrationRequest) GetDstServer() string {
-	if x != nil {
-		return x.DstServer
+func (m *ChannelDetachRequest) GetChannel() isChannelDetachRequest_Channel {
+	if m != nil {
+		return m.Channel
 	}
-	return ""
+	return nil
 }
 
-func (x *StartMigrationRequest) GetDisks() []string {
-	if x != nil {
-		return x.Disks
+func (x *ChannelDetachRequest) GetVsock() *ChannelDetachRequest_VirtioVSock {
+	if x, ok := x.GetChannel().(*ChannelDetachRequest_Vsock); ok {
+		return x.Vsock
 	}
 	return nil
 }
 
-func (x *StartMigrationRequest) GetOverrides() *v2.MigrationOverrides {
-	if x != nil {
-		return x.Overrides
+func (x *ChannelDetachRequest) GetSerialPort() *ChannelDetachRequest_VirtioSerialPort {
+	if x, ok := x.GetChannel().(*ChannelDetachRequest_SerialPort); ok {
+		return x.SerialPort
 	}
 	return nil
 }
 
-func (x *StartMigrationRequest) GetCreateDisks() bool {
+func (x *ChannelDetachRequest) GetLive() bool {
 	if x != nil {
-		return x.CreateDisks
+		return x.Live
 	}
 	return false
 }
 
-func (x *StartMigrationRequest) GetRemoveAfter() bool {
-	if x != nil {
-		return x.RemoveAfter
-	}
-	return false
+type isChannelDetachRequest_Channel interface {
+	isChannelDetachRequest_Channel()
 }
 
-type StartMigrationResponse struct {
-	state         protoimpl.MessageState
+type ChannelDetachRequest_Vsock struct {
+	Vsock *ChannelDetachRequest_VirtioVSock `protobuf:"bytes,2,opt,name=vsock,proto3,oneof"`
+}
+
+type ChannelDetachRequest_SerialPort struct {
+	SerialPort *ChannelDetachRequest_VirtioSerialPort `protobuf:"bytes,3,opt,name=serial_port,json=serialPort,proto3,oneof"`
+}
+
+func (*ChannelDetachRequest_Vsock) isChannelDetachRequest_Channel() {}
+
+func (*ChannelDetachRequest_SerialPort) isChannelDetachRequest_Channel() {}
+
+type CloudInitDriveAttachRequest struct {
+	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TaskKey string `protobuf:"bytes,1,opt,name=task_key,json=taskKey,proto3" json:"task_key,omitempty"`
+	Name   string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Media  string             `protobuf:"bytes,2,opt,name=media,proto3" json:"media,omitempty"`
+	Driver v2.CloudInitDriver `protobuf:"varint,3,opt,name=driver,proto3,enum=kvmrun.api.types.v2.CloudInitDriver" json:"driver,omitempty"`
 }
 
-func (x *StartMigrationResponse) Reset() {
-	*x = StartMigrationResponse{}
+func (x *CloudInitDriveAttachRequest) Reset() {
+	*x = CloudInitDriveAttachRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[53]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StartMigrationResponse) String() string {
+func (x *CloudInitDriveAttachRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartMigrationResponse) ProtoMessage() {}
+func (*CloudInitDriveAttachRequest) ProtoMessage() {}
 
-func (x *StartMigrationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[53]
+func (x *CloudInitDriveAttachRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3382,47 +3603,57 @@ func (x *StartMigrationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartMigrationResponse.ProtoReflect.Descriptor instead.
-func (*StartMigrationResponse) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use CloudInitDriveAttachRequest.ProtoReflect.Descriptor instead.
+func (*CloudInitDriveAttachRequest) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *StartMigrationResponse) GetTaskKey() string {
+func (x *CloudInitDriveAttachRequest) GetName() string {
 	if x != nil {
-		return x.TaskKey
+		return x.Name
 	}
 	return ""
 }
 
-type ExternalKernelSetRequest struct {
+func (x *CloudInitDriveAttachRequest) GetMedia() string {
+	if x != nil {
+		return x.Media
+	}
+	return ""
+}
+
+func (x *CloudInitDriveAttachRequest) GetDriver() v2.CloudInitDriver {
+	if x != nil {
+		return x.Driver
+	}
+	return v2.CloudInitDriver(0)
+}
+
+type CloudInitDriveDetachRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Image   string `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
-	Initrd  string `protobuf:"bytes,3,opt,name=initrd,proto3" json:"initrd,omitempty"`
-	Cmdline string `protobuf:"bytes,4,opt,name=cmdline,proto3" json:"cmdline,omitempty"`
-	Modiso  string `protobuf:"bytes,5,opt,name=modiso,proto3" json:"modiso,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *ExternalKernelSetRequest) Reset() {
-	*x = ExternalKernelSetRequest{}
+func (x *CloudInitDriveDetachRequest) Reset() {
+	*x = CloudInitDriveDetachRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[54]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExternalKernelSetRequest) String() string {
+func (x *CloudInitDriveDetachRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExternalKernelSetRequest) ProtoMessage() {}
+func (*CloudInitDriveDetachRequest) ProtoMessage() {}
 
-func (x *ExternalKernelSetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[54]
+func (x *CloudInitDriveDetachRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3433,71 +3664,113 @@ func (x *ExternalKernelSetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExternalKernelSetRequest.ProtoReflect.Descriptor instead.
-func (*ExternalKernelSetRequest) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use CloudInitDriveDetachRequest.ProtoReflect.Descriptor instead.
+func (*CloudInitDriveDetachRequest) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *ExternalKernelSetRequest) GetName() string {
+func (x *CloudInitDriveDetachRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *ExternalKernelSetRequest) GetImage() string {
-	if x != nil {
-		return x.Image
+type CloudInitDriveChangeMediaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Media string `protobuf:"bytes,2,opt,name=media,proto3" json:"media,omitempty"`
+	Live  bool   `protobuf:"varint,100,opt,name=live,proto3" json:"live,omitempty"`
+}
+
+func (x *CloudInitDriveChangeMediaRequest) Reset() {
+	*x = CloudInitDriveChangeMediaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_machines_v2_machines_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *ExternalKernelSetRequest) GetInitrd() string {
+func (x *CloudInitDriveChangeMediaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloudInitDriveChangeMediaRequest) ProtoMessage() {}
+
+func (x *CloudInitDriveChangeMediaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloudInitDriveChangeMediaRequest.ProtoReflect.Descriptor instead.
+func (*CloudInitDriveChangeMediaRequest) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *CloudInitDriveChangeMediaRequest) GetName() string {
 	if x != nil {
-		return x.Initrd
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ExternalKernelSetRequest) GetCmdline() string {
+func (x *CloudInitDriveChangeMediaRequest) GetMedia() string {
 	if x != nil {
-		return x.Cmdline
+		return x.Media
 	}
 	return ""
 }
 
-func (x *ExternalKernelSetRequest) GetModiso() string {
+func (x *CloudInitDriveChangeMediaRequest) GetLive() bool {
 	if x != nil {
-		return x.Modiso
+		return x.Live
 	}
-	return ""
+	return false
 }
 
-type ExternalKernelRemoveRequest struct {
+type StartDiskBackupRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DiskName    string `protobuf:"bytes,2,opt,name=disk_name,json=diskName,proto3" json:"disk_name,omitempty"`
+	Target      string `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	Incremental bool   `protobuf:"varint,4,opt,name=incremental,proto3" json:"incremental,omitempty"`
+	ClearBitmap bool   `protobuf:"varint,5,opt,name=clear_bitmap,json=clearBitmap,proto3" json:"clear_bitmap,omitempty"`
+	FsFreeze    bool   `protobuf:"varint,6,opt,name=fs_freeze,json=fsFreeze,proto3" json:"fs_freeze,omitempty"`
+	// bytes per second, 0 means unlimited
+	Speed uint64 `protobuf:"varint,7,opt,name=speed,proto3" json:"speed,omitempty"`
 }
 
-func (x *ExternalKernelRemoveRequest) Reset() {
-	*x = ExternalKernelRemoveRequest{}
+func (x *StartDiskBackupRequest) Reset() {
+	*x = StartDiskBackupRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[55]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExternalKernelRemoveRequest) String() string {
+func (x *StartDiskBackupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExternalKernelRemoveRequest) ProtoMessage() {}
+func (*StartDiskBackupRequest) ProtoMessage() {}
 
-func (x *ExternalKernelRemoveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[55]
+func (x *StartDiskBackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3508,43 +3781,85 @@ func (x *ExternalKernelRemoveRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExternalKernelRemoveRequest.ProtoReflect.Descriptor instead.
-func (*ExternalKernelRemoveRequest) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use StartDiskBackupRequest.ProtoReflect.Descriptor instead.
+func (*StartDiskBackupRequest) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *ExternalKernelRemoveRequest) GetName() string {
+func (x *StartDiskBackupRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-type ChannelAttachRequest_VirtioVSock struct {
+func (x *StartDiskBackupRequest) GetDiskName() string {
+	if x != nil {
+		return x.DiskName
+	}
+	return ""
+}
+
+func (x *StartDiskBackupRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *StartDiskBackupRequest) GetIncremental() bool {
+	if x != nil {
+		return x.Incremental
+	}
+	return false
+}
+
+func (x *StartDiskBackupRequest) GetClearBitmap() bool {
+	if x != nil {
+		return x.ClearBitmap
+	}
+	return false
+}
+
+func (x *StartDiskBackupRequest) GetFsFreeze() bool {
+	if x != nil {
+		return x.FsFreeze
+	}
+	return false
+}
+
+func (x *StartDiskBackupRequest) GetSpeed() uint64 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+type StartDiskBackupResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ContextID uint32 `protobuf:"varint,1,opt,name=context_id,json=contextId,proto3" json:"context_id,omitempty"`
+	TaskKey string `protobuf:"bytes,1,opt,name=task_key,json=taskKey,proto3" json:"task_key,omitempty"`
 }
 
-func (x *ChannelAttachRequest_VirtioVSock) Reset() {
-	*x = ChannelAttachRequest_VirtioVSock{}
+func (x *StartDiskBackupResponse) Reset() {
+	*x = StartDiskBackupResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[57]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChannelAttachRequest_VirtioVSock) String() string {
+func (x *StartDiskBackupResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelAttachRequest_VirtioVSock) ProtoMessage() {}
+func (*StartDiskBackupResponse) ProtoMessage() {}
 
-func (x *ChannelAttachRequest_VirtioVSock) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[57]
+func (x *StartDiskBackupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3555,44 +3870,49 @@ func (x *ChannelAttachRequest_VirtioVSock) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelAttachRequest_VirtioVSock.ProtoReflect.Descriptor instead.
-func (*ChannelAttachRequest_VirtioVSock) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{45, 0}
+// Deprecated: Use StartDiskBackupResponse.ProtoReflect.Descriptor instead.
+func (*StartDiskBackupResponse) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *ChannelAttachRequest_VirtioVSock) GetContextID() uint32 {
+func (x *StartDiskBackupResponse) GetTaskKey() string {
 	if x != nil {
-		return x.ContextID
+		return x.TaskKey
 	}
-	return 0
+	return ""
 }
 
-type ChannelAttachRequest_VirtioSerialPort struct {
+type StartMachineBackupRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PortID        string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
-	PortGuestName string `protobuf:"bytes,2,opt,name=port_guest_name,json=portGuestName,proto3" json:"port_guest_name,omitempty"`
+	Name        string                              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Targets     []*StartMachineBackupRequest_Target `protobuf:"bytes,2,rep,name=targets,proto3" json:"targets,omitempty"`
+	Incremental bool                                `protobuf:"varint,3,opt,name=incremental,proto3" json:"incremental,omitempty"`
+	ClearBitmap bool                                `protobuf:"varint,4,opt,name=clear_bitmap,json=clearBitmap,proto3" json:"clear_bitmap,omitempty"`
+	FsFreeze    bool                                `protobuf:"varint,5,opt,name=fs_freeze,json=fsFreeze,proto3" json:"fs_freeze,omitempty"`
+	// bytes per second for each disk, 0 means unlimited
+	Speed uint64 `protobuf:"varint,6,opt,name=speed,proto3" json:"speed,omitempty"`
 }
 
-func (x *ChannelAttachRequest_VirtioSerialPort) Reset() {
-	*x = ChannelAttachRequest_VirtioSerialPort{}
+func (x *StartMachineBackupRequest) Reset() {
+	*x = StartMachineBackupRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[58]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChannelAttachRequest_VirtioSerialPort) String() string {
+func (x *StartMachineBackupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelAttachRequest_VirtioSerialPort) ProtoMessage() {}
+func (*StartMachineBackupRequest) ProtoMessage() {}
 
-func (x *ChannelAttachRequest_VirtioSerialPort) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[58]
+func (x *StartMachineBackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3603,48 +3923,78 @@ func (x *ChannelAttachRequest_VirtioSerialPort) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelAttachRequest_VirtioSerialPort.ProtoReflect.Descriptor instead.
-func (*ChannelAttachRequest_VirtioSerialPort) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{45, 1}
+// Deprecated: Use StartMachineBackupRequest.ProtoReflect.Descriptor instead.
+func (*StartMachineBackupRequest) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *ChannelAttachRequest_VirtioSerialPort) GetPortID() string {
+func (x *StartMachineBackupRequest) GetName() string {
 	if x != nil {
-		return x.PortID
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ChannelAttachRequest_VirtioSerialPort) GetPortGuestName() string {
+func (x *StartMachineBackupRequest) GetTargets() []*StartMachineBackupRequest_Target {
 	if x != nil {
-		return x.PortGuestName
+		return x.Targets
 	}
-	return ""
-}
-
-type ChannelDetachRequest_VirtioVSock struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+	return nil
 }
 
-func (x *ChannelDetachRequest_VirtioVSock) Reset() {
-	*x = ChannelDetachRequest_VirtioVSock{}
+func (x *StartMachineBackupRequest) GetIncremental() bool {
+	if x != nil {
+		return x.Incremental
+	}
+	return false
+}
+
+func (x *StartMachineBackupRequest) GetClearBitmap() bool {
+	if x != nil {
+		return x.ClearBitmap
+	}
+	return false
+}
+
+func (x *StartMachineBackupRequest) GetFsFreeze() bool {
+	if x != nil {
+		return x.FsFreeze
+	}
+	return false
+}
+
+func (x *StartMachineBackupRequest) GetSpeed() uint64 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+type StartMachineBackupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TaskKey string `protobuf:"bytes,1,opt,name=task_key,json=taskKey,proto3" json:"task_key,omitempty"`
+}
+
+func (x *StartMachineBackupResponse) Reset() {
+	*x = StartMachineBackupResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[59]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChannelDetachRequest_VirtioVSock) String() string {
+func (x *StartMachineBackupResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelDetachRequest_VirtioVSock) ProtoMessage() {}
+func (*StartMachineBackupResponse) ProtoMessage() {}
 
-func (x *ChannelDetachRequest_VirtioVSock) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[59]
+func (x *StartMachineBackupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3655,36 +4005,49 @@ func (x *ChannelDetachRequest_VirtioVSock) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelDetachRequest_VirtioVSock.ProtoReflect.Descriptor instead.
-func (*ChannelDetachRequest_VirtioVSock) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{46, 0}
+// Deprecated: Use StartMachineBackupResponse.ProtoReflect.Descriptor instead.
+func (*StartMachineBackupResponse) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{64}
 }
 
-type ChannelDetachRequest_VirtioSerialPort struct {
+func (x *StartMachineBackupResponse) GetTaskKey() string {
+	if x != nil {
+		return x.TaskKey
+	}
+	return ""
+}
+
+type StartBackupExportRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PortID string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	Name        string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Disks       []string `protobuf:"bytes,2,rep,name=disks,proto3" json:"disks,omitempty"`
+	ListenAddr  string   `protobuf:"bytes,3,opt,name=listen_addr,json=listenAddr,proto3" json:"listen_addr,omitempty"`
+	Tls         bool     `protobuf:"varint,4,opt,name=tls,proto3" json:"tls,omitempty"`
+	Incremental bool     `protobuf:"varint,5,opt,name=incremental,proto3" json:"incremental,omitempty"`
+	FsFreeze    bool     `protobuf:"varint,6,opt,name=fs_freeze,json=fsFreeze,proto3" json:"fs_freeze,omitempty"`
+	Timeout     uint32   `protobuf:"varint,7,opt,name=timeout,proto3" json:"timeout,omitempty"`
 }
 
-func (x *ChannelDetachRequest_VirtioSerialPort) Reset() {
-	*x = ChannelDetachRequest_VirtioSerialPort{}
+func (x *StartBackupExportRequest) Reset() {
+	*x = StartBackupExportRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_machines_v2_machines_proto_msgTypes[60]
+		mi := &file_services_machines_v2_machines_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChannelDetachRequest_VirtioSerialPort) String() string {
+func (x *StartBackupExportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelDetachRequest_VirtioSerialPort) ProtoMessage() {}
+func (*StartBackupExportRequest) ProtoMessage() {}
 
-func (x *ChannelDetachRequest_VirtioSerialPort) ProtoReflect() protoreflect.Message {
-	mi := &file_services_machines_v2_machines_proto_msgTypes[60]
+func (x *StartBackupExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3695,888 +4058,2914 @@ func (x *ChannelDetachRequest_VirtioSerialPort) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelDetachRequest_VirtioSerialPort.ProtoReflect.Descriptor instead.
-func (*ChannelDetachRequest_VirtioSerialPort) Descriptor() ([]byte, []int) {
-	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{46, 1}
+// Deprecated: Use StartBackupExportRequest.ProtoReflect.Descriptor instead.
+func (*StartBackupExportRequest) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *ChannelDetachRequest_VirtioSerialPort) GetPortID() string {
+func (x *StartBackupExportRequest) GetName() string {
 	if x != nil {
-		return x.PortID
+		return x.Name
 	}
 	return ""
 }
 
-var File_services_machines_v2_machines_proto protoreflect.FileDescriptor
+func (x *StartBackupExportRequest) GetDisks() []string {
+	if x != nil {
+		return x.Disks
+	}
+	return nil
+}
 
-var file_services_machines_v2_machines_proto_rawDesc = []byte{
-	0x0a, 0x23, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69,
-	0x6e, 0x65, 0x73, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1f, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69,
-	0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x17, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68,
-	0x69, 0x6e, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x35, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x30, 0x78, 0x65, 0x66, 0x35, 0x33, 0x2f, 0x67, 0x6f,
-	0x2d, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x66, 0x69,
-	0x65, 0x6c, 0x64, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0xca, 0x02, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75,
-	0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4d,
-	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x74, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74,
-	0x65, 0x6e, 0x74, 0x12, 0x67, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x66, 0x69, 0x6c,
-	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75,
-	0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d,
-	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x46, 0x69,
-	0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x06, 0xca, 0xed, 0x1a, 0x02, 0x08, 0x01,
-	0x52, 0x0a, 0x65, 0x78, 0x74, 0x72, 0x61, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c,
-	0x71, 0x65, 0x6d, 0x75, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x64, 0x69, 0x72, 0x18, 0x6e, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x71, 0x65, 0x6d, 0x75, 0x52, 0x6f, 0x6f, 0x74, 0x64, 0x69, 0x72, 0x1a,
-	0x3d, 0x0a, 0x0f, 0x45, 0x78, 0x74, 0x72, 0x61, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x48,
-	0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x36, 0x0a, 0x07, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x52,
-	0x07, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x22, 0x39, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f,
-	0x72, 0x63, 0x65, 0x22, 0x48, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x63,
-	0x68, 0x69, 0x6e, 0x65, 0x52, 0x07, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x22, 0x20, 0x0a,
-	0x0a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
-	0x45, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36,
-	0x0a, 0x07, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x52, 0x07, 0x6d,
-	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x22, 0x26, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x4e,
-	0x0a, 0x11, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e,
-	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x47,
-	0x0a, 0x0c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
-	0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x77, 0x61, 0x69, 0x74, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0x4b, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x70, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x61,
-	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x77, 0x61, 0x69, 0x74, 0x12, 0x14,
-	0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66,
-	0x6f, 0x72, 0x63, 0x65, 0x22, 0x38, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x61,
-	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x77, 0x61, 0x69, 0x74, 0x22, 0x36,
-	0x0a, 0x0c, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x61, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x04, 0x77, 0x61, 0x69, 0x74, 0x22, 0x23, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x48, 0x0a, 0x0c, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x08, 0x6d,
-	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x52, 0x08, 0x6d, 0x61, 0x63,
-	0x68, 0x69, 0x6e, 0x65, 0x73, 0x22, 0x28, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22,
-	0x2f, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73,
-	0x22, 0x77, 0x0a, 0x12, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x53, 0x65, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d,
-	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x66, 0x6c, 0x61, 0x73, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x71, 0x65, 0x6d, 0x75, 0x5f, 0x72,
-	0x6f, 0x6f, 0x74, 0x64, 0x69, 0x72, 0x18, 0x6e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x71, 0x65,
-	0x6d, 0x75, 0x52, 0x6f, 0x6f, 0x74, 0x64, 0x69, 0x72, 0x22, 0x2b, 0x0a, 0x15, 0x46, 0x69, 0x72,
-	0x6d, 0x77, 0x61, 0x72, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x6e, 0x0a, 0x16, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
-	0x53, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x22, 0x6b, 0x0a, 0x13, 0x43, 0x50, 0x55, 0x53, 0x65, 0x74,
-	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12,
-	0x12, 0x0a, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c,
-	0x69, 0x76, 0x65, 0x22, 0x44, 0x0a, 0x14, 0x43, 0x50, 0x55, 0x53, 0x65, 0x74, 0x53, 0x6f, 0x63,
-	0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x07, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x22, 0x52, 0x0a, 0x12, 0x43, 0x50, 0x55,
-	0x53, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x76,
-	0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x22, 0x3e, 0x0a,
-	0x12, 0x43, 0x50, 0x55, 0x53, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x22, 0xb0, 0x01,
-	0x0a, 0x17, 0x48, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x74, 0x74, 0x61,
-	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a,
-	0x08, 0x70, 0x63, 0x69, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x70, 0x63, 0x69, 0x41, 0x64, 0x64, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x6d, 0x75, 0x6c, 0x74,
-	0x69, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0d, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f,
-	0x0a, 0x0b, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x67, 0x70, 0x75, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x47, 0x70, 0x75, 0x12,
-	0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x5a,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x4d, 0x6f, 0x64, 0x65,
-	0x22, 0x48, 0x0a, 0x17, 0x48, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65,
-	0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x19, 0x0a, 0x08, 0x70, 0x63, 0x69, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x70, 0x63, 0x69, 0x41, 0x64, 0x64, 0x72, 0x22, 0x72, 0x0a, 0x27, 0x48, 0x6f,
-	0x73, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69,
-	0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x63, 0x69,
-	0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x63, 0x69,
-	0x41, 0x64, 0x64, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x6f,
-	0x0a, 0x24, 0x48, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x74, 0x50,
-	0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x47, 0x50, 0x55, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x63,
-	0x69, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x63,
-	0x69, 0x41, 0x64, 0x64, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22,
-	0x44, 0x0a, 0x12, 0x56, 0x4e, 0x43, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x59, 0x0a, 0x13, 0x56, 0x4e, 0x43, 0x41, 0x63, 0x74, 0x69,
-	0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0a,
-	0x72, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x22, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x56, 0x4e, 0x43, 0x52, 0x65, 0x71, 0x75, 0x69, 0x73,
-	0x69, 0x74, 0x65, 0x73, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x73,
-	0x22, 0x68, 0x0a, 0x18, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41,
-	0x74, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x38, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24,
-	0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x68, 0x0a, 0x18, 0x49, 0x6e,
-	0x70, 0x75, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75,
-	0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x49,
-	0x6e, 0x70, 0x75, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x22, 0x90, 0x02, 0x0a, 0x12, 0x43, 0x64, 0x72, 0x6f, 0x6d, 0x41, 0x74,
-	0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6d, 0x65, 0x64, 0x69, 0x61,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65,
-	0x64, 0x69, 0x61, 0x12, 0x38, 0x0a, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x64, 0x72, 0x6f, 0x6d, 0x44,
-	0x72, 0x69, 0x76, 0x65, 0x72, 0x52, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x1a, 0x0a,
-	0x08, 0x72, 0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x08, 0x72, 0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6f, 0x6f,
-	0x74, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x6f,
-	0x6f, 0x74, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x22, 0x5d, 0x0a, 0x12, 0x43, 0x64, 0x72, 0x6f, 0x6d,
-	0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x17, 0x43, 0x64, 0x72, 0x6f, 0x6d,
-	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x5f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
-	0x65, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69,
-	0x76, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x22, 0x62,
-	0x0a, 0x17, 0x43, 0x64, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x64,
-	0x69, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a,
-	0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x69,
-	0x76, 0x65, 0x22, 0xfd, 0x01, 0x0a, 0x11, 0x44, 0x69, 0x73, 0x6b, 0x41, 0x74, 0x74, 0x61, 0x63,
-	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09,
-	0x64, 0x69, 0x73, 0x6b, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x64, 0x69, 0x73, 0x6b, 0x50, 0x61, 0x74, 0x68, 0x12, 0x37, 0x0a, 0x06, 0x64, 0x72, 0x69,
-	0x76, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6b, 0x76, 0x6d, 0x72,
-	0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e,
-	0x44, 0x69, 0x73, 0x6b, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52, 0x06, 0x64, 0x72, 0x69, 0x76,
-	0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x6f, 0x70, 0x73, 0x5f, 0x72, 0x64, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x06, 0x69, 0x6f, 0x70, 0x73, 0x52, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69,
-	0x6f, 0x70, 0x73, 0x5f, 0x77, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x69, 0x6f,
-	0x70, 0x73, 0x57, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6f, 0x6f, 0x74, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x74, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12,
-	0x0a, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x69,
-	0x76, 0x65, 0x22, 0x58, 0x0a, 0x11, 0x44, 0x69, 0x73, 0x6b, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64,
-	0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x64, 0x69, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x76, 0x65,
-	0x18, 0x64, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x22, 0x70, 0x0a, 0x15,
-	0x44, 0x69, 0x73, 0x6b, 0x53, 0x65, 0x74, 0x49, 0x4f, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73,
-	0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69,
-	0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6f, 0x70, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x69, 0x6f, 0x70, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69,
-	0x76, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x22, 0x4e,
-	0x0a, 0x1b, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x51, 0x65, 0x6d, 0x75,
-	0x42, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x50,
-	0x0a, 0x1d, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x51, 0x65, 0x6d, 0x75,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x64, 0x65, 0x76, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65,
-	0x22, 0x8b, 0x02, 0x0a, 0x15, 0x4e, 0x65, 0x74, 0x49, 0x66, 0x61, 0x63, 0x65, 0x41, 0x74, 0x74,
-	0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16,
-	0x0a, 0x06, 0x69, 0x66, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x69, 0x66, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4e, 0x65, 0x74,
-	0x49, 0x66, 0x61, 0x63, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52, 0x06, 0x64, 0x72, 0x69,
-	0x76, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x77, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x77, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1f, 0x0a, 0x0b,
-	0x69, 0x66, 0x75, 0x70, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x69, 0x66, 0x75, 0x70, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x23, 0x0a,
-	0x0d, 0x69, 0x66, 0x64, 0x6f, 0x77, 0x6e, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x66, 0x64, 0x6f, 0x77, 0x6e, 0x53, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69,
-	0x76, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x22, 0x57,
-	0x0a, 0x15, 0x4e, 0x65, 0x74, 0x49, 0x66, 0x61, 0x63, 0x65, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x69,
-	0x66, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x66, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x22, 0x5a, 0x0a, 0x18, 0x4e, 0x65, 0x74, 0x49, 0x66,
-	0x61, 0x63, 0x65, 0x53, 0x65, 0x74, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x66, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x66, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
-	0x61, 0x74, 0x68, 0x22, 0x87, 0x01, 0x0a, 0x1b, 0x4e, 0x65, 0x74, 0x49, 0x66, 0x61, 0x63, 0x65,
-	0x53, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x66, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x66, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x3c, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26,
-	0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4e, 0x65, 0x74, 0x49, 0x66, 0x61, 0x63, 0x65, 0x4c, 0x69, 0x6e,
-	0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x5e, 0x0a,
-	0x18, 0x4e, 0x65, 0x74, 0x49, 0x66, 0x61, 0x63, 0x65, 0x53, 0x65, 0x74, 0x51, 0x75, 0x65, 0x75,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x69, 0x66, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69,
-	0x66, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x73, 0x22, 0x92, 0x03,
-	0x0a, 0x14, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x59, 0x0a, 0x05, 0x76, 0x73,
-	0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x6b, 0x76, 0x6d, 0x72,
-	0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
-	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x2e, 0x56, 0x69, 0x72, 0x74, 0x69, 0x6f, 0x56, 0x53, 0x6f, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x05,
-	0x76, 0x73, 0x6f, 0x63, 0x6b, 0x12, 0x69, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f,
-	0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x46, 0x2e, 0x6b, 0x76, 0x6d,
-	0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x69, 0x6f, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x50, 0x6f,
-	0x72, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
-	0x6c, 0x69, 0x76, 0x65, 0x1a, 0x2c, 0x0a, 0x0b, 0x56, 0x69, 0x72, 0x74, 0x69, 0x6f, 0x56, 0x53,
-	0x6f, 0x63, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
-	0x49, 0x64, 0x1a, 0x53, 0x0a, 0x10, 0x56, 0x69, 0x72, 0x74, 0x69, 0x6f, 0x53, 0x65, 0x72, 0x69,
-	0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12,
-	0x26, 0x0a, 0x0f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x67, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x75,
-	0x65, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x22, 0xcb, 0x02, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x65,
-	0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x59, 0x0a, 0x05, 0x76, 0x73, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41,
-	0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32,
-	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x69, 0x6f, 0x56, 0x53, 0x6f, 0x63,
-	0x6b, 0x48, 0x00, 0x52, 0x05, 0x76, 0x73, 0x6f, 0x63, 0x6b, 0x12, 0x69, 0x0a, 0x0b, 0x73, 0x65,
-	0x72, 0x69, 0x61, 0x6c, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x46, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76,
-	0x32, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x69, 0x6f, 0x53, 0x65, 0x72,
-	0x69, 0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x69, 0x61,
-	0x6c, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x64, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x1a, 0x0d, 0x0a, 0x0b, 0x56, 0x69, 0x72,
-	0x74, 0x69, 0x6f, 0x56, 0x53, 0x6f, 0x63, 0x6b, 0x1a, 0x2b, 0x0a, 0x10, 0x56, 0x69, 0x72, 0x74,
-	0x69, 0x6f, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x17, 0x0a, 0x07,
-	0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
-	0x6f, 0x72, 0x74, 0x49, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x22, 0x85, 0x01, 0x0a, 0x1b, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x49, 0x6e, 0x69, 0x74, 0x44, 0x72,
-	0x69, 0x76, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x3c, 0x0a, 0x06, 0x64, 0x72,
-	0x69, 0x76, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6b, 0x76, 0x6d,
-	0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32,
-	0x2e, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x49, 0x6e, 0x69, 0x74, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72,
-	0x52, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x22, 0x31, 0x0a, 0x1b, 0x43, 0x6c, 0x6f, 0x75,
-	0x64, 0x49, 0x6e, 0x69, 0x74, 0x44, 0x72, 0x69, 0x76, 0x65, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x60, 0x0a, 0x20, 0x43,
-	0x6c, 0x6f, 0x75, 0x64, 0x49, 0x6e, 0x69, 0x74, 0x44, 0x72, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x76,
-	0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x22, 0xa6, 0x01,
-	0x0a, 0x16, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x42, 0x61, 0x63, 0x6b, 0x75,
-	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09,
-	0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x61, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x5f, 0x62, 0x69, 0x74,
-	0x6d, 0x61, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x63, 0x6c, 0x65, 0x61, 0x72,
-	0x42, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x22, 0x34, 0x0a, 0x17, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44,
-	0x69, 0x73, 0x6b, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x4b, 0x65, 0x79, 0x22, 0xed, 0x01, 0x0a,
-	0x15, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x73,
-	0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x64, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x69, 0x73,
-	0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x12,
-	0x45, 0x0a, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x52, 0x09, 0x6f, 0x76, 0x65,
-	0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x5f, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x22, 0x33, 0x0a, 0x16,
-	0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x4b, 0x65,
-	0x79, 0x22, 0x8e, 0x01, 0x0a, 0x18, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4b, 0x65,
-	0x72, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6e, 0x69, 0x74,
-	0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x6e, 0x69, 0x74, 0x72, 0x64,
-	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6d, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x63, 0x6d, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f,
-	0x64, 0x69, 0x73, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x69,
-	0x73, 0x6f, 0x22, 0x31, 0x0a, 0x1b, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4b, 0x65,
-	0x72, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x32, 0xc7, 0x38, 0x0a, 0x0e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e,
-	0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x88, 0x01, 0x0a, 0x06, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x12, 0x2e, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
-	0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
-	0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x3a, 0x01, 0x2a, 0x22,
-	0x12, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61,
-	0x6d, 0x65, 0x7d, 0x12, 0x85, 0x01, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x2e,
-	0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f,
-	0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x2a, 0x12, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63,
-	0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x7c, 0x0a, 0x03, 0x47,
-	0x65, 0x74, 0x12, 0x2b, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
-	0x73, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2c, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76,
-	0x32, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69,
-	0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x95, 0x01, 0x0a, 0x09, 0x47, 0x65,
-	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x31, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61,
-	0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x6b, 0x76, 0x6d,
-	0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68,
-	0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x73, 0x12, 0x73, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2d, 0x2e, 0x6b, 0x76, 0x6d,
-	0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x61,
-	0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x01, 0x2a, 0x1a, 0x18, 0x2f, 0x76,
-	0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d,
-	0x2f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x70, 0x0a, 0x04, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x2c,
-	0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32,
-	0x2e, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a, 0x1a,
-	0x17, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61,
-	0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x74, 0x6f, 0x70, 0x12, 0x79, 0x0a, 0x07, 0x52, 0x65, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x12, 0x2f, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
-	0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x25, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x1a, 0x1a, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63,
-	0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x12, 0x73, 0x0a, 0x05, 0x52, 0x65, 0x73, 0x65, 0x74, 0x12, 0x2d, 0x2e, 0x6b,
-	0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x52,
-	0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x01, 0x2a, 0x1a, 0x18,
-	0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d,
-	0x65, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x65, 0x74, 0x12, 0x79, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x2c, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e,
-	0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d,
-	0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69,
-	0x6e, 0x65, 0x73, 0x12, 0x8e, 0x01, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65,
-	0x73, 0x12, 0x31, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73,
-	0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69,
-	0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14,
-	0x12, 0x12, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2f, 0x6e,
-	0x61, 0x6d, 0x65, 0x73, 0x12, 0x82, 0x01, 0x0a, 0x0b, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72,
-	0x65, 0x53, 0x65, 0x74, 0x12, 0x33, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69,
-	0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x53,
-	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x3a, 0x01, 0x2a, 0x1a, 0x1b, 0x2f, 0x76,
-	0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d,
-	0x2f, 0x66, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x12, 0x85, 0x01, 0x0a, 0x0e, 0x46, 0x69,
-	0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x36, 0x2e, 0x6b,
-	0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x46,
-	0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x23, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x1d, 0x2a, 0x1b, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
-	0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x66, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72,
-	0x65, 0x12, 0x88, 0x01, 0x0a, 0x0f, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x65, 0x74, 0x4c,
-	0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x37, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68,
-	0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x65,
-	0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a, 0x01,
-	0x2a, 0x1a, 0x19, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b,
-	0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x7f, 0x0a, 0x0c,
-	0x43, 0x50, 0x55, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x34, 0x2e, 0x6b,
-	0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x43,
-	0x50, 0x55, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x1a, 0x16, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69,
-	0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x63, 0x70, 0x75, 0x12, 0x89, 0x01,
-	0x0a, 0x0d, 0x43, 0x50, 0x55, 0x53, 0x65, 0x74, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12,
-	0x35, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76,
-	0x32, 0x2e, 0x43, 0x50, 0x55, 0x53, 0x65, 0x74, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x29,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x3a, 0x01, 0x2a, 0x1a, 0x1e, 0x2f, 0x76, 0x32, 0x2f, 0x6d,
-	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x63, 0x70,
-	0x75, 0x2f, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x83, 0x01, 0x0a, 0x0b, 0x43, 0x50,
-	0x55, 0x53, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x33, 0x2e, 0x6b, 0x76, 0x6d, 0x72,
-	0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
-	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x50, 0x55, 0x53,
-	0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x3a, 0x01,
-	0x2a, 0x1a, 0x1c, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b,
-	0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x63, 0x70, 0x75, 0x2f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x12,
-	0x83, 0x01, 0x0a, 0x0b, 0x43, 0x50, 0x55, 0x53, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12,
-	0x33, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76,
-	0x32, 0x2e, 0x43, 0x50, 0x55, 0x53, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x27, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x21, 0x3a, 0x01, 0x2a, 0x1a, 0x1c, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63,
-	0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x63, 0x70, 0x75, 0x2f,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x8b, 0x01, 0x0a, 0x10, 0x48, 0x6f, 0x73, 0x74, 0x44, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x12, 0x38, 0x2e, 0x6b, 0x76, 0x6d,
-	0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x48, 0x6f, 0x73,
-	0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x25, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63,
-	0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x68, 0x6f, 0x73, 0x74,
-	0x70, 0x63, 0x69, 0x12, 0x88, 0x01, 0x0a, 0x10, 0x48, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69,
-	0x63, 0x65, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x12, 0x38, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75,
-	0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d,
-	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x44,
-	0x65, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x1c, 0x2a, 0x1a, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f,
-	0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x68, 0x6f, 0x73, 0x74, 0x70, 0x63, 0x69, 0x12, 0xae,
-	0x01, 0x0a, 0x20, 0x48, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x74,
-	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x48, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
-	0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x53, 0x65, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x3a, 0x01, 0x2a,
-	0x1a, 0x1d, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e,
-	0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x68, 0x6f, 0x73, 0x74, 0x70, 0x63, 0x69, 0x2f, 0x6d, 0x66, 0x12,
-	0xb1, 0x01, 0x0a, 0x1d, 0x48, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65,
-	0x74, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x47, 0x50, 0x55, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x45, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73,
-	0x2e, 0x76, 0x32, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65,
-	0x74, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x47, 0x50, 0x55, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x3a, 0x01, 0x2a, 0x1a, 0x26, 0x2f, 0x76, 0x32,
-	0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f,
-	0x68, 0x6f, 0x73, 0x74, 0x70, 0x63, 0x69, 0x2f, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x2d,
-	0x67, 0x70, 0x75, 0x12, 0x9b, 0x01, 0x0a, 0x0b, 0x56, 0x4e, 0x43, 0x41, 0x63, 0x74, 0x69, 0x76,
-	0x61, 0x74, 0x65, 0x12, 0x33, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69,
+func (x *StartBackupExportRequest) GetListenAddr() string {
+	if x != nil {
+		return x.ListenAddr
+	}
+	return ""
+}
+
+func (x *StartBackupExportRequest) GetTls() bool {
+	if x != nil {
+		return x.Tls
+	}
+	return false
+}
+
+func (x *StartBackupExportRequest) GetIncremental() bool {
+	if x != nil {
+		return x.Incremental
+	}
+	return false
+}
+
+func (x *StartBackupExportRequest) GetFsFreeze() bool {
+	if x != nil {
+		return x.FsFreeze
+	}
+	return false
+}
+
+func (x *StartBackupExportRequest) GetTimeout() uint32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+type StartBackupExportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TaskKey      string                              `protobuf:"bytes,1,opt,name=task_key,json=taskKey,proto3" json:"task_key,omitempty"`
+	Exports      []*StartBackupExportResponse_Export `protobuf:"bytes,2,rep,name=exports,proto3" json:"exports,omitempty"`
+	FsConsistent bool                                `protobuf:"varint,3,opt,name=fs_consistent,json=fsConsistent,proto3" json:"fs_consistent,omitempty"`
+}
+
+func (x *StartBackupExportResponse) Reset() {
+	*x = StartBackupExportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_machines_v2_machines_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartBackupExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartBackupExportResponse) ProtoMessage() {}
+
+func (x *StartBackupExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartBackupExportResponse.ProtoReflect.Descriptor instead.
+func (*StartBackupExportResponse) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *StartBackupExportResponse) GetTaskKey() string {
+	if x != nil {
+		return x.TaskKey
+	}
+	return ""
+}
+
+func (x *StartBackupExportResponse) GetExports() []*StartBackupExportResponse_Export {
+	if x != nil {
+		return x.Exports
+	}
+	return nil
+}
+
+func (x *StartBackupExportResponse) GetFsConsistent() bool {
+	if x != nil {
+		return x.FsConsistent
+	}
+	return false
+}
+
+type FinishBackupExportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Success bool   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *FinishBackupExportRequest) Reset() {
+	*x = FinishBackupExportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_machines_v2_machines_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FinishBackupExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishBackupExportRequest) ProtoMessage() {}
+
+func (x *FinishBackupExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishBackupExportRequest.ProtoReflect.Descriptor instead.
+func (*FinishBackupExportRequest) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *FinishBackupExportRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FinishBackupExportRequest) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type StartDiskRestoreRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DiskName string `protobuf:"bytes,2,opt,name=disk_name,json=diskName,proto3" json:"disk_name,omitempty"`
+	Source   string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+}
+
+func (x *StartDiskRestoreRequest) Reset() {
+	*x = StartDiskRestoreRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_machines_v2_machines_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartDiskRestoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartDiskRestoreRequest) ProtoMessage() {}
+
+func (x *StartDiskRestoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartDiskRestoreRequest.ProtoReflect.Descriptor instead.
+func (*StartDiskRestoreRequest) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *StartDiskRestoreRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StartDiskRestoreRequest) GetDiskName() string {
+	if x != nil {
+		return x.DiskName
+	}
+	return ""
+}
+
+func (x *StartDiskRestoreRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type StartDiskRestoreResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TaskKey      string `protobuf:"bytes,1,opt,name=task_key,json=taskKey,proto3" json:"task_key,omitempty"`
+	AttachedDisk string `protobuf:"bytes,2,opt,name=attached_disk,json=attachedDisk,proto3" json:"attached_disk,omitempty"`
+}
+
+func (x *StartDiskRestoreResponse) Reset() {
+	*x = StartDiskRestoreResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_machines_v2_machines_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartDiskRestoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartDiskRestoreResponse) ProtoMessage() {}
+
+func (x *StartDiskRestoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartDiskRestoreResponse.ProtoReflect.Descriptor instead.
+func (*StartDiskRestoreResponse) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *StartDiskRestoreResponse) GetTaskKey() string {
+	if x != nil {
+		return x.TaskKey
+	}
+	return ""
+}
+
+func (x *StartDiskRestoreResponse) GetAttachedDisk() string {
+	if x != nil {
+		return x.AttachedDisk
+	}
+	return ""
+}
+
+type StartDiskMoveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name         string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DiskName     string `protobuf:"bytes,2,opt,name=disk_name,json=diskName,proto3" json:"disk_name,omitempty"`
+	Target       string `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	CreateTarget bool   `protobuf:"varint,4,opt,name=create_target,json=createTarget,proto3" json:"create_target,omitempty"`
+	RemoveSource bool   `protobuf:"varint,5,opt,name=remove_source,json=removeSource,proto3" json:"remove_source,omitempty"`
+	Speed        uint64 `protobuf:"varint,6,opt,name=speed,proto3" json:"speed,omitempty"`
+}
+
+func (x *StartDiskMoveRequest) Reset() {
+	*x = StartDiskMoveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_machines_v2_machines_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartDiskMoveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartDiskMoveRequest) ProtoMessage() {}
+
+func (x *StartDiskMoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartDiskMoveRequest.ProtoReflect.Descriptor instead.
+func (*StartDiskMoveRequest) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *StartDiskMoveRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StartDiskMoveRequest) GetDiskName() string {
+	if x != nil {
+		return x.DiskName
+	}
+	return ""
+}
+
+func (x *StartDiskMoveRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *StartDiskMoveRequest) GetCreateTarget() bool {
+	if x != nil {
+		return x.CreateTarget
+	}
+	return false
+}
+
+func (x *StartDiskMoveRequest) GetRemoveSource() bool {
+	if x != nil {
+		return x.RemoveSource
+	}
+	return false
+}
+
+func (x *StartDiskMoveRequest) GetSpeed() uint64 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+type StartDiskMoveResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TaskKey string `protobuf:"bytes,1,opt,name=task_key,json=taskKey,proto3" json:"task_key,omitempty"`
+}
+
+func (x *StartDiskMoveResponse) Reset() {
+	*x = StartDiskMoveResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_machines_v2_machines_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartDiskMoveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartDiskMoveResponse) ProtoMessage() {}
+
+func (x *StartDiskMoveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartDiskMoveResponse.ProtoReflect.Descriptor instead.
+func (*StartDiskMoveResponse) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *StartDiskMoveResponse) GetTaskKey() string {
+	if x != nil {
+		return x.TaskKey
+	}
+	return ""
+}
+
+type BackupPolicySetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Policy *v2.BackupPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+}
+
+func (x *BackupPolicySetRequest) Reset() {
+	*x = BackupPolicySetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_machines_v2_machines_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackupPolicySetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupPolicySetRequest) ProtoMessage() {}
+
+func (x *BackupPolicySetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupPolicySetRequest.ProtoReflect.Descriptor instead.
+func (*BackupPolicySetRequest) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *BackupPolicySetRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BackupPolicySetRequest) GetPolicy() *v2.BackupPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type BackupPolicyGetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *BackupPolicyGetRequest) Reset() {
+	*x = BackupPolicyGetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_machines_v2_machines_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackupPolicyGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupPolicyGetRequest) ProtoMessage() {}
+
+func (x *BackupPolicyGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupPolicyGetRequest.ProtoReflect.Descriptor instead.
+func (*BackupPolicyGetRequest) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *BackupPolicyGetRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type BackupPolicyGetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Policy *v2.BackupPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	// unix time in seconds, 0 if the policy is disabled
+	NextRun uint64 `protobuf:"varint,2,opt,name=next_run,json=nextRun,proto3" json:"next_run,omitempty"`
+}
+
+func (x *BackupPolicyGetResponse) Reset() {
+	*x = BackupPolicyGetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_machines_v2_machines_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackupPolicyGetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupPolicyGetResponse) ProtoMessage() {}
+
+func (x *BackupPolicyGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupPolicyGetResponse.ProtoReflect.Descriptor instead.
+func (*BackupPolicyGetResponse) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *BackupPolicyGetResponse) GetPolicy() *v2.BackupPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+func (x *BackupPolicyGetResponse) GetNextRun() uint64 {
+	if x != nil {
+		return x.NextRun
+	}
+	return 0
+}
+
+type BackupPolicyRemoveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *BackupPolicyRemoveRequest) Reset() {
+	*x = BackupPolicyRemoveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_machines_v2_machines_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackupPolicyRemoveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupPolicyRemoveRequest) ProtoMessage() {}
+
+func (x *BackupPolicyRemoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupPolicyRemoveRequest.ProtoReflect.Descriptor instead.
+func (*BackupPolicyRemoveRequest) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *BackupPolicyRemoveRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type BackupCatalogListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DiskName string `protobuf:"bytes,2,opt,name=disk_name,json=diskName,proto3" json:"disk_name,omitempty"`
+}
+
+func (x *BackupCatalogListRequest) Reset() {
+	*x = BackupCatalogListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_machines_v2_machines_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackupCatalogListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupCatalogListRequest) ProtoMessage() {}
+
+func (x *BackupCatalogListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupCatalogListRequest.ProtoReflect.Descriptor instead.
+func (*BackupCatalogListRequest) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *BackupCatalogListRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BackupCatalogListRequest) GetDiskName() string {
+	if x != nil {
+		return x.DiskName
+	}
+	return ""
+}
+
+type BackupCatalogListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*v2.BackupCatalogEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *BackupCatalogListResponse) Reset() {
+	*x = BackupCatalogListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_machines_v2_machines_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackupCatalogListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupCatalogListResponse) ProtoMessage() {}
+
+func (x *BackupCatalogListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupCatalogListResponse.ProtoReflect.Descriptor instead.
+func (*BackupCatalogListResponse) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *BackupCatalogListResponse) GetEntries() []*v2.BackupCatalogEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type StartMigrationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DstServer   string                 `protobuf:"bytes,2,opt,name=dst_server,json=dstServer,proto3" json:"dst_server,omitempty"`
+	Disks       []string               `protobuf:"bytes,3,rep,name=disks,proto3" json:"disks,omitempty"`
+	Overrides   *v2.MigrationOverrides `protobuf:"bytes,4,opt,name=overrides,proto3" json:"overrides,omitempty"`
+	CreateDisks bool                   `protobuf:"varint,5,opt,name=create_disks,json=createDisks,proto3" json:"create_disks,omitempty"`
+	RemoveAfter bool                   `protobuf:"varint,6,opt,name=remove_after,json=removeAfter,proto3" json:"remove_after,omitempty"`
+	Tls         v2.MigrationTLS        `protobuf:"varint,7,opt,name=tls,proto3,enum=kvmrun.api.types.v2.MigrationTLS" json:"tls,omitempty"`
+	Tuning      *v2.MigrationTuning    `protobuf:"bytes,8,opt,name=tuning,proto3" json:"tuning,omitempty"`
+}
+
+func (x *StartMigrationRequest) Reset() {
+	*x = StartMigrationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_machines_v2_machines_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartMigrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartMigrationRequest) ProtoMessage() {}
+
+func (x *StartMigrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartMigrationRequest.ProtoReflect.Descriptor instead.
+func (*StartMigrationRequest) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *StartMigrationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StartMigrationRequest) GetDstServer() string {
+	if x != nil {
+		return x.DstServer
+	}
+	return ""
+}
+
+func (x *StartMigrationRequest) GetDisks() []string {
+	if x != nil {
+		return x.Disks
+	}
+	return nil
+}
+
+func (x *StartMigrationRequest) GetOverrides() *v2.MigrationOverrides {
+	if x != nil {
+		return x.Overrides
+	}
+	return nil
+}
+
+func (x *StartMigrationRequest) GetCreateDisks() bool {
+	if x != nil {
+		return x.CreateDisks
+	}
+	return false
+}
+
+func (x *StartMigrationRequest) GetRemoveAfter() bool {
+	if x != nil {
+		return x.RemoveAfter
+	}
+	return false
+}
+
+func (x *StartMigrationRequest) GetTls() v2.MigrationTLS {
+	if x != nil {
+		return x.Tls
+	}
+	return v2.MigrationTLS(0)
+}
+
+func (x *StartMigrationRequest) GetTuning() *v2.MigrationTuning {
+	if x != nil {
+		return x.Tuning
+	}
+	return nil
+}
+
+type StartMigrationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TaskKey string `protobuf:"bytes,1,opt,name=task_key,json=taskKey,proto3" json:"task_key,omitempty"`
+}
+
+func (x *StartMigrationResponse) Reset() {
+	*x = StartMigrationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_machines_v2_machines_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartMigrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartMigrationResponse) ProtoMessage() {}
+
+func (x *StartMigrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartMigrationResponse.ProtoReflect.Descriptor instead.
+func (*StartMigrationResponse) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *StartMigrationResponse) GetTaskKey() string {
+	if x != nil {
+		return x.TaskKey
+	}
+	return ""
+}
+
+type MigrationCheckRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DstServer   string                 `protobuf:"bytes,2,opt,name=dst_server,json=dstServer,proto3" json:"dst_server,omitempty"`
+	Disks       []string               `protobuf:"bytes,3,rep,name=disks,proto3" json:"disks,omitempty"`
+	Overrides   *v2.MigrationOverrides `protobuf:"bytes,4,opt,name=overrides,proto3" json:"overrides,omitempty"`
+	CreateDisks bool                   `protobuf:"varint,5,opt,name=create_disks,json=createDisks,proto3" json:"create_disks,omitempty"`
+	Tls         v2.MigrationTLS        `protobuf:"varint,6,opt,name=tls,proto3,enum=kvmrun.api.types.v2.MigrationTLS" json:"tls,omitempty"`
+	Tuning      *v2.MigrationTuning    `protobuf:"bytes,7,opt,name=tuning,proto3" json:"tuning,omitempty"`
+}
+
+func (x *MigrationCheckRequest) Reset() {
+	*x = MigrationCheckRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_machines_v2_machines_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MigrationCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrationCheckRequest) ProtoMessage() {}
+
+func (x *MigrationCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrationCheckRequest.ProtoReflect.Descriptor instead.
+func (*MigrationCheckRequest) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *MigrationCheckRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MigrationCheckRequest) GetDstServer() string {
+	if x != nil {
+		return x.DstServer
+	}
+	return ""
+}
+
+func (x *MigrationCheckRequest) GetDisks() []string {
+	if x != nil {
+		return x.Disks
+	}
+	return nil
+}
+
+func (x *MigrationCheckRequest) GetOverrides() *v2.MigrationOverrides {
+	if x != nil {
+		return x.Overrides
+	}
+	return nil
+}
+
+func (x *MigrationCheckRequest) GetCreateDisks() bool {
+	if x != nil {
+		return x.CreateDisks
+	}
+	return false
+}
+
+func (x *MigrationCheckRequest) GetTls() v2.MigrationTLS {
+	if x != nil {
+		return x.Tls
+	}
+	return v2.MigrationTLS(0)
+}
+
+func (x *MigrationCheckRequest) GetTuning() *v2.MigrationTuning {
+	if x != nil {
+		return x.Tuning
+	}
+	return nil
+}
+
+type MigrationCheckResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Blockers []*v2.MigrationCheckIssue `protobuf:"bytes,1,rep,name=blockers,proto3" json:"blockers,omitempty"`
+	Warnings []*v2.MigrationCheckIssue `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
+}
+
+func (x *MigrationCheckResponse) Reset() {
+	*x = MigrationCheckResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_machines_v2_machines_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MigrationCheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrationCheckResponse) ProtoMessage() {}
+
+func (x *MigrationCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrationCheckResponse.ProtoReflect.Descriptor instead.
+func (*MigrationCheckResponse) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *MigrationCheckResponse) GetBlockers() []*v2.MigrationCheckIssue {
+	if x != nil {
+		return x.Blockers
+	}
+	return nil
+}
+
+func (x *MigrationCheckResponse) GetWarnings() []*v2.MigrationCheckIssue {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type StartEvacuationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DstServers     []string            `protobuf:"bytes,1,rep,name=dst_servers,json=dstServers,proto3" json:"dst_servers,omitempty"`
+	Concurrency    uint32              `protobuf:"varint,2,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	WithLocalDisks bool                `protobuf:"varint,3,opt,name=with_local_disks,json=withLocalDisks,proto3" json:"with_local_disks,omitempty"`
+	CreateDisks    bool                `protobuf:"varint,4,opt,name=create_disks,json=createDisks,proto3" json:"create_disks,omitempty"`
+	RemoveAfter    bool                `protobuf:"varint,5,opt,name=remove_after,json=removeAfter,proto3" json:"remove_after,omitempty"`
+	Tls            v2.MigrationTLS     `protobuf:"varint,6,opt,name=tls,proto3,enum=kvmrun.api.types.v2.MigrationTLS" json:"tls,omitempty"`
+	Tuning         *v2.MigrationTuning `protobuf:"bytes,7,opt,name=tuning,proto3" json:"tuning,omitempty"`
+}
+
+func (x *StartEvacuationRequest) Reset() {
+	*x = StartEvacuationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_machines_v2_machines_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartEvacuationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartEvacuationRequest) ProtoMessage() {}
+
+func (x *StartEvacuationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartEvacuationRequest.ProtoReflect.Descriptor instead.
+func (*StartEvacuationRequest) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *StartEvacuationRequest) GetDstServers() []string {
+	if x != nil {
+		return x.DstServers
+	}
+	return nil
+}
+
+func (x *StartEvacuationRequest) GetConcurrency() uint32 {
+	if x != nil {
+		return x.Concurrency
+	}
+	return 0
+}
+
+func (x *StartEvacuationRequest) GetWithLocalDisks() bool {
+	if x != nil {
+		return x.WithLocalDisks
+	}
+	return false
+}
+
+func (x *StartEvacuationRequest) GetCreateDisks() bool {
+	if x != nil {
+		return x.CreateDisks
+	}
+	return false
+}
+
+func (x *StartEvacuationRequest) GetRemoveAfter() bool {
+	if x != nil {
+		return x.RemoveAfter
+	}
+	return false
+}
+
+func (x *StartEvacuationRequest) GetTls() v2.MigrationTLS {
+	if x != nil {
+		return x.Tls
+	}
+	return v2.MigrationTLS(0)
+}
+
+func (x *StartEvacuationRequest) GetTuning() *v2.MigrationTuning {
+	if x != nil {
+		return x.Tuning
+	}
+	return nil
+}
+
+type StartEvacuationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TaskKey string `protobuf:"bytes,1,opt,name=task_key,json=taskKey,proto3" json:"task_key,omitempty"`
+}
+
+func (x *StartEvacuationResponse) Reset() {
+	*x = StartEvacuationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_machines_v2_machines_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartEvacuationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartEvacuationResponse) ProtoMessage() {}
+
+func (x *StartEvacuationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartEvacuationResponse.ProtoReflect.Descriptor instead.
+func (*StartEvacuationResponse) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *StartEvacuationResponse) GetTaskKey() string {
+	if x != nil {
+		return x.TaskKey
+	}
+	return ""
+}
+
+type ExternalKernelSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Image   string `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
+	Initrd  string `protobuf:"bytes,3,opt,name=initrd,proto3" json:"initrd,omitempty"`
+	Cmdline string `protobuf:"bytes,4,opt,name=cmdline,proto3" json:"cmdline,omitempty"`
+	Modiso  string `protobuf:"bytes,5,opt,name=modiso,proto3" json:"modiso,omitempty"`
+}
+
+func (x *ExternalKernelSetRequest) Reset() {
+	*x = ExternalKernelSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_machines_v2_machines_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExternalKernelSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalKernelSetRequest) ProtoMessage() {}
+
+func (x *ExternalKernelSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalKernelSetRequest.ProtoReflect.Descriptor instead.
+func (*ExternalKernelSetRequest) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ExternalKernelSetRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExternalKernelSetRequest) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *ExternalKernelSetRequest) GetInitrd() string {
+	if x != nil {
+		return x.Initrd
+	}
+	return ""
+}
+
+func (x *ExternalKernelSetRequest) GetCmdline() string {
+	if x != nil {
+		return x.Cmdline
+	}
+	return ""
+}
+
+func (x *ExternalKernelSetRequest) GetModiso() string {
+	if x != nil {
+		return x.Modiso
+	}
+	return ""
+}
+
+type ExternalKernelRemoveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *ExternalKernelRemoveRequest) Reset() {
+	*x = ExternalKernelRemoveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_machines_v2_machines_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExternalKernelRemoveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalKernelRemoveRequest) ProtoMessage() {}
+
+func (x *ExternalKernelRemoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalKernelRemoveRequest.ProtoReflect.Descriptor instead.
+func (*ExternalKernelRemoveRequest) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ExternalKernelRemoveRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ChannelAttachRequest_VirtioVSock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContextID uint32 `protobuf:"varint,1,opt,name=context_id,json=contextId,proto3" json:"context_id,omitempty"`
+}
+
+func (x *ChannelAttachRequest_VirtioVSock) Reset() {
+	*x = ChannelAttachRequest_VirtioVSock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_machines_v2_machines_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelAttachRequest_VirtioVSock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelAttachRequest_VirtioVSock) ProtoMessage() {}
+
+func (x *ChannelAttachRequest_VirtioVSock) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelAttachRequest_VirtioVSock.ProtoReflect.Descriptor instead.
+func (*ChannelAttachRequest_VirtioVSock) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{56, 0}
+}
+
+func (x *ChannelAttachRequest_VirtioVSock) GetContextID() uint32 {
+	if x != nil {
+		return x.ContextID
+	}
+	return 0
+}
+
+type ChannelAttachRequest_VirtioSerialPort struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PortID        string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	PortGuestName string `protobuf:"bytes,2,opt,name=port_guest_name,json=portGuestName,proto3" json:"port_guest_name,omitempty"`
+}
+
+func (x *ChannelAttachRequest_VirtioSerialPort) Reset() {
+	*x = ChannelAttachRequest_VirtioSerialPort{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_machines_v2_machines_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelAttachRequest_VirtioSerialPort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelAttachRequest_VirtioSerialPort) ProtoMessage() {}
+
+func (x *ChannelAttachRequest_VirtioSerialPort) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelAttachRequest_VirtioSerialPort.ProtoReflect.Descriptor instead.
+func (*ChannelAttachRequest_VirtioSerialPort) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{56, 1}
+}
+
+func (x *ChannelAttachRequest_VirtioSerialPort) GetPortID() string {
+	if x != nil {
+		return x.PortID
+	}
+	return ""
+}
+
+func (x *ChannelAttachRequest_VirtioSerialPort) GetPortGuestName() string {
+	if x != nil {
+		return x.PortGuestName
+	}
+	return ""
+}
+
+type ChannelDetachRequest_VirtioVSock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ChannelDetachRequest_VirtioVSock) Reset() {
+	*x = ChannelDetachRequest_VirtioVSock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_machines_v2_machines_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelDetachRequest_VirtioVSock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelDetachRequest_VirtioVSock) ProtoMessage() {}
+
+func (x *ChannelDetachRequest_VirtioVSock) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelDetachRequest_VirtioVSock.ProtoReflect.Descriptor instead.
+func (*ChannelDetachRequest_VirtioVSock) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{57, 0}
+}
+
+type ChannelDetachRequest_VirtioSerialPort struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PortID string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+}
+
+func (x *ChannelDetachRequest_VirtioSerialPort) Reset() {
+	*x = ChannelDetachRequest_VirtioSerialPort{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_machines_v2_machines_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelDetachRequest_VirtioSerialPort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelDetachRequest_VirtioSerialPort) ProtoMessage() {}
+
+func (x *ChannelDetachRequest_VirtioSerialPort) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelDetachRequest_VirtioSerialPort.ProtoReflect.Descriptor instead.
+func (*ChannelDetachRequest_VirtioSerialPort) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{57, 1}
+}
+
+func (x *ChannelDetachRequest_VirtioSerialPort) GetPortID() string {
+	if x != nil {
+		return x.PortID
+	}
+	return ""
+}
+
+type StartMachineBackupRequest_Target struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DiskName string `protobuf:"bytes,1,opt,name=disk_name,json=diskName,proto3" json:"disk_name,omitempty"`
+	Target   string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+}
+
+func (x *StartMachineBackupRequest_Target) Reset() {
+	*x = StartMachineBackupRequest_Target{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_machines_v2_machines_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartMachineBackupRequest_Target) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartMachineBackupRequest_Target) ProtoMessage() {}
+
+func (x *StartMachineBackupRequest_Target) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartMachineBackupRequest_Target.ProtoReflect.Descriptor instead.
+func (*StartMachineBackupRequest_Target) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{63, 0}
+}
+
+func (x *StartMachineBackupRequest_Target) GetDiskName() string {
+	if x != nil {
+		return x.DiskName
+	}
+	return ""
+}
+
+func (x *StartMachineBackupRequest_Target) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+type StartBackupExportResponse_Export struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DiskName string `protobuf:"bytes,1,opt,name=disk_name,json=diskName,proto3" json:"disk_name,omitempty"`
+	Uri      string `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`
+	Bitmap   string `protobuf:"bytes,3,opt,name=bitmap,proto3" json:"bitmap,omitempty"`
+}
+
+func (x *StartBackupExportResponse_Export) Reset() {
+	*x = StartBackupExportResponse_Export{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_machines_v2_machines_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartBackupExportResponse_Export) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartBackupExportResponse_Export) ProtoMessage() {}
+
+func (x *StartBackupExportResponse_Export) ProtoReflect() protoreflect.Message {
+	mi := &file_services_machines_v2_machines_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartBackupExportResponse_Export.ProtoReflect.Descriptor instead.
+func (*StartBackupExportResponse_Export) Descriptor() ([]byte, []int) {
+	return file_services_machines_v2_machines_proto_rawDescGZIP(), []int{66, 0}
+}
+
+func (x *StartBackupExportResponse_Export) GetDiskName() string {
+	if x != nil {
+		return x.DiskName
+	}
+	return ""
+}
+
+func (x *StartBackupExportResponse_Export) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *StartBackupExportResponse_Export) GetBitmap() string {
+	if x != nil {
+		return x.Bitmap
+	}
+	return ""
+}
+
+var File_services_machines_v2_machines_proto protoreflect.FileDescriptor
+
+var file_services_machines_v2_machines_proto_rawDesc = []byte{
+	0x0a, 0x23, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x73, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1f, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x17, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x35, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x30, 0x78, 0x65, 0x66, 0x35, 0x33, 0x2f, 0x67, 0x6f,
+	0x2d, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xca, 0x02, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75,
+	0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x74, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x74, 0x12, 0x67, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x66, 0x69, 0x6c,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75,
+	0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x46, 0x69,
+	0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x06, 0xca, 0xed, 0x1a, 0x02, 0x08, 0x01,
+	0x52, 0x0a, 0x65, 0x78, 0x74, 0x72, 0x61, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x71, 0x65, 0x6d, 0x75, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x64, 0x69, 0x72, 0x18, 0x6e, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x71, 0x65, 0x6d, 0x75, 0x52, 0x6f, 0x6f, 0x74, 0x64, 0x69, 0x72, 0x1a,
+	0x3d, 0x0a, 0x0f, 0x45, 0x78, 0x74, 0x72, 0x61, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x48,
+	0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x36, 0x0a, 0x07, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x52,
+	0x07, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x22, 0x39, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f,
+	0x72, 0x63, 0x65, 0x22, 0x48, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x52, 0x07, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x22, 0x20, 0x0a,
+	0x0a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x45, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36,
+	0x0a, 0x07, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x52, 0x07, 0x6d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x22, 0x26, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x4e,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x77,
+	0x0a, 0x0c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x77, 0x61, 0x69, 0x74, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x69, 0x73, 0x63, 0x61,
+	0x72, 0x64, 0x5f, 0x73, 0x61, 0x76, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x53, 0x61, 0x76,
+	0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x4b, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x61,
+	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x77, 0x61, 0x69, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66,
+	0x6f, 0x72, 0x63, 0x65, 0x22, 0x38, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x61,
+	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x77, 0x61, 0x69, 0x74, 0x22, 0x36,
+	0x0a, 0x0c, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x61, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x04, 0x77, 0x61, 0x69, 0x74, 0x22, 0x22, 0x0a, 0x0c, 0x50, 0x61, 0x75, 0x73, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x23, 0x0a, 0x0d, 0x52, 0x65,
+	0x73, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x29, 0x0a, 0x13, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x31, 0x0a, 0x14, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x4b, 0x65, 0x79, 0x22, 0x23, 0x0a,
+	0x0b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x22, 0x48, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x38, 0x0a, 0x08, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x52, 0x08, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x22, 0x28, 0x0a, 0x10,
+	0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x2f, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x22, 0x77, 0x0a, 0x12, 0x46, 0x69, 0x72, 0x6d, 0x77,
+	0x61, 0x72, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x73, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x73, 0x68, 0x12, 0x21, 0x0a,
+	0x0c, 0x71, 0x65, 0x6d, 0x75, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x64, 0x69, 0x72, 0x18, 0x6e, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x71, 0x65, 0x6d, 0x75, 0x52, 0x6f, 0x6f, 0x74, 0x64, 0x69, 0x72,
+	0x22, 0x2b, 0x0a, 0x15, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x6e, 0x0a,
+	0x16, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x63, 0x74, 0x75, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x61, 0x63, 0x74,
+	0x75, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x76,
+	0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x22, 0x6b, 0x0a,
+	0x13, 0x43, 0x50, 0x55, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x75,
+	0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x64,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x22, 0x44, 0x0a, 0x14, 0x43, 0x50,
+	0x55, 0x53, 0x65, 0x74, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x22, 0x52, 0x0a, 0x12, 0x43, 0x50, 0x55, 0x53, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75,
+	0x6f, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61,
+	0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
+	0x6c, 0x69, 0x76, 0x65, 0x22, 0x3e, 0x0a, 0x12, 0x43, 0x50, 0x55, 0x53, 0x65, 0x74, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x22, 0xc4, 0x01, 0x0a, 0x17, 0x48, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x63, 0x69, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x63, 0x69, 0x41, 0x64, 0x64, 0x72, 0x12,
+	0x24, 0x0a, 0x0d, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x66, 0x75, 0x6e,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79,
+	0x5f, 0x67, 0x70, 0x75, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x6d,
+	0x61, 0x72, 0x79, 0x47, 0x70, 0x75, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74,
+	0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x5a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x74, 0x72,
+	0x69, 0x63, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x18,
+	0x64, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x22, 0x5c, 0x0a, 0x17, 0x48,
+	0x6f, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x63,
+	0x69, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x63,
+	0x69, 0x41, 0x64, 0x64, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x64, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x22, 0x72, 0x0a, 0x27, 0x48, 0x6f, 0x73,
+	0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x66,
+	0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x63, 0x69, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x63, 0x69, 0x41,
+	0x64, 0x64, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x6f, 0x0a,
+	0x24, 0x48, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x74, 0x50, 0x72,
+	0x69, 0x6d, 0x61, 0x72, 0x79, 0x47, 0x50, 0x55, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x63, 0x69,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x63, 0x69,
+	0x41, 0x64, 0x64, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x44,
+	0x0a, 0x12, 0x56, 0x4e, 0x43, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x22, 0x59, 0x0a, 0x13, 0x56, 0x4e, 0x43, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0a, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x56, 0x4e, 0x43, 0x52, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69,
+	0x74, 0x65, 0x73, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x73, 0x22,
+	0x68, 0x0a, 0x18, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x38, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e,
+	0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x68, 0x0a, 0x18, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x22, 0x90, 0x02, 0x0a, 0x12, 0x43, 0x64, 0x72, 0x6f, 0x6d, 0x41, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x64,
+	0x69, 0x61, 0x12, 0x38, 0x0a, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x20, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x64, 0x72, 0x6f, 0x6d, 0x44, 0x72,
+	0x69, 0x76, 0x65, 0x72, 0x52, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x72, 0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6f, 0x6f, 0x74,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x6f, 0x6f,
+	0x74, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x22, 0x5d, 0x0a, 0x12, 0x43, 0x64, 0x72, 0x6f, 0x6d, 0x44,
+	0x65, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x04, 0x6c, 0x69, 0x76, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x17, 0x43, 0x64, 0x72, 0x6f, 0x6d, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x76,
+	0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x22, 0x62, 0x0a,
+	0x17, 0x43, 0x64, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x64, 0x69,
+	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x69, 0x76,
+	0x65, 0x22, 0x95, 0x02, 0x0a, 0x11, 0x44, 0x69, 0x73, 0x6b, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64,
+	0x69, 0x73, 0x6b, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x64, 0x69, 0x73, 0x6b, 0x50, 0x61, 0x74, 0x68, 0x12, 0x37, 0x0a, 0x06, 0x64, 0x72, 0x69, 0x76,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75,
+	0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x44,
+	0x69, 0x73, 0x6b, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65,
+	0x72, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x6f, 0x70, 0x73, 0x5f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x06, 0x69, 0x6f, 0x70, 0x73, 0x52, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x6f,
+	0x70, 0x73, 0x5f, 0x77, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x69, 0x6f, 0x70,
+	0x73, 0x57, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6f, 0x6f, 0x74, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x74, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x64, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x22, 0x58, 0x0a, 0x11, 0x44, 0x69, 0x73,
+	0x6b, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c,
+	0x69, 0x76, 0x65, 0x22, 0x70, 0x0a, 0x15, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x65, 0x74, 0x49, 0x4f,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x69, 0x6f, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x69, 0x6f, 0x70,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x04, 0x6c, 0x69, 0x76, 0x65, 0x22, 0x4e, 0x0a, 0x1b, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x51, 0x65, 0x6d, 0x75, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73,
+	0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x50, 0x0a, 0x1d, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x73,
+	0x69, 0x7a, 0x65, 0x51, 0x65, 0x6d, 0x75, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x64, 0x65, 0x76, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69,
+	0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64,
+	0x69, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x56, 0x0a, 0x0f, 0x44, 0x69, 0x73, 0x6b, 0x47,
+	0x72, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22,
+	0x26, 0x0a, 0x10, 0x44, 0x69, 0x73, 0x6b, 0x47, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x6a, 0x0a, 0x19, 0x44, 0x69, 0x73, 0x6b, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x64, 0x69,
+	0x73, 0x6b, 0x73, 0x22, 0x2d, 0x0a, 0x17, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x5b, 0x0a, 0x18, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f,
+	0x0a, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x52, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x22,
+	0x6a, 0x0a, 0x19, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x22, 0x54, 0x0a, 0x19, 0x44,
+	0x69, 0x73, 0x6b, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x76, 0x65, 0x72,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0x8b, 0x02, 0x0a, 0x15, 0x4e, 0x65, 0x74, 0x49, 0x66, 0x61, 0x63, 0x65, 0x41, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x69, 0x66, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x69, 0x66, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4e, 0x65,
+	0x74, 0x49, 0x66, 0x61, 0x63, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52, 0x06, 0x64, 0x72,
+	0x69, 0x76, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x77, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x77, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1f, 0x0a,
+	0x0b, 0x69, 0x66, 0x75, 0x70, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x69, 0x66, 0x75, 0x70, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x69, 0x66, 0x64, 0x6f, 0x77, 0x6e, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x66, 0x64, 0x6f, 0x77, 0x6e, 0x53, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6c,
+	0x69, 0x76, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x22,
+	0x57, 0x0a, 0x15, 0x4e, 0x65, 0x74, 0x49, 0x66, 0x61, 0x63, 0x65, 0x44, 0x65, 0x74, 0x61, 0x63,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x69, 0x66, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x66,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x64, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x22, 0x5a, 0x0a, 0x18, 0x4e, 0x65, 0x74, 0x49,
+	0x66, 0x61, 0x63, 0x65, 0x53, 0x65, 0x74, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x66, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x66, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x22, 0x87, 0x01, 0x0a, 0x1b, 0x4e, 0x65, 0x74, 0x49, 0x66, 0x61, 0x63,
+	0x65, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x66, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x66, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x3c, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x26, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4e, 0x65, 0x74, 0x49, 0x66, 0x61, 0x63, 0x65, 0x4c, 0x69,
+	0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x5e,
+	0x0a, 0x18, 0x4e, 0x65, 0x74, 0x49, 0x66, 0x61, 0x63, 0x65, 0x53, 0x65, 0x74, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x69, 0x66, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x69, 0x66, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x73, 0x22, 0x92,
+	0x03, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x59, 0x0a, 0x05, 0x76,
+	0x73, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x6b, 0x76, 0x6d,
+	0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x69, 0x6f, 0x56, 0x53, 0x6f, 0x63, 0x6b, 0x48, 0x00, 0x52,
+	0x05, 0x76, 0x73, 0x6f, 0x63, 0x6b, 0x12, 0x69, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c,
+	0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x46, 0x2e, 0x6b, 0x76,
+	0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x69, 0x6f, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x50,
+	0x6f, 0x72, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x50, 0x6f, 0x72,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x04, 0x6c, 0x69, 0x76, 0x65, 0x1a, 0x2c, 0x0a, 0x0b, 0x56, 0x69, 0x72, 0x74, 0x69, 0x6f, 0x56,
+	0x53, 0x6f, 0x63, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x49, 0x64, 0x1a, 0x53, 0x0a, 0x10, 0x56, 0x69, 0x72, 0x74, 0x69, 0x6f, 0x53, 0x65, 0x72,
+	0x69, 0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x72, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64,
+	0x12, 0x26, 0x0a, 0x0f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x67, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x6f, 0x72, 0x74, 0x47,
+	0x75, 0x65, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x22, 0xcb, 0x02, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x44,
+	0x65, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x59, 0x0a, 0x05, 0x76, 0x73, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x41, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76,
+	0x32, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x69, 0x6f, 0x56, 0x53, 0x6f,
+	0x63, 0x6b, 0x48, 0x00, 0x52, 0x05, 0x76, 0x73, 0x6f, 0x63, 0x6b, 0x12, 0x69, 0x0a, 0x0b, 0x73,
+	0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x46, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e,
+	0x76, 0x32, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x69, 0x6f, 0x53, 0x65,
+	0x72, 0x69, 0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x69,
+	0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x64,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x1a, 0x0d, 0x0a, 0x0b, 0x56, 0x69,
+	0x72, 0x74, 0x69, 0x6f, 0x56, 0x53, 0x6f, 0x63, 0x6b, 0x1a, 0x2b, 0x0a, 0x10, 0x56, 0x69, 0x72,
+	0x74, 0x69, 0x6f, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x22, 0x85, 0x01, 0x0a, 0x1b, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x49, 0x6e, 0x69, 0x74, 0x44,
+	0x72, 0x69, 0x76, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x3c, 0x0a, 0x06, 0x64,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6b, 0x76,
+	0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76,
+	0x32, 0x2e, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x49, 0x6e, 0x69, 0x74, 0x44, 0x72, 0x69, 0x76, 0x65,
+	0x72, 0x52, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x22, 0x31, 0x0a, 0x1b, 0x43, 0x6c, 0x6f,
+	0x75, 0x64, 0x49, 0x6e, 0x69, 0x74, 0x44, 0x72, 0x69, 0x76, 0x65, 0x44, 0x65, 0x74, 0x61, 0x63,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x60, 0x0a, 0x20,
+	0x43, 0x6c, 0x6f, 0x75, 0x64, 0x49, 0x6e, 0x69, 0x74, 0x44, 0x72, 0x69, 0x76, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69,
+	0x76, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x22, 0xd9,
+	0x01, 0x0a, 0x16, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x42, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61,
+	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x61, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x5f, 0x62, 0x69,
+	0x74, 0x6d, 0x61, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x63, 0x6c, 0x65, 0x61,
+	0x72, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x73, 0x5f, 0x66, 0x72,
+	0x65, 0x65, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66, 0x73, 0x46, 0x72,
+	0x65, 0x65, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x22, 0x34, 0x0a, 0x17, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x4b, 0x65, 0x79,
+	0x22, 0xc3, 0x02, 0x0a, 0x19, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x5b, 0x0a, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69,
 	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
-	0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x56, 0x4e, 0x43, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75,
+	0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x12,
+	0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61,
+	0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x5f, 0x62, 0x69, 0x74, 0x6d, 0x61,
+	0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x42, 0x69,
+	0x74, 0x6d, 0x61, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x73, 0x5f, 0x66, 0x72, 0x65, 0x65, 0x7a,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66, 0x73, 0x46, 0x72, 0x65, 0x65, 0x7a,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x1a, 0x3d, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x37, 0x0a, 0x1a, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x4b, 0x65, 0x79, 0x22,
+	0xd0, 0x01, 0x0a, 0x18, 0x53, 0x74, 0x61, 0x72, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x6c, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x74, 0x6c, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x63,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x66,
+	0x73, 0x5f, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x66, 0x73, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x22, 0x89, 0x02, 0x0a, 0x19, 0x53, 0x74, 0x61, 0x72, 0x74, 0x42, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x4b, 0x65, 0x79, 0x12, 0x5b, 0x0a, 0x07, 0x65,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x6b,
+	0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x07, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x73, 0x5f, 0x63,
+	0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x66, 0x73, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x1a, 0x4f, 0x0a,
+	0x06, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x70,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x22, 0x49,
+	0x0a, 0x19, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x62, 0x0a, 0x17, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73,
+	0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x5a, 0x0a,
+	0x18, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x73,
+	0x6b, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x73,
+	0x6b, 0x4b, 0x65, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64,
+	0x5f, 0x64, 0x69, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x65, 0x64, 0x44, 0x69, 0x73, 0x6b, 0x22, 0xbf, 0x01, 0x0a, 0x14, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x22, 0x32, 0x0a, 0x15, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x4b, 0x65, 0x79, 0x22,
+	0x67, 0x0a, 0x16, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a,
+	0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x76, 0x32, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x2c, 0x0a, 0x16, 0x42, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x6f, 0x0a, 0x17, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x39, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x19, 0x0a, 0x08,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x6e, 0x65, 0x78, 0x74, 0x52, 0x75, 0x6e, 0x22, 0x2f, 0x0a, 0x19, 0x42, 0x61, 0x63, 0x6b, 0x75,
+	0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x4b, 0x0a, 0x18, 0x42, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73,
+	0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x5e, 0x0a, 0x19, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x43,
+	0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x41, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0xe0, 0x02, 0x0a, 0x15, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d,
+	0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x12, 0x45, 0x0a, 0x09, 0x6f, 0x76, 0x65, 0x72,
+	0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6b, 0x76,
+	0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76,
+	0x32, 0x2e, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x76, 0x65, 0x72, 0x72,
+	0x69, 0x64, 0x65, 0x73, 0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73,
+	0x6b, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x03, 0x74, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x4c, 0x53, 0x52, 0x03, 0x74, 0x6c, 0x73, 0x12, 0x3c, 0x0a, 0x06, 0x74, 0x75,
+	0x6e, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6b, 0x76, 0x6d,
+	0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32,
+	0x2e, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x75, 0x6e, 0x69, 0x6e, 0x67,
+	0x52, 0x06, 0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0x33, 0x0a, 0x16, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x4b, 0x65, 0x79, 0x22, 0xbd, 0x02,
+	0x0a, 0x15, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x64,
+	0x73, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x64, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x69,
+	0x73, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73,
+	0x12, 0x45, 0x0a, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x52, 0x09, 0x6f, 0x76,
+	0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x5f, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x12, 0x33, 0x0a, 0x03, 0x74, 0x6c,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x69,
+	0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x4c, 0x53, 0x52, 0x03, 0x74, 0x6c, 0x73, 0x12,
+	0x3c, 0x0a, 0x06, 0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x75, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0xa4, 0x01,
+	0x0a, 0x16, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6b, 0x76, 0x6d,
+	0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32,
+	0x2e, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49,
+	0x73, 0x73, 0x75, 0x65, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x44,
+	0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x28, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x73, 0x73, 0x75, 0x65, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e,
+	0x69, 0x6e, 0x67, 0x73, 0x22, 0xbe, 0x02, 0x0a, 0x16, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x76,
+	0x61, 0x63, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x64, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x12, 0x28, 0x0a, 0x10, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x5f, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x77, 0x69,
+	0x74, 0x68, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x66, 0x74,
+	0x65, 0x72, 0x12, 0x33, 0x0a, 0x03, 0x74, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x21, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x4c, 0x53, 0x52, 0x03, 0x74, 0x6c, 0x73, 0x12, 0x3c, 0x0a, 0x06, 0x74, 0x75, 0x6e, 0x69, 0x6e,
+	0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x69,
+	0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x74,
+	0x75, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0x34, 0x0a, 0x17, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x76,
+	0x61, 0x63, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x4b, 0x65, 0x79, 0x22, 0x8e, 0x01, 0x0a, 0x18,
+	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x53, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6e, 0x69, 0x74, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x69, 0x6e, 0x69, 0x74, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6d,
+	0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6d, 0x64,
+	0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x69, 0x73, 0x6f, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x69, 0x73, 0x6f, 0x22, 0x31, 0x0a, 0x1b,
+	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x32,
+	0xc9, 0x51, 0x0a, 0x0e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x88, 0x01, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x2e, 0x2e,
+	0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e,
+	0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x3a, 0x01, 0x2a, 0x22, 0x12, 0x2f, 0x76, 0x32, 0x2f, 0x6d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x85, 0x01,
+	0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x2e, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75,
+	0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75,
+	0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x14, 0x2a, 0x12, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b,
+	0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x7c, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x2b, 0x2e, 0x6b,
+	0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6b, 0x76, 0x6d, 0x72,
+	0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12,
+	0x12, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61,
+	0x6d, 0x65, 0x7d, 0x12, 0x95, 0x01, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x31, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73,
+	0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b,
+	0x12, 0x19, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e,
+	0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x73, 0x0a, 0x05, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x12, 0x2d, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x23, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1d, 0x3a, 0x01, 0x2a, 0x1a, 0x18, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x12, 0x70, 0x0a, 0x04, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x2c, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75,
 	0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d,
-	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x56, 0x4e, 0x43, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x1a, 0x16, 0x2f, 0x76, 0x32, 0x2f, 0x6d,
-	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x76, 0x6e,
-	0x63, 0x12, 0x92, 0x01, 0x0a, 0x11, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x12, 0x39, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x22,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a, 0x1a, 0x17, 0x2f, 0x76, 0x32, 0x2f, 0x6d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x74,
+	0x6f, 0x70, 0x12, 0x79, 0x0a, 0x07, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2f, 0x2e,
+	0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e,
+	0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01,
+	0x2a, 0x1a, 0x1a, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b,
+	0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x73, 0x0a,
+	0x05, 0x52, 0x65, 0x73, 0x65, 0x74, 0x12, 0x2d, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x23, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x01, 0x2a, 0x1a, 0x18, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x72, 0x65, 0x73,
+	0x65, 0x74, 0x12, 0x73, 0x0a, 0x05, 0x50, 0x61, 0x75, 0x73, 0x65, 0x12, 0x2d, 0x2e, 0x6b, 0x76,
+	0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x61,
+	0x75, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x01, 0x2a, 0x1a, 0x18, 0x2f,
+	0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65,
+	0x7d, 0x2f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6d,
+	0x65, 0x12, 0x2e, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73,
+	0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1e, 0x3a, 0x01, 0x2a, 0x1a, 0x19, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x12,
+	0xaf, 0x01, 0x0a, 0x13, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x34, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53,
+	0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e,
+	0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x3a, 0x01, 0x2a, 0x22,
+	0x20, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61,
+	0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x2d, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e,
+	0x64, 0x12, 0x79, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2c, 0x2e, 0x6b, 0x76, 0x6d, 0x72,
+	0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e,
 	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61,
-	0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x44,
-	0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c,
+	0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x8e, 0x01, 0x0a,
+	0x09, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x31, 0x2e, 0x6b, 0x76, 0x6d,
+	0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e,
+	0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x32, 0x2f, 0x6d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x82, 0x01,
+	0x0a, 0x0b, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x53, 0x65, 0x74, 0x12, 0x33, 0x2e,
+	0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e,
+	0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x24, 0x3a, 0x01, 0x2a, 0x22, 0x1f, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69,
-	0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x2d,
-	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x8f, 0x01, 0x0a, 0x11, 0x49, 0x6e, 0x70, 0x75, 0x74,
-	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x12, 0x39, 0x2e, 0x6b,
-	0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x49,
-	0x6e, 0x70, 0x75, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x20, 0x3a, 0x01, 0x2a, 0x1a, 0x1b, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x66, 0x69, 0x72, 0x6d, 0x77, 0x61,
+	0x72, 0x65, 0x12, 0x85, 0x01, 0x0a, 0x0e, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x36, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x2a, 0x1b, 0x2f,
+	0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65,
+	0x7d, 0x2f, 0x66, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x12, 0x88, 0x01, 0x0a, 0x0f, 0x4d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x37,
+	0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32,
+	0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x2a, 0x1f, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63,
-	0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x69, 0x6e, 0x70, 0x75,
-	0x74, 0x2d, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x8d, 0x01, 0x0a, 0x0b, 0x43, 0x64, 0x72,
-	0x6f, 0x6d, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x12, 0x33, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75,
+	0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a, 0x01, 0x2a, 0x1a, 0x19, 0x2f, 0x76, 0x32, 0x2f,
+	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x7f, 0x0a, 0x0c, 0x43, 0x50, 0x55, 0x53, 0x65, 0x74, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x34, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x50, 0x55, 0x53, 0x65, 0x74, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x1a, 0x16,
+	0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d,
+	0x65, 0x7d, 0x2f, 0x63, 0x70, 0x75, 0x12, 0x89, 0x01, 0x0a, 0x0d, 0x43, 0x50, 0x55, 0x53, 0x65,
+	0x74, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x35, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75,
 	0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d,
-	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x64, 0x72, 0x6f, 0x6d,
-	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x3a, 0x01, 0x2a,
-	0x22, 0x26, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e,
-	0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x63, 0x64, 0x72, 0x6f, 0x6d, 0x2f, 0x7b, 0x64, 0x65, 0x76, 0x69,
-	0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x8a, 0x01, 0x0a, 0x0b, 0x43, 0x64, 0x72,
-	0x6f, 0x6d, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x12, 0x33, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x50, 0x55, 0x53, 0x65,
+	0x74, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x3a,
+	0x01, 0x2a, 0x1a, 0x1e, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f,
+	0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x63, 0x70, 0x75, 0x2f, 0x73, 0x6f, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x12, 0x83, 0x01, 0x0a, 0x0b, 0x43, 0x50, 0x55, 0x53, 0x65, 0x74, 0x51, 0x75, 0x6f,
+	0x74, 0x61, 0x12, 0x33, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x73, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x50, 0x55, 0x53, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x3a, 0x01, 0x2a, 0x1a, 0x1c, 0x2f, 0x76, 0x32, 0x2f,
+	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x63,
+	0x70, 0x75, 0x2f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x83, 0x01, 0x0a, 0x0b, 0x43, 0x50, 0x55,
+	0x53, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x33, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75,
 	0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d,
-	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x64, 0x72, 0x6f, 0x6d,
-	0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x50, 0x55, 0x53, 0x65,
+	0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x2a, 0x26, 0x2f,
-	0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65,
-	0x7d, 0x2f, 0x63, 0x64, 0x72, 0x6f, 0x6d, 0x2f, 0x7b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x9d, 0x01, 0x0a, 0x10, 0x43, 0x64, 0x72, 0x6f, 0x6d, 0x43,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x38, 0x2e, 0x6b, 0x76, 0x6d,
-	0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x64, 0x72,
-	0x6f, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x37, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x31, 0x3a, 0x01, 0x2a, 0x1a, 0x2c, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63,
-	0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x63, 0x64, 0x72, 0x6f,
-	0x6d, 0x2f, 0x7b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f,
-	0x6d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x9a, 0x01, 0x0a, 0x10, 0x43, 0x64, 0x72, 0x6f, 0x6d, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x38, 0x2e, 0x6b, 0x76, 0x6d,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x3a, 0x01, 0x2a,
+	0x1a, 0x1c, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e,
+	0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x63, 0x70, 0x75, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x8b,
+	0x01, 0x0a, 0x10, 0x48, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x12, 0x38, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a,
+	0x22, 0x1a, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e,
+	0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x68, 0x6f, 0x73, 0x74, 0x70, 0x63, 0x69, 0x12, 0x88, 0x01, 0x0a,
+	0x10, 0x48, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x74, 0x61, 0x63,
+	0x68, 0x12, 0x38, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73,
+	0x2e, 0x76, 0x32, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65,
+	0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x2a, 0x1a, 0x2f, 0x76, 0x32,
+	0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f,
+	0x68, 0x6f, 0x73, 0x74, 0x70, 0x63, 0x69, 0x12, 0xae, 0x01, 0x0a, 0x20, 0x48, 0x6f, 0x73, 0x74,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x66, 0x75,
+	0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x48, 0x2e, 0x6b,
+	0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x48,
+	0x6f, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x74, 0x4d, 0x75, 0x6c, 0x74,
+	0x69, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x28,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x3a, 0x01, 0x2a, 0x1a, 0x1d, 0x2f, 0x76, 0x32, 0x2f, 0x6d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x68, 0x6f,
+	0x73, 0x74, 0x70, 0x63, 0x69, 0x2f, 0x6d, 0x66, 0x12, 0xb1, 0x01, 0x0a, 0x1d, 0x48, 0x6f, 0x73,
+	0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x74, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72,
+	0x79, 0x47, 0x50, 0x55, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x45, 0x2e, 0x6b, 0x76, 0x6d,
 	0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x64, 0x72,
-	0x6f, 0x6d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x34, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x2e, 0x2a, 0x2c, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
-	0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x63, 0x64, 0x72, 0x6f, 0x6d, 0x2f, 0x7b,
-	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6d, 0x65, 0x64,
-	0x69, 0x61, 0x12, 0x7c, 0x0a, 0x0a, 0x44, 0x69, 0x73, 0x6b, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
-	0x12, 0x32, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e,
-	0x76, 0x32, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x22, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a, 0x22, 0x17, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63,
-	0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x69, 0x73, 0x6b,
-	0x12, 0x79, 0x0a, 0x0a, 0x44, 0x69, 0x73, 0x6b, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x12, 0x32,
-	0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32,
-	0x2e, 0x44, 0x69, 0x73, 0x6b, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x19, 0x2a, 0x17, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f,
-	0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x69, 0x73, 0x6b, 0x12, 0x9a, 0x01, 0x0a, 0x10,
-	0x44, 0x69, 0x73, 0x6b, 0x53, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74,
-	0x12, 0x36, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65,
+	0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x48, 0x6f, 0x73,
+	0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x74, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72,
+	0x79, 0x47, 0x50, 0x55, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x2b, 0x3a, 0x01, 0x2a, 0x1a, 0x26, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x68, 0x6f, 0x73, 0x74, 0x70, 0x63, 0x69,
+	0x2f, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x2d, 0x67, 0x70, 0x75, 0x12, 0x9b, 0x01, 0x0a,
+	0x0b, 0x56, 0x4e, 0x43, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x33, 0x2e, 0x6b,
+	0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x56,
+	0x4e, 0x43, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x34, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73,
+	0x2e, 0x76, 0x32, 0x2e, 0x56, 0x4e, 0x43, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a,
+	0x01, 0x2a, 0x1a, 0x16, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f,
+	0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x76, 0x6e, 0x63, 0x12, 0x92, 0x01, 0x0a, 0x11, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x12, 0x39, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65,
 	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e,
-	0x76, 0x32, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x65, 0x74, 0x49, 0x4f, 0x4c, 0x69, 0x6d, 0x69,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x3a, 0x01, 0x2a, 0x1a, 0x2b, 0x2f, 0x76, 0x32,
-	0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f,
-	0x64, 0x69, 0x73, 0x6b, 0x2f, 0x7b, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d,
-	0x2f, 0x69, 0x6f, 0x70, 0x73, 0x2d, 0x72, 0x64, 0x12, 0x9b, 0x01, 0x0a, 0x11, 0x44, 0x69, 0x73,
-	0x6b, 0x53, 0x65, 0x74, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x36,
-	0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32,
-	0x2e, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x65, 0x74, 0x49, 0x4f, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52,
+	0x76, 0x32, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x3a, 0x01, 0x2a, 0x22, 0x1f,
+	0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d,
+	0x65, 0x7d, 0x2f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x2d, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x8f, 0x01, 0x0a, 0x11, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x44,
+	0x65, 0x74, 0x61, 0x63, 0x68, 0x12, 0x39, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21,
+	0x2a, 0x1f, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e,
+	0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x2d, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x8d, 0x01, 0x0a, 0x0b, 0x43, 0x64, 0x72, 0x6f, 0x6d, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x12, 0x33, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73,
+	0x2e, 0x76, 0x32, 0x2e, 0x43, 0x64, 0x72, 0x6f, 0x6d, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x36,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x3a, 0x01, 0x2a, 0x1a, 0x2b, 0x2f, 0x76, 0x32, 0x2f, 0x6d,
-	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x69,
-	0x73, 0x6b, 0x2f, 0x7b, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x69,
-	0x6f, 0x70, 0x73, 0x2d, 0x77, 0x72, 0x12, 0xa5, 0x01, 0x0a, 0x14, 0x44, 0x69, 0x73, 0x6b, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x51, 0x65, 0x6d, 0x75, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x12,
-	0x3c, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76,
-	0x32, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x51, 0x65, 0x6d, 0x75,
-	0x42, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x31,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x3a, 0x01, 0x2a, 0x22, 0x26, 0x2f, 0x76, 0x32, 0x2f, 0x6d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x63, 0x64,
+	0x72, 0x6f, 0x6d, 0x2f, 0x7b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x7d, 0x12, 0x8a, 0x01, 0x0a, 0x0b, 0x43, 0x64, 0x72, 0x6f, 0x6d, 0x44, 0x65, 0x74, 0x61, 0x63,
+	0x68, 0x12, 0x33, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73,
+	0x2e, 0x76, 0x32, 0x2e, 0x43, 0x64, 0x72, 0x6f, 0x6d, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x2e,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x2a, 0x26, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x63, 0x64, 0x72, 0x6f, 0x6d,
+	0x2f, 0x7b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x9d,
+	0x01, 0x0a, 0x10, 0x43, 0x64, 0x72, 0x6f, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x65,
+	0x64, 0x69, 0x61, 0x12, 0x38, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x64, 0x72, 0x6f, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x3a, 0x01, 0x2a,
+	0x1a, 0x2c, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e,
+	0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x63, 0x64, 0x72, 0x6f, 0x6d, 0x2f, 0x7b, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x9a,
+	0x01, 0x0a, 0x10, 0x43, 0x64, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65,
+	0x64, 0x69, 0x61, 0x12, 0x38, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x64, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x2a, 0x2f, 0x2f,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x2a, 0x2c, 0x2f,
 	0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65,
-	0x7d, 0x2f, 0x64, 0x69, 0x73, 0x6b, 0x2f, 0x7b, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x7d, 0x2f, 0x71, 0x65, 0x6d, 0x75, 0x2d, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x12, 0xac,
-	0x01, 0x0a, 0x16, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x51, 0x65, 0x6d,
-	0x75, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x64, 0x65, 0x76, 0x12, 0x3e, 0x2e, 0x6b, 0x76, 0x6d, 0x72,
+	0x7d, 0x2f, 0x63, 0x64, 0x72, 0x6f, 0x6d, 0x2f, 0x7b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x7c, 0x0a, 0x0a, 0x44,
+	0x69, 0x73, 0x6b, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x12, 0x32, 0x2e, 0x6b, 0x76, 0x6d, 0x72,
+	0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x73, 0x6b,
+	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a,
+	0x22, 0x17, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e,
+	0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x69, 0x73, 0x6b, 0x12, 0x79, 0x0a, 0x0a, 0x44, 0x69, 0x73,
+	0x6b, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x12, 0x32, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x44, 0x65,
+	0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x2a, 0x17, 0x2f, 0x76, 0x32,
+	0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f,
+	0x64, 0x69, 0x73, 0x6b, 0x12, 0x9a, 0x01, 0x0a, 0x10, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x65, 0x74,
+	0x52, 0x65, 0x61, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x36, 0x2e, 0x6b, 0x76, 0x6d, 0x72,
 	0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
 	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x73, 0x6b,
+	0x53, 0x65, 0x74, 0x49, 0x4f, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x30, 0x3a, 0x01, 0x2a, 0x1a, 0x2b, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x69, 0x73, 0x6b, 0x2f, 0x7b, 0x64,
+	0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x69, 0x6f, 0x70, 0x73, 0x2d, 0x72,
+	0x64, 0x12, 0x9b, 0x01, 0x0a, 0x11, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x65, 0x74, 0x57, 0x72, 0x69,
+	0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x36, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x65,
+	0x74, 0x49, 0x4f, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x3a,
+	0x01, 0x2a, 0x1a, 0x2b, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f,
+	0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x69, 0x73, 0x6b, 0x2f, 0x7b, 0x64, 0x69, 0x73,
+	0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x69, 0x6f, 0x70, 0x73, 0x2d, 0x77, 0x72, 0x12,
+	0xa5, 0x01, 0x0a, 0x14, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x51, 0x65,
+	0x6d, 0x75, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x12, 0x3c, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75,
+	0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x51, 0x65, 0x6d, 0x75, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x37,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x2a, 0x2f, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x69, 0x73, 0x6b, 0x2f,
+	0x7b, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x71, 0x65, 0x6d, 0x75,
+	0x2d, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x12, 0xac, 0x01, 0x0a, 0x16, 0x44, 0x69, 0x73, 0x6b,
 	0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x51, 0x65, 0x6d, 0x75, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x64,
-	0x65, 0x76, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x3a, 0x01, 0x2a, 0x1a, 0x2f, 0x2f, 0x76,
-	0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d,
-	0x2f, 0x64, 0x69, 0x73, 0x6b, 0x2f, 0x7b, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x7d, 0x2f, 0x71, 0x65, 0x6d, 0x75, 0x2d, 0x72, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x92, 0x01,
-	0x0a, 0x0e, 0x4e, 0x65, 0x74, 0x49, 0x66, 0x61, 0x63, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
-	0x12, 0x36, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e,
-	0x76, 0x32, 0x2e, 0x4e, 0x65, 0x74, 0x49, 0x66, 0x61, 0x63, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63,
-	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x76, 0x12, 0x3e, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x73, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x51,
+	0x65, 0x6d, 0x75, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x64, 0x65, 0x76, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x34, 0x3a, 0x01, 0x2a, 0x1a, 0x2f, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x69, 0x73, 0x6b, 0x2f, 0x7b,
+	0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x71, 0x65, 0x6d, 0x75, 0x2d,
+	0x72, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x12, 0xa4, 0x01, 0x0a, 0x08, 0x44, 0x69, 0x73, 0x6b, 0x47,
+	0x72, 0x6f, 0x77, 0x12, 0x30, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x47, 0x72, 0x6f, 0x77, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x47, 0x72, 0x6f, 0x77,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d,
+	0x3a, 0x01, 0x2a, 0x1a, 0x28, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x69, 0x73, 0x6b, 0x2f, 0x7b, 0x64, 0x69,
+	0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x67, 0x72, 0x6f, 0x77, 0x12, 0xa0, 0x01,
+	0x0a, 0x12, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x12, 0x3a, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30,
+	0x3a, 0x01, 0x2a, 0x22, 0x2b, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x2f, 0x7b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d,
+	0x12, 0xad, 0x01, 0x0a, 0x10, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x38, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x39, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76,
+	0x32, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f,
+	0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73,
+	0x12, 0x9d, 0x01, 0x0a, 0x12, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x3a, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x33, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x2d, 0x2a, 0x2b, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x2f, 0x7b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d,
+	0x12, 0xa7, 0x01, 0x0a, 0x12, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x12, 0x3a, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x3d, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x37, 0x3a, 0x01, 0x2a, 0x1a, 0x32, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x2f, 0x7b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x7d, 0x2f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x12, 0x92, 0x01, 0x0a, 0x0e, 0x4e,
+	0x65, 0x74, 0x49, 0x66, 0x61, 0x63, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x12, 0x36, 0x2e,
+	0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e,
+	0x4e, 0x65, 0x74, 0x49, 0x66, 0x61, 0x63, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x30, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x3a, 0x01, 0x2a, 0x22, 0x25, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6e, 0x65, 0x74,
+	0x2d, 0x69, 0x66, 0x61, 0x63, 0x65, 0x2f, 0x7b, 0x69, 0x66, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12,
+	0x8f, 0x01, 0x0a, 0x0e, 0x4e, 0x65, 0x74, 0x49, 0x66, 0x61, 0x63, 0x65, 0x44, 0x65, 0x74, 0x61,
+	0x63, 0x68, 0x12, 0x36, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4e, 0x65, 0x74, 0x49, 0x66, 0x61, 0x63, 0x65, 0x44, 0x65, 0x74,
+	0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x2a, 0x25, 0x2f, 0x76, 0x32, 0x2f,
+	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6e,
+	0x65, 0x74, 0x2d, 0x69, 0x66, 0x61, 0x63, 0x65, 0x2f, 0x7b, 0x69, 0x66, 0x6e, 0x61, 0x6d, 0x65,
+	0x7d, 0x12, 0xa3, 0x01, 0x0a, 0x14, 0x4e, 0x65, 0x74, 0x49, 0x66, 0x61, 0x63, 0x65, 0x53, 0x65,
+	0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x2e, 0x6b, 0x76, 0x6d,
+	0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4e, 0x65, 0x74,
+	0x49, 0x66, 0x61, 0x63, 0x65, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x3a, 0x01, 0x2a, 0x22, 0x25, 0x2f, 0x76, 0x32,
+	0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x3a, 0x01, 0x2a, 0x1a, 0x2a, 0x2f, 0x76, 0x32,
 	0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f,
 	0x6e, 0x65, 0x74, 0x2d, 0x69, 0x66, 0x61, 0x63, 0x65, 0x2f, 0x7b, 0x69, 0x66, 0x6e, 0x61, 0x6d,
-	0x65, 0x7d, 0x12, 0x8f, 0x01, 0x0a, 0x0e, 0x4e, 0x65, 0x74, 0x49, 0x66, 0x61, 0x63, 0x65, 0x44,
-	0x65, 0x74, 0x61, 0x63, 0x68, 0x12, 0x36, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68,
-	0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4e, 0x65, 0x74, 0x49, 0x66, 0x61, 0x63, 0x65,
-	0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x2a, 0x25, 0x2f,
+	0x65, 0x7d, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0xa4, 0x01, 0x0a, 0x13, 0x4e, 0x65, 0x74, 0x49,
+	0x66, 0x61, 0x63, 0x65, 0x53, 0x65, 0x74, 0x55, 0x70, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12,
+	0x39, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76,
+	0x32, 0x2e, 0x4e, 0x65, 0x74, 0x49, 0x66, 0x61, 0x63, 0x65, 0x53, 0x65, 0x74, 0x53, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x3a, 0x01, 0x2a, 0x1a, 0x2f, 0x2f,
+	0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65,
+	0x7d, 0x2f, 0x6e, 0x65, 0x74, 0x2d, 0x69, 0x66, 0x61, 0x63, 0x65, 0x2f, 0x7b, 0x69, 0x66, 0x6e,
+	0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x75, 0x70, 0x2d, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0xa8,
+	0x01, 0x0a, 0x15, 0x4e, 0x65, 0x74, 0x49, 0x66, 0x61, 0x63, 0x65, 0x53, 0x65, 0x74, 0x44, 0x6f,
+	0x77, 0x6e, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x39, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75,
+	0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4e, 0x65, 0x74, 0x49, 0x66,
+	0x61, 0x63, 0x65, 0x53, 0x65, 0x74, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x3c, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x36, 0x3a, 0x01, 0x2a, 0x1a, 0x31, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6e, 0x65, 0x74, 0x2d, 0x69,
+	0x66, 0x61, 0x63, 0x65, 0x2f, 0x7b, 0x69, 0x66, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x6f,
+	0x77, 0x6e, 0x2d, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x9f, 0x01, 0x0a, 0x11, 0x4e, 0x65,
+	0x74, 0x49, 0x66, 0x61, 0x63, 0x65, 0x53, 0x65, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x73, 0x12,
+	0x39, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76,
+	0x32, 0x2e, 0x4e, 0x65, 0x74, 0x49, 0x66, 0x61, 0x63, 0x65, 0x53, 0x65, 0x74, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x3a, 0x01, 0x2a, 0x1a, 0x2c, 0x2f,
 	0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65,
 	0x7d, 0x2f, 0x6e, 0x65, 0x74, 0x2d, 0x69, 0x66, 0x61, 0x63, 0x65, 0x2f, 0x7b, 0x69, 0x66, 0x6e,
-	0x61, 0x6d, 0x65, 0x7d, 0x12, 0xa3, 0x01, 0x0a, 0x14, 0x4e, 0x65, 0x74, 0x49, 0x66, 0x61, 0x63,
-	0x65, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x2e,
+	0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x73, 0x12, 0x85, 0x01, 0x0a, 0x0d,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x12, 0x35, 0x2e,
 	0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
 	0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e,
-	0x4e, 0x65, 0x74, 0x49, 0x66, 0x61, 0x63, 0x65, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x3a, 0x01, 0x2a, 0x1a, 0x2a,
-	0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d,
-	0x65, 0x7d, 0x2f, 0x6e, 0x65, 0x74, 0x2d, 0x69, 0x66, 0x61, 0x63, 0x65, 0x2f, 0x7b, 0x69, 0x66,
-	0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0xa4, 0x01, 0x0a, 0x13, 0x4e,
-	0x65, 0x74, 0x49, 0x66, 0x61, 0x63, 0x65, 0x53, 0x65, 0x74, 0x55, 0x70, 0x53, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x12, 0x39, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
-	0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4e, 0x65, 0x74, 0x49, 0x66, 0x61, 0x63, 0x65, 0x53, 0x65, 0x74,
-	0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x3a, 0x01, 0x2a,
-	0x1a, 0x2f, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e,
-	0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6e, 0x65, 0x74, 0x2d, 0x69, 0x66, 0x61, 0x63, 0x65, 0x2f, 0x7b,
-	0x69, 0x66, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x75, 0x70, 0x2d, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x12, 0xa8, 0x01, 0x0a, 0x15, 0x4e, 0x65, 0x74, 0x49, 0x66, 0x61, 0x63, 0x65, 0x53, 0x65,
-	0x74, 0x44, 0x6f, 0x77, 0x6e, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x39, 0x2e, 0x6b, 0x76,
-	0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4e, 0x65,
-	0x74, 0x49, 0x66, 0x61, 0x63, 0x65, 0x53, 0x65, 0x74, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x3c,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x3a, 0x01, 0x2a, 0x1a, 0x31, 0x2f, 0x76, 0x32, 0x2f, 0x6d,
-	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6e, 0x65,
-	0x74, 0x2d, 0x69, 0x66, 0x61, 0x63, 0x65, 0x2f, 0x7b, 0x69, 0x66, 0x6e, 0x61, 0x6d, 0x65, 0x7d,
-	0x2f, 0x64, 0x6f, 0x77, 0x6e, 0x2d, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x9f, 0x01, 0x0a,
-	0x11, 0x4e, 0x65, 0x74, 0x49, 0x66, 0x61, 0x63, 0x65, 0x53, 0x65, 0x74, 0x51, 0x75, 0x65, 0x75,
-	0x65, 0x73, 0x12, 0x39, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
-	0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4e, 0x65, 0x74, 0x49, 0x66, 0x61, 0x63, 0x65, 0x53, 0x65, 0x74,
-	0x51, 0x75, 0x65, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x3a, 0x01, 0x2a,
-	0x1a, 0x2c, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e,
-	0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6e, 0x65, 0x74, 0x2d, 0x69, 0x66, 0x61, 0x63, 0x65, 0x2f, 0x7b,
-	0x69, 0x66, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x73, 0x12, 0x85,
-	0x01, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
-	0x12, 0x35, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e,
-	0x76, 0x32, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x76, 0x32, 0x2f,
-	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x63,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x82, 0x01, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x12, 0x35, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75,
-	0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d,
-	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x25, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x12, 0x82, 0x01, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x44,
+	0x65, 0x74, 0x61, 0x63, 0x68, 0x12, 0x35, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x44,
+	0x65, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x2a, 0x1a, 0x2f, 0x76,
+	0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d,
+	0x2f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x96, 0x01, 0x0a, 0x14, 0x43, 0x6c, 0x6f,
+	0x75, 0x64, 0x49, 0x6e, 0x69, 0x74, 0x44, 0x72, 0x69, 0x76, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x12, 0x3c, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73,
+	0x2e, 0x76, 0x32, 0x2e, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x49, 0x6e, 0x69, 0x74, 0x44, 0x72, 0x69,
+	0x76, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
 	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x2a,
-	0x1a, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61,
-	0x6d, 0x65, 0x7d, 0x2f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x96, 0x01, 0x0a, 0x14,
-	0x43, 0x6c, 0x6f, 0x75, 0x64, 0x49, 0x6e, 0x69, 0x74, 0x44, 0x72, 0x69, 0x76, 0x65, 0x41, 0x74,
-	0x74, 0x61, 0x63, 0x68, 0x12, 0x3c, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x3a,
+	0x01, 0x2a, 0x22, 0x1d, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f,
+	0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2d, 0x69, 0x6e, 0x69,
+	0x74, 0x12, 0x93, 0x01, 0x0a, 0x14, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x49, 0x6e, 0x69, 0x74, 0x44,
+	0x72, 0x69, 0x76, 0x65, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x12, 0x3c, 0x2e, 0x6b, 0x76, 0x6d,
+	0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x6c, 0x6f,
+	0x75, 0x64, 0x49, 0x6e, 0x69, 0x74, 0x44, 0x72, 0x69, 0x76, 0x65, 0x44, 0x65, 0x74, 0x61, 0x63,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x2a, 0x1d, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x2d, 0x69, 0x6e, 0x69, 0x74, 0x12, 0xa6, 0x01, 0x0a, 0x19, 0x43, 0x6c, 0x6f, 0x75,
+	0x64, 0x49, 0x6e, 0x69, 0x74, 0x44, 0x72, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x4d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x41, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x49, 0x6e, 0x69,
+	0x74, 0x44, 0x72, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x65, 0x64, 0x69,
+	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x3a, 0x01, 0x2a, 0x1a, 0x23, 0x2f, 0x76, 0x32,
+	0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f,
+	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2d, 0x69, 0x6e, 0x69, 0x74, 0x2f, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x12, 0xbc, 0x01, 0x0a, 0x16, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x42, 0x61,
+	0x63, 0x6b, 0x75, 0x70, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x37, 0x2e, 0x6b, 0x76,
+	0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70,
 	0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69,
-	0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x49, 0x6e, 0x69, 0x74,
-	0x44, 0x72, 0x69, 0x76, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x22, 0x3a, 0x01, 0x2a, 0x22, 0x1d, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69,
-	0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2d,
-	0x69, 0x6e, 0x69, 0x74, 0x12, 0x93, 0x01, 0x0a, 0x14, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x49, 0x6e,
-	0x69, 0x74, 0x44, 0x72, 0x69, 0x76, 0x65, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x12, 0x3c, 0x2e,
+	0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x69, 0x73, 0x6b,
+	0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x3a, 0x01, 0x2a, 0x22, 0x24, 0x2f, 0x76, 0x32, 0x2f, 0x6d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x2d, 0x64, 0x69, 0x73, 0x6b, 0x2d, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12,
+	0xc8, 0x01, 0x0a, 0x19, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3a, 0x2e,
 	0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
 	0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e,
-	0x43, 0x6c, 0x6f, 0x75, 0x64, 0x49, 0x6e, 0x69, 0x74, 0x44, 0x72, 0x69, 0x76, 0x65, 0x44, 0x65,
-	0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x2a, 0x1d, 0x2f, 0x76, 0x32,
-	0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f,
-	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2d, 0x69, 0x6e, 0x69, 0x74, 0x12, 0xa6, 0x01, 0x0a, 0x19, 0x43,
-	0x6c, 0x6f, 0x75, 0x64, 0x49, 0x6e, 0x69, 0x74, 0x44, 0x72, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x41, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x42, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3b, 0x2e, 0x6b, 0x76, 0x6d, 0x72,
+	0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x3a, 0x01,
+	0x2a, 0x22, 0x27, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b,
+	0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x2d, 0x6d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x2d, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12, 0xbd, 0x01, 0x0a, 0x11, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x39, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e,
+	0x76, 0x32, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x6b, 0x76,
+	0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x3a,
+	0x01, 0x2a, 0x22, 0x26, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f,
+	0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x2d, 0x62, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x2d, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x9c, 0x01, 0x0a, 0x12, 0x46,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x3a, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73,
+	0x2e, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x3a, 0x01, 0x2a,
+	0x22, 0x27, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e,
+	0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x2d, 0x62, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x2d, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0xc0, 0x01, 0x0a, 0x17, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x38, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x69, 0x73,
+	0x6b, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x39, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76,
+	0x32, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x2a, 0x3a, 0x01, 0x2a, 0x22, 0x25, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x2d,
+	0x64, 0x69, 0x73, 0x6b, 0x2d, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0xb4, 0x01, 0x0a,
+	0x14, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x4d, 0x6f, 0x76, 0x65, 0x50, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x35, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x69, 0x73,
+	0x6b, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x6b,
+	0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x3a, 0x01, 0x2a, 0x22,
+	0x22, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61,
+	0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x2d, 0x64, 0x69, 0x73, 0x6b, 0x2d, 0x6d,
+	0x6f, 0x76, 0x65, 0x12, 0x8f, 0x01, 0x0a, 0x0f, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x53, 0x65, 0x74, 0x12, 0x37, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25,
+	0x3a, 0x01, 0x2a, 0x1a, 0x20, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x2d, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0xae, 0x01, 0x0a, 0x0f, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x47, 0x65, 0x74, 0x12, 0x37, 0x2e, 0x6b, 0x76, 0x6d, 0x72,
+	0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x42, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x38, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x73, 0x2e, 0x76, 0x32, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x2d,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x92, 0x01, 0x0a, 0x12, 0x42, 0x61, 0x63, 0x6b, 0x75,
+	0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x3a, 0x2e,
+	0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e,
+	0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x2a, 0x20, 0x2f, 0x76, 0x32, 0x2f, 0x6d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x62, 0x61,
+	0x63, 0x6b, 0x75, 0x70, 0x2d, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0xb5, 0x01, 0x0a, 0x11,
+	0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x39, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73,
+	0x2e, 0x76, 0x32, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f,
+	0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x6b,
+	0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x42,
+	0x61, 0x63, 0x6b, 0x75, 0x70, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23,
+	0x12, 0x21, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e,
+	0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x2d, 0x63, 0x61, 0x74, 0x61,
+	0x6c, 0x6f, 0x67, 0x12, 0xb7, 0x01, 0x0a, 0x15, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x69, 0x67,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x36, 0x2e,
+	0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x69, 0x67,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x3a, 0x01, 0x2a, 0x22, 0x22, 0x2f, 0x76, 0x32, 0x2f, 0x6d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x2d, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0xb0, 0x01,
+	0x0a, 0x0e, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x12, 0x36, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e,
+	0x76, 0x32, 0x2e, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75,
 	0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d,
-	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x6c, 0x6f, 0x75, 0x64,
-	0x49, 0x6e, 0x69, 0x74, 0x44, 0x72, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d,
-	0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x3a, 0x01, 0x2a, 0x1a, 0x23,
-	0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d,
-	0x65, 0x7d, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2d, 0x69, 0x6e, 0x69, 0x74, 0x2f, 0x6d, 0x65,
-	0x64, 0x69, 0x61, 0x12, 0xbc, 0x01, 0x0a, 0x16, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x69, 0x73,
-	0x6b, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x37,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x69, 0x67, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x3a, 0x01, 0x2a, 0x22, 0x22, 0x2f, 0x76,
+	0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d,
+	0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x2d, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0xb5, 0x01, 0x0a, 0x16, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x76, 0x61, 0x63, 0x75, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x37, 0x2e, 0x6b, 0x76,
+	0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x45, 0x76, 0x61, 0x63, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x76, 0x61, 0x63,
+	0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x3a, 0x01, 0x2a, 0x22, 0x1d, 0x2f, 0x76, 0x32, 0x2f, 0x6d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x2d, 0x65, 0x76,
+	0x61, 0x63, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x95, 0x01, 0x0a, 0x11, 0x45, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x74, 0x12, 0x39,
 	0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76,
 	0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32,
-	0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61,
-	0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44,
-	0x69, 0x73, 0x6b, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x3a, 0x01, 0x2a, 0x22, 0x24, 0x2f, 0x76,
+	0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x53,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x3a, 0x01, 0x2a, 0x1a, 0x22, 0x2f, 0x76,
 	0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d,
-	0x2f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x2d, 0x64, 0x69, 0x73, 0x6b, 0x2d, 0x62, 0x61, 0x63, 0x6b,
-	0x75, 0x70, 0x12, 0xb7, 0x01, 0x0a, 0x15, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x69, 0x67, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x36, 0x2e, 0x6b,
-	0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x53,
-	0x74, 0x61, 0x72, 0x74, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69,
-	0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x69, 0x67, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x27, 0x3a, 0x01, 0x2a, 0x22, 0x22, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61,
-	0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x2d, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x95, 0x01, 0x0a,
-	0x11, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x53,
-	0x65, 0x74, 0x12, 0x39, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
-	0x73, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4b, 0x65, 0x72,
-	0x6e, 0x65, 0x6c, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x3a, 0x01, 0x2a,
-	0x1a, 0x22, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e,
-	0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2d, 0x6b, 0x65,
-	0x72, 0x6e, 0x65, 0x6c, 0x12, 0x98, 0x01, 0x0a, 0x14, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x4b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x3c, 0x2e,
-	0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2e, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e,
-	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x52, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x2a, 0x22, 0x2f, 0x76, 0x32,
-	0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f,
-	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2d, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x42,
-	0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x30, 0x78,
-	0x65, 0x66, 0x35, 0x33, 0x2f, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
-	0x73, 0x2f, 0x76, 0x32, 0x3b, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2d, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c,
+	0x12, 0x98, 0x01, 0x0a, 0x14, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4b, 0x65, 0x72,
+	0x6e, 0x65, 0x6c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x3c, 0x2e, 0x6b, 0x76, 0x6d, 0x72,
+	0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x4b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x2a, 0x22, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x65, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x2d, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x42, 0x3c, 0x5a, 0x3a, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x30, 0x78, 0x65, 0x66, 0x35, 0x33,
+	0x2f, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x2f, 0x76, 0x32,
+	0x3b, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -4591,7 +6980,7 @@ func file_services_machines_v2_machines_proto_rawDescGZIP() []byte {
 	return file_services_machines_v2_machines_proto_rawDescData
 }
 
-var file_services_machines_v2_machines_proto_msgTypes = make([]protoimpl.MessageInfo, 61)
+var file_services_machines_v2_machines_proto_msgTypes = make([]protoimpl.MessageInfo, 93)
 var file_services_machines_v2_machines_proto_goTypes = []interface{}{
 	(*CreateRequest)(nil),                           // 0: kvmrun.api.services.machines.v2.CreateRequest
 	(*CreateResponse)(nil),                          // 1: kvmrun.api.services.machines.v2.CreateResponse
@@ -4605,192 +6994,283 @@ var file_services_machines_v2_machines_proto_goTypes = []interface{}{
 	(*StopRequest)(nil),                             // 9: kvmrun.api.services.machines.v2.StopRequest
 	(*RestartRequest)(nil),                          // 10: kvmrun.api.services.machines.v2.RestartRequest
 	(*ResetRequest)(nil),                            // 11: kvmrun.api.services.machines.v2.ResetRequest
-	(*ListRequest)(nil),                             // 12: kvmrun.api.services.machines.v2.ListRequest
-	(*ListResponse)(nil),                            // 13: kvmrun.api.services.machines.v2.ListResponse
-	(*ListNamesRequest)(nil),                        // 14: kvmrun.api.services.machines.v2.ListNamesRequest
-	(*ListNamesResponse)(nil),                       // 15: kvmrun.api.services.machines.v2.ListNamesResponse
-	(*FirmwareSetRequest)(nil),                      // 16: kvmrun.api.services.machines.v2.FirmwareSetRequest
-	(*FirmwareRemoveRequest)(nil),                   // 17: kvmrun.api.services.machines.v2.FirmwareRemoveRequest
-	(*MemorySetLimitsRequest)(nil),                  // 18: kvmrun.api.services.machines.v2.MemorySetLimitsRequest
-	(*CPUSetLimitsRequest)(nil),                     // 19: kvmrun.api.services.machines.v2.CPUSetLimitsRequest
-	(*CPUSetSocketsRequest)(nil),                    // 20: kvmrun.api.services.machines.v2.CPUSetSocketsRequest
-	(*CPUSetQuotaRequest)(nil),                      // 21: kvmrun.api.services.machines.v2.CPUSetQuotaRequest
-	(*CPUSetModelRequest)(nil),                      // 22: kvmrun.api.services.machines.v2.CPUSetModelRequest
-	(*HostDeviceAttachRequest)(nil),                 // 23: kvmrun.api.services.machines.v2.HostDeviceAttachRequest
-	(*HostDeviceDetachRequest)(nil),                 // 24: kvmrun.api.services.machines.v2.HostDeviceDetachRequest
-	(*HostDeviceSetMultifunctionOptionRequest)(nil), // 25: kvmrun.api.services.machines.v2.HostDeviceSetMultifunctionOptionRequest
-	(*HostDeviceSetPrimaryGPUOptionRequest)(nil),    // 26: kvmrun.api.services.machines.v2.HostDeviceSetPrimaryGPUOptionRequest
-	(*VNCActivateRequest)(nil),                      // 27: kvmrun.api.services.machines.v2.VNCActivateRequest
-	(*VNCActivateResponse)(nil),                     // 28: kvmrun.api.services.machines.v2.VNCActivateResponse
-	(*InputDeviceAttachRequest)(nil),                // 29: kvmrun.api.services.machines.v2.InputDeviceAttachRequest
-	(*InputDeviceDetachRequest)(nil),                // 30: kvmrun.api.services.machines.v2.InputDeviceDetachRequest
-	(*CdromAttachRequest)(nil),                      // 31: kvmrun.api.services.machines.v2.CdromAttachRequest
-	(*CdromDetachRequest)(nil),                      // 32: kvmrun.api.services.machines.v2.CdromDetachRequest
-	(*CdromChangeMediaRequest)(nil),                 // 33: kvmrun.api.services.machines.v2.CdromChangeMediaRequest
-	(*CdromRemoveMediaRequest)(nil),                 // 34: kvmrun.api.services.machines.v2.CdromRemoveMediaRequest
-	(*DiskAttachRequest)(nil),                       // 35: kvmrun.api.services.machines.v2.DiskAttachRequest
-	(*DiskDetachRequest)(nil),                       // 36: kvmrun.api.services.machines.v2.DiskDetachRequest
-	(*DiskSetIOLimitRequest)(nil),                   // 37: kvmrun.api.services.machines.v2.DiskSetIOLimitRequest
-	(*DiskRemoveQemuBitmapRequest)(nil),             // 38: kvmrun.api.services.machines.v2.DiskRemoveQemuBitmapRequest
-	(*DiskResizeQemuBlockdevRequest)(nil),           // 39: kvmrun.api.services.machines.v2.DiskResizeQemuBlockdevRequest
-	(*NetIfaceAttachRequest)(nil),                   // 40: kvmrun.api.services.machines.v2.NetIfaceAttachRequest
-	(*NetIfaceDetachRequest)(nil),                   // 41: kvmrun.api.services.machines.v2.NetIfaceDetachRequest
-	(*NetIfaceSetScriptRequest)(nil),                // 42: kvmrun.api.services.machines.v2.NetIfaceSetScriptRequest
-	(*NetIfaceSetLinkStateRequest)(nil),             // 43: kvmrun.api.services.machines.v2.NetIfaceSetLinkStateRequest
-	(*NetIfaceSetQueuesRequest)(nil),                // 44: kvmrun.api.services.machines.v2.NetIfaceSetQueuesRequest
-	(*ChannelAttachRequest)(nil),                    // 45: kvmrun.api.services.machines.v2.ChannelAttachRequest
-	(*ChannelDetachRequest)(nil),                    // 46: kvmrun.api.services.machines.v2.ChannelDetachRequest
-	(*CloudInitDriveAttachRequest)(nil),             // 47: kvmrun.api.services.machines.v2.CloudInitDriveAttachRequest
-	(*CloudInitDriveDetachRequest)(nil),             // 48: kvmrun.api.services.machines.v2.CloudInitDriveDetachRequest
-	(*CloudInitDriveChangeMediaRequest)(nil),        // 49: kvmrun.api.services.machines.v2.CloudInitDriveChangeMediaRequest
-	(*StartDiskBackupRequest)(nil),                  // 50: kvmrun.api.services.machines.v2.StartDiskBackupRequest
-	(*StartDiskBackupResponse)(nil),                 // 51: kvmrun.api.services.machines.v2.StartDiskBackupResponse
-	(*StartMigrationRequest)(nil),                   // 52: kvmrun.api.services.machines.v2.StartMigrationRequest
-	(*StartMigrationResponse)(nil),                  // 53: kvmrun.api.services.machines.v2.StartMigrationResponse
-	(*ExternalKernelSetRequest)(nil),                // 54: kvmrun.api.services.machines.v2.ExternalKernelSetRequest
-	(*ExternalKernelRemoveRequest)(nil),             // 55: kvmrun.api.services.machines.v2.ExternalKernelRemoveRequest
-	nil,                                             // 56: kvmrun.api.services.machines.v2.CreateRequest.ExtraFilesEntry
-	(*ChannelAttachRequest_VirtioVSock)(nil),        // 57: kvmrun.api.services.machines.v2.ChannelAttachRequest.VirtioVSock
-	(*ChannelAttachRequest_VirtioSerialPort)(nil),   // 58: kvmrun.api.services.machines.v2.ChannelAttachRequest.VirtioSerialPort
-	(*ChannelDetachRequest_VirtioVSock)(nil),        // 59: kvmrun.api.services.machines.v2.ChannelDetachRequest.VirtioVSock
-	(*ChannelDetachRequest_VirtioSerialPort)(nil),   // 60: kvmrun.api.services.machines.v2.ChannelDetachRequest.VirtioSerialPort
-	(*v2.MachineOpts)(nil),                          // 61: kvmrun.api.types.v2.MachineOpts
-	(*v2.Machine)(nil),                              // 62: kvmrun.api.types.v2.Machine
-	(*v2.MachineEvent)(nil),                         // 63: kvmrun.api.types.v2.MachineEvent
-	(*v2.VNCRequisites)(nil),                        // 64: kvmrun.api.types.v2.VNCRequisites
-	(v2.InputDeviceType)(0),                         // 65: kvmrun.api.types.v2.InputDeviceType
-	(v2.CdromDriver)(0),                             // 66: kvmrun.api.types.v2.CdromDriver
-	(v2.DiskDriver)(0),                              // 67: kvmrun.api.types.v2.DiskDriver
-	(v2.NetIfaceDriver)(0),                          // 68: kvmrun.api.types.v2.NetIfaceDriver
-	(v2.NetIfaceLinkState)(0),                       // 69: kvmrun.api.types.v2.NetIfaceLinkState
-	(v2.CloudInitDriver)(0),                         // 70: kvmrun.api.types.v2.CloudInitDriver
-	(*v2.MigrationOverrides)(nil),                   // 71: kvmrun.api.types.v2.MigrationOverrides
-	(*emptypb.Empty)(nil),                           // 72: google.protobuf.Empty
+	(*PauseRequest)(nil),                            // 12: kvmrun.api.services.machines.v2.PauseRequest
+	(*ResumeRequest)(nil),                           // 13: kvmrun.api.services.machines.v2.ResumeRequest
+	(*StartSuspendRequest)(nil),                     // 14: kvmrun.api.services.machines.v2.StartSuspendRequest
+	(*StartSuspendResponse)(nil),                    // 15: kvmrun.api.services.machines.v2.StartSuspendResponse
+	(*ListRequest)(nil),                             // 16: kvmrun.api.services.machines.v2.ListRequest
+	(*ListResponse)(nil),                            // 17: kvmrun.api.services.machines.v2.ListResponse
+	(*ListNamesRequest)(nil),                        // 18: kvmrun.api.services.machines.v2.ListNamesRequest
+	(*ListNamesResponse)(nil),                       // 19: kvmrun.api.services.machines.v2.ListNamesResponse
+	(*FirmwareSetRequest)(nil),                      // 20: kvmrun.api.services.machines.v2.FirmwareSetRequest
+	(*FirmwareRemoveRequest)(nil),                   // 21: kvmrun.api.services.machines.v2.FirmwareRemoveRequest
+	(*MemorySetLimitsRequest)(nil),                  // 22: kvmrun.api.services.machines.v2.MemorySetLimitsRequest
+	(*CPUSetLimitsRequest)(nil),                     // 23: kvmrun.api.services.machines.v2.CPUSetLimitsRequest
+	(*CPUSetSocketsRequest)(nil),                    // 24: kvmrun.api.services.machines.v2.CPUSetSocketsRequest
+	(*CPUSetQuotaRequest)(nil),                      // 25: kvmrun.api.services.machines.v2.CPUSetQuotaRequest
+	(*CPUSetModelRequest)(nil),                      // 26: kvmrun.api.services.machines.v2.CPUSetModelRequest
+	(*HostDeviceAttachRequest)(nil),                 // 27: kvmrun.api.services.machines.v2.HostDeviceAttachRequest
+	(*HostDeviceDetachRequest)(nil),                 // 28: kvmrun.api.services.machines.v2.HostDeviceDetachRequest
+	(*HostDeviceSetMultifunctionOptionRequest)(nil), // 29: kvmrun.api.services.machines.v2.HostDeviceSetMultifunctionOptionRequest
+	(*HostDeviceSetPrimaryGPUOptionRequest)(nil),    // 30: kvmrun.api.services.machines.v2.HostDeviceSetPrimaryGPUOptionRequest
+	(*VNCActivateRequest)(nil),                      // 31: kvmrun.api.services.machines.v2.VNCActivateRequest
+	(*VNCActivateResponse)(nil),                     // 32: kvmrun.api.services.machines.v2.VNCActivateResponse
+	(*InputDeviceAttachRequest)(nil),                // 33: kvmrun.api.services.machines.v2.InputDeviceAttachRequest
+	(*InputDeviceDetachRequest)(nil),                // 34: kvmrun.api.services.machines.v2.InputDeviceDetachRequest
+	(*CdromAttachRequest)(nil),                      // 35: kvmrun.api.services.machines.v2.CdromAttachRequest
+	(*CdromDetachRequest)(nil),                      // 36: kvmrun.api.services.machines.v2.CdromDetachRequest
+	(*CdromChangeMediaRequest)(nil),                 // 37: kvmrun.api.services.machines.v2.CdromChangeMediaRequest
+	(*CdromRemoveMediaRequest)(nil),                 // 38: kvmrun.api.services.machines.v2.CdromRemoveMediaRequest
+	(*DiskAttachRequest)(nil),                       // 39: kvmrun.api.services.machines.v2.DiskAttachRequest
+	(*DiskDetachRequest)(nil),                       // 40: kvmrun.api.services.machines.v2.DiskDetachRequest
+	(*DiskSetIOLimitRequest)(nil),                   // 41: kvmrun.api.services.machines.v2.DiskSetIOLimitRequest
+	(*DiskRemoveQemuBitmapRequest)(nil),             // 42: kvmrun.api.services.machines.v2.DiskRemoveQemuBitmapRequest
+	(*DiskResizeQemuBlockdevRequest)(nil),           // 43: kvmrun.api.services.machines.v2.DiskResizeQemuBlockdevRequest
+	(*DiskGrowRequest)(nil),                         // 44: kvmrun.api.services.machines.v2.DiskGrowRequest
+	(*DiskGrowResponse)(nil),                        // 45: kvmrun.api.services.machines.v2.DiskGrowResponse
+	(*DiskSnapshotCreateRequest)(nil),               // 46: kvmrun.api.services.machines.v2.DiskSnapshotCreateRequest
+	(*DiskSnapshotListRequest)(nil),                 // 47: kvmrun.api.services.machines.v2.DiskSnapshotListRequest
+	(*DiskSnapshotListResponse)(nil),                // 48: kvmrun.api.services.machines.v2.DiskSnapshotListResponse
+	(*DiskSnapshotDeleteRequest)(nil),               // 49: kvmrun.api.services.machines.v2.DiskSnapshotDeleteRequest
+	(*DiskSnapshotRevertRequest)(nil),               // 50: kvmrun.api.services.machines.v2.DiskSnapshotRevertRequest
+	(*NetIfaceAttachRequest)(nil),                   // 51: kvmrun.api.services.machines.v2.NetIfaceAttachRequest
+	(*NetIfaceDetachRequest)(nil),                   // 52: kvmrun.api.services.machines.v2.NetIfaceDetachRequest
+	(*NetIfaceSetScriptRequest)(nil),                // 53: kvmrun.api.services.machines.v2.NetIfaceSetScriptRequest
+	(*NetIfaceSetLinkStateRequest)(nil),             // 54: kvmrun.api.services.machines.v2.NetIfaceSetLinkStateRequest
+	(*NetIfaceSetQueuesRequest)(nil),                // 55: kvmrun.api.services.machines.v2.NetIfaceSetQueuesRequest
+	(*ChannelAttachRequest)(nil),                    // 56: kvmrun.api.services.machines.v2.ChannelAttachRequest
+	(*ChannelDetachRequest)(nil),                    // 57: kvmrun.api.services.machines.v2.ChannelDetachRequest
+	(*CloudInitDriveAttachRequest)(nil),             // 58: kvmrun.api.services.machines.v2.CloudInitDriveAttachRequest
+	(*CloudInitDriveDetachRequest)(nil),             // 59: kvmrun.api.services.machines.v2.CloudInitDriveDetachRequest
+	(*CloudInitDriveChangeMediaRequest)(nil),        // 60: kvmrun.api.services.machines.v2.CloudInitDriveChangeMediaRequest
+	(*StartDiskBackupRequest)(nil),                  // 61: kvmrun.api.services.machines.v2.StartDiskBackupRequest
+	(*StartDiskBackupResponse)(nil),                 // 62: kvmrun.api.services.machines.v2.StartDiskBackupResponse
+	(*StartMachineBackupRequest)(nil),               // 63: kvmrun.api.services.machines.v2.StartMachineBackupRequest
+	(*StartMachineBackupResponse)(nil),              // 64: kvmrun.api.services.machines.v2.StartMachineBackupResponse
+	(*StartBackupExportRequest)(nil),                // 65: kvmrun.api.services.machines.v2.StartBackupExportRequest
+	(*StartBackupExportResponse)(nil),               // 66: kvmrun.api.services.machines.v2.StartBackupExportResponse
+	(*FinishBackupExportRequest)(nil),               // 67: kvmrun.api.services.machines.v2.FinishBackupExportRequest
+	(*StartDiskRestoreRequest)(nil),                 // 68: kvmrun.api.services.machines.v2.StartDiskRestoreRequest
+	(*StartDiskRestoreResponse)(nil),                // 69: kvmrun.api.services.machines.v2.StartDiskRestoreResponse
+	(*StartDiskMoveRequest)(nil),                    // 70: kvmrun.api.services.machines.v2.StartDiskMoveRequest
+	(*StartDiskMoveResponse)(nil),                   // 71: kvmrun.api.services.machines.v2.StartDiskMoveResponse
+	(*BackupPolicySetRequest)(nil),                  // 72: kvmrun.api.services.machines.v2.BackupPolicySetRequest
+	(*BackupPolicyGetRequest)(nil),                  // 73: kvmrun.api.services.machines.v2.BackupPolicyGetRequest
+	(*BackupPolicyGetResponse)(nil),                 // 74: kvmrun.api.services.machines.v2.BackupPolicyGetResponse
+	(*BackupPolicyRemoveRequest)(nil),               // 75: kvmrun.api.services.machines.v2.BackupPolicyRemoveRequest
+	(*BackupCatalogListRequest)(nil),                // 76: kvmrun.api.services.machines.v2.BackupCatalogListRequest
+	(*BackupCatalogListResponse)(nil),               // 77: kvmrun.api.services.machines.v2.BackupCatalogListResponse
+	(*StartMigrationRequest)(nil),                   // 78: kvmrun.api.services.machines.v2.StartMigrationRequest
+	(*StartMigrationResponse)(nil),                  // 79: kvmrun.api.services.machines.v2.StartMigrationResponse
+	(*MigrationCheckRequest)(nil),                   // 80: kvmrun.api.services.machines.v2.MigrationCheckRequest
+	(*MigrationCheckResponse)(nil),                  // 81: kvmrun.api.services.machines.v2.MigrationCheckResponse
+	(*StartEvacuationRequest)(nil),                  // 82: kvmrun.api.services.machines.v2.StartEvacuationRequest
+	(*StartEvacuationResponse)(nil),                 // 83: kvmrun.api.services.machines.v2.StartEvacuationResponse
+	(*ExternalKernelSetRequest)(nil),                // 84: kvmrun.api.services.machines.v2.ExternalKernelSetRequest
+	(*ExternalKernelRemoveRequest)(nil),             // 85: kvmrun.api.services.machines.v2.ExternalKernelRemoveRequest
+	nil,                                             // 86: kvmrun.api.services.machines.v2.CreateRequest.ExtraFilesEntry
+	(*ChannelAttachRequest_VirtioVSock)(nil),        // 87: kvmrun.api.services.machines.v2.ChannelAttachRequest.VirtioVSock
+	(*ChannelAttachRequest_VirtioSerialPort)(nil),   // 88: kvmrun.api.services.machines.v2.ChannelAttachRequest.VirtioSerialPort
+	(*ChannelDetachRequest_VirtioVSock)(nil),        // 89: kvmrun.api.services.machines.v2.ChannelDetachRequest.VirtioVSock
+	(*ChannelDetachRequest_VirtioSerialPort)(nil),   // 90: kvmrun.api.services.machines.v2.ChannelDetachRequest.VirtioSerialPort
+	(*StartMachineBackupRequest_Target)(nil),        // 91: kvmrun.api.services.machines.v2.StartMachineBackupRequest.Target
+	(*StartBackupExportResponse_Export)(nil),        // 92: kvmrun.api.services.machines.v2.StartBackupExportResponse.Export
+	(*v2.MachineOpts)(nil),                          // 93: kvmrun.api.types.v2.MachineOpts
+	(*v2.Machine)(nil),                              // 94: kvmrun.api.types.v2.Machine
+	(*v2.MachineEvent)(nil),                         // 95: kvmrun.api.types.v2.MachineEvent
+	(*v2.VNCRequisites)(nil),                        // 96: kvmrun.api.types.v2.VNCRequisites
+	(v2.InputDeviceType)(0),                         // 97: kvmrun.api.types.v2.InputDeviceType
+	(v2.CdromDriver)(0),                             // 98: kvmrun.api.types.v2.CdromDriver
+	(v2.DiskDriver)(0),                              // 99: kvmrun.api.types.v2.DiskDriver
+	(*v2.DiskSnapshot)(nil),                         // 100: kvmrun.api.types.v2.DiskSnapshot
+	(v2.NetIfaceDriver)(0),                          // 101: kvmrun.api.types.v2.NetIfaceDriver
+	(v2.NetIfaceLinkState)(0),                       // 102: kvmrun.api.types.v2.NetIfaceLinkState
+	(v2.CloudInitDriver)(0),                         // 103: kvmrun.api.types.v2.CloudInitDriver
+	(*v2.BackupPolicy)(nil),                         // 104: kvmrun.api.types.v2.BackupPolicy
+	(*v2.BackupCatalogEntry)(nil),                   // 105: kvmrun.api.types.v2.BackupCatalogEntry
+	(*v2.MigrationOverrides)(nil),                   // 106: kvmrun.api.types.v2.MigrationOverrides
+	(v2.MigrationTLS)(0),                            // 107: kvmrun.api.types.v2.MigrationTLS
+	(*v2.MigrationTuning)(nil),                      // 108: kvmrun.api.types.v2.MigrationTuning
+	(*v2.MigrationCheckIssue)(nil),                  // 109: kvmrun.api.types.v2.MigrationCheckIssue
+	(*emptypb.Empty)(nil),                           // 110: google.protobuf.Empty
 }
 var file_services_machines_v2_machines_proto_depIdxs = []int32{
-	61, // 0: kvmrun.api.services.machines.v2.CreateRequest.options:type_name -> kvmrun.api.types.v2.MachineOpts
-	56, // 1: kvmrun.api.services.machines.v2.CreateRequest.extra_files:type_name -> kvmrun.api.services.machines.v2.CreateRequest.ExtraFilesEntry
-	62, // 2: kvmrun.api.services.machines.v2.CreateResponse.machine:type_name -> kvmrun.api.types.v2.Machine
-	62, // 3: kvmrun.api.services.machines.v2.DeleteResponse.machine:type_name -> kvmrun.api.types.v2.Machine
-	62, // 4: kvmrun.api.services.machines.v2.GetResponse.machine:type_name -> kvmrun.api.types.v2.Machine
-	63, // 5: kvmrun.api.services.machines.v2.GetEventsResponse.events:type_name -> kvmrun.api.types.v2.MachineEvent
-	62, // 6: kvmrun.api.services.machines.v2.ListResponse.machines:type_name -> kvmrun.api.types.v2.Machine
-	64, // 7: kvmrun.api.services.machines.v2.VNCActivateResponse.requisites:type_name -> kvmrun.api.types.v2.VNCRequisites
-	65, // 8: kvmrun.api.services.machines.v2.InputDeviceAttachRequest.type:type_name -> kvmrun.api.types.v2.InputDeviceType
-	65, // 9: kvmrun.api.services.machines.v2.InputDeviceDetachRequest.type:type_name -> kvmrun.api.types.v2.InputDeviceType
-	66, // 10: kvmrun.api.services.machines.v2.CdromAttachRequest.driver:type_name -> kvmrun.api.types.v2.CdromDriver
-	67, // 11: kvmrun.api.services.machines.v2.DiskAttachRequest.driver:type_name -> kvmrun.api.types.v2.DiskDriver
-	68, // 12: kvmrun.api.services.machines.v2.NetIfaceAttachRequest.driver:type_name -> kvmrun.api.types.v2.NetIfaceDriver
-	69, // 13: kvmrun.api.services.machines.v2.NetIfaceSetLinkStateRequest.state:type_name -> kvmrun.api.types.v2.NetIfaceLinkState
-	57, // 14: kvmrun.api.services.machines.v2.ChannelAttachRequest.vsock:type_name -> kvmrun.api.services.machines.v2.ChannelAttachRequest.VirtioVSock
-	58, // 15: kvmrun.api.services.machines.v2.ChannelAttachRequest.serial_port:type_name -> kvmrun.api.services.machines.v2.ChannelAttachRequest.VirtioSerialPort
-	59, // 16: kvmrun.api.services.machines.v2.ChannelDetachRequest.vsock:type_name -> kvmrun.api.services.machines.v2.ChannelDetachRequest.VirtioVSock
-	60, // 17: kvmrun.api.services.machines.v2.ChannelDetachRequest.serial_port:type_name -> kvmrun.api.services.machines.v2.ChannelDetachRequest.VirtioSerialPort
-	70, // 18: kvmrun.api.services.machines.v2.CloudInitDriveAttachRequest.driver:type_name -> kvmrun.api.types.v2.CloudInitDriver
-	71, // 19: kvmrun.api.services.machines.v2.StartMigrationRequest.overrides:type_name -> kvmrun.api.types.v2.MigrationOverrides
-	0,  // 20: kvmrun.api.services.machines.v2.MachineService.Create:input_type -> kvmrun.api.services.machines.v2.CreateRequest
-	2,  // 21: kvmrun.api.services.machines.v2.MachineService.Delete:input_type -> kvmrun.api.services.machines.v2.DeleteRequest
-	4,  // 22: kvmrun.api.services.machines.v2.MachineService.Get:input_type -> kvmrun.api.services.machines.v2.GetRequest
-	6,  // 23: kvmrun.api.services.machines.v2.MachineService.GetEvents:input_type -> kvmrun.api.services.machines.v2.GetEventsRequest
-	8,  // 24: kvmrun.api.services.machines.v2.MachineService.Start:input_type -> kvmrun.api.services.machines.v2.StartRequest
-	9,  // 25: kvmrun.api.services.machines.v2.MachineService.Stop:input_type -> kvmrun.api.services.machines.v2.StopRequest
-	10, // 26: kvmrun.api.services.machines.v2.MachineService.Restart:input_type -> kvmrun.api.services.machines.v2.RestartRequest
-	11, // 27: kvmrun.api.services.machines.v2.MachineService.Reset:input_type -> kvmrun.api.services.machines.v2.ResetRequest
-	12, // 28: kvmrun.api.services.machines.v2.MachineService.List:input_type -> kvmrun.api.services.machines.v2.ListRequest
-	14, // 29: kvmrun.api.services.machines.v2.MachineService.ListNames:input_type -> kvmrun.api.services.machines.v2.ListNamesRequest
-	16, // 30: kvmrun.api.services.machines.v2.MachineService.FirmwareSet:input_type -> kvmrun.api.services.machines.v2.FirmwareSetRequest
-	17, // 31: kvmrun.api.services.machines.v2.MachineService.FirmwareRemove:input_type -> kvmrun.api.services.machines.v2.FirmwareRemoveRequest
-	18, // 32: kvmrun.api.services.machines.v2.MachineService.MemorySetLimits:input_type -> kvmrun.api.services.machines.v2.MemorySetLimitsRequest
-	19, // 33: kvmrun.api.services.machines.v2.MachineService.CPUSetLimits:input_type -> kvmrun.api.services.machines.v2.CPUSetLimitsRequest
-	20, // 34: kvmrun.api.services.machines.v2.MachineService.CPUSetSockets:input_type -> kvmrun.api.services.machines.v2.CPUSetSocketsRequest
-	21, // 35: kvmrun.api.services.machines.v2.MachineService.CPUSetQuota:input_type -> kvmrun.api.services.machines.v2.CPUSetQuotaRequest
-	22, // 36: kvmrun.api.services.machines.v2.MachineService.CPUSetModel:input_type -> kvmrun.api.services.machines.v2.CPUSetModelRequest
-	23, // 37: kvmrun.api.services.machines.v2.MachineService.HostDeviceAttach:input_type -> kvmrun.api.services.machines.v2.HostDeviceAttachRequest
-	24, // 38: kvmrun.api.services.machines.v2.MachineService.HostDeviceDetach:input_type -> kvmrun.api.services.machines.v2.HostDeviceDetachRequest
-	25, // 39: kvmrun.api.services.machines.v2.MachineService.HostDeviceSetMultifunctionOption:input_type -> kvmrun.api.services.machines.v2.HostDeviceSetMultifunctionOptionRequest
-	26, // 40: kvmrun.api.services.machines.v2.MachineService.HostDeviceSetPrimaryGPUOption:input_type -> kvmrun.api.services.machines.v2.HostDeviceSetPrimaryGPUOptionRequest
-	27, // 41: kvmrun.api.services.machines.v2.MachineService.VNCActivate:input_type -> kvmrun.api.services.machines.v2.VNCActivateRequest
-	29, // 42: kvmrun.api.services.machines.v2.MachineService.InputDeviceAttach:input_type -> kvmrun.api.services.machines.v2.InputDeviceAttachRequest
-	30, // 43: kvmrun.api.services.machines.v2.MachineService.InputDeviceDetach:input_type -> kvmrun.api.services.machines.v2.InputDeviceDetachRequest
-	31, // 44: kvmrun.api.services.machines.v2.MachineService.CdromAttach:input_type -> kvmrun.api.services.machines.v2.CdromAttachRequest
-	32, // 45: kvmrun.api.services.machines.v2.MachineService.CdromDetach:input_type -> kvmrun.api.services.machines.v2.CdromDetachRequest
-	33, // 46: kvmrun.api.services.machines.v2.MachineService.CdromChangeMedia:input_type -> kvmrun.api.services.machines.v2.CdromChangeMediaRequest
-	34, // 47: kvmrun.api.services.machines.v2.MachineService.CdromRemoveMedia:input_type -> kvmrun.api.services.machines.v2.CdromRemoveMediaRequest
-	35, // 48: kvmrun.api.services.machines.v2.MachineService.DiskAttach:input_type -> kvmrun.api.services.machines.v2.DiskAttachRequest
-	36, // 49: kvmrun.api.services.machines.v2.MachineService.DiskDetach:input_type -> kvmrun.api.services.machines.v2.DiskDetachRequest
-	37, // 50: kvmrun.api.services.machines.v2.MachineService.DiskSetReadLimit:input_type -> kvmrun.api.services.machines.v2.DiskSetIOLimitRequest
-	37, // 51: kvmrun.api.services.machines.v2.MachineService.DiskSetWriteLimit:input_type -> kvmrun.api.services.machines.v2.DiskSetIOLimitRequest
-	38, // 52: kvmrun.api.services.machines.v2.MachineService.DiskRemoveQemuBitmap:input_type -> kvmrun.api.services.machines.v2.DiskRemoveQemuBitmapRequest
-	39, // 53: kvmrun.api.services.machines.v2.MachineService.DiskResizeQemuBlockdev:input_type -> kvmrun.api.services.machines.v2.DiskResizeQemuBlockdevRequest
-	40, // 54: kvmrun.api.services.machines.v2.MachineService.NetIfaceAttach:input_type -> kvmrun.api.services.machines.v2.NetIfaceAttachRequest
-	41, // 55: kvmrun.api.services.machines.v2.MachineService.NetIfaceDetach:input_type -> kvmrun.api.services.machines.v2.NetIfaceDetachRequest
-	43, // 56: kvmrun.api.services.machines.v2.MachineService.NetIfaceSetLinkState:input_type -> kvmrun.api.services.machines.v2.NetIfaceSetLinkStateRequest
-	42, // 57: kvmrun.api.services.machines.v2.MachineService.NetIfaceSetUpScript:input_type -> kvmrun.api.services.machines.v2.NetIfaceSetScriptRequest
-	42, // 58: kvmrun.api.services.machines.v2.MachineService.NetIfaceSetDownScript:input_type -> kvmrun.api.services.machines.v2.NetIfaceSetScriptRequest
-	44, // 59: kvmrun.api.services.machines.v2.MachineService.NetIfaceSetQueues:input_type -> kvmrun.api.services.machines.v2.NetIfaceSetQueuesRequest
-	45, // 60: kvmrun.api.services.machines.v2.MachineService.ChannelAttach:input_type -> kvmrun.api.services.machines.v2.ChannelAttachRequest
-	46, // 61: kvmrun.api.services.machines.v2.MachineService.ChannelDetach:input_type -> kvmrun.api.services.machines.v2.ChannelDetachRequest
-	47, // 62: kvmrun.api.services.machines.v2.MachineService.CloudInitDriveAttach:input_type -> kvmrun.api.services.machines.v2.CloudInitDriveAttachRequest
-	48, // 63: kvmrun.api.services.machines.v2.MachineService.CloudInitDriveDetach:input_type -> kvmrun.api.services.machines.v2.CloudInitDriveDetachRequest
-	49, // 64: kvmrun.api.services.machines.v2.MachineService.CloudInitDriveChangeMedia:input_type -> kvmrun.api.services.machines.v2.CloudInitDriveChangeMediaRequest
-	50, // 65: kvmrun.api.services.machines.v2.MachineService.StartDiskBackupProcess:input_type -> kvmrun.api.services.machines.v2.StartDiskBackupRequest
-	52, // 66: kvmrun.api.services.machines.v2.MachineService.StartMigrationProcess:input_type -> kvmrun.api.services.machines.v2.StartMigrationRequest
-	54, // 67: kvmrun.api.services.machines.v2.MachineService.ExternalKernelSet:input_type -> kvmrun.api.services.machines.v2.ExternalKernelSetRequest
-	55, // 68: kvmrun.api.services.machines.v2.MachineService.ExternalKernelRemove:input_type -> kvmrun.api.services.machines.v2.ExternalKernelRemoveRequest
-	1,  // 69: kvmrun.api.services.machines.v2.MachineService.Create:output_type -> kvmrun.api.services.machines.v2.CreateResponse
-	3,  // 70: kvmrun.api.services.machines.v2.MachineService.Delete:output_type -> kvmrun.api.services.machines.v2.DeleteResponse
-	5,  // 71: kvmrun.api.services.machines.v2.MachineService.Get:output_type -> kvmrun.api.services.machines.v2.GetResponse
-	7,  // 72: kvmrun.api.services.machines.v2.MachineService.GetEvents:output_type -> kvmrun.api.services.machines.v2.GetEventsResponse
-	72, // 73: kvmrun.api.services.machines.v2.MachineService.Start:output_type -> google.protobuf.Empty
-	72, // 74: kvmrun.api.services.machines.v2.MachineService.Stop:output_type -> google.protobuf.Empty
-	72, // 75: kvmrun.api.services.machines.v2.MachineService.Restart:output_type -> google.protobuf.Empty
-	72, // 76: kvmrun.api.services.machines.v2.MachineService.Reset:output_type -> google.protobuf.Empty
-	13, // 77: kvmrun.api.services.machines.v2.MachineService.List:output_type -> kvmrun.api.services.machines.v2.ListResponse
-	15, // 78: kvmrun.api.services.machines.v2.MachineService.ListNames:output_type -> kvmrun.api.services.machines.v2.ListNamesResponse
-	72, // 79: kvmrun.api.services.machines.v2.MachineService.FirmwareSet:output_type -> google.protobuf.Empty
-	72, // 80: kvmrun.api.services.machines.v2.MachineService.FirmwareRemove:output_type -> google.protobuf.Empty
-	72, // 81: kvmrun.api.services.machines.v2.MachineService.MemorySetLimits:output_type -> google.protobuf.Empty
-	72, // 82: kvmrun.api.services.machines.v2.MachineService.CPUSetLimits:output_type -> google.protobuf.Empty
-	72, // 83: kvmrun.api.services.machines.v2.MachineService.CPUSetSockets:output_type -> google.protobuf.Empty
-	72, // 84: kvmrun.api.services.machines.v2.MachineService.CPUSetQuota:output_type -> google.protobuf.Empty
-	72, // 85: kvmrun.api.services.machines.v2.MachineService.CPUSetModel:output_type -> google.protobuf.Empty
-	72, // 86: kvmrun.api.services.machines.v2.MachineService.HostDeviceAttach:output_type -> google.protobuf.Empty
-	72, // 87: kvmrun.api.services.machines.v2.MachineService.HostDeviceDetach:output_type -> google.protobuf.Empty
-	72, // 88: kvmrun.api.services.machines.v2.MachineService.HostDeviceSetMultifunctionOption:output_type -> google.protobuf.Empty
-	72, // 89: kvmrun.api.services.machines.v2.MachineService.HostDeviceSetPrimaryGPUOption:output_type -> google.protobuf.Empty
-	28, // 90: kvmrun.api.services.machines.v2.MachineService.VNCActivate:output_type -> kvmrun.api.services.machines.v2.VNCActivateResponse
-	72, // 91: kvmrun.api.services.machines.v2.MachineService.InputDeviceAttach:output_type -> google.protobuf.Empty
-	72, // 92: kvmrun.api.services.machines.v2.MachineService.InputDeviceDetach:output_type -> google.protobuf.Empty
-	72, // 93: kvmrun.api.services.machines.v2.MachineService.CdromAttach:output_type -> google.protobuf.Empty
-	72, // 94: kvmrun.api.services.machines.v2.MachineService.CdromDetach:output_type -> google.protobuf.Empty
-	72, // 95: kvmrun.api.services.machines.v2.MachineService.CdromChangeMedia:output_type -> google.protobuf.Empty
-	72, // 96: kvmrun.api.services.machines.v2.MachineService.CdromRemoveMedia:output_type -> google.protobuf.Empty
-	72, // 97: kvmrun.api.services.machines.v2.MachineService.DiskAttach:output_type -> google.protobuf.Empty
-	72, // 98: kvmrun.api.services.machines.v2.MachineService.DiskDetach:output_type -> google.protobuf.Empty
-	72, // 99: kvmrun.api.services.machines.v2.MachineService.DiskSetReadLimit:output_type -> google.protobuf.Empty
-	72, // 100: kvmrun.api.services.machines.v2.MachineService.DiskSetWriteLimit:output_type -> google.protobuf.Empty
-	72, // 101: kvmrun.api.services.machines.v2.MachineService.DiskRemoveQemuBitmap:output_type -> google.protobuf.Empty
-	72, // 102: kvmrun.api.services.machines.v2.MachineService.DiskResizeQemuBlockdev:output_type -> google.protobuf.Empty
-	72, // 103: kvmrun.api.services.machines.v2.MachineService.NetIfaceAttach:output_type -> google.protobuf.Empty
-	72, // 104: kvmrun.api.services.machines.v2.MachineService.NetIfaceDetach:output_type -> google.protobuf.Empty
-	72, // 105: kvmrun.api.services.machines.v2.MachineService.NetIfaceSetLinkState:output_type -> google.protobuf.Empty
-	72, // 106: kvmrun.api.services.machines.v2.MachineService.NetIfaceSetUpScript:output_type -> google.protobuf.Empty
-	72, // 107: kvmrun.api.services.machines.v2.MachineService.NetIfaceSetDownScript:output_type -> google.protobuf.Empty
-	72, // 108: kvmrun.api.services.machines.v2.MachineService.NetIfaceSetQueues:output_type -> google.protobuf.Empty
-	72, // 109: kvmrun.api.services.machines.v2.MachineService.ChannelAttach:output_type -> google.protobuf.Empty
-	72, // 110: kvmrun.api.services.machines.v2.MachineService.ChannelDetach:output_type -> google.protobuf.Empty
-	72, // 111: kvmrun.api.services.machines.v2.MachineService.CloudInitDriveAttach:output_type -> google.protobuf.Empty
-	72, // 112: kvmrun.api.services.machines.v2.MachineService.CloudInitDriveDetach:output_type -> google.protobuf.Empty
-	72, // 113: kvmrun.api.services.machines.v2.MachineService.CloudInitDriveChangeMedia:output_type -> google.protobuf.Empty
-	51, // 114: kvmrun.api.services.machines.v2.MachineService.StartDiskBackupProcess:output_type -> kvmrun.api.services.machines.v2.StartDiskBackupResponse
-	53, // 115: kvmrun.api.services.machines.v2.MachineService.StartMigrationProcess:output_type -> kvmrun.api.services.machines.v2.StartMigrationResponse
-	72, // 116: kvmrun.api.services.machines.v2.MachineService.ExternalKernelSet:output_type -> google.protobuf.Empty
-	72, // 117: kvmrun.api.services.machines.v2.MachineService.ExternalKernelRemove:output_type -> google.protobuf.Empty
-	69, // [69:118] is the sub-list for method output_type
-	20, // [20:69] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
+	93,  // 0: kvmrun.api.services.machines.v2.CreateRequest.options:type_name -> kvmrun.api.types.v2.MachineOpts
+	86,  // 1: kvmrun.api.services.machines.v2.CreateRequest.extra_files:type_name -> kvmrun.api.services.machines.v2.CreateRequest.ExtraFilesEntry
+	94,  // 2: kvmrun.api.services.machines.v2.CreateResponse.machine:type_name -> kvmrun.api.types.v2.Machine
+	94,  // 3: kvmrun.api.services.machines.v2.DeleteResponse.machine:type_name -> kvmrun.api.types.v2.Machine
+	94,  // 4: kvmrun.api.services.machines.v2.GetResponse.machine:type_name -> kvmrun.api.types.v2.Machine
+	95,  // 5: kvmrun.api.services.machines.v2.GetEventsResponse.events:type_name -> kvmrun.api.types.v2.MachineEvent
+	94,  // 6: kvmrun.api.services.machines.v2.ListResponse.machines:type_name -> kvmrun.api.types.v2.Machine
+	96,  // 7: kvmrun.api.services.machines.v2.VNCActivateResponse.requisites:type_name -> kvmrun.api.types.v2.VNCRequisites
+	97,  // 8: kvmrun.api.services.machines.v2.InputDeviceAttachRequest.type:type_name -> kvmrun.api.types.v2.InputDeviceType
+	97,  // 9: kvmrun.api.services.machines.v2.InputDeviceDetachRequest.type:type_name -> kvmrun.api.types.v2.InputDeviceType
+	98,  // 10: kvmrun.api.services.machines.v2.CdromAttachRequest.driver:type_name -> kvmrun.api.types.v2.CdromDriver
+	99,  // 11: kvmrun.api.services.machines.v2.DiskAttachRequest.driver:type_name -> kvmrun.api.types.v2.DiskDriver
+	100, // 12: kvmrun.api.services.machines.v2.DiskSnapshotListResponse.snapshots:type_name -> kvmrun.api.types.v2.DiskSnapshot
+	101, // 13: kvmrun.api.services.machines.v2.NetIfaceAttachRequest.driver:type_name -> kvmrun.api.types.v2.NetIfaceDriver
+	102, // 14: kvmrun.api.services.machines.v2.NetIfaceSetLinkStateRequest.state:type_name -> kvmrun.api.types.v2.NetIfaceLinkState
+	87,  // 15: kvmrun.api.services.machines.v2.ChannelAttachRequest.vsock:type_name -> kvmrun.api.services.machines.v2.ChannelAttachRequest.VirtioVSock
+	88,  // 16: kvmrun.api.services.machines.v2.ChannelAttachRequest.serial_port:type_name -> kvmrun.api.services.machines.v2.ChannelAttachRequest.VirtioSerialPort
+	89,  // 17: kvmrun.api.services.machines.v2.ChannelDetachRequest.vsock:type_name -> kvmrun.api.services.machines.v2.ChannelDetachRequest.VirtioVSock
+	90,  // 18: kvmrun.api.services.machines.v2.ChannelDetachRequest.serial_port:type_name -> kvmrun.api.services.machines.v2.ChannelDetachRequest.VirtioSerialPort
+	103, // 19: kvmrun.api.services.machines.v2.CloudInitDriveAttachRequest.driver:type_name -> kvmrun.api.types.v2.CloudInitDriver
+	91,  // 20: kvmrun.api.services.machines.v2.StartMachineBackupRequest.targets:type_name -> kvmrun.api.services.machines.v2.StartMachineBackupRequest.Target
+	92,  // 21: kvmrun.api.services.machines.v2.StartBackupExportResponse.exports:type_name -> kvmrun.api.services.machines.v2.StartBackupExportResponse.Export
+	104, // 22: kvmrun.api.services.machines.v2.BackupPolicySetRequest.policy:type_name -> kvmrun.api.types.v2.BackupPolicy
+	104, // 23: kvmrun.api.services.machines.v2.BackupPolicyGetResponse.policy:type_name -> kvmrun.api.types.v2.BackupPolicy
+	105, // 24: kvmrun.api.services.machines.v2.BackupCatalogListResponse.entries:type_name -> kvmrun.api.types.v2.BackupCatalogEntry
+	106, // 25: kvmrun.api.services.machines.v2.StartMigrationRequest.overrides:type_name -> kvmrun.api.types.v2.MigrationOverrides
+	107, // 26: kvmrun.api.services.machines.v2.StartMigrationRequest.tls:type_name -> kvmrun.api.types.v2.MigrationTLS
+	108, // 27: kvmrun.api.services.machines.v2.StartMigrationRequest.tuning:type_name -> kvmrun.api.types.v2.MigrationTuning
+	106, // 28: kvmrun.api.services.machines.v2.MigrationCheckRequest.overrides:type_name -> kvmrun.api.types.v2.MigrationOverrides
+	107, // 29: kvmrun.api.services.machines.v2.MigrationCheckRequest.tls:type_name -> kvmrun.api.types.v2.MigrationTLS
+	108, // 30: kvmrun.api.services.machines.v2.MigrationCheckRequest.tuning:type_name -> kvmrun.api.types.v2.MigrationTuning
+	109, // 31: kvmrun.api.services.machines.v2.MigrationCheckResponse.blockers:type_name -> kvmrun.api.types.v2.MigrationCheckIssue
+	109, // 32: kvmrun.api.services.machines.v2.MigrationCheckResponse.warnings:type_name -> kvmrun.api.types.v2.MigrationCheckIssue
+	107, // 33: kvmrun.api.services.machines.v2.StartEvacuationRequest.tls:type_name -> kvmrun.api.types.v2.MigrationTLS
+	108, // 34: kvmrun.api.services.machines.v2.StartEvacuationRequest.tuning:type_name -> kvmrun.api.types.v2.MigrationTuning
+	0,   // 35: kvmrun.api.services.machines.v2.MachineService.Create:input_type -> kvmrun.api.services.machines.v2.CreateRequest
+	2,   // 36: kvmrun.api.services.machines.v2.MachineService.Delete:input_type -> kvmrun.api.services.machines.v2.DeleteRequest
+	4,   // 37: kvmrun.api.services.machines.v2.MachineService.Get:input_type -> kvmrun.api.services.machines.v2.GetRequest
+	6,   // 38: kvmrun.api.services.machines.v2.MachineService.GetEvents:input_type -> kvmrun.api.services.machines.v2.GetEventsRequest
+	8,   // 39: kvmrun.api.services.machines.v2.MachineService.Start:input_type -> kvmrun.api.services.machines.v2.StartRequest
+	9,   // 40: kvmrun.api.services.machines.v2.MachineService.Stop:input_type -> kvmrun.api.services.machines.v2.StopRequest
+	10,  // 41: kvmrun.api.services.machines.v2.MachineService.Restart:input_type -> kvmrun.api.services.machines.v2.RestartRequest
+	11,  // 42: kvmrun.api.services.machines.v2.MachineService.Reset:input_type -> kvmrun.api.services.machines.v2.ResetRequest
+	12,  // 43: kvmrun.api.services.machines.v2.MachineService.Pause:input_type -> kvmrun.api.services.machines.v2.PauseRequest
+	13,  // 44: kvmrun.api.services.machines.v2.MachineService.Resume:input_type -> kvmrun.api.services.machines.v2.ResumeRequest
+	14,  // 45: kvmrun.api.services.machines.v2.MachineService.StartSuspendProcess:input_type -> kvmrun.api.services.machines.v2.StartSuspendRequest
+	16,  // 46: kvmrun.api.services.machines.v2.MachineService.List:input_type -> kvmrun.api.services.machines.v2.ListRequest
+	18,  // 47: kvmrun.api.services.machines.v2.MachineService.ListNames:input_type -> kvmrun.api.services.machines.v2.ListNamesRequest
+	20,  // 48: kvmrun.api.services.machines.v2.MachineService.FirmwareSet:input_type -> kvmrun.api.services.machines.v2.FirmwareSetRequest
+	21,  // 49: kvmrun.api.services.machines.v2.MachineService.FirmwareRemove:input_type -> kvmrun.api.services.machines.v2.FirmwareRemoveRequest
+	22,  // 50: kvmrun.api.services.machines.v2.MachineService.MemorySetLimits:input_type -> kvmrun.api.services.machines.v2.MemorySetLimitsRequest
+	23,  // 51: kvmrun.api.services.machines.v2.MachineService.CPUSetLimits:input_type -> kvmrun.api.services.machines.v2.CPUSetLimitsRequest
+	24,  // 52: kvmrun.api.services.machines.v2.MachineService.CPUSetSockets:input_type -> kvmrun.api.services.machines.v2.CPUSetSocketsRequest
+	25,  // 53: kvmrun.api.services.machines.v2.MachineService.CPUSetQuota:input_type -> kvmrun.api.services.machines.v2.CPUSetQuotaRequest
+	26,  // 54: kvmrun.api.services.machines.v2.MachineService.CPUSetModel:input_type -> kvmrun.api.services.machines.v2.CPUSetModelRequest
+	27,  // 55: kvmrun.api.services.machines.v2.MachineService.HostDeviceAttach:input_type -> kvmrun.api.services.machines.v2.HostDeviceAttachRequest
+	28,  // 56: kvmrun.api.services.machines.v2.MachineService.HostDeviceDetach:input_type -> kvmrun.api.services.machines.v2.HostDeviceDetachRequest
+	29,  // 57: kvmrun.api.services.machines.v2.MachineService.HostDeviceSetMultifunctionOption:input_type -> kvmrun.api.services.machines.v2.HostDeviceSetMultifunctionOptionRequest
+	30,  // 58: kvmrun.api.services.machines.v2.MachineService.HostDeviceSetPrimaryGPUOption:input_type -> kvmrun.api.services.machines.v2.HostDeviceSetPrimaryGPUOptionRequest
+	31,  // 59: kvmrun.api.services.machines.v2.MachineService.VNCActivate:input_type -> kvmrun.api.services.machines.v2.VNCActivateRequest
+	33,  // 60: kvmrun.api.services.machines.v2.MachineService.InputDeviceAttach:input_type -> kvmrun.api.services.machines.v2.InputDeviceAttachRequest
+	34,  // 61: kvmrun.api.services.machines.v2.MachineService.InputDeviceDetach:input_type -> kvmrun.api.services.machines.v2.InputDeviceDetachRequest
+	35,  // 62: kvmrun.api.services.machines.v2.MachineService.CdromAttach:input_type -> kvmrun.api.services.machines.v2.CdromAttachRequest
+	36,  // 63: kvmrun.api.services.machines.v2.MachineService.CdromDetach:input_type -> kvmrun.api.services.machines.v2.CdromDetachRequest
+	37,  // 64: kvmrun.api.services.machines.v2.MachineService.CdromChangeMedia:input_type -> kvmrun.api.services.machines.v2.CdromChangeMediaRequest
+	38,  // 65: kvmrun.api.services.machines.v2.MachineService.CdromRemoveMedia:input_type -> kvmrun.api.services.machines.v2.CdromRemoveMediaRequest
+	39,  // 66: kvmrun.api.services.machines.v2.MachineService.DiskAttach:input_type -> kvmrun.api.services.machines.v2.DiskAttachRequest
+	40,  // 67: kvmrun.api.services.machines.v2.MachineService.DiskDetach:input_type -> kvmrun.api.services.machines.v2.DiskDetachRequest
+	41,  // 68: kvmrun.api.services.machines.v2.MachineService.DiskSetReadLimit:input_type -> kvmrun.api.services.machines.v2.DiskSetIOLimitRequest
+	41,  // 69: kvmrun.api.services.machines.v2.MachineService.DiskSetWriteLimit:input_type -> kvmrun.api.services.machines.v2.DiskSetIOLimitRequest
+	42,  // 70: kvmrun.api.services.machines.v2.MachineService.DiskRemoveQemuBitmap:input_type -> kvmrun.api.services.machines.v2.DiskRemoveQemuBitmapRequest
+	43,  // 71: kvmrun.api.services.machines.v2.MachineService.DiskResizeQemuBlockdev:input_type -> kvmrun.api.services.machines.v2.DiskResizeQemuBlockdevRequest
+	44,  // 72: kvmrun.api.services.machines.v2.MachineService.DiskGrow:input_type -> kvmrun.api.services.machines.v2.DiskGrowRequest
+	46,  // 73: kvmrun.api.services.machines.v2.MachineService.DiskSnapshotCreate:input_type -> kvmrun.api.services.machines.v2.DiskSnapshotCreateRequest
+	47,  // 74: kvmrun.api.services.machines.v2.MachineService.DiskSnapshotList:input_type -> kvmrun.api.services.machines.v2.DiskSnapshotListRequest
+	49,  // 75: kvmrun.api.services.machines.v2.MachineService.DiskSnapshotDelete:input_type -> kvmrun.api.services.machines.v2.DiskSnapshotDeleteRequest
+	50,  // 76: kvmrun.api.services.machines.v2.MachineService.DiskSnapshotRevert:input_type -> kvmrun.api.services.machines.v2.DiskSnapshotRevertRequest
+	51,  // 77: kvmrun.api.services.machines.v2.MachineService.NetIfaceAttach:input_type -> kvmrun.api.services.machines.v2.NetIfaceAttachRequest
+	52,  // 78: kvmrun.api.services.machines.v2.MachineService.NetIfaceDetach:input_type -> kvmrun.api.services.machines.v2.NetIfaceDetachRequest
+	54,  // 79: kvmrun.api.services.machines.v2.MachineService.NetIfaceSetLinkState:input_type -> kvmrun.api.services.machines.v2.NetIfaceSetLinkStateRequest
+	53,  // 80: kvmrun.api.services.machines.v2.MachineService.NetIfaceSetUpScript:input_type -> kvmrun.api.services.machines.v2.NetIfaceSetScriptRequest
+	53,  // 81: kvmrun.api.services.machines.v2.MachineService.NetIfaceSetDownScript:input_type -> kvmrun.api.services.machines.v2.NetIfaceSetScriptRequest
+	55,  // 82: kvmrun.api.services.machines.v2.MachineService.NetIfaceSetQueues:input_type -> kvmrun.api.services.machines.v2.NetIfaceSetQueuesRequest
+	56,  // 83: kvmrun.api.services.machines.v2.MachineService.ChannelAttach:input_type -> kvmrun.api.services.machines.v2.ChannelAttachRequest
+	57,  // 84: kvmrun.api.services.machines.v2.MachineService.ChannelDetach:input_type -> kvmrun.api.services.machines.v2.ChannelDetachRequest
+	58,  // 85: kvmrun.api.services.machines.v2.MachineService.CloudInitDriveAttach:input_type -> kvmrun.api.services.machines.v2.CloudInitDriveAttachRequest
+	59,  // 86: kvmrun.api.services.machines.v2.MachineService.CloudInitDriveDetach:input_type -> kvmrun.api.services.machines.v2.CloudInitDriveDetachRequest
+	60,  // 87: kvmrun.api.services.machines.v2.MachineService.CloudInitDriveChangeMedia:input_type -> kvmrun.api.services.machines.v2.CloudInitDriveChangeMediaRequest
+	61,  // 88: kvmrun.api.services.machines.v2.MachineService.StartDiskBackupProcess:input_type -> kvmrun.api.services.machines.v2.StartDiskBackupRequest
+	63,  // 89: kvmrun.api.services.machines.v2.MachineService.StartMachineBackupProcess:input_type -> kvmrun.api.services.machines.v2.StartMachineBackupRequest
+	65,  // 90: kvmrun.api.services.machines.v2.MachineService.StartBackupExport:input_type -> kvmrun.api.services.machines.v2.StartBackupExportRequest
+	67,  // 91: kvmrun.api.services.machines.v2.MachineService.FinishBackupExport:input_type -> kvmrun.api.services.machines.v2.FinishBackupExportRequest
+	68,  // 92: kvmrun.api.services.machines.v2.MachineService.StartDiskRestoreProcess:input_type -> kvmrun.api.services.machines.v2.StartDiskRestoreRequest
+	70,  // 93: kvmrun.api.services.machines.v2.MachineService.StartDiskMoveProcess:input_type -> kvmrun.api.services.machines.v2.StartDiskMoveRequest
+	72,  // 94: kvmrun.api.services.machines.v2.MachineService.BackupPolicySet:input_type -> kvmrun.api.services.machines.v2.BackupPolicySetRequest
+	73,  // 95: kvmrun.api.services.machines.v2.MachineService.BackupPolicyGet:input_type -> kvmrun.api.services.machines.v2.BackupPolicyGetRequest
+	75,  // 96: kvmrun.api.services.machines.v2.MachineService.BackupPolicyRemove:input_type -> kvmrun.api.services.machines.v2.BackupPolicyRemoveRequest
+	76,  // 97: kvmrun.api.services.machines.v2.MachineService.BackupCatalogList:input_type -> kvmrun.api.services.machines.v2.BackupCatalogListRequest
+	78,  // 98: kvmrun.api.services.machines.v2.MachineService.StartMigrationProcess:input_type -> kvmrun.api.services.machines.v2.StartMigrationRequest
+	80,  // 99: kvmrun.api.services.machines.v2.MachineService.MigrationCheck:input_type -> kvmrun.api.services.machines.v2.MigrationCheckRequest
+	82,  // 100: kvmrun.api.services.machines.v2.MachineService.StartEvacuationProcess:input_type -> kvmrun.api.services.machines.v2.StartEvacuationRequest
+	84,  // 101: kvmrun.api.services.machines.v2.MachineService.ExternalKernelSet:input_type -> kvmrun.api.services.machines.v2.ExternalKernelSetRequest
+	85,  // 102: kvmrun.api.services.machines.v2.MachineService.ExternalKernelRemove:input_type -> kvmrun.api.services.machines.v2.ExternalKernelRemoveRequest
+	1,   // 103: kvmrun.api.services.machines.v2.MachineService.Create:output_type -> kvmrun.api.services.machines.v2.CreateResponse
+	3,   // 104: kvmrun.api.services.machines.v2.MachineService.Delete:output_type -> kvmrun.api.services.machines.v2.DeleteResponse
+	5,   // 105: kvmrun.api.services.machines.v2.MachineService.Get:output_type -> kvmrun.api.services.machines.v2.GetResponse
+	7,   // 106: kvmrun.api.services.machines.v2.MachineService.GetEvents:output_type -> kvmrun.api.services.machines.v2.GetEventsResponse
+	110, // 107: kvmrun.api.services.machines.v2.MachineService.Start:output_type -> google.protobuf.Empty
+	110, // 108: kvmrun.api.services.machines.v2.MachineService.Stop:output_type -> google.protobuf.Empty
+	110, // 109: kvmrun.api.services.machines.v2.MachineService.Restart:output_type -> google.protobuf.Empty
+	110, // 110: kvmrun.api.services.machines.v2.MachineService.Reset:output_type -> google.protobuf.Empty
+	110, // 111: kvmrun.api.services.machines.v2.MachineService.Pause:output_type -> google.protobuf.Empty
+	110, // 112: kvmrun.api.services.machines.v2.MachineService.Resume:output_type -> google.protobuf.Empty
+	15,  // 113: kvmrun.api.services.machines.v2.MachineService.StartSuspendProcess:output_type -> kvmrun.api.services.machines.v2.StartSuspendResponse
+	17,  // 114: kvmrun.api.services.machines.v2.MachineService.List:output_type -> kvmrun.api.services.machines.v2.ListResponse
+	19,  // 115: kvmrun.api.services.machines.v2.MachineService.ListNames:output_type -> kvmrun.api.services.machines.v2.ListNamesResponse
+	110, // 116: kvmrun.api.services.machines.v2.MachineService.FirmwareSet:output_type -> google.protobuf.Empty
+	110, // 117: kvmrun.api.services.machines.v2.MachineService.FirmwareRemove:output_type -> google.protobuf.Empty
+	110, // 118: kvmrun.api.services.machines.v2.MachineService.MemorySetLimits:output_type -> google.protobuf.Empty
+	110, // 119: kvmrun.api.services.machines.v2.MachineService.CPUSetLimits:output_type -> google.protobuf.Empty
+	110, // 120: kvmrun.api.services.machines.v2.MachineService.CPUSetSockets:output_type -> google.protobuf.Empty
+	110, // 121: kvmrun.api.services.machines.v2.MachineService.CPUSetQuota:output_type -> google.protobuf.Empty
+	110, // 122: kvmrun.api.services.machines.v2.MachineService.CPUSetModel:output_type -> google.protobuf.Empty
+	110, // 123: kvmrun.api.services.machines.v2.MachineService.HostDeviceAttach:output_type -> google.protobuf.Empty
+	110, // 124: kvmrun.api.services.machines.v2.MachineService.HostDeviceDetach:output_type -> google.protobuf.Empty
+	110, // 125: kvmrun.api.services.machines.v2.MachineService.HostDeviceSetMultifunctionOption:output_type -> google.protobuf.Empty
+	110, // 126: kvmrun.api.services.machines.v2.MachineService.HostDeviceSetPrimaryGPUOption:output_type -> google.protobuf.Empty
+	32,  // 127: kvmrun.api.services.machines.v2.MachineService.VNCActivate:output_type -> kvmrun.api.services.machines.v2.VNCActivateResponse
+	110, // 128: kvmrun.api.services.machines.v2.MachineService.InputDeviceAttach:output_type -> google.protobuf.Empty
+	110, // 129: kvmrun.api.services.machines.v2.MachineService.InputDeviceDetach:output_type -> google.protobuf.Empty
+	110, // 130: kvmrun.api.services.machines.v2.MachineService.CdromAttach:output_type -> google.protobuf.Empty
+	110, // 131: kvmrun.api.services.machines.v2.MachineService.CdromDetach:output_type -> google.protobuf.Empty
+	110, // 132: kvmrun.api.services.machines.v2.MachineService.CdromChangeMedia:output_type -> google.protobuf.Empty
+	110, // 133: kvmrun.api.services.machines.v2.MachineService.CdromRemoveMedia:output_type -> google.protobuf.Empty
+	110, // 134: kvmrun.api.services.machines.v2.MachineService.DiskAttach:output_type -> google.protobuf.Empty
+	110, // 135: kvmrun.api.services.machines.v2.MachineService.DiskDetach:output_type -> google.protobuf.Empty
+	110, // 136: kvmrun.api.services.machines.v2.MachineService.DiskSetReadLimit:output_type -> google.protobuf.Empty
+	110, // 137: kvmrun.api.services.machines.v2.MachineService.DiskSetWriteLimit:output_type -> google.protobuf.Empty
+	110, // 138: kvmrun.api.services.machines.v2.MachineService.DiskRemoveQemuBitmap:output_type -> google.protobuf.Empty
+	110, // 139: kvmrun.api.services.machines.v2.MachineService.DiskResizeQemuBlockdev:output_type -> google.protobuf.Empty
+	45,  // 140: kvmrun.api.services.machines.v2.MachineService.DiskGrow:output_type -> kvmrun.api.services.machines.v2.DiskGrowResponse
+	110, // 141: kvmrun.api.services.machines.v2.MachineService.DiskSnapshotCreate:output_type -> google.protobuf.Empty
+	48,  // 142: kvmrun.api.services.machines.v2.MachineService.DiskSnapshotList:output_type -> kvmrun.api.services.machines.v2.DiskSnapshotListResponse
+	110, // 143: kvmrun.api.services.machines.v2.MachineService.DiskSnapshotDelete:output_type -> google.protobuf.Empty
+	110, // 144: kvmrun.api.services.machines.v2.MachineService.DiskSnapshotRevert:output_type -> google.protobuf.Empty
+	110, // 145: kvmrun.api.services.machines.v2.MachineService.NetIfaceAttach:output_type -> google.protobuf.Empty
+	110, // 146: kvmrun.api.services.machines.v2.MachineService.NetIfaceDetach:output_type -> google.protobuf.Empty
+	110, // 147: kvmrun.api.services.machines.v2.MachineService.NetIfaceSetLinkState:output_type -> google.protobuf.Empty
+	110, // 148: kvmrun.api.services.machines.v2.MachineService.NetIfaceSetUpScript:output_type -> google.protobuf.Empty
+	110, // 149: kvmrun.api.services.machines.v2.MachineService.NetIfaceSetDownScript:output_type -> google.protobuf.Empty
+	110, // 150: kvmrun.api.services.machines.v2.MachineService.NetIfaceSetQueues:output_type -> google.protobuf.Empty
+	110, // 151: kvmrun.api.services.machines.v2.MachineService.ChannelAttach:output_type -> google.protobuf.Empty
+	110, // 152: kvmrun.api.services.machines.v2.MachineService.ChannelDetach:output_type -> google.protobuf.Empty
+	110, // 153: kvmrun.api.services.machines.v2.MachineService.CloudInitDriveAttach:output_type -> google.protobuf.Empty
+	110, // 154: kvmrun.api.services.machines.v2.MachineService.CloudInitDriveDetach:output_type -> google.protobuf.Empty
+	110, // 155: kvmrun.api.services.machines.v2.MachineService.CloudInitDriveChangeMedia:output_type -> google.protobuf.Empty
+	62,  // 156: kvmrun.api.services.machines.v2.MachineService.StartDiskBackupProcess:output_type -> kvmrun.api.services.machines.v2.StartDiskBackupResponse
+	64,  // 157: kvmrun.api.services.machines.v2.MachineService.StartMachineBackupProcess:output_type -> kvmrun.api.services.machines.v2.StartMachineBackupResponse
+	66,  // 158: kvmrun.api.services.machines.v2.MachineService.StartBackupExport:output_type -> kvmrun.api.services.machines.v2.StartBackupExportResponse
+	110, // 159: kvmrun.api.services.machines.v2.MachineService.FinishBackupExport:output_type -> google.protobuf.Empty
+	69,  // 160: kvmrun.api.services.machines.v2.MachineService.StartDiskRestoreProcess:output_type -> kvmrun.api.services.machines.v2.StartDiskRestoreResponse
+	71,  // 161: kvmrun.api.services.machines.v2.MachineService.StartDiskMoveProcess:output_type -> kvmrun.api.services.machines.v2.StartDiskMoveResponse
+	110, // 162: kvmrun.api.services.machines.v2.MachineService.BackupPolicySet:output_type -> google.protobuf.Empty
+	74,  // 163: kvmrun.api.services.machines.v2.MachineService.BackupPolicyGet:output_type -> kvmrun.api.services.machines.v2.BackupPolicyGetResponse
+	110, // 164: kvmrun.api.services.machines.v2.MachineService.BackupPolicyRemove:output_type -> google.protobuf.Empty
+	77,  // 165: kvmrun.api.services.machines.v2.MachineService.BackupCatalogList:output_type -> kvmrun.api.services.machines.v2.BackupCatalogListResponse
+	79,  // 166: kvmrun.api.services.machines.v2.MachineService.StartMigrationProcess:output_type -> kvmrun.api.services.machines.v2.StartMigrationResponse
+	81,  // 167: kvmrun.api.services.machines.v2.MachineService.MigrationCheck:output_type -> kvmrun.api.services.machines.v2.MigrationCheckResponse
+	83,  // 168: kvmrun.api.services.machines.v2.MachineService.StartEvacuationProcess:output_type -> kvmrun.api.services.machines.v2.StartEvacuationResponse
+	110, // 169: kvmrun.api.services.machines.v2.MachineService.ExternalKernelSet:output_type -> google.protobuf.Empty
+	110, // 170: kvmrun.api.services.machines.v2.MachineService.ExternalKernelRemove:output_type -> google.protobuf.Empty
+	103, // [103:171] is the sub-list for method output_type
+	35,  // [35:103] is the sub-list for method input_type
+	35,  // [35:35] is the sub-list for extension type_name
+	35,  // [35:35] is the sub-list for extension extendee
+	0,   // [0:35] is the sub-list for field type_name
 }
 
 func init() { file_services_machines_v2_machines_proto_init() }
@@ -4811,8 +7291,248 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateResponse); i {
+		file_services_machines_v2_machines_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_machines_v2_machines_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_machines_v2_machines_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_machines_v2_machines_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_machines_v2_machines_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_machines_v2_machines_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_machines_v2_machines_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEventsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_machines_v2_machines_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_machines_v2_machines_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_machines_v2_machines_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestartRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_machines_v2_machines_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_machines_v2_machines_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_machines_v2_machines_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_machines_v2_machines_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartSuspendRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_machines_v2_machines_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartSuspendResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_machines_v2_machines_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_machines_v2_machines_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_machines_v2_machines_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListNamesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_machines_v2_machines_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListNamesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_machines_v2_machines_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FirmwareSetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_machines_v2_machines_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FirmwareRemoveRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4823,8 +7543,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteRequest); i {
+		file_services_machines_v2_machines_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemorySetLimitsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4835,8 +7555,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteResponse); i {
+		file_services_machines_v2_machines_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CPUSetLimitsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4847,8 +7567,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRequest); i {
+		file_services_machines_v2_machines_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CPUSetSocketsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4859,8 +7579,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetResponse); i {
+		file_services_machines_v2_machines_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CPUSetQuotaRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4871,8 +7591,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetEventsRequest); i {
+		file_services_machines_v2_machines_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CPUSetModelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4883,8 +7603,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetEventsResponse); i {
+		file_services_machines_v2_machines_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostDeviceAttachRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4895,8 +7615,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartRequest); i {
+		file_services_machines_v2_machines_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostDeviceDetachRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4907,8 +7627,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopRequest); i {
+		file_services_machines_v2_machines_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostDeviceSetMultifunctionOptionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4919,8 +7639,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RestartRequest); i {
+		file_services_machines_v2_machines_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostDeviceSetPrimaryGPUOptionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4931,8 +7651,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResetRequest); i {
+		file_services_machines_v2_machines_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VNCActivateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4943,8 +7663,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListRequest); i {
+		file_services_machines_v2_machines_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VNCActivateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4955,8 +7675,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListResponse); i {
+		file_services_machines_v2_machines_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InputDeviceAttachRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4967,8 +7687,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListNamesRequest); i {
+		file_services_machines_v2_machines_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InputDeviceDetachRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4979,8 +7699,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListNamesResponse); i {
+		file_services_machines_v2_machines_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CdromAttachRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4991,8 +7711,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FirmwareSetRequest); i {
+		file_services_machines_v2_machines_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CdromDetachRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5003,8 +7723,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FirmwareRemoveRequest); i {
+		file_services_machines_v2_machines_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CdromChangeMediaRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5015,8 +7735,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MemorySetLimitsRequest); i {
+		file_services_machines_v2_machines_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CdromRemoveMediaRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5027,8 +7747,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CPUSetLimitsRequest); i {
+		file_services_machines_v2_machines_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskAttachRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5039,8 +7759,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CPUSetSocketsRequest); i {
+		file_services_machines_v2_machines_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskDetachRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5051,8 +7771,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CPUSetQuotaRequest); i {
+		file_services_machines_v2_machines_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskSetIOLimitRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5063,8 +7783,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CPUSetModelRequest); i {
+		file_services_machines_v2_machines_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskRemoveQemuBitmapRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5075,8 +7795,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostDeviceAttachRequest); i {
+		file_services_machines_v2_machines_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskResizeQemuBlockdevRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5087,8 +7807,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostDeviceDetachRequest); i {
+		file_services_machines_v2_machines_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskGrowRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5099,8 +7819,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostDeviceSetMultifunctionOptionRequest); i {
+		file_services_machines_v2_machines_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskGrowResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5111,8 +7831,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HostDeviceSetPrimaryGPUOptionRequest); i {
+		file_services_machines_v2_machines_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskSnapshotCreateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5123,8 +7843,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VNCActivateRequest); i {
+		file_services_machines_v2_machines_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskSnapshotListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5135,8 +7855,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VNCActivateResponse); i {
+		file_services_machines_v2_machines_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskSnapshotListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5147,8 +7867,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InputDeviceAttachRequest); i {
+		file_services_machines_v2_machines_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskSnapshotDeleteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5159,8 +7879,152 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InputDeviceDetachRequest); i {
+		file_services_machines_v2_machines_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskSnapshotRevertRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_machines_v2_machines_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetIfaceAttachRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_machines_v2_machines_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetIfaceDetachRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_machines_v2_machines_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetIfaceSetScriptRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_machines_v2_machines_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetIfaceSetLinkStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_machines_v2_machines_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetIfaceSetQueuesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_machines_v2_machines_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelAttachRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_machines_v2_machines_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelDetachRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_machines_v2_machines_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloudInitDriveAttachRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_machines_v2_machines_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloudInitDriveDetachRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_machines_v2_machines_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloudInitDriveChangeMediaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_machines_v2_machines_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartDiskBackupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_machines_v2_machines_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartDiskBackupResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5171,8 +8035,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CdromAttachRequest); i {
+		file_services_machines_v2_machines_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartMachineBackupRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5183,8 +8047,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CdromDetachRequest); i {
+		file_services_machines_v2_machines_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartMachineBackupResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5195,8 +8059,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CdromChangeMediaRequest); i {
+		file_services_machines_v2_machines_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartBackupExportRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5207,8 +8071,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CdromRemoveMediaRequest); i {
+		file_services_machines_v2_machines_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartBackupExportResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5219,8 +8083,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiskAttachRequest); i {
+		file_services_machines_v2_machines_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FinishBackupExportRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5231,8 +8095,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiskDetachRequest); i {
+		file_services_machines_v2_machines_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartDiskRestoreRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5243,8 +8107,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiskSetIOLimitRequest); i {
+		file_services_machines_v2_machines_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartDiskRestoreResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5255,8 +8119,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiskRemoveQemuBitmapRequest); i {
+		file_services_machines_v2_machines_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartDiskMoveRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5267,8 +8131,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiskResizeQemuBlockdevRequest); i {
+		file_services_machines_v2_machines_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartDiskMoveResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5279,8 +8143,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NetIfaceAttachRequest); i {
+		file_services_machines_v2_machines_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackupPolicySetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5291,8 +8155,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NetIfaceDetachRequest); i {
+		file_services_machines_v2_machines_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackupPolicyGetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5303,8 +8167,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NetIfaceSetScriptRequest); i {
+		file_services_machines_v2_machines_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackupPolicyGetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5315,8 +8179,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NetIfaceSetLinkStateRequest); i {
+		file_services_machines_v2_machines_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackupPolicyRemoveRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5327,8 +8191,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NetIfaceSetQueuesRequest); i {
+		file_services_machines_v2_machines_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackupCatalogListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5339,8 +8203,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelAttachRequest); i {
+		file_services_machines_v2_machines_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackupCatalogListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5351,8 +8215,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelDetachRequest); i {
+		file_services_machines_v2_machines_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartMigrationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5363,8 +8227,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CloudInitDriveAttachRequest); i {
+		file_services_machines_v2_machines_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartMigrationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5375,8 +8239,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CloudInitDriveDetachRequest); i {
+		file_services_machines_v2_machines_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MigrationCheckRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5387,8 +8251,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CloudInitDriveChangeMediaRequest); i {
+		file_services_machines_v2_machines_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MigrationCheckResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5399,8 +8263,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartDiskBackupRequest); i {
+		file_services_machines_v2_machines_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartEvacuationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5411,8 +8275,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartDiskBackupResponse); i {
+		file_services_machines_v2_machines_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartEvacuationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5423,8 +8287,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartMigrationRequest); i {
+		file_services_machines_v2_machines_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExternalKernelSetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5435,8 +8299,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartMigrationResponse); i {
+		file_services_machines_v2_machines_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExternalKernelRemoveRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5447,8 +8311,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExternalKernelSetRequest); i {
+		file_services_machines_v2_machines_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelAttachRequest_VirtioVSock); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5459,8 +8323,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExternalKernelRemoveRequest); i {
+		file_services_machines_v2_machines_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelAttachRequest_VirtioSerialPort); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5471,8 +8335,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelAttachRequest_VirtioVSock); i {
+		file_services_machines_v2_machines_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelDetachRequest_VirtioVSock); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5483,8 +8347,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelAttachRequest_VirtioSerialPort); i {
+		file_services_machines_v2_machines_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelDetachRequest_VirtioSerialPort); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5495,8 +8359,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelDetachRequest_VirtioVSock); i {
+		file_services_machines_v2_machines_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartMachineBackupRequest_Target); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5507,8 +8371,8 @@ func file_services_machines_v2_machines_proto_init() {
 				return nil
 			}
 		}
-		file_services_machines_v2_machines_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelDetachRequest_VirtioSerialPort); i {
+		file_services_machines_v2_machines_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartBackupExportResponse_Export); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5520,11 +8384,11 @@ func file_services_machines_v2_machines_proto_init() {
 			}
 		}
 	}
-	file_services_machines_v2_machines_proto_msgTypes[45].OneofWrappers = []interface{}{
+	file_services_machines_v2_machines_proto_msgTypes[56].OneofWrappers = []interface{}{
 		(*ChannelAttachRequest_Vsock)(nil),
 		(*ChannelAttachRequest_SerialPort)(nil),
 	}
-	file_services_machines_v2_machines_proto_msgTypes[46].OneofWrappers = []interface{}{
+	file_services_machines_v2_machines_proto_msgTypes[57].OneofWrappers = []interface{}{
 		(*ChannelDetachRequest_Vsock)(nil),
 		(*ChannelDetachRequest_SerialPort)(nil),
 	}
@@ -5534,7 +8398,7 @@ func file_services_machines_v2_machines_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_services_machines_v2_machines_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   61,
+			NumMessages:   93,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -5568,6 +8432,9 @@ type MachineServiceClient interface {
 	Stop(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Restart(ctx context.Context, in *RestartRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Reset(ctx context.Context, in *ResetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Pause(ctx context.Context, in *PauseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Resume(ctx context.Context, in *ResumeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	StartSuspendProcess(ctx context.Context, in *StartSuspendRequest, opts ...grpc.CallOption) (*StartSuspendResponse, error)
 	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
 	ListNames(ctx context.Context, in *ListNamesRequest, opts ...grpc.CallOption) (*ListNamesResponse, error)
 	FirmwareSet(ctx context.Context, in *FirmwareSetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -5594,6 +8461,11 @@ type MachineServiceClient interface {
 	DiskSetWriteLimit(ctx context.Context, in *DiskSetIOLimitRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DiskRemoveQemuBitmap(ctx context.Context, in *DiskRemoveQemuBitmapRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DiskResizeQemuBlockdev(ctx context.Context, in *DiskResizeQemuBlockdevRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DiskGrow(ctx context.Context, in *DiskGrowRequest, opts ...grpc.CallOption) (*DiskGrowResponse, error)
+	DiskSnapshotCreate(ctx context.Context, in *DiskSnapshotCreateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DiskSnapshotList(ctx context.Context, in *DiskSnapshotListRequest, opts ...grpc.CallOption) (*DiskSnapshotListResponse, error)
+	DiskSnapshotDelete(ctx context.Context, in *DiskSnapshotDeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DiskSnapshotRevert(ctx context.Context, in *DiskSnapshotRevertRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	NetIfaceAttach(ctx context.Context, in *NetIfaceAttachRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	NetIfaceDetach(ctx context.Context, in *NetIfaceDetachRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	NetIfaceSetLinkState(ctx context.Context, in *NetIfaceSetLinkStateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -5606,7 +8478,18 @@ type MachineServiceClient interface {
 	CloudInitDriveDetach(ctx context.Context, in *CloudInitDriveDetachRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	CloudInitDriveChangeMedia(ctx context.Context, in *CloudInitDriveChangeMediaRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	StartDiskBackupProcess(ctx context.Context, in *StartDiskBackupRequest, opts ...grpc.CallOption) (*StartDiskBackupResponse, error)
+	StartMachineBackupProcess(ctx context.Context, in *StartMachineBackupRequest, opts ...grpc.CallOption) (*StartMachineBackupResponse, error)
+	StartBackupExport(ctx context.Context, in *StartBackupExportRequest, opts ...grpc.CallOption) (*StartBackupExportResponse, error)
+	FinishBackupExport(ctx context.Context, in *FinishBackupExportRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	StartDiskRestoreProcess(ctx context.Context, in *StartDiskRestoreRequest, opts ...grpc.CallOption) (*StartDiskRestoreResponse, error)
+	StartDiskMoveProcess(ctx context.Context, in *StartDiskMoveRequest, opts ...grpc.CallOption) (*StartDiskMoveResponse, error)
+	BackupPolicySet(ctx context.Context, in *BackupPolicySetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	BackupPolicyGet(ctx context.Context, in *BackupPolicyGetRequest, opts ...grpc.CallOption) (*BackupPolicyGetResponse, error)
+	BackupPolicyRemove(ctx context.Context, in *BackupPolicyRemoveRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	BackupCatalogList(ctx context.Context, in *BackupCatalogListRequest, opts ...grpc.CallOption) (*BackupCatalogListResponse, error)
 	StartMigrationProcess(ctx context.Context, in *StartMigrationRequest, opts ...grpc.CallOption) (*StartMigrationResponse, error)
+	MigrationCheck(ctx context.Context, in *MigrationCheckRequest, opts ...grpc.CallOption) (*MigrationCheckResponse, error)
+	StartEvacuationProcess(ctx context.Context, in *StartEvacuationRequest, opts ...grpc.CallOption) (*StartEvacuationResponse, error)
 	ExternalKernelSet(ctx context.Context, in *ExternalKernelSetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ExternalKernelRemove(ctx context.Context, in *ExternalKernelRemoveRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
@@ -5691,6 +8574,33 @@ func (c *machineServiceClient) Reset(ctx context.Context, in *ResetRequest, opts
 	return out, nil
 }
 
+func (c *machineServiceClient) Pause(ctx context.Context, in *PauseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/kvmrun.api.services.machines.v2.MachineService/Pause", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) Resume(ctx context.Context, in *ResumeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/kvmrun.api.services.machines.v2.MachineService/Resume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) StartSuspendProcess(ctx context.Context, in *StartSuspendRequest, opts ...grpc.CallOption) (*StartSuspendResponse, error) {
+	out := new(StartSuspendResponse)
+	err := c.cc.Invoke(ctx, "/kvmrun.api.services.machines.v2.MachineService/StartSuspendProcess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *machineServiceClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error) {
 	out := new(ListResponse)
 	err := c.cc.Invoke(ctx, "/kvmrun.api.services.machines.v2.MachineService/List", in, out, opts...)
@@ -5925,6 +8835,51 @@ func (c *machineServiceClient) DiskResizeQemuBlockdev(ctx context.Context, in *D
 	return out, nil
 }
 
+func (c *machineServiceClient) DiskGrow(ctx context.Context, in *DiskGrowRequest, opts ...grpc.CallOption) (*DiskGrowResponse, error) {
+	out := new(DiskGrowResponse)
+	err := c.cc.Invoke(ctx, "/kvmrun.api.services.machines.v2.MachineService/DiskGrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) DiskSnapshotCreate(ctx context.Context, in *DiskSnapshotCreateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/kvmrun.api.services.machines.v2.MachineService/DiskSnapshotCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) DiskSnapshotList(ctx context.Context, in *DiskSnapshotListRequest, opts ...grpc.CallOption) (*DiskSnapshotListResponse, error) {
+	out := new(DiskSnapshotListResponse)
+	err := c.cc.Invoke(ctx, "/kvmrun.api.services.machines.v2.MachineService/DiskSnapshotList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) DiskSnapshotDelete(ctx context.Context, in *DiskSnapshotDeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/kvmrun.api.services.machines.v2.MachineService/DiskSnapshotDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) DiskSnapshotRevert(ctx context.Context, in *DiskSnapshotRevertRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/kvmrun.api.services.machines.v2.MachineService/DiskSnapshotRevert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *machineServiceClient) NetIfaceAttach(ctx context.Context, in *NetIfaceAttachRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/kvmrun.api.services.machines.v2.MachineService/NetIfaceAttach", in, out, opts...)
@@ -6033,6 +8988,87 @@ func (c *machineServiceClient) StartDiskBackupProcess(ctx context.Context, in *S
 	return out, nil
 }
 
+func (c *machineServiceClient) StartMachineBackupProcess(ctx context.Context, in *StartMachineBackupRequest, opts ...grpc.CallOption) (*StartMachineBackupResponse, error) {
+	out := new(StartMachineBackupResponse)
+	err := c.cc.Invoke(ctx, "/kvmrun.api.services.machines.v2.MachineService/StartMachineBackupProcess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) StartBackupExport(ctx context.Context, in *StartBackupExportRequest, opts ...grpc.CallOption) (*StartBackupExportResponse, error) {
+	out := new(StartBackupExportResponse)
+	err := c.cc.Invoke(ctx, "/kvmrun.api.services.machines.v2.MachineService/StartBackupExport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) FinishBackupExport(ctx context.Context, in *FinishBackupExportRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/kvmrun.api.services.machines.v2.MachineService/FinishBackupExport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) StartDiskRestoreProcess(ctx context.Context, in *StartDiskRestoreRequest, opts ...grpc.CallOption) (*StartDiskRestoreResponse, error) {
+	out := new(StartDiskRestoreResponse)
+	err := c.cc.Invoke(ctx, "/kvmrun.api.services.machines.v2.MachineService/StartDiskRestoreProcess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) StartDiskMoveProcess(ctx context.Context, in *StartDiskMoveRequest, opts ...grpc.CallOption) (*StartDiskMoveResponse, error) {
+	out := new(StartDiskMoveResponse)
+	err := c.cc.Invoke(ctx, "/kvmrun.api.services.machines.v2.MachineService/StartDiskMoveProcess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) BackupPolicySet(ctx context.Context, in *BackupPolicySetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/kvmrun.api.services.machines.v2.MachineService/BackupPolicySet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) BackupPolicyGet(ctx context.Context, in *BackupPolicyGetRequest, opts ...grpc.CallOption) (*BackupPolicyGetResponse, error) {
+	out := new(BackupPolicyGetResponse)
+	err := c.cc.Invoke(ctx, "/kvmrun.api.services.machines.v2.MachineService/BackupPolicyGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) BackupPolicyRemove(ctx context.Context, in *BackupPolicyRemoveRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/kvmrun.api.services.machines.v2.MachineService/BackupPolicyRemove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) BackupCatalogList(ctx context.Context, in *BackupCatalogListRequest, opts ...grpc.CallOption) (*BackupCatalogListResponse, error) {
+	out := new(BackupCatalogListResponse)
+	err := c.cc.Invoke(ctx, "/kvmrun.api.services.machines.v2.MachineService/BackupCatalogList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *machineServiceClient) StartMigrationProcess(ctx context.Context, in *StartMigrationRequest, opts ...grpc.CallOption) (*StartMigrationResponse, error) {
 	out := new(StartMigrationResponse)
 	err := c.cc.Invoke(ctx, "/kvmrun.api.services.machines.v2.MachineService/StartMigrationProcess", in, out, opts...)
@@ -6042,6 +9078,24 @@ func (c *machineServiceClient) StartMigrationProcess(ctx context.Context, in *St
 	return out, nil
 }
 
+func (c *machineServiceClient) MigrationCheck(ctx context.Context, in *MigrationCheckRequest, opts ...grpc.CallOption) (*MigrationCheckResponse, error) {
+	out := new(MigrationCheckResponse)
+	err := c.cc.Invoke(ctx, "/kvmrun.api.services.machines.v2.MachineService/MigrationCheck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) StartEvacuationProcess(ctx context.Context, in *StartEvacuationRequest, opts ...grpc.CallOption) (*StartEvacuationResponse, error) {
+	out := new(StartEvacuationResponse)
+	err := c.cc.Invoke(ctx, "/kvmrun.api.services.machines.v2.MachineService/StartEvacuationProcess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *machineServiceClient) ExternalKernelSet(ctx context.Context, in *ExternalKernelSetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/kvmrun.api.services.machines.v2.MachineService/ExternalKernelSet", in, out, opts...)
@@ -6070,6 +9124,9 @@ type MachineServiceServer interface {
 	Stop(context.Context, *StopRequest) (*emptypb.Empty, error)
 	Restart(context.Context, *RestartRequest) (*emptypb.Empty, error)
 	Reset(context.Context, *ResetRequest) (*emptypb.Empty, error)
+	Pause(context.Context, *PauseRequest) (*emptypb.Empty, error)
+	Resume(context.Context, *ResumeRequest) (*emptypb.Empty, error)
+	StartSuspendProcess(context.Context, *StartSuspendRequest) (*StartSuspendResponse, error)
 	List(context.Context, *ListRequest) (*ListResponse, error)
 	ListNames(context.Context, *ListNamesRequest) (*ListNamesResponse, error)
 	FirmwareSet(context.Context, *FirmwareSetRequest) (*emptypb.Empty, error)
@@ -6096,6 +9153,11 @@ type MachineServiceServer interface {
 	DiskSetWriteLimit(context.Context, *DiskSetIOLimitRequest) (*emptypb.Empty, error)
 	DiskRemoveQemuBitmap(context.Context, *DiskRemoveQemuBitmapRequest) (*emptypb.Empty, error)
 	DiskResizeQemuBlockdev(context.Context, *DiskResizeQemuBlockdevRequest) (*emptypb.Empty, error)
+	DiskGrow(context.Context, *DiskGrowRequest) (*DiskGrowResponse, error)
+	DiskSnapshotCreate(context.Context, *DiskSnapshotCreateRequest) (*emptypb.Empty, error)
+	DiskSnapshotList(context.Context, *DiskSnapshotListRequest) (*DiskSnapshotListResponse, error)
+	DiskSnapshotDelete(context.Context, *DiskSnapshotDeleteRequest) (*emptypb.Empty, error)
+	DiskSnapshotRevert(context.Context, *DiskSnapshotRevertRequest) (*emptypb.Empty, error)
 	NetIfaceAttach(context.Context, *NetIfaceAttachRequest) (*emptypb.Empty, error)
 	NetIfaceDetach(context.Context, *NetIfaceDetachRequest) (*emptypb.Empty, error)
 	NetIfaceSetLinkState(context.Context, *NetIfaceSetLinkStateRequest) (*emptypb.Empty, error)
@@ -6108,7 +9170,18 @@ type MachineServiceServer interface {
 	CloudInitDriveDetach(context.Context, *CloudInitDriveDetachRequest) (*emptypb.Empty, error)
 	CloudInitDriveChangeMedia(context.Context, *CloudInitDriveChangeMediaRequest) (*emptypb.Empty, error)
 	StartDiskBackupProcess(context.Context, *StartDiskBackupRequest) (*StartDiskBackupResponse, error)
+	StartMachineBackupProcess(context.Context, *StartMachineBackupRequest) (*StartMachineBackupResponse, error)
+	StartBackupExport(context.Context, *StartBackupExportRequest) (*StartBackupExportResponse, error)
+	FinishBackupExport(context.Context, *FinishBackupExportRequest) (*emptypb.Empty, error)
+	StartDiskRestoreProcess(context.Context, *StartDiskRestoreRequest) (*StartDiskRestoreResponse, error)
+	StartDiskMoveProcess(context.Context, *StartDiskMoveRequest) (*StartDiskMoveResponse, error)
+	BackupPolicySet(context.Context, *BackupPolicySetRequest) (*emptypb.Empty, error)
+	BackupPolicyGet(context.Context, *BackupPolicyGetRequest) (*BackupPolicyGetResponse, error)
+	BackupPolicyRemove(context.Context, *BackupPolicyRemoveRequest) (*emptypb.Empty, error)
+	BackupCatalogList(context.Context, *BackupCatalogListRequest) (*BackupCatalogListResponse, error)
 	StartMigrationProcess(context.Context, *StartMigrationRequest) (*StartMigrationResponse, error)
+	MigrationCheck(context.Context, *MigrationCheckRequest) (*MigrationCheckResponse, error)
+	StartEvacuationProcess(context.Context, *StartEvacuationRequest) (*StartEvacuationResponse, error)
 	ExternalKernelSet(context.Context, *ExternalKernelSetRequest) (*emptypb.Empty, error)
 	ExternalKernelRemove(context.Context, *ExternalKernelRemoveRequest) (*emptypb.Empty, error)
 }
@@ -6141,6 +9214,15 @@ func (*UnimplementedMachineServiceServer) Restart(context.Context, *RestartReque
 func (*UnimplementedMachineServiceServer) Reset(context.Context, *ResetRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Reset not implemented")
 }
+func (*UnimplementedMachineServiceServer) Pause(context.Context, *PauseRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pause not implemented")
+}
+func (*UnimplementedMachineServiceServer) Resume(context.Context, *ResumeRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Resume not implemented")
+}
+func (*UnimplementedMachineServiceServer) StartSuspendProcess(context.Context, *StartSuspendRequest) (*StartSuspendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartSuspendProcess not implemented")
+}
 func (*UnimplementedMachineServiceServer) List(context.Context, *ListRequest) (*ListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
@@ -6219,6 +9301,21 @@ func (*UnimplementedMachineServiceServer) DiskRemoveQemuBitmap(context.Context,
 func (*UnimplementedMachineServiceServer) DiskResizeQemuBlockdev(context.Context, *DiskResizeQemuBlockdevRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DiskResizeQemuBlockdev not implemented")
 }
+func (*UnimplementedMachineServiceServer) DiskGrow(context.Context, *DiskGrowRequest) (*DiskGrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiskGrow not implemented")
+}
+func (*UnimplementedMachineServiceServer) DiskSnapshotCreate(context.Context, *DiskSnapshotCreateRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiskSnapshotCreate not implemented")
+}
+func (*UnimplementedMachineServiceServer) DiskSnapshotList(context.Context, *DiskSnapshotListRequest) (*DiskSnapshotListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiskSnapshotList not implemented")
+}
+func (*UnimplementedMachineServiceServer) DiskSnapshotDelete(context.Context, *DiskSnapshotDeleteRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiskSnapshotDelete not implemented")
+}
+func (*UnimplementedMachineServiceServer) DiskSnapshotRevert(context.Context, *DiskSnapshotRevertRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiskSnapshotRevert not implemented")
+}
 func (*UnimplementedMachineServiceServer) NetIfaceAttach(context.Context, *NetIfaceAttachRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NetIfaceAttach not implemented")
 }
@@ -6255,9 +9352,42 @@ func (*UnimplementedMachineServiceServer) CloudInitDriveChangeMedia(context.Cont
 func (*UnimplementedMachineServiceServer) StartDiskBackupProcess(context.Context, *StartDiskBackupRequest) (*StartDiskBackupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StartDiskBackupProcess not implemented")
 }
+func (*UnimplementedMachineServiceServer) StartMachineBackupProcess(context.Context, *StartMachineBackupRequest) (*StartMachineBackupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartMachineBackupProcess not implemented")
+}
+func (*UnimplementedMachineServiceServer) StartBackupExport(context.Context, *StartBackupExportRequest) (*StartBackupExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartBackupExport not implemented")
+}
+func (*UnimplementedMachineServiceServer) FinishBackupExport(context.Context, *FinishBackupExportRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishBackupExport not implemented")
+}
+func (*UnimplementedMachineServiceServer) StartDiskRestoreProcess(context.Context, *StartDiskRestoreRequest) (*StartDiskRestoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartDiskRestoreProcess not implemented")
+}
+func (*UnimplementedMachineServiceServer) StartDiskMoveProcess(context.Context, *StartDiskMoveRequest) (*StartDiskMoveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartDiskMoveProcess not implemented")
+}
+func (*UnimplementedMachineServiceServer) BackupPolicySet(context.Context, *BackupPolicySetRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BackupPolicySet not implemented")
+}
+func (*UnimplementedMachineServiceServer) BackupPolicyGet(context.Context, *BackupPolicyGetRequest) (*BackupPolicyGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BackupPolicyGet not implemented")
+}
+func (*UnimplementedMachineServiceServer) BackupPolicyRemove(context.Context, *BackupPolicyRemoveRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BackupPolicyRemove not implemented")
+}
+func (*UnimplementedMachineServiceServer) BackupCatalogList(context.Context, *BackupCatalogListRequest) (*BackupCatalogListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BackupCatalogList not implemented")
+}
 func (*UnimplementedMachineServiceServer) StartMigrationProcess(context.Context, *StartMigrationRequest) (*StartMigrationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StartMigrationProcess not implemented")
 }
+func (*UnimplementedMachineServiceServer) MigrationCheck(context.Context, *MigrationCheckRequest) (*MigrationCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrationCheck not implemented")
+}
+func (*UnimplementedMachineServiceServer) StartEvacuationProcess(context.Context, *StartEvacuationRequest) (*StartEvacuationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartEvacuationProcess not implemented")
+}
 func (*UnimplementedMachineServiceServer) ExternalKernelSet(context.Context, *ExternalKernelSetRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExternalKernelSet not implemented")
 }
@@ -6377,38 +9507,92 @@ func _MachineService_Stop_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_Restart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RestartRequest)
+func _MachineService_Restart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).Restart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kvmrun.api.services.machines.v2.MachineService/Restart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).Restart(ctx, req.(*RestartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_Reset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).Reset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kvmrun.api.services.machines.v2.MachineService/Reset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).Reset(ctx, req.(*ResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_Pause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).Pause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kvmrun.api.services.machines.v2.MachineService/Pause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).Pause(ctx, req.(*PauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_Resume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).Restart(ctx, in)
+		return srv.(MachineServiceServer).Resume(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/kvmrun.api.services.machines.v2.MachineService/Restart",
+		FullMethod: "/kvmrun.api.services.machines.v2.MachineService/Resume",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).Restart(ctx, req.(*RestartRequest))
+		return srv.(MachineServiceServer).Resume(ctx, req.(*ResumeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_Reset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResetRequest)
+func _MachineService_StartSuspendProcess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartSuspendRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).Reset(ctx, in)
+		return srv.(MachineServiceServer).StartSuspendProcess(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/kvmrun.api.services.machines.v2.MachineService/Reset",
+		FullMethod: "/kvmrun.api.services.machines.v2.MachineService/StartSuspendProcess",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).Reset(ctx, req.(*ResetRequest))
+		return srv.(MachineServiceServer).StartSuspendProcess(ctx, req.(*StartSuspendRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -6881,6 +10065,96 @@ func _MachineService_DiskResizeQemuBlockdev_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MachineService_DiskGrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiskGrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).DiskGrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kvmrun.api.services.machines.v2.MachineService/DiskGrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).DiskGrow(ctx, req.(*DiskGrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_DiskSnapshotCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiskSnapshotCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).DiskSnapshotCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kvmrun.api.services.machines.v2.MachineService/DiskSnapshotCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).DiskSnapshotCreate(ctx, req.(*DiskSnapshotCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_DiskSnapshotList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiskSnapshotListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).DiskSnapshotList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kvmrun.api.services.machines.v2.MachineService/DiskSnapshotList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).DiskSnapshotList(ctx, req.(*DiskSnapshotListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_DiskSnapshotDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiskSnapshotDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).DiskSnapshotDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kvmrun.api.services.machines.v2.MachineService/DiskSnapshotDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).DiskSnapshotDelete(ctx, req.(*DiskSnapshotDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_DiskSnapshotRevert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiskSnapshotRevertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).DiskSnapshotRevert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kvmrun.api.services.machines.v2.MachineService/DiskSnapshotRevert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).DiskSnapshotRevert(ctx, req.(*DiskSnapshotRevertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MachineService_NetIfaceAttach_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(NetIfaceAttachRequest)
 	if err := dec(in); err != nil {
@@ -7097,6 +10371,168 @@ func _MachineService_StartDiskBackupProcess_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MachineService_StartMachineBackupProcess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartMachineBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).StartMachineBackupProcess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kvmrun.api.services.machines.v2.MachineService/StartMachineBackupProcess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).StartMachineBackupProcess(ctx, req.(*StartMachineBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_StartBackupExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartBackupExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).StartBackupExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kvmrun.api.services.machines.v2.MachineService/StartBackupExport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).StartBackupExport(ctx, req.(*StartBackupExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_FinishBackupExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinishBackupExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).FinishBackupExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kvmrun.api.services.machines.v2.MachineService/FinishBackupExport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).FinishBackupExport(ctx, req.(*FinishBackupExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_StartDiskRestoreProcess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartDiskRestoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).StartDiskRestoreProcess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kvmrun.api.services.machines.v2.MachineService/StartDiskRestoreProcess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).StartDiskRestoreProcess(ctx, req.(*StartDiskRestoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_StartDiskMoveProcess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartDiskMoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).StartDiskMoveProcess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kvmrun.api.services.machines.v2.MachineService/StartDiskMoveProcess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).StartDiskMoveProcess(ctx, req.(*StartDiskMoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_BackupPolicySet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupPolicySetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).BackupPolicySet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kvmrun.api.services.machines.v2.MachineService/BackupPolicySet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).BackupPolicySet(ctx, req.(*BackupPolicySetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_BackupPolicyGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupPolicyGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).BackupPolicyGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kvmrun.api.services.machines.v2.MachineService/BackupPolicyGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).BackupPolicyGet(ctx, req.(*BackupPolicyGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_BackupPolicyRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupPolicyRemoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).BackupPolicyRemove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kvmrun.api.services.machines.v2.MachineService/BackupPolicyRemove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).BackupPolicyRemove(ctx, req.(*BackupPolicyRemoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_BackupCatalogList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupCatalogListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).BackupCatalogList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kvmrun.api.services.machines.v2.MachineService/BackupCatalogList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).BackupCatalogList(ctx, req.(*BackupCatalogListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MachineService_StartMigrationProcess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StartMigrationRequest)
 	if err := dec(in); err != nil {
@@ -7115,6 +10551,42 @@ func _MachineService_StartMigrationProcess_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MachineService_MigrationCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrationCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).MigrationCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kvmrun.api.services.machines.v2.MachineService/MigrationCheck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).MigrationCheck(ctx, req.(*MigrationCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_StartEvacuationProcess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartEvacuationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).StartEvacuationProcess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kvmrun.api.services.machines.v2.MachineService/StartEvacuationProcess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).StartEvacuationProcess(ctx, req.(*StartEvacuationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MachineService_ExternalKernelSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ExternalKernelSetRequest)
 	if err := dec(in); err != nil {
@@ -7187,6 +10659,18 @@ var _MachineService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Reset",
 			Handler:    _MachineService_Reset_Handler,
 		},
+		{
+			MethodName: "Pause",
+			Handler:    _MachineService_Pause_Handler,
+		},
+		{
+			MethodName: "Resume",
+			Handler:    _MachineService_Resume_Handler,
+		},
+		{
+			MethodName: "StartSuspendProcess",
+			Handler:    _MachineService_StartSuspendProcess_Handler,
+		},
 		{
 			MethodName: "List",
 			Handler:    _MachineService_List_Handler,
@@ -7291,6 +10775,26 @@ var _MachineService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DiskResizeQemuBlockdev",
 			Handler:    _MachineService_DiskResizeQemuBlockdev_Handler,
 		},
+		{
+			MethodName: "DiskGrow",
+			Handler:    _MachineService_DiskGrow_Handler,
+		},
+		{
+			MethodName: "DiskSnapshotCreate",
+			Handler:    _MachineService_DiskSnapshotCreate_Handler,
+		},
+		{
+			MethodName: "DiskSnapshotList",
+			Handler:    _MachineService_DiskSnapshotList_Handler,
+		},
+		{
+			MethodName: "DiskSnapshotDelete",
+			Handler:    _MachineService_DiskSnapshotDelete_Handler,
+		},
+		{
+			MethodName: "DiskSnapshotRevert",
+			Handler:    _MachineService_DiskSnapshotRevert_Handler,
+		},
 		{
 			MethodName: "NetIfaceAttach",
 			Handler:    _MachineService_NetIfaceAttach_Handler,
@@ -7339,10 +10843,54 @@ var _MachineService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StartDiskBackupProcess",
 			Handler:    _MachineService_StartDiskBackupProcess_Handler,
 		},
+		{
+			MethodName: "StartMachineBackupProcess",
+			Handler:    _MachineService_StartMachineBackupProcess_Handler,
+		},
+		{
+			MethodName: "StartBackupExport",
+			Handler:    _MachineService_StartBackupExport_Handler,
+		},
+		{
+			MethodName: "FinishBackupExport",
+			Handler:    _MachineService_FinishBackupExport_Handler,
+		},
+		{
+			MethodName: "StartDiskRestoreProcess",
+			Handler:    _MachineService_StartDiskRestoreProcess_Handler,
+		},
+		{
+			MethodName: "StartDiskMoveProcess",
+			Handler:    _MachineService_StartDiskMoveProcess_Handler,
+		},
+		{
+			MethodName: "BackupPolicySet",
+			Handler:    _MachineService_BackupPolicySet_Handler,
+		},
+		{
+			MethodName: "BackupPolicyGet",
+			Handler:    _MachineService_BackupPolicyGet_Handler,
+		},
+		{
+			MethodName: "BackupPolicyRemove",
+			Handler:    _MachineService_BackupPolicyRemove_Handler,
+		},
+		{
+			MethodName: "BackupCatalogList",
+			Handler:    _MachineService_BackupCatalogList_Handler,
+		},
 		{
 			MethodName: "StartMigrationProcess",
 			Handler:    _MachineService_StartMigrationProcess_Handler,
 		},
+		{
+			MethodName: "MigrationCheck",
+			Handler:    _MachineService_MigrationCheck_Handler,
+		},
+		{
+			MethodName: "StartEvacuationProcess",
+			Handler:    _MachineService_StartEvacuationProcess_Handler,
+		},
 		{
 			MethodName: "ExternalKernelSet",
 			Handler:    _MachineService_ExternalKernelSet_Handler,
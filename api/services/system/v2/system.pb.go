@@ -197,13 +197,15 @@ type StartIncomingMigrationRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name         string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Manifest     []byte            `protobuf:"bytes,2,opt,name=manifest,proto3" json:"manifest,omitempty"`
-	Disks        map[string]uint64 `protobuf:"bytes,3,rep,name=disks,proto3" json:"disks,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	ExtraFiles   map[string][]byte `protobuf:"bytes,4,rep,name=extra_files,json=extraFiles,proto3" json:"extra_files,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	CreateDisks  bool              `protobuf:"varint,5,opt,name=create_disks,json=createDisks,proto3" json:"create_disks,omitempty"`
-	ListenAddr   string            `protobuf:"bytes,6,opt,name=listen_addr,json=listenAddr,proto3" json:"listen_addr,omitempty"`
-	TurnOffAfter bool              `protobuf:"varint,7,opt,name=turn_off_after,json=turnOffAfter,proto3" json:"turn_off_after,omitempty"`
+	Name         string              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Manifest     []byte              `protobuf:"bytes,2,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	Disks        map[string]uint64   `protobuf:"bytes,3,rep,name=disks,proto3" json:"disks,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	ExtraFiles   map[string][]byte   `protobuf:"bytes,4,rep,name=extra_files,json=extraFiles,proto3" json:"extra_files,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	CreateDisks  bool                `protobuf:"varint,5,opt,name=create_disks,json=createDisks,proto3" json:"create_disks,omitempty"`
+	ListenAddr   string              `protobuf:"bytes,6,opt,name=listen_addr,json=listenAddr,proto3" json:"listen_addr,omitempty"`
+	TurnOffAfter bool                `protobuf:"varint,7,opt,name=turn_off_after,json=turnOffAfter,proto3" json:"turn_off_after,omitempty"`
+	Tls          bool                `protobuf:"varint,8,opt,name=tls,proto3" json:"tls,omitempty"`
+	Tuning       *v2.MigrationTuning `protobuf:"bytes,9,opt,name=tuning,proto3" json:"tuning,omitempty"`
 }
 
 func (x *StartIncomingMigrationRequest) Reset() {
@@ -287,6 +289,20 @@ func (x *StartIncomingMigrationRequest) GetTurnOffAfter() bool {
 	return false
 }
 
+func (x *StartIncomingMigrationRequest) GetTls() bool {
+	if x != nil {
+		return x.Tls
+	}
+	return false
+}
+
+func (x *StartIncomingMigrationRequest) GetTuning() *v2.MigrationTuning {
+	if x != nil {
+		return x.Tuning
+	}
+	return nil
+}
+
 type StartIncomingMigrationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -334,6 +350,242 @@ func (x *StartIncomingMigrationResponse) GetRequisites() *v2.IncomingMigrationRe
 	return nil
 }
 
+type CheckIncomingMigrationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Manifest    []byte            `protobuf:"bytes,2,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	Disks       map[string]uint64 `protobuf:"bytes,3,rep,name=disks,proto3" json:"disks,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	ExtraFiles  map[string][]byte `protobuf:"bytes,4,rep,name=extra_files,json=extraFiles,proto3" json:"extra_files,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	CreateDisks bool              `protobuf:"varint,5,opt,name=create_disks,json=createDisks,proto3" json:"create_disks,omitempty"`
+}
+
+func (x *CheckIncomingMigrationRequest) Reset() {
+	*x = CheckIncomingMigrationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_system_v2_system_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckIncomingMigrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckIncomingMigrationRequest) ProtoMessage() {}
+
+func (x *CheckIncomingMigrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_system_v2_system_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckIncomingMigrationRequest.ProtoReflect.Descriptor instead.
+func (*CheckIncomingMigrationRequest) Descriptor() ([]byte, []int) {
+	return file_services_system_v2_system_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CheckIncomingMigrationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CheckIncomingMigrationRequest) GetManifest() []byte {
+	if x != nil {
+		return x.Manifest
+	}
+	return nil
+}
+
+func (x *CheckIncomingMigrationRequest) GetDisks() map[string]uint64 {
+	if x != nil {
+		return x.Disks
+	}
+	return nil
+}
+
+func (x *CheckIncomingMigrationRequest) GetExtraFiles() map[string][]byte {
+	if x != nil {
+		return x.ExtraFiles
+	}
+	return nil
+}
+
+func (x *CheckIncomingMigrationRequest) GetCreateDisks() bool {
+	if x != nil {
+		return x.CreateDisks
+	}
+	return false
+}
+
+type CheckIncomingMigrationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Blockers    []*v2.MigrationCheckIssue `protobuf:"bytes,1,rep,name=blockers,proto3" json:"blockers,omitempty"`
+	Warnings    []*v2.MigrationCheckIssue `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	QemuVersion string                    `protobuf:"bytes,3,opt,name=qemu_version,json=qemuVersion,proto3" json:"qemu_version,omitempty"`
+}
+
+func (x *CheckIncomingMigrationResponse) Reset() {
+	*x = CheckIncomingMigrationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_system_v2_system_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckIncomingMigrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckIncomingMigrationResponse) ProtoMessage() {}
+
+func (x *CheckIncomingMigrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_system_v2_system_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckIncomingMigrationResponse.ProtoReflect.Descriptor instead.
+func (*CheckIncomingMigrationResponse) Descriptor() ([]byte, []int) {
+	return file_services_system_v2_system_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CheckIncomingMigrationResponse) GetBlockers() []*v2.MigrationCheckIssue {
+	if x != nil {
+		return x.Blockers
+	}
+	return nil
+}
+
+func (x *CheckIncomingMigrationResponse) GetWarnings() []*v2.MigrationCheckIssue {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *CheckIncomingMigrationResponse) GetQemuVersion() string {
+	if x != nil {
+		return x.QemuVersion
+	}
+	return ""
+}
+
+type HostSetDrainModeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (x *HostSetDrainModeRequest) Reset() {
+	*x = HostSetDrainModeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_system_v2_system_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HostSetDrainModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostSetDrainModeRequest) ProtoMessage() {}
+
+func (x *HostSetDrainModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_system_v2_system_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostSetDrainModeRequest.ProtoReflect.Descriptor instead.
+func (*HostSetDrainModeRequest) Descriptor() ([]byte, []int) {
+	return file_services_system_v2_system_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *HostSetDrainModeRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type HostGetDrainModeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (x *HostGetDrainModeResponse) Reset() {
+	*x = HostGetDrainModeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_system_v2_system_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HostGetDrainModeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostGetDrainModeResponse) ProtoMessage() {}
+
+func (x *HostGetDrainModeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_system_v2_system_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostGetDrainModeResponse.ProtoReflect.Descriptor instead.
+func (*HostGetDrainModeResponse) Descriptor() ([]byte, []int) {
+	return file_services_system_v2_system_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *HostGetDrainModeResponse) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
 type GetAppConfResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -345,7 +597,7 @@ type GetAppConfResponse struct {
 func (x *GetAppConfResponse) Reset() {
 	*x = GetAppConfResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_system_v2_system_proto_msgTypes[5]
+		mi := &file_services_system_v2_system_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -358,7 +610,7 @@ func (x *GetAppConfResponse) String() string {
 func (*GetAppConfResponse) ProtoMessage() {}
 
 func (x *GetAppConfResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_system_v2_system_proto_msgTypes[5]
+	mi := &file_services_system_v2_system_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -371,7 +623,7 @@ func (x *GetAppConfResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAppConfResponse.ProtoReflect.Descriptor instead.
 func (*GetAppConfResponse) Descriptor() ([]byte, []int) {
-	return file_services_system_v2_system_proto_rawDescGZIP(), []int{5}
+	return file_services_system_v2_system_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetAppConfResponse) GetAppConf() *v2.AppConf {
@@ -411,7 +663,7 @@ var file_services_system_v2_system_proto_rawDesc = []byte{
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10,
 	0x67, 0x72, 0x61, 0x63, 0x65, 0x66, 0x75, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x67, 0x72, 0x61, 0x63, 0x65, 0x66, 0x75, 0x6c,
-	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x90, 0x04, 0x0a, 0x1d, 0x53, 0x74, 0x61, 0x72,
+	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0xe0, 0x04, 0x0a, 0x1d, 0x53, 0x74, 0x61, 0x72,
 	0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
 	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a,
@@ -437,7 +689,12 @@ var file_services_system_v2_system_proto_rawDesc = []byte{
 	0x0a, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x12, 0x24, 0x0a, 0x0e, 0x74,
 	0x75, 0x72, 0x6e, 0x5f, 0x6f, 0x66, 0x66, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20,
 	0x01, 0x28, 0x08, 0x52, 0x0c, 0x74, 0x75, 0x72, 0x6e, 0x4f, 0x66, 0x66, 0x41, 0x66, 0x74, 0x65,
-	0x72, 0x1a, 0x38, 0x0a, 0x0a, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x72, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03,
+	0x74, 0x6c, 0x73, 0x12, 0x3c, 0x0a, 0x06, 0x74, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x74, 0x75, 0x6e, 0x69, 0x6e,
+	0x67, 0x1a, 0x38, 0x0a, 0x0a, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
 	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
 	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
 	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3d, 0x0a, 0x0f, 0x45,
@@ -451,48 +708,119 @@ var file_services_system_v2_system_proto_rawDesc = []byte{
 	0x32, 0x30, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79,
 	0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x4d,
 	0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74,
-	0x65, 0x73, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x73, 0x22, 0x4d,
-	0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x70, 0x70,
-	0x43, 0x6f, 0x6e, 0x66, 0x52, 0x07, 0x61, 0x70, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x32, 0x96, 0x05,
-	0x0a, 0x0d, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x6c, 0x0a, 0x14, 0x51, 0x65, 0x6d, 0x75, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x3a, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e,
+	0x65, 0x73, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x73, 0x22, 0xc9,
+	0x03, 0x0a, 0x1d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67,
+	0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x06, 0xca, 0xed, 0x1a, 0x02, 0x08, 0x01, 0x52, 0x08,
+	0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x5d, 0x0a, 0x05, 0x64, 0x69, 0x73, 0x6b,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x47, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e,
 	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x51, 0x65, 0x6d, 0x75, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x70, 0x0a,
-	0x16, 0x51, 0x65, 0x6d, 0x75, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x72,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x3c, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e,
+	0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x63,
+	0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x12, 0x75, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x72, 0x61,
+	0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4c, 0x2e, 0x6b,
+	0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61,
+	0x46, 0x69, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x06, 0xca, 0xed, 0x1a, 0x02,
+	0x08, 0x01, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x72, 0x61, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x6b,
+	0x73, 0x1a, 0x38, 0x0a, 0x0a, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3d, 0x0a, 0x0f, 0x45,
+	0x78, 0x74, 0x72, 0x61, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xcf, 0x01, 0x0a, 0x1e, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x4d, 0x69, 0x67, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a,
+	0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x49, 0x73, 0x73, 0x75, 0x65, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x65, 0x72, 0x73, 0x12, 0x44, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x69, 0x67, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x73, 0x73, 0x75, 0x65, 0x52,
+	0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x71, 0x65, 0x6d,
+	0x75, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x71, 0x65, 0x6d, 0x75, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x33, 0x0a, 0x17,
+	0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x74, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x4d, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x22, 0x34, 0x0a, 0x18, 0x48, 0x6f, 0x73, 0x74, 0x47, 0x65, 0x74, 0x44, 0x72, 0x61, 0x69,
+	0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x4d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x70,
+	0x70, 0x43, 0x6f, 0x6e, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a,
+	0x08, 0x61, 0x70, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x70, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x52, 0x07, 0x61,
+	0x70, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x32, 0xfd, 0x07, 0x0a, 0x0d, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x6c, 0x0a, 0x14, 0x51, 0x65, 0x6d, 0x75,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x12, 0x3a, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x32,
+	0x2e, 0x51, 0x65, 0x6d, 0x75, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x70, 0x0a, 0x16, 0x51, 0x65, 0x6d, 0x75, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x12, 0x3c, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x32,
+	0x2e, 0x51, 0x65, 0x6d, 0x75, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x72,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x10, 0x51, 0x65, 0x6d, 0x75,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x36, 0x2e, 0x6b,
+	0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x51, 0x65, 0x6d,
+	0x75, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x97,
+	0x01, 0x0a, 0x16, 0x53, 0x74, 0x61, 0x72, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67,
+	0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x2e, 0x6b, 0x76, 0x6d, 0x72,
+	0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x49,
+	0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3d, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e,
 	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x51, 0x65, 0x6d, 0x75, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
-	0x64, 0x0a, 0x10, 0x51, 0x65, 0x6d, 0x75, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53,
-	0x74, 0x6f, 0x70, 0x12, 0x36, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69,
+	0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x49, 0x6e, 0x63,
+	0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x97, 0x01, 0x0a, 0x16, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69,
 	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
-	0x2e, 0x76, 0x32, 0x2e, 0x51, 0x65, 0x6d, 0x75, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x97, 0x01, 0x0a, 0x16, 0x53, 0x74, 0x61, 0x72, 0x74, 0x49,
-	0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x3c, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65,
+	0x2e, 0x76, 0x32, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e,
+	0x67, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x3d, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76,
+	0x32, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x4d,
+	0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x4a, 0x0a, 0x16, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x47, 0x72, 0x61, 0x63,
+	0x65, 0x66, 0x75, 0x6c, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x64,
+	0x0a, 0x10, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x74, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x36, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e,
+	0x76, 0x32, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x74, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x4d,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x10, 0x48, 0x6f, 0x73, 0x74, 0x47, 0x65, 0x74, 0x44,
+	0x72, 0x61, 0x69, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x37, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65,
 	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x32,
-	0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x4d, 0x69,
-	0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3d,
-	0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x53,
-	0x74, 0x61, 0x72, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x4d, 0x69, 0x67, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x4a, 0x0a, 0x16, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x47, 0x72, 0x61, 0x63, 0x65, 0x66, 0x75,
-	0x6c, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x0a, 0x47,
+	0x2e, 0x48, 0x6f, 0x73, 0x74, 0x47, 0x65, 0x74, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x4d, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x0a, 0x47,
 	0x65, 0x74, 0x41, 0x70, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
 	0x79, 0x1a, 0x31, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73,
@@ -517,42 +845,61 @@ func file_services_system_v2_system_proto_rawDescGZIP() []byte {
 	return file_services_system_v2_system_proto_rawDescData
 }
 
-var file_services_system_v2_system_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_services_system_v2_system_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_services_system_v2_system_proto_goTypes = []interface{}{
 	(*QemuInstanceRegisterRequest)(nil),    // 0: kvmrun.api.services.system.v2.QemuInstanceRegisterRequest
 	(*QemuInstanceDeregisterRequest)(nil),  // 1: kvmrun.api.services.system.v2.QemuInstanceDeregisterRequest
 	(*QemuInstanceStopRequest)(nil),        // 2: kvmrun.api.services.system.v2.QemuInstanceStopRequest
 	(*StartIncomingMigrationRequest)(nil),  // 3: kvmrun.api.services.system.v2.StartIncomingMigrationRequest
 	(*StartIncomingMigrationResponse)(nil), // 4: kvmrun.api.services.system.v2.StartIncomingMigrationResponse
-	(*GetAppConfResponse)(nil),             // 5: kvmrun.api.services.system.v2.GetAppConfResponse
-	nil,                                    // 6: kvmrun.api.services.system.v2.StartIncomingMigrationRequest.DisksEntry
-	nil,                                    // 7: kvmrun.api.services.system.v2.StartIncomingMigrationRequest.ExtraFilesEntry
-	(*v2.IncomingMigrationRequisites)(nil), // 8: kvmrun.api.types.v2.IncomingMigrationRequisites
-	(*v2.AppConf)(nil),                     // 9: kvmrun.api.types.v2.AppConf
-	(*emptypb.Empty)(nil),                  // 10: google.protobuf.Empty
+	(*CheckIncomingMigrationRequest)(nil),  // 5: kvmrun.api.services.system.v2.CheckIncomingMigrationRequest
+	(*CheckIncomingMigrationResponse)(nil), // 6: kvmrun.api.services.system.v2.CheckIncomingMigrationResponse
+	(*HostSetDrainModeRequest)(nil),        // 7: kvmrun.api.services.system.v2.HostSetDrainModeRequest
+	(*HostGetDrainModeResponse)(nil),       // 8: kvmrun.api.services.system.v2.HostGetDrainModeResponse
+	(*GetAppConfResponse)(nil),             // 9: kvmrun.api.services.system.v2.GetAppConfResponse
+	nil,                                    // 10: kvmrun.api.services.system.v2.StartIncomingMigrationRequest.DisksEntry
+	nil,                                    // 11: kvmrun.api.services.system.v2.StartIncomingMigrationRequest.ExtraFilesEntry
+	nil,                                    // 12: kvmrun.api.services.system.v2.CheckIncomingMigrationRequest.DisksEntry
+	nil,                                    // 13: kvmrun.api.services.system.v2.CheckIncomingMigrationRequest.ExtraFilesEntry
+	(*v2.MigrationTuning)(nil),             // 14: kvmrun.api.types.v2.MigrationTuning
+	(*v2.IncomingMigrationRequisites)(nil), // 15: kvmrun.api.types.v2.IncomingMigrationRequisites
+	(*v2.MigrationCheckIssue)(nil),         // 16: kvmrun.api.types.v2.MigrationCheckIssue
+	(*v2.AppConf)(nil),                     // 17: kvmrun.api.types.v2.AppConf
+	(*emptypb.Empty)(nil),                  // 18: google.protobuf.Empty
 }
 var file_services_system_v2_system_proto_depIdxs = []int32{
-	6,  // 0: kvmrun.api.services.system.v2.StartIncomingMigrationRequest.disks:type_name -> kvmrun.api.services.system.v2.StartIncomingMigrationRequest.DisksEntry
-	7,  // 1: kvmrun.api.services.system.v2.StartIncomingMigrationRequest.extra_files:type_name -> kvmrun.api.services.system.v2.StartIncomingMigrationRequest.ExtraFilesEntry
-	8,  // 2: kvmrun.api.services.system.v2.StartIncomingMigrationResponse.requisites:type_name -> kvmrun.api.types.v2.IncomingMigrationRequisites
-	9,  // 3: kvmrun.api.services.system.v2.GetAppConfResponse.app_conf:type_name -> kvmrun.api.types.v2.AppConf
-	0,  // 4: kvmrun.api.services.system.v2.SystemService.QemuInstanceRegister:input_type -> kvmrun.api.services.system.v2.QemuInstanceRegisterRequest
-	1,  // 5: kvmrun.api.services.system.v2.SystemService.QemuInstanceDeregister:input_type -> kvmrun.api.services.system.v2.QemuInstanceDeregisterRequest
-	2,  // 6: kvmrun.api.services.system.v2.SystemService.QemuInstanceStop:input_type -> kvmrun.api.services.system.v2.QemuInstanceStopRequest
-	3,  // 7: kvmrun.api.services.system.v2.SystemService.StartIncomingMigration:input_type -> kvmrun.api.services.system.v2.StartIncomingMigrationRequest
-	10, // 8: kvmrun.api.services.system.v2.SystemService.ServerGracefulShutdown:input_type -> google.protobuf.Empty
-	10, // 9: kvmrun.api.services.system.v2.SystemService.GetAppConf:input_type -> google.protobuf.Empty
-	10, // 10: kvmrun.api.services.system.v2.SystemService.QemuInstanceRegister:output_type -> google.protobuf.Empty
-	10, // 11: kvmrun.api.services.system.v2.SystemService.QemuInstanceDeregister:output_type -> google.protobuf.Empty
-	10, // 12: kvmrun.api.services.system.v2.SystemService.QemuInstanceStop:output_type -> google.protobuf.Empty
-	4,  // 13: kvmrun.api.services.system.v2.SystemService.StartIncomingMigration:output_type -> kvmrun.api.services.system.v2.StartIncomingMigrationResponse
-	10, // 14: kvmrun.api.services.system.v2.SystemService.ServerGracefulShutdown:output_type -> google.protobuf.Empty
-	5,  // 15: kvmrun.api.services.system.v2.SystemService.GetAppConf:output_type -> kvmrun.api.services.system.v2.GetAppConfResponse
-	10, // [10:16] is the sub-list for method output_type
-	4,  // [4:10] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	10, // 0: kvmrun.api.services.system.v2.StartIncomingMigrationRequest.disks:type_name -> kvmrun.api.services.system.v2.StartIncomingMigrationRequest.DisksEntry
+	11, // 1: kvmrun.api.services.system.v2.StartIncomingMigrationRequest.extra_files:type_name -> kvmrun.api.services.system.v2.StartIncomingMigrationRequest.ExtraFilesEntry
+	14, // 2: kvmrun.api.services.system.v2.StartIncomingMigrationRequest.tuning:type_name -> kvmrun.api.types.v2.MigrationTuning
+	15, // 3: kvmrun.api.services.system.v2.StartIncomingMigrationResponse.requisites:type_name -> kvmrun.api.types.v2.IncomingMigrationRequisites
+	12, // 4: kvmrun.api.services.system.v2.CheckIncomingMigrationRequest.disks:type_name -> kvmrun.api.services.system.v2.CheckIncomingMigrationRequest.DisksEntry
+	13, // 5: kvmrun.api.services.system.v2.CheckIncomingMigrationRequest.extra_files:type_name -> kvmrun.api.services.system.v2.CheckIncomingMigrationRequest.ExtraFilesEntry
+	16, // 6: kvmrun.api.services.system.v2.CheckIncomingMigrationResponse.blockers:type_name -> kvmrun.api.types.v2.MigrationCheckIssue
+	16, // 7: kvmrun.api.services.system.v2.CheckIncomingMigrationResponse.warnings:type_name -> kvmrun.api.types.v2.MigrationCheckIssue
+	17, // 8: kvmrun.api.services.system.v2.GetAppConfResponse.app_conf:type_name -> kvmrun.api.types.v2.AppConf
+	0,  // 9: kvmrun.api.services.system.v2.SystemService.QemuInstanceRegister:input_type -> kvmrun.api.services.system.v2.QemuInstanceRegisterRequest
+	1,  // 10: kvmrun.api.services.system.v2.SystemService.QemuInstanceDeregister:input_type -> kvmrun.api.services.system.v2.QemuInstanceDeregisterRequest
+	2,  // 11: kvmrun.api.services.system.v2.SystemService.QemuInstanceStop:input_type -> kvmrun.api.services.system.v2.QemuInstanceStopRequest
+	3,  // 12: kvmrun.api.services.system.v2.SystemService.StartIncomingMigration:input_type -> kvmrun.api.services.system.v2.StartIncomingMigrationRequest
+	5,  // 13: kvmrun.api.services.system.v2.SystemService.CheckIncomingMigration:input_type -> kvmrun.api.services.system.v2.CheckIncomingMigrationRequest
+	18, // 14: kvmrun.api.services.system.v2.SystemService.ServerGracefulShutdown:input_type -> google.protobuf.Empty
+	7,  // 15: kvmrun.api.services.system.v2.SystemService.HostSetDrainMode:input_type -> kvmrun.api.services.system.v2.HostSetDrainModeRequest
+	18, // 16: kvmrun.api.services.system.v2.SystemService.HostGetDrainMode:input_type -> google.protobuf.Empty
+	18, // 17: kvmrun.api.services.system.v2.SystemService.GetAppConf:input_type -> google.protobuf.Empty
+	18, // 18: kvmrun.api.services.system.v2.SystemService.QemuInstanceRegister:output_type -> google.protobuf.Empty
+	18, // 19: kvmrun.api.services.system.v2.SystemService.QemuInstanceDeregister:output_type -> google.protobuf.Empty
+	18, // 20: kvmrun.api.services.system.v2.SystemService.QemuInstanceStop:output_type -> google.protobuf.Empty
+	4,  // 21: kvmrun.api.services.system.v2.SystemService.StartIncomingMigration:output_type -> kvmrun.api.services.system.v2.StartIncomingMigrationResponse
+	6,  // 22: kvmrun.api.services.system.v2.SystemService.CheckIncomingMigration:output_type -> kvmrun.api.services.system.v2.CheckIncomingMigrationResponse
+	18, // 23: kvmrun.api.services.system.v2.SystemService.ServerGracefulShutdown:output_type -> google.protobuf.Empty
+	18, // 24: kvmrun.api.services.system.v2.SystemService.HostSetDrainMode:output_type -> google.protobuf.Empty
+	8,  // 25: kvmrun.api.services.system.v2.SystemService.HostGetDrainMode:output_type -> kvmrun.api.services.system.v2.HostGetDrainModeResponse
+	9,  // 26: kvmrun.api.services.system.v2.SystemService.GetAppConf:output_type -> kvmrun.api.services.system.v2.GetAppConfResponse
+	18, // [18:27] is the sub-list for method output_type
+	9,  // [9:18] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_services_system_v2_system_proto_init() }
@@ -622,6 +969,54 @@ func file_services_system_v2_system_proto_init() {
 			}
 		}
 		file_services_system_v2_system_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckIncomingMigrationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_system_v2_system_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckIncomingMigrationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_system_v2_system_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostSetDrainModeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_system_v2_system_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostGetDrainModeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_system_v2_system_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetAppConfResponse); i {
 			case 0:
 				return &v.state
@@ -640,7 +1035,7 @@ func file_services_system_v2_system_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_services_system_v2_system_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -670,7 +1065,10 @@ type SystemServiceClient interface {
 	QemuInstanceDeregister(ctx context.Context, in *QemuInstanceDeregisterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	QemuInstanceStop(ctx context.Context, in *QemuInstanceStopRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	StartIncomingMigration(ctx context.Context, in *StartIncomingMigrationRequest, opts ...grpc.CallOption) (*StartIncomingMigrationResponse, error)
+	CheckIncomingMigration(ctx context.Context, in *CheckIncomingMigrationRequest, opts ...grpc.CallOption) (*CheckIncomingMigrationResponse, error)
 	ServerGracefulShutdown(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	HostSetDrainMode(ctx context.Context, in *HostSetDrainModeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	HostGetDrainMode(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*HostGetDrainModeResponse, error)
 	GetAppConf(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetAppConfResponse, error)
 }
 
@@ -718,6 +1116,15 @@ func (c *systemServiceClient) StartIncomingMigration(ctx context.Context, in *St
 	return out, nil
 }
 
+func (c *systemServiceClient) CheckIncomingMigration(ctx context.Context, in *CheckIncomingMigrationRequest, opts ...grpc.CallOption) (*CheckIncomingMigrationResponse, error) {
+	out := new(CheckIncomingMigrationResponse)
+	err := c.cc.Invoke(ctx, "/kvmrun.api.services.system.v2.SystemService/CheckIncomingMigration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *systemServiceClient) ServerGracefulShutdown(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/kvmrun.api.services.system.v2.SystemService/ServerGracefulShutdown", in, out, opts...)
@@ -727,6 +1134,24 @@ func (c *systemServiceClient) ServerGracefulShutdown(ctx context.Context, in *em
 	return out, nil
 }
 
+func (c *systemServiceClient) HostSetDrainMode(ctx context.Context, in *HostSetDrainModeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/kvmrun.api.services.system.v2.SystemService/HostSetDrainMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemServiceClient) HostGetDrainMode(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*HostGetDrainModeResponse, error) {
+	out := new(HostGetDrainModeResponse)
+	err := c.cc.Invoke(ctx, "/kvmrun.api.services.system.v2.SystemService/HostGetDrainMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *systemServiceClient) GetAppConf(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetAppConfResponse, error) {
 	out := new(GetAppConfResponse)
 	err := c.cc.Invoke(ctx, "/kvmrun.api.services.system.v2.SystemService/GetAppConf", in, out, opts...)
@@ -742,7 +1167,10 @@ type SystemServiceServer interface {
 	QemuInstanceDeregister(context.Context, *QemuInstanceDeregisterRequest) (*emptypb.Empty, error)
 	QemuInstanceStop(context.Context, *QemuInstanceStopRequest) (*emptypb.Empty, error)
 	StartIncomingMigration(context.Context, *StartIncomingMigrationRequest) (*StartIncomingMigrationResponse, error)
+	CheckIncomingMigration(context.Context, *CheckIncomingMigrationRequest) (*CheckIncomingMigrationResponse, error)
 	ServerGracefulShutdown(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	HostSetDrainMode(context.Context, *HostSetDrainModeRequest) (*emptypb.Empty, error)
+	HostGetDrainMode(context.Context, *emptypb.Empty) (*HostGetDrainModeResponse, error)
 	GetAppConf(context.Context, *emptypb.Empty) (*GetAppConfResponse, error)
 }
 
@@ -762,9 +1190,18 @@ func (*UnimplementedSystemServiceServer) QemuInstanceStop(context.Context, *Qemu
 func (*UnimplementedSystemServiceServer) StartIncomingMigration(context.Context, *StartIncomingMigrationRequest) (*StartIncomingMigrationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StartIncomingMigration not implemented")
 }
+func (*UnimplementedSystemServiceServer) CheckIncomingMigration(context.Context, *CheckIncomingMigrationRequest) (*CheckIncomingMigrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckIncomingMigration not implemented")
+}
 func (*UnimplementedSystemServiceServer) ServerGracefulShutdown(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ServerGracefulShutdown not implemented")
 }
+func (*UnimplementedSystemServiceServer) HostSetDrainMode(context.Context, *HostSetDrainModeRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HostSetDrainMode not implemented")
+}
+func (*UnimplementedSystemServiceServer) HostGetDrainMode(context.Context, *emptypb.Empty) (*HostGetDrainModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HostGetDrainMode not implemented")
+}
 func (*UnimplementedSystemServiceServer) GetAppConf(context.Context, *emptypb.Empty) (*GetAppConfResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAppConf not implemented")
 }
@@ -845,6 +1282,24 @@ func _SystemService_StartIncomingMigration_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SystemService_CheckIncomingMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckIncomingMigrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemServiceServer).CheckIncomingMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kvmrun.api.services.system.v2.SystemService/CheckIncomingMigration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemServiceServer).CheckIncomingMigration(ctx, req.(*CheckIncomingMigrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SystemService_ServerGracefulShutdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -863,6 +1318,42 @@ func _SystemService_ServerGracefulShutdown_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SystemService_HostSetDrainMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HostSetDrainModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemServiceServer).HostSetDrainMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kvmrun.api.services.system.v2.SystemService/HostSetDrainMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemServiceServer).HostSetDrainMode(ctx, req.(*HostSetDrainModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SystemService_HostGetDrainMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemServiceServer).HostGetDrainMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kvmrun.api.services.system.v2.SystemService/HostGetDrainMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemServiceServer).HostGetDrainMode(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SystemService_GetAppConf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -901,10 +1392,22 @@ var _SystemService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StartIncomingMigration",
 			Handler:    _SystemService_StartIncomingMigration_Handler,
 		},
+		{
+			MethodName: "CheckIncomingMigration",
+			Handler:    _SystemService_CheckIncomingMigration_Handler,
+		},
 		{
 			MethodName: "ServerGracefulShutdown",
 			Handler:    _SystemService_ServerGracefulShutdown_Handler,
 		},
+		{
+			MethodName: "HostSetDrainMode",
+			Handler:    _SystemService_HostSetDrainMode_Handler,
+		},
+		{
+			MethodName: "HostGetDrainMode",
+			Handler:    _SystemService_HostGetDrainMode_Handler,
+		},
 		{
 			MethodName: "GetAppConf",
 			Handler:    _SystemService_GetAppConf_Handler,
@@ -90,6 +90,55 @@ func (MachineState) EnumDescriptor() ([]byte, []int) {
 	return file_types_v2_machines_proto_rawDescGZIP(), []int{0}
 }
 
+type MigrationTLS int32
+
+const (
+	MigrationTLS_DEFAULT_TLS MigrationTLS = 0
+	MigrationTLS_TLS_ON      MigrationTLS = 1
+	MigrationTLS_TLS_OFF     MigrationTLS = 2
+)
+
+// Enum value maps for MigrationTLS.
+var (
+	MigrationTLS_name = map[int32]string{
+		0: "DEFAULT_TLS",
+		1: "TLS_ON",
+		2: "TLS_OFF",
+	}
+	MigrationTLS_value = map[string]int32{
+		"DEFAULT_TLS": 0,
+		"TLS_ON":      1,
+		"TLS_OFF":     2,
+	}
+)
+
+func (x MigrationTLS) Enum() *MigrationTLS {
+	p := new(MigrationTLS)
+	*p = x
+	return p
+}
+
+func (x MigrationTLS) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MigrationTLS) Descriptor() protoreflect.EnumDescriptor {
+	return file_types_v2_machines_proto_enumTypes[1].Descriptor()
+}
+
+func (MigrationTLS) Type() protoreflect.EnumType {
+	return &file_types_v2_machines_proto_enumTypes[1]
+}
+
+func (x MigrationTLS) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MigrationTLS.Descriptor instead.
+func (MigrationTLS) EnumDescriptor() ([]byte, []int) {
+	return file_types_v2_machines_proto_rawDescGZIP(), []int{1}
+}
+
 type NetIfaceDriver int32
 
 const (
@@ -97,6 +146,7 @@ const (
 	NetIfaceDriver_VIRTIO_NET_PCI       NetIfaceDriver = 1
 	NetIfaceDriver_RTL8139              NetIfaceDriver = 2
 	NetIfaceDriver_E1000                NetIfaceDriver = 3
+	NetIfaceDriver_VIRTIO_NET_DEVICE    NetIfaceDriver = 4
 )
 
 // Enum value maps for NetIfaceDriver.
@@ -106,12 +156,14 @@ var (
 		1: "VIRTIO_NET_PCI",
 		2: "RTL8139",
 		3: "E1000",
+		4: "VIRTIO_NET_DEVICE",
 	}
 	NetIfaceDriver_value = map[string]int32{
 		"UNDEFINED_NET_DRIVER": 0,
 		"VIRTIO_NET_PCI":       1,
 		"RTL8139":              2,
 		"E1000":                3,
+		"VIRTIO_NET_DEVICE":    4,
 	}
 )
 
@@ -126,11 +178,11 @@ func (x NetIfaceDriver) String() string {
 }
 
 func (NetIfaceDriver) Descriptor() protoreflect.EnumDescriptor {
-	return file_types_v2_machines_proto_enumTypes[1].Descriptor()
+	return file_types_v2_machines_proto_enumTypes[2].Descriptor()
 }
 
 func (NetIfaceDriver) Type() protoreflect.EnumType {
-	return &file_types_v2_machines_proto_enumTypes[1]
+	return &file_types_v2_machines_proto_enumTypes[2]
 }
 
 func (x NetIfaceDriver) Number() protoreflect.EnumNumber {
@@ -139,7 +191,7 @@ func (x NetIfaceDriver) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NetIfaceDriver.Descriptor instead.
 func (NetIfaceDriver) EnumDescriptor() ([]byte, []int) {
-	return file_types_v2_machines_proto_rawDescGZIP(), []int{1}
+	return file_types_v2_machines_proto_rawDescGZIP(), []int{2}
 }
 
 type NetIfaceLinkState int32
@@ -175,11 +227,11 @@ func (x NetIfaceLinkState) String() string {
 }
 
 func (NetIfaceLinkState) Descriptor() protoreflect.EnumDescriptor {
-	return file_types_v2_machines_proto_enumTypes[2].Descriptor()
+	return file_types_v2_machines_proto_enumTypes[3].Descriptor()
 }
 
 func (NetIfaceLinkState) Type() protoreflect.EnumType {
-	return &file_types_v2_machines_proto_enumTypes[2]
+	return &file_types_v2_machines_proto_enumTypes[3]
 }
 
 func (x NetIfaceLinkState) Number() protoreflect.EnumNumber {
@@ -188,7 +240,7 @@ func (x NetIfaceLinkState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NetIfaceLinkState.Descriptor instead.
 func (NetIfaceLinkState) EnumDescriptor() ([]byte, []int) {
-	return file_types_v2_machines_proto_rawDescGZIP(), []int{2}
+	return file_types_v2_machines_proto_rawDescGZIP(), []int{3}
 }
 
 type DiskDriver int32
@@ -198,6 +250,7 @@ const (
 	DiskDriver_VIRTIO_BLK_PCI        DiskDriver = 1
 	DiskDriver_SCSI_HD               DiskDriver = 2
 	DiskDriver_IDE_HD                DiskDriver = 3
+	DiskDriver_VIRTIO_BLK_DEVICE     DiskDriver = 4
 )
 
 // Enum value maps for DiskDriver.
@@ -207,12 +260,14 @@ var (
 		1: "VIRTIO_BLK_PCI",
 		2: "SCSI_HD",
 		3: "IDE_HD",
+		4: "VIRTIO_BLK_DEVICE",
 	}
 	DiskDriver_value = map[string]int32{
 		"UNDEFINED_DISK_DRIVER": 0,
 		"VIRTIO_BLK_PCI":        1,
 		"SCSI_HD":               2,
 		"IDE_HD":                3,
+		"VIRTIO_BLK_DEVICE":     4,
 	}
 )
 
@@ -227,11 +282,11 @@ func (x DiskDriver) String() string {
 }
 
 func (DiskDriver) Descriptor() protoreflect.EnumDescriptor {
-	return file_types_v2_machines_proto_enumTypes[3].Descriptor()
+	return file_types_v2_machines_proto_enumTypes[4].Descriptor()
 }
 
 func (DiskDriver) Type() protoreflect.EnumType {
-	return &file_types_v2_machines_proto_enumTypes[3]
+	return &file_types_v2_machines_proto_enumTypes[4]
 }
 
 func (x DiskDriver) Number() protoreflect.EnumNumber {
@@ -240,7 +295,7 @@ func (x DiskDriver) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DiskDriver.Descriptor instead.
 func (DiskDriver) EnumDescriptor() ([]byte, []int) {
-	return file_types_v2_machines_proto_rawDescGZIP(), []int{3}
+	return file_types_v2_machines_proto_rawDescGZIP(), []int{4}
 }
 
 type CdromDriver int32
@@ -276,11 +331,11 @@ func (x CdromDriver) String() string {
 }
 
 func (CdromDriver) Descriptor() protoreflect.EnumDescriptor {
-	return file_types_v2_machines_proto_enumTypes[4].Descriptor()
+	return file_types_v2_machines_proto_enumTypes[5].Descriptor()
 }
 
 func (CdromDriver) Type() protoreflect.EnumType {
-	return &file_types_v2_machines_proto_enumTypes[4]
+	return &file_types_v2_machines_proto_enumTypes[5]
 }
 
 func (x CdromDriver) Number() protoreflect.EnumNumber {
@@ -289,7 +344,7 @@ func (x CdromDriver) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CdromDriver.Descriptor instead.
 func (CdromDriver) EnumDescriptor() ([]byte, []int) {
-	return file_types_v2_machines_proto_rawDescGZIP(), []int{4}
+	return file_types_v2_machines_proto_rawDescGZIP(), []int{5}
 }
 
 type CloudInitDriver int32
@@ -325,11 +380,11 @@ func (x CloudInitDriver) String() string {
 }
 
 func (CloudInitDriver) Descriptor() protoreflect.EnumDescriptor {
-	return file_types_v2_machines_proto_enumTypes[5].Descriptor()
+	return file_types_v2_machines_proto_enumTypes[6].Descriptor()
 }
 
 func (CloudInitDriver) Type() protoreflect.EnumType {
-	return &file_types_v2_machines_proto_enumTypes[5]
+	return &file_types_v2_machines_proto_enumTypes[6]
 }
 
 func (x CloudInitDriver) Number() protoreflect.EnumNumber {
@@ -338,7 +393,7 @@ func (x CloudInitDriver) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CloudInitDriver.Descriptor instead.
 func (CloudInitDriver) EnumDescriptor() ([]byte, []int) {
-	return file_types_v2_machines_proto_rawDescGZIP(), []int{5}
+	return file_types_v2_machines_proto_rawDescGZIP(), []int{6}
 }
 
 type InputDeviceType int32
@@ -371,11 +426,11 @@ func (x InputDeviceType) String() string {
 }
 
 func (InputDeviceType) Descriptor() protoreflect.EnumDescriptor {
-	return file_types_v2_machines_proto_enumTypes[6].Descriptor()
+	return file_types_v2_machines_proto_enumTypes[7].Descriptor()
 }
 
 func (InputDeviceType) Type() protoreflect.EnumType {
-	return &file_types_v2_machines_proto_enumTypes[6]
+	return &file_types_v2_machines_proto_enumTypes[7]
 }
 
 func (x InputDeviceType) Number() protoreflect.EnumNumber {
@@ -384,7 +439,7 @@ func (x InputDeviceType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use InputDeviceType.Descriptor instead.
 func (InputDeviceType) EnumDescriptor() ([]byte, []int) {
-	return file_types_v2_machines_proto_rawDescGZIP(), []int{6}
+	return file_types_v2_machines_proto_rawDescGZIP(), []int{7}
 }
 
 type MachineOpts struct {
@@ -617,6 +672,201 @@ func (x *Machine) GetLifeTime() uint64 {
 	return 0
 }
 
+type MigrationTuning struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// bytes per second
+	MaxBandwidth uint64 `protobuf:"varint,1,opt,name=max_bandwidth,json=maxBandwidth,proto3" json:"max_bandwidth,omitempty"`
+	// milliseconds
+	DowntimeLimit      uint64 `protobuf:"varint,2,opt,name=downtime_limit,json=downtimeLimit,proto3" json:"downtime_limit,omitempty"`
+	MultifdChannels    uint32 `protobuf:"varint,3,opt,name=multifd_channels,json=multifdChannels,proto3" json:"multifd_channels,omitempty"`
+	MultifdCompression string `protobuf:"bytes,4,opt,name=multifd_compression,json=multifdCompression,proto3" json:"multifd_compression,omitempty"`
+	DisableXbzrle      bool   `protobuf:"varint,5,opt,name=disable_xbzrle,json=disableXbzrle,proto3" json:"disable_xbzrle,omitempty"`
+	// bytes
+	XbzrleCacheSize     uint64 `protobuf:"varint,6,opt,name=xbzrle_cache_size,json=xbzrleCacheSize,proto3" json:"xbzrle_cache_size,omitempty"`
+	DisableAutoConverge bool   `protobuf:"varint,7,opt,name=disable_auto_converge,json=disableAutoConverge,proto3" json:"disable_auto_converge,omitempty"`
+	// percents
+	CPUThrottleInitial   uint32 `protobuf:"varint,8,opt,name=cpu_throttle_initial,json=cpuThrottleInitial,proto3" json:"cpu_throttle_initial,omitempty"`
+	CPUThrottleIncrement uint32 `protobuf:"varint,9,opt,name=cpu_throttle_increment,json=cpuThrottleIncrement,proto3" json:"cpu_throttle_increment,omitempty"`
+	Postcopy             bool   `protobuf:"varint,10,opt,name=postcopy,proto3" json:"postcopy,omitempty"`
+	PostcopyAfterPasses  uint32 `protobuf:"varint,11,opt,name=postcopy_after_passes,json=postcopyAfterPasses,proto3" json:"postcopy_after_passes,omitempty"`
+	// seconds
+	PostcopyAfterSeconds uint32 `protobuf:"varint,12,opt,name=postcopy_after_seconds,json=postcopyAfterSeconds,proto3" json:"postcopy_after_seconds,omitempty"`
+}
+
+func (x *MigrationTuning) Reset() {
+	*x = MigrationTuning{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v2_machines_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MigrationTuning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrationTuning) ProtoMessage() {}
+
+func (x *MigrationTuning) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v2_machines_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrationTuning.ProtoReflect.Descriptor instead.
+func (*MigrationTuning) Descriptor() ([]byte, []int) {
+	return file_types_v2_machines_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MigrationTuning) GetMaxBandwidth() uint64 {
+	if x != nil {
+		return x.MaxBandwidth
+	}
+	return 0
+}
+
+func (x *MigrationTuning) GetDowntimeLimit() uint64 {
+	if x != nil {
+		return x.DowntimeLimit
+	}
+	return 0
+}
+
+func (x *MigrationTuning) GetMultifdChannels() uint32 {
+	if x != nil {
+		return x.MultifdChannels
+	}
+	return 0
+}
+
+func (x *MigrationTuning) GetMultifdCompression() string {
+	if x != nil {
+		return x.MultifdCompression
+	}
+	return ""
+}
+
+func (x *MigrationTuning) GetDisableXbzrle() bool {
+	if x != nil {
+		return x.DisableXbzrle
+	}
+	return false
+}
+
+func (x *MigrationTuning) GetXbzrleCacheSize() uint64 {
+	if x != nil {
+		return x.XbzrleCacheSize
+	}
+	return 0
+}
+
+func (x *MigrationTuning) GetDisableAutoConverge() bool {
+	if x != nil {
+		return x.DisableAutoConverge
+	}
+	return false
+}
+
+func (x *MigrationTuning) GetCPUThrottleInitial() uint32 {
+	if x != nil {
+		return x.CPUThrottleInitial
+	}
+	return 0
+}
+
+func (x *MigrationTuning) GetCPUThrottleIncrement() uint32 {
+	if x != nil {
+		return x.CPUThrottleIncrement
+	}
+	return 0
+}
+
+func (x *MigrationTuning) GetPostcopy() bool {
+	if x != nil {
+		return x.Postcopy
+	}
+	return false
+}
+
+func (x *MigrationTuning) GetPostcopyAfterPasses() uint32 {
+	if x != nil {
+		return x.PostcopyAfterPasses
+	}
+	return 0
+}
+
+func (x *MigrationTuning) GetPostcopyAfterSeconds() uint32 {
+	if x != nil {
+		return x.PostcopyAfterSeconds
+	}
+	return 0
+}
+
+type MigrationCheckIssue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subject string `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	Desc    string `protobuf:"bytes,2,opt,name=desc,proto3" json:"desc,omitempty"`
+}
+
+func (x *MigrationCheckIssue) Reset() {
+	*x = MigrationCheckIssue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v2_machines_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MigrationCheckIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrationCheckIssue) ProtoMessage() {}
+
+func (x *MigrationCheckIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v2_machines_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrationCheckIssue.ProtoReflect.Descriptor instead.
+func (*MigrationCheckIssue) Descriptor() ([]byte, []int) {
+	return file_types_v2_machines_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MigrationCheckIssue) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *MigrationCheckIssue) GetDesc() string {
+	if x != nil {
+		return x.Desc
+	}
+	return ""
+}
+
 type MigrationOverrides struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -630,7 +880,7 @@ type MigrationOverrides struct {
 func (x *MigrationOverrides) Reset() {
 	*x = MigrationOverrides{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_v2_machines_proto_msgTypes[2]
+		mi := &file_types_v2_machines_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -643,7 +893,7 @@ func (x *MigrationOverrides) String() string {
 func (*MigrationOverrides) ProtoMessage() {}
 
 func (x *MigrationOverrides) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v2_machines_proto_msgTypes[2]
+	mi := &file_types_v2_machines_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -656,7 +906,7 @@ func (x *MigrationOverrides) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MigrationOverrides.ProtoReflect.Descriptor instead.
 func (*MigrationOverrides) Descriptor() ([]byte, []int) {
-	return file_types_v2_machines_proto_rawDescGZIP(), []int{2}
+	return file_types_v2_machines_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *MigrationOverrides) GetName() string {
@@ -693,7 +943,7 @@ type IncomingMigrationRequisites struct {
 func (x *IncomingMigrationRequisites) Reset() {
 	*x = IncomingMigrationRequisites{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_v2_machines_proto_msgTypes[3]
+		mi := &file_types_v2_machines_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -706,7 +956,7 @@ func (x *IncomingMigrationRequisites) String() string {
 func (*IncomingMigrationRequisites) ProtoMessage() {}
 
 func (x *IncomingMigrationRequisites) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v2_machines_proto_msgTypes[3]
+	mi := &file_types_v2_machines_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -719,58 +969,301 @@ func (x *IncomingMigrationRequisites) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IncomingMigrationRequisites.ProtoReflect.Descriptor instead.
 func (*IncomingMigrationRequisites) Descriptor() ([]byte, []int) {
-	return file_types_v2_machines_proto_rawDescGZIP(), []int{3}
+	return file_types_v2_machines_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *IncomingMigrationRequisites) GetIncomingPort() uint32 {
 	if x != nil {
-		return x.IncomingPort
+		return x.IncomingPort
+	}
+	return 0
+}
+
+func (x *IncomingMigrationRequisites) GetNBDPort() uint32 {
+	if x != nil {
+		return x.NBDPort
+	}
+	return 0
+}
+
+func (x *IncomingMigrationRequisites) GetPID() uint32 {
+	if x != nil {
+		return x.PID
+	}
+	return 0
+}
+
+type VNCRequisites struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Password string `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	Display  uint32 `protobuf:"varint,2,opt,name=display,proto3" json:"display,omitempty"`
+	Port     uint32 `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	WSPort   uint32 `protobuf:"varint,4,opt,name=ws_port,json=wsPort,proto3" json:"ws_port,omitempty"`
+}
+
+func (x *VNCRequisites) Reset() {
+	*x = VNCRequisites{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v2_machines_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VNCRequisites) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VNCRequisites) ProtoMessage() {}
+
+func (x *VNCRequisites) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v2_machines_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VNCRequisites.ProtoReflect.Descriptor instead.
+func (*VNCRequisites) Descriptor() ([]byte, []int) {
+	return file_types_v2_machines_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *VNCRequisites) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *VNCRequisites) GetDisplay() uint32 {
+	if x != nil {
+		return x.Display
+	}
+	return 0
+}
+
+func (x *VNCRequisites) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *VNCRequisites) GetWSPort() uint32 {
+	if x != nil {
+		return x.WSPort
+	}
+	return 0
+}
+
+type DiskSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// unix time in seconds
+	Created uint64   `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Disks   []string `protobuf:"bytes,3,rep,name=disks,proto3" json:"disks,omitempty"`
+}
+
+func (x *DiskSnapshot) Reset() {
+	*x = DiskSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v2_machines_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiskSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskSnapshot) ProtoMessage() {}
+
+func (x *DiskSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v2_machines_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskSnapshot.ProtoReflect.Descriptor instead.
+func (*DiskSnapshot) Descriptor() ([]byte, []int) {
+	return file_types_v2_machines_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DiskSnapshot) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DiskSnapshot) GetCreated() uint64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *DiskSnapshot) GetDisks() []string {
+	if x != nil {
+		return x.Disks
+	}
+	return nil
+}
+
+type BackupPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cron expression: "minute hour day-of-month month day-of-week"
+	Schedule       string   `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	Disks          []string `protobuf:"bytes,2,rep,name=disks,proto3" json:"disks,omitempty"`
+	FullEvery      uint32   `protobuf:"varint,3,opt,name=full_every,json=fullEvery,proto3" json:"full_every,omitempty"`
+	TargetTemplate string   `protobuf:"bytes,4,opt,name=target_template,json=targetTemplate,proto3" json:"target_template,omitempty"`
+	KeepChains     uint32   `protobuf:"varint,5,opt,name=keep_chains,json=keepChains,proto3" json:"keep_chains,omitempty"`
+	KeepDays       uint32   `protobuf:"varint,6,opt,name=keep_days,json=keepDays,proto3" json:"keep_days,omitempty"`
+	FsFreeze       bool     `protobuf:"varint,7,opt,name=fs_freeze,json=fsFreeze,proto3" json:"fs_freeze,omitempty"`
+	Disabled       bool     `protobuf:"varint,8,opt,name=disabled,proto3" json:"disabled,omitempty"`
+}
+
+func (x *BackupPolicy) Reset() {
+	*x = BackupPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v2_machines_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackupPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupPolicy) ProtoMessage() {}
+
+func (x *BackupPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v2_machines_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupPolicy.ProtoReflect.Descriptor instead.
+func (*BackupPolicy) Descriptor() ([]byte, []int) {
+	return file_types_v2_machines_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BackupPolicy) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *BackupPolicy) GetDisks() []string {
+	if x != nil {
+		return x.Disks
+	}
+	return nil
+}
+
+func (x *BackupPolicy) GetFullEvery() uint32 {
+	if x != nil {
+		return x.FullEvery
+	}
+	return 0
+}
+
+func (x *BackupPolicy) GetTargetTemplate() string {
+	if x != nil {
+		return x.TargetTemplate
+	}
+	return ""
+}
+
+func (x *BackupPolicy) GetKeepChains() uint32 {
+	if x != nil {
+		return x.KeepChains
+	}
+	return 0
+}
+
+func (x *BackupPolicy) GetKeepDays() uint32 {
+	if x != nil {
+		return x.KeepDays
 	}
 	return 0
 }
 
-func (x *IncomingMigrationRequisites) GetNBDPort() uint32 {
+func (x *BackupPolicy) GetFsFreeze() bool {
 	if x != nil {
-		return x.NBDPort
+		return x.FsFreeze
 	}
-	return 0
+	return false
 }
 
-func (x *IncomingMigrationRequisites) GetPID() uint32 {
+func (x *BackupPolicy) GetDisabled() bool {
 	if x != nil {
-		return x.PID
+		return x.Disabled
 	}
-	return 0
+	return false
 }
 
-type VNCRequisites struct {
+type BackupCatalogEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Password string `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
-	Display  uint32 `protobuf:"varint,2,opt,name=display,proto3" json:"display,omitempty"`
-	Port     uint32 `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
-	WSPort   uint32 `protobuf:"varint,4,opt,name=ws_port,json=wsPort,proto3" json:"ws_port,omitempty"`
+	DiskName string `protobuf:"bytes,1,opt,name=disk_name,json=diskName,proto3" json:"disk_name,omitempty"`
+	Path     string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// full or incremental
+	Type   string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Size   uint64 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	TaskID string `protobuf:"bytes,5,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// unix time in seconds
+	Created uint64 `protobuf:"varint,6,opt,name=created,proto3" json:"created,omitempty"`
 }
 
-func (x *VNCRequisites) Reset() {
-	*x = VNCRequisites{}
+func (x *BackupCatalogEntry) Reset() {
+	*x = BackupCatalogEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_v2_machines_proto_msgTypes[4]
+		mi := &file_types_v2_machines_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VNCRequisites) String() string {
+func (x *BackupCatalogEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VNCRequisites) ProtoMessage() {}
+func (*BackupCatalogEntry) ProtoMessage() {}
 
-func (x *VNCRequisites) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v2_machines_proto_msgTypes[4]
+func (x *BackupCatalogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v2_machines_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -781,35 +1274,49 @@ func (x *VNCRequisites) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VNCRequisites.ProtoReflect.Descriptor instead.
-func (*VNCRequisites) Descriptor() ([]byte, []int) {
-	return file_types_v2_machines_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use BackupCatalogEntry.ProtoReflect.Descriptor instead.
+func (*BackupCatalogEntry) Descriptor() ([]byte, []int) {
+	return file_types_v2_machines_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *VNCRequisites) GetPassword() string {
+func (x *BackupCatalogEntry) GetDiskName() string {
 	if x != nil {
-		return x.Password
+		return x.DiskName
 	}
 	return ""
 }
 
-func (x *VNCRequisites) GetDisplay() uint32 {
+func (x *BackupCatalogEntry) GetPath() string {
 	if x != nil {
-		return x.Display
+		return x.Path
 	}
-	return 0
+	return ""
 }
 
-func (x *VNCRequisites) GetPort() uint32 {
+func (x *BackupCatalogEntry) GetType() string {
 	if x != nil {
-		return x.Port
+		return x.Type
+	}
+	return ""
+}
+
+func (x *BackupCatalogEntry) GetSize() uint64 {
+	if x != nil {
+		return x.Size
 	}
 	return 0
 }
 
-func (x *VNCRequisites) GetWSPort() uint32 {
+func (x *BackupCatalogEntry) GetTaskID() string {
 	if x != nil {
-		return x.WSPort
+		return x.TaskID
+	}
+	return ""
+}
+
+func (x *BackupCatalogEntry) GetCreated() uint64 {
+	if x != nil {
+		return x.Created
 	}
 	return 0
 }
@@ -827,7 +1334,7 @@ type MachineEvent struct {
 func (x *MachineEvent) Reset() {
 	*x = MachineEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_v2_machines_proto_msgTypes[5]
+		mi := &file_types_v2_machines_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -840,7 +1347,7 @@ func (x *MachineEvent) String() string {
 func (*MachineEvent) ProtoMessage() {}
 
 func (x *MachineEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v2_machines_proto_msgTypes[5]
+	mi := &file_types_v2_machines_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -853,7 +1360,7 @@ func (x *MachineEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MachineEvent.ProtoReflect.Descriptor instead.
 func (*MachineEvent) Descriptor() ([]byte, []int) {
-	return file_types_v2_machines_proto_rawDescGZIP(), []int{5}
+	return file_types_v2_machines_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *MachineEvent) GetType() string {
@@ -889,7 +1396,7 @@ type MachineOpts_Firmware struct {
 func (x *MachineOpts_Firmware) Reset() {
 	*x = MachineOpts_Firmware{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_v2_machines_proto_msgTypes[6]
+		mi := &file_types_v2_machines_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -902,7 +1409,7 @@ func (x *MachineOpts_Firmware) String() string {
 func (*MachineOpts_Firmware) ProtoMessage() {}
 
 func (x *MachineOpts_Firmware) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v2_machines_proto_msgTypes[6]
+	mi := &file_types_v2_machines_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -944,7 +1451,7 @@ type MachineOpts_Memory struct {
 func (x *MachineOpts_Memory) Reset() {
 	*x = MachineOpts_Memory{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_v2_machines_proto_msgTypes[7]
+		mi := &file_types_v2_machines_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -957,7 +1464,7 @@ func (x *MachineOpts_Memory) String() string {
 func (*MachineOpts_Memory) ProtoMessage() {}
 
 func (x *MachineOpts_Memory) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v2_machines_proto_msgTypes[7]
+	mi := &file_types_v2_machines_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1002,7 +1509,7 @@ type MachineOpts_CPU struct {
 func (x *MachineOpts_CPU) Reset() {
 	*x = MachineOpts_CPU{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_v2_machines_proto_msgTypes[8]
+		mi := &file_types_v2_machines_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1015,7 +1522,7 @@ func (x *MachineOpts_CPU) String() string {
 func (*MachineOpts_CPU) ProtoMessage() {}
 
 func (x *MachineOpts_CPU) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v2_machines_proto_msgTypes[8]
+	mi := &file_types_v2_machines_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1077,7 +1584,7 @@ type MachineOpts_InputDevice struct {
 func (x *MachineOpts_InputDevice) Reset() {
 	*x = MachineOpts_InputDevice{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_v2_machines_proto_msgTypes[9]
+		mi := &file_types_v2_machines_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1090,7 +1597,7 @@ func (x *MachineOpts_InputDevice) String() string {
 func (*MachineOpts_InputDevice) ProtoMessage() {}
 
 func (x *MachineOpts_InputDevice) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v2_machines_proto_msgTypes[9]
+	mi := &file_types_v2_machines_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1129,7 +1636,7 @@ type MachineOpts_Cdrom struct {
 func (x *MachineOpts_Cdrom) Reset() {
 	*x = MachineOpts_Cdrom{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_v2_machines_proto_msgTypes[10]
+		mi := &file_types_v2_machines_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1142,7 +1649,7 @@ func (x *MachineOpts_Cdrom) String() string {
 func (*MachineOpts_Cdrom) ProtoMessage() {}
 
 func (x *MachineOpts_Cdrom) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v2_machines_proto_msgTypes[10]
+	mi := &file_types_v2_machines_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1205,18 +1712,22 @@ type MachineOpts_Disk struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path      string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	Driver    string `protobuf:"bytes,2,opt,name=driver,proto3" json:"driver,omitempty"`
-	IopsRd    uint32 `protobuf:"varint,3,opt,name=iops_rd,json=iopsRd,proto3" json:"iops_rd,omitempty"`
-	IopsWr    uint32 `protobuf:"varint,4,opt,name=iops_wr,json=iopsWr,proto3" json:"iops_wr,omitempty"`
-	Bootindex uint32 `protobuf:"varint,5,opt,name=bootindex,proto3" json:"bootindex,omitempty"`
-	Addr      string `protobuf:"bytes,6,opt,name=addr,proto3" json:"addr,omitempty"`
+	Path      string                        `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Driver    string                        `protobuf:"bytes,2,opt,name=driver,proto3" json:"driver,omitempty"`
+	IopsRd    uint32                        `protobuf:"varint,3,opt,name=iops_rd,json=iopsRd,proto3" json:"iops_rd,omitempty"`
+	IopsWr    uint32                        `protobuf:"varint,4,opt,name=iops_wr,json=iopsWr,proto3" json:"iops_wr,omitempty"`
+	Bootindex uint32                        `protobuf:"varint,5,opt,name=bootindex,proto3" json:"bootindex,omitempty"`
+	Addr      string                        `protobuf:"bytes,6,opt,name=addr,proto3" json:"addr,omitempty"`
+	Snapshots []string                      `protobuf:"bytes,7,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	Readonly  bool                          `protobuf:"varint,8,opt,name=readonly,proto3" json:"readonly,omitempty"`
+	Backup    *MachineOpts_Disk_BackupState `protobuf:"bytes,9,opt,name=backup,proto3" json:"backup,omitempty"`
+	Format    string                        `protobuf:"bytes,10,opt,name=format,proto3" json:"format,omitempty"`
 }
 
 func (x *MachineOpts_Disk) Reset() {
 	*x = MachineOpts_Disk{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_v2_machines_proto_msgTypes[11]
+		mi := &file_types_v2_machines_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1229,7 +1740,7 @@ func (x *MachineOpts_Disk) String() string {
 func (*MachineOpts_Disk) ProtoMessage() {}
 
 func (x *MachineOpts_Disk) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v2_machines_proto_msgTypes[11]
+	mi := &file_types_v2_machines_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1287,6 +1798,34 @@ func (x *MachineOpts_Disk) GetAddr() string {
 	return ""
 }
 
+func (x *MachineOpts_Disk) GetSnapshots() []string {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+func (x *MachineOpts_Disk) GetReadonly() bool {
+	if x != nil {
+		return x.Readonly
+	}
+	return false
+}
+
+func (x *MachineOpts_Disk) GetBackup() *MachineOpts_Disk_BackupState {
+	if x != nil {
+		return x.Backup
+	}
+	return nil
+}
+
+func (x *MachineOpts_Disk) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
 type MachineOpts_NetIface struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1304,7 +1843,7 @@ type MachineOpts_NetIface struct {
 func (x *MachineOpts_NetIface) Reset() {
 	*x = MachineOpts_NetIface{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_v2_machines_proto_msgTypes[12]
+		mi := &file_types_v2_machines_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1317,7 +1856,7 @@ func (x *MachineOpts_NetIface) String() string {
 func (*MachineOpts_NetIface) ProtoMessage() {}
 
 func (x *MachineOpts_NetIface) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v2_machines_proto_msgTypes[12]
+	mi := &file_types_v2_machines_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1394,7 +1933,7 @@ type MachineOpts_ChannelVSock struct {
 func (x *MachineOpts_ChannelVSock) Reset() {
 	*x = MachineOpts_ChannelVSock{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_v2_machines_proto_msgTypes[13]
+		mi := &file_types_v2_machines_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1407,7 +1946,7 @@ func (x *MachineOpts_ChannelVSock) String() string {
 func (*MachineOpts_ChannelVSock) ProtoMessage() {}
 
 func (x *MachineOpts_ChannelVSock) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v2_machines_proto_msgTypes[13]
+	mi := &file_types_v2_machines_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1449,7 +1988,7 @@ type MachineOpts_CloudInit struct {
 func (x *MachineOpts_CloudInit) Reset() {
 	*x = MachineOpts_CloudInit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_v2_machines_proto_msgTypes[14]
+		mi := &file_types_v2_machines_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1462,7 +2001,7 @@ func (x *MachineOpts_CloudInit) String() string {
 func (*MachineOpts_CloudInit) ProtoMessage() {}
 
 func (x *MachineOpts_CloudInit) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v2_machines_proto_msgTypes[14]
+	mi := &file_types_v2_machines_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1506,7 +2045,7 @@ type MachineOpts_Kernel struct {
 func (x *MachineOpts_Kernel) Reset() {
 	*x = MachineOpts_Kernel{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_v2_machines_proto_msgTypes[15]
+		mi := &file_types_v2_machines_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1519,7 +2058,7 @@ func (x *MachineOpts_Kernel) String() string {
 func (*MachineOpts_Kernel) ProtoMessage() {}
 
 func (x *MachineOpts_Kernel) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v2_machines_proto_msgTypes[15]
+	mi := &file_types_v2_machines_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1576,7 +2115,7 @@ type MachineOpts_HostDevice struct {
 func (x *MachineOpts_HostDevice) Reset() {
 	*x = MachineOpts_HostDevice{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_v2_machines_proto_msgTypes[16]
+		mi := &file_types_v2_machines_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1589,7 +2128,7 @@ func (x *MachineOpts_HostDevice) String() string {
 func (*MachineOpts_HostDevice) ProtoMessage() {}
 
 func (x *MachineOpts_HostDevice) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v2_machines_proto_msgTypes[16]
+	mi := &file_types_v2_machines_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1626,6 +2165,77 @@ func (x *MachineOpts_HostDevice) GetPrimaryGPU() bool {
 	return false
 }
 
+type MachineOpts_Disk_BackupState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Incremental      bool     `protobuf:"varint,1,opt,name=incremental,proto3" json:"incremental,omitempty"`
+	Reason           string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	PersistentBitmap bool     `protobuf:"varint,3,opt,name=persistent_bitmap,json=persistentBitmap,proto3" json:"persistent_bitmap,omitempty"`
+	Images           []string `protobuf:"bytes,4,rep,name=images,proto3" json:"images,omitempty"`
+}
+
+func (x *MachineOpts_Disk_BackupState) Reset() {
+	*x = MachineOpts_Disk_BackupState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v2_machines_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MachineOpts_Disk_BackupState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MachineOpts_Disk_BackupState) ProtoMessage() {}
+
+func (x *MachineOpts_Disk_BackupState) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v2_machines_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MachineOpts_Disk_BackupState.ProtoReflect.Descriptor instead.
+func (*MachineOpts_Disk_BackupState) Descriptor() ([]byte, []int) {
+	return file_types_v2_machines_proto_rawDescGZIP(), []int{0, 5, 0}
+}
+
+func (x *MachineOpts_Disk_BackupState) GetIncremental() bool {
+	if x != nil {
+		return x.Incremental
+	}
+	return false
+}
+
+func (x *MachineOpts_Disk_BackupState) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *MachineOpts_Disk_BackupState) GetPersistentBitmap() bool {
+	if x != nil {
+		return x.PersistentBitmap
+	}
+	return false
+}
+
+func (x *MachineOpts_Disk_BackupState) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
 type MachineEvent_Timestamp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1638,7 +2248,7 @@ type MachineEvent_Timestamp struct {
 func (x *MachineEvent_Timestamp) Reset() {
 	*x = MachineEvent_Timestamp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_v2_machines_proto_msgTypes[19]
+		mi := &file_types_v2_machines_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1651,7 +2261,7 @@ func (x *MachineEvent_Timestamp) String() string {
 func (*MachineEvent_Timestamp) ProtoMessage() {}
 
 func (x *MachineEvent_Timestamp) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v2_machines_proto_msgTypes[19]
+	mi := &file_types_v2_machines_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1664,7 +2274,7 @@ func (x *MachineEvent_Timestamp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MachineEvent_Timestamp.ProtoReflect.Descriptor instead.
 func (*MachineEvent_Timestamp) Descriptor() ([]byte, []int) {
-	return file_types_v2_machines_proto_rawDescGZIP(), []int{5, 0}
+	return file_types_v2_machines_proto_rawDescGZIP(), []int{10, 0}
 }
 
 func (x *MachineEvent_Timestamp) GetSeconds() uint64 {
@@ -1686,8 +2296,8 @@ var File_types_v2_machines_proto protoreflect.FileDescriptor
 var file_types_v2_machines_proto_rawDesc = []byte{
 	0x0a, 0x17, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x63, 0x68, 0x69,
 	0x6e, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x6b, 0x76, 0x6d, 0x72, 0x75,
-	0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x22, 0xee,
-	0x0e, 0x0a, 0x0b, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x74, 0x73, 0x12, 0x21,
+	0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x22, 0x9a,
+	0x11, 0x0a, 0x0b, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x74, 0x73, 0x12, 0x21,
 	0x0a, 0x0c, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70,
 	0x65, 0x12, 0x45, 0x0a, 0x08, 0x66, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x18, 0x02, 0x20,
@@ -1764,7 +2374,7 @@ var file_types_v2_machines_proto_rawDesc = []byte{
 	0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6f, 0x6f, 0x74, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20,
 	0x01, 0x28, 0x0d, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x74, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12,
 	0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64,
-	0x64, 0x72, 0x1a, 0x96, 0x01, 0x0a, 0x04, 0x44, 0x69, 0x73, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x64, 0x72, 0x1a, 0xc2, 0x03, 0x0a, 0x04, 0x44, 0x69, 0x73, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x70,
 	0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12,
 	0x16, 0x0a, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x6f, 0x70, 0x73, 0x5f,
@@ -1773,146 +2383,242 @@ var file_types_v2_machines_proto_rawDesc = []byte{
 	0x0d, 0x52, 0x06, 0x69, 0x6f, 0x70, 0x73, 0x57, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6f, 0x6f,
 	0x74, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x62, 0x6f,
 	0x6f, 0x74, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x1a, 0xab, 0x01, 0x0a, 0x08,
-	0x4e, 0x65, 0x74, 0x49, 0x66, 0x61, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x66, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x66, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x77, 0x5f, 0x61,
-	0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x77, 0x41, 0x64, 0x64,
-	0x72, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x66, 0x75, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x69, 0x66, 0x75, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x66, 0x64, 0x6f, 0x77, 0x6e, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x66, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x12, 0x0a,
-	0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64,
-	0x72, 0x12, 0x16, 0x0a, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x73, 0x1a, 0x41, 0x0a, 0x0c, 0x43, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x56, 0x53, 0x6f, 0x63, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x78, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x1a, 0x37, 0x0a, 0x09,
-	0x43, 0x6c, 0x6f, 0x75, 0x64, 0x49, 0x6e, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
-	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a,
-	0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64,
-	0x72, 0x69, 0x76, 0x65, 0x72, 0x1a, 0x68, 0x0a, 0x06, 0x4b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x12,
-	0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6e, 0x69, 0x74, 0x72, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x6e, 0x69, 0x74, 0x72, 0x64, 0x12, 0x16, 0x0a,
-	0x06, 0x6d, 0x6f, 0x64, 0x69, 0x73, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d,
-	0x6f, 0x64, 0x69, 0x73, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6d, 0x64, 0x6c, 0x69, 0x6e, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6d, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x1a,
-	0x6e, 0x0a, 0x0a, 0x48, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x19, 0x0a,
-	0x08, 0x70, 0x63, 0x69, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x70, 0x63, 0x69, 0x41, 0x64, 0x64, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x6d, 0x75, 0x6c, 0x74,
-	0x69, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0d, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f,
-	0x0a, 0x0b, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x67, 0x70, 0x75, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x47, 0x70, 0x75, 0x22,
-	0x9b, 0x02, 0x0a, 0x07, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x38, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x20, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x74,
-	0x73, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3a, 0x0a, 0x07, 0x72, 0x75, 0x6e,
-	0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x76, 0x6d,
-	0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32,
-	0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x74, 0x73, 0x52, 0x07, 0x72, 0x75,
-	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74,
-	0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x73, 0x69,
-	0x73, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69,
-	0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x10,
-	0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x70, 0x69, 0x64,
-	0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x69, 0x66, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x08, 0x6c, 0x69, 0x66, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xc1, 0x02,
-	0x0a, 0x12, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x76, 0x65, 0x72, 0x72,
-	0x69, 0x64, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x48, 0x0a, 0x05, 0x64, 0x69, 0x73, 0x6b,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x69,
-	0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73,
-	0x2e, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x64, 0x69, 0x73,
-	0x6b, 0x73, 0x12, 0x55, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x66, 0x61, 0x63, 0x65, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x69, 0x67,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x2e,
-	0x4e, 0x65, 0x74, 0x49, 0x66, 0x61, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09,
-	0x6e, 0x65, 0x74, 0x49, 0x66, 0x61, 0x63, 0x65, 0x73, 0x1a, 0x38, 0x0a, 0x0a, 0x44, 0x69, 0x73,
-	0x6b, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x61,
+	0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x61,
+	0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x12, 0x49, 0x0a, 0x06, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x4f, 0x70, 0x74, 0x73, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x2e, 0x42, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x06, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x1a, 0x8c, 0x01, 0x0a, 0x0b, 0x42, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x63, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69,
+	0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74,
+	0x5f, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x70,
+	0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x12,
+	0x16, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x1a, 0xab, 0x01, 0x0a, 0x08, 0x4e, 0x65, 0x74, 0x49,
+	0x66, 0x61, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x66, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x66, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x72,
+	0x69, 0x76, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x77, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x77, 0x41, 0x64, 0x64, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x69, 0x66, 0x75, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x66, 0x75,
+	0x70, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x66, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x69, 0x66, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64,
+	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x73, 0x1a, 0x41, 0x0a, 0x0c, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x56, 0x53, 0x6f, 0x63, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x1a, 0x37, 0x0a, 0x09, 0x43, 0x6c, 0x6f, 0x75,
+	0x64, 0x49, 0x6e, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65,
+	0x72, 0x1a, 0x68, 0x0a, 0x06, 0x4b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6e, 0x69, 0x74, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x69, 0x6e, 0x69, 0x74, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x64,
+	0x69, 0x73, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x69, 0x73,
+	0x6f, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6d, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x6d, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x1a, 0x6e, 0x0a, 0x0a, 0x48,
+	0x6f, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x63, 0x69,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x63, 0x69,
+	0x41, 0x64, 0x64, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x66, 0x75, 0x6e,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72,
+	0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x67, 0x70, 0x75, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x47, 0x70, 0x75, 0x22, 0x9b, 0x02, 0x0a, 0x07,
+	0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x06, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x76,
+	0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76,
+	0x32, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x74, 0x73, 0x52, 0x06, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3a, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x74, 0x73, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x74, 0x12, 0x37, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x21, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x70, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x6c, 0x69, 0x66, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x6c, 0x69, 0x66, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xae, 0x04, 0x0a, 0x0f, 0x4d, 0x69,
+	0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x0a,
+	0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64,
+	0x74, 0x68, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x64, 0x6f, 0x77, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x66, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x66, 0x64, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x66, 0x64, 0x5f,
+	0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x12, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x66, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x78, 0x62, 0x7a, 0x72, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x64,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x58, 0x62, 0x7a, 0x72, 0x6c, 0x65, 0x12, 0x2a, 0x0a, 0x11,
+	0x78, 0x62, 0x7a, 0x72, 0x6c, 0x65, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x78, 0x62, 0x7a, 0x72, 0x6c, 0x65, 0x43,
+	0x61, 0x63, 0x68, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x67,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x41, 0x75, 0x74, 0x6f, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x14,
+	0x63, 0x70, 0x75, 0x5f, 0x74, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x63, 0x70, 0x75, 0x54,
+	0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x34,
+	0x0a, 0x16, 0x63, 0x70, 0x75, 0x5f, 0x74, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x69,
+	0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14,
+	0x63, 0x70, 0x75, 0x54, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x49, 0x6e, 0x63, 0x72, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x63, 0x6f, 0x70, 0x79,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x63, 0x6f, 0x70, 0x79,
+	0x12, 0x32, 0x0a, 0x15, 0x70, 0x6f, 0x73, 0x74, 0x63, 0x6f, 0x70, 0x79, 0x5f, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x13, 0x70, 0x6f, 0x73, 0x74, 0x63, 0x6f, 0x70, 0x79, 0x41, 0x66, 0x74, 0x65, 0x72, 0x50, 0x61,
+	0x73, 0x73, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x70, 0x6f, 0x73, 0x74, 0x63, 0x6f, 0x70, 0x79,
+	0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x70, 0x6f, 0x73, 0x74, 0x63, 0x6f, 0x70, 0x79, 0x41, 0x66,
+	0x74, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x43, 0x0a, 0x13, 0x4d, 0x69,
+	0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x73, 0x73, 0x75,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x65, 0x73, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x65, 0x73, 0x63, 0x22,
+	0xc1, 0x02, 0x0a, 0x12, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x76, 0x65,
+	0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x48, 0x0a, 0x05, 0x64, 0x69,
+	0x73, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6b, 0x76, 0x6d, 0x72,
+	0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e,
+	0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+	0x65, 0x73, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x64,
+	0x69, 0x73, 0x6b, 0x73, 0x12, 0x55, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x66, 0x61, 0x63,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75,
+	0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4d,
+	0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+	0x73, 0x2e, 0x4e, 0x65, 0x74, 0x49, 0x66, 0x61, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x09, 0x6e, 0x65, 0x74, 0x49, 0x66, 0x61, 0x63, 0x65, 0x73, 0x1a, 0x38, 0x0a, 0x0a, 0x44,
+	0x69, 0x73, 0x6b, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3c, 0x0a, 0x0e, 0x4e, 0x65, 0x74, 0x49, 0x66, 0x61, 0x63,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
 	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x1a, 0x3c, 0x0a, 0x0e, 0x4e, 0x65, 0x74, 0x49, 0x66, 0x61, 0x63, 0x65, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x22, 0x6f, 0x0a, 0x1b, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x4d, 0x69, 0x67,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x73,
-	0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x72,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e,
-	0x67, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x62, 0x64, 0x5f, 0x70, 0x6f, 0x72,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6e, 0x62, 0x64, 0x50, 0x6f, 0x72, 0x74,
-	0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x70,
-	0x69, 0x64, 0x22, 0x72, 0x0a, 0x0d, 0x56, 0x4e, 0x43, 0x52, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69,
-	0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12,
-	0x18, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x07, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x17, 0x0a,
-	0x07, 0x77, 0x73, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
-	0x77, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x22, 0xcc, 0x01, 0x0a, 0x0c, 0x4d, 0x61, 0x63, 0x68, 0x69,
-	0x6e, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
-	0x49, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x1a, 0x49, 0x0a, 0x09, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x6f, 0x6e,
-	0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
-	0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x65,
-	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x2a, 0x96, 0x01, 0x0a, 0x0c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e,
-	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x4e, 0x4f, 0x53, 0x54, 0x41, 0x54,
-	0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x54, 0x41, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x10,
-	0x01, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x0a,
-	0x0a, 0x06, 0x50, 0x41, 0x55, 0x53, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x48,
-	0x55, 0x54, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x4e, 0x41, 0x43,
-	0x54, 0x49, 0x56, 0x45, 0x10, 0x05, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x52, 0x41, 0x53, 0x48, 0x45,
-	0x44, 0x10, 0x06, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x4e, 0x43, 0x4f, 0x4d, 0x49, 0x4e, 0x47, 0x10,
-	0x07, 0x12, 0x0d, 0x0a, 0x09, 0x4d, 0x49, 0x47, 0x52, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x08,
-	0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x49, 0x47, 0x52, 0x41, 0x54, 0x45, 0x44, 0x10, 0x09, 0x2a, 0x56,
-	0x0a, 0x0e, 0x4e, 0x65, 0x74, 0x49, 0x66, 0x61, 0x63, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72,
-	0x12, 0x18, 0x0a, 0x14, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x5f, 0x4e, 0x45,
-	0x54, 0x5f, 0x44, 0x52, 0x49, 0x56, 0x45, 0x52, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x56, 0x49,
-	0x52, 0x54, 0x49, 0x4f, 0x5f, 0x4e, 0x45, 0x54, 0x5f, 0x50, 0x43, 0x49, 0x10, 0x01, 0x12, 0x0b,
-	0x0a, 0x07, 0x52, 0x54, 0x4c, 0x38, 0x31, 0x33, 0x39, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x45,
-	0x31, 0x30, 0x30, 0x30, 0x10, 0x03, 0x2a, 0x3f, 0x0a, 0x11, 0x4e, 0x65, 0x74, 0x49, 0x66, 0x61,
-	0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x55,
-	0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x45, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x55, 0x50, 0x10, 0x01, 0x12, 0x08, 0x0a,
-	0x04, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x02, 0x2a, 0x54, 0x0a, 0x0a, 0x44, 0x69, 0x73, 0x6b, 0x44,
-	0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x15, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e,
-	0x45, 0x44, 0x5f, 0x44, 0x49, 0x53, 0x4b, 0x5f, 0x44, 0x52, 0x49, 0x56, 0x45, 0x52, 0x10, 0x00,
-	0x12, 0x12, 0x0a, 0x0e, 0x56, 0x49, 0x52, 0x54, 0x49, 0x4f, 0x5f, 0x42, 0x4c, 0x4b, 0x5f, 0x50,
-	0x43, 0x49, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x43, 0x53, 0x49, 0x5f, 0x48, 0x44, 0x10,
-	0x02, 0x12, 0x0a, 0x0a, 0x06, 0x49, 0x44, 0x45, 0x5f, 0x48, 0x44, 0x10, 0x03, 0x2a, 0x42, 0x0a,
-	0x0b, 0x43, 0x64, 0x72, 0x6f, 0x6d, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x16,
-	0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x5f, 0x43, 0x44, 0x52, 0x4f, 0x4d, 0x5f,
-	0x44, 0x52, 0x49, 0x56, 0x45, 0x52, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x43, 0x53, 0x49,
-	0x5f, 0x43, 0x44, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x49, 0x44, 0x45, 0x5f, 0x43, 0x44, 0x10,
-	0x02, 0x2a, 0x4f, 0x0a, 0x0f, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x49, 0x6e, 0x69, 0x74, 0x44, 0x72,
-	0x69, 0x76, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x1a, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45,
-	0x44, 0x5f, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x44, 0x52, 0x49, 0x56,
-	0x45, 0x52, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x49, 0x5f, 0x49, 0x44, 0x45, 0x5f, 0x43,
-	0x44, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x49, 0x5f, 0x46, 0x4c, 0x4f, 0x50, 0x50, 0x59,
-	0x10, 0x02, 0x2a, 0x3b, 0x0a, 0x0f, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e,
-	0x45, 0x44, 0x5f, 0x49, 0x4e, 0x50, 0x55, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x00, 0x12,
-	0x0e, 0x0a, 0x0a, 0x55, 0x53, 0x42, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x54, 0x10, 0x01, 0x42,
-	0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x30, 0x78,
-	0x65, 0x66, 0x35, 0x33, 0x2f, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x32, 0x3b, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x02, 0x38, 0x01, 0x22, 0x6f, 0x0a, 0x1b, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x4d,
+	0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74,
+	0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x69, 0x6e, 0x63, 0x6f, 0x6d,
+	0x69, 0x6e, 0x67, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x62, 0x64, 0x5f, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6e, 0x62, 0x64, 0x50, 0x6f,
+	0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x03, 0x70, 0x69, 0x64, 0x22, 0x72, 0x0a, 0x0d, 0x56, 0x4e, 0x43, 0x52, 0x65, 0x71, 0x75, 0x69,
+	0x73, 0x69, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x77, 0x73, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x06, 0x77, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x22, 0x52, 0x0a, 0x0c, 0x44, 0x69, 0x73, 0x6b,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x22, 0xff, 0x01, 0x0a,
+	0x0c, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x69, 0x73,
+	0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x65, 0x76, 0x65, 0x72, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x09, 0x66, 0x75, 0x6c, 0x6c, 0x45, 0x76, 0x65, 0x72, 0x79, 0x12, 0x27,
+	0x0a, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6b, 0x65, 0x65, 0x70, 0x5f,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6b, 0x65,
+	0x65, 0x70, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6b, 0x65, 0x65, 0x70,
+	0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6b, 0x65, 0x65,
+	0x70, 0x44, 0x61, 0x79, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x73, 0x5f, 0x66, 0x72, 0x65, 0x65,
+	0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66, 0x73, 0x46, 0x72, 0x65, 0x65,
+	0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0xa0,
+	0x01, 0x0a, 0x12, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x22, 0xcc, 0x01, 0x0a, 0x0c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x49, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
+	0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x1a, 0x49, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x22, 0x0a, 0x0c,
+	0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0c, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x2a, 0x96, 0x01, 0x0a, 0x0c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x0b, 0x0a, 0x07, 0x4e, 0x4f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x10, 0x00, 0x12, 0x0c,
+	0x0a, 0x08, 0x53, 0x54, 0x41, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07,
+	0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x41, 0x55,
+	0x53, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x48, 0x55, 0x54, 0x44, 0x4f, 0x57,
+	0x4e, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x4e, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10,
+	0x05, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x52, 0x41, 0x53, 0x48, 0x45, 0x44, 0x10, 0x06, 0x12, 0x0c,
+	0x0a, 0x08, 0x49, 0x4e, 0x43, 0x4f, 0x4d, 0x49, 0x4e, 0x47, 0x10, 0x07, 0x12, 0x0d, 0x0a, 0x09,
+	0x4d, 0x49, 0x47, 0x52, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x08, 0x12, 0x0c, 0x0a, 0x08, 0x4d,
+	0x49, 0x47, 0x52, 0x41, 0x54, 0x45, 0x44, 0x10, 0x09, 0x2a, 0x38, 0x0a, 0x0c, 0x4d, 0x69, 0x67,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x4c, 0x53, 0x12, 0x0f, 0x0a, 0x0b, 0x44, 0x45, 0x46,
+	0x41, 0x55, 0x4c, 0x54, 0x5f, 0x54, 0x4c, 0x53, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x54, 0x4c,
+	0x53, 0x5f, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x4c, 0x53, 0x5f, 0x4f, 0x46,
+	0x46, 0x10, 0x02, 0x2a, 0x6d, 0x0a, 0x0e, 0x4e, 0x65, 0x74, 0x49, 0x66, 0x61, 0x63, 0x65, 0x44,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x14, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e,
+	0x45, 0x44, 0x5f, 0x4e, 0x45, 0x54, 0x5f, 0x44, 0x52, 0x49, 0x56, 0x45, 0x52, 0x10, 0x00, 0x12,
+	0x12, 0x0a, 0x0e, 0x56, 0x49, 0x52, 0x54, 0x49, 0x4f, 0x5f, 0x4e, 0x45, 0x54, 0x5f, 0x50, 0x43,
+	0x49, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x54, 0x4c, 0x38, 0x31, 0x33, 0x39, 0x10, 0x02,
+	0x12, 0x09, 0x0a, 0x05, 0x45, 0x31, 0x30, 0x30, 0x30, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x56,
+	0x49, 0x52, 0x54, 0x49, 0x4f, 0x5f, 0x4e, 0x45, 0x54, 0x5f, 0x44, 0x45, 0x56, 0x49, 0x43, 0x45,
+	0x10, 0x04, 0x2a, 0x3f, 0x0a, 0x11, 0x4e, 0x65, 0x74, 0x49, 0x66, 0x61, 0x63, 0x65, 0x4c, 0x69,
+	0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x55, 0x4e, 0x44, 0x45, 0x46,
+	0x49, 0x4e, 0x45, 0x44, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x10,
+	0x00, 0x12, 0x06, 0x0a, 0x02, 0x55, 0x50, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x4f, 0x57,
+	0x4e, 0x10, 0x02, 0x2a, 0x6b, 0x0a, 0x0a, 0x44, 0x69, 0x73, 0x6b, 0x44, 0x72, 0x69, 0x76, 0x65,
+	0x72, 0x12, 0x19, 0x0a, 0x15, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x5f, 0x44,
+	0x49, 0x53, 0x4b, 0x5f, 0x44, 0x52, 0x49, 0x56, 0x45, 0x52, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e,
+	0x56, 0x49, 0x52, 0x54, 0x49, 0x4f, 0x5f, 0x42, 0x4c, 0x4b, 0x5f, 0x50, 0x43, 0x49, 0x10, 0x01,
+	0x12, 0x0b, 0x0a, 0x07, 0x53, 0x43, 0x53, 0x49, 0x5f, 0x48, 0x44, 0x10, 0x02, 0x12, 0x0a, 0x0a,
+	0x06, 0x49, 0x44, 0x45, 0x5f, 0x48, 0x44, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x56, 0x49, 0x52,
+	0x54, 0x49, 0x4f, 0x5f, 0x42, 0x4c, 0x4b, 0x5f, 0x44, 0x45, 0x56, 0x49, 0x43, 0x45, 0x10, 0x04,
+	0x2a, 0x42, 0x0a, 0x0b, 0x43, 0x64, 0x72, 0x6f, 0x6d, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12,
+	0x1a, 0x0a, 0x16, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x5f, 0x43, 0x44, 0x52,
+	0x4f, 0x4d, 0x5f, 0x44, 0x52, 0x49, 0x56, 0x45, 0x52, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x53,
+	0x43, 0x53, 0x49, 0x5f, 0x43, 0x44, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x49, 0x44, 0x45, 0x5f,
+	0x43, 0x44, 0x10, 0x02, 0x2a, 0x4f, 0x0a, 0x0f, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x49, 0x6e, 0x69,
+	0x74, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x1a, 0x55, 0x4e, 0x44, 0x45, 0x46,
+	0x49, 0x4e, 0x45, 0x44, 0x5f, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x44,
+	0x52, 0x49, 0x56, 0x45, 0x52, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x49, 0x5f, 0x49, 0x44,
+	0x45, 0x5f, 0x43, 0x44, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x49, 0x5f, 0x46, 0x4c, 0x4f,
+	0x50, 0x50, 0x59, 0x10, 0x02, 0x2a, 0x3b, 0x0a, 0x0f, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x55, 0x4e, 0x44, 0x45,
+	0x46, 0x49, 0x4e, 0x45, 0x44, 0x5f, 0x49, 0x4e, 0x50, 0x55, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x55, 0x53, 0x42, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x54,
+	0x10, 0x01, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x30, 0x78, 0x65, 0x66, 0x35, 0x33, 0x2f, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x32, 0x3b, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1927,60 +2633,68 @@ func file_types_v2_machines_proto_rawDescGZIP() []byte {
 	return file_types_v2_machines_proto_rawDescData
 }
 
-var file_types_v2_machines_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
-var file_types_v2_machines_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_types_v2_machines_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_types_v2_machines_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_types_v2_machines_proto_goTypes = []interface{}{
-	(MachineState)(0),                   // 0: kvmrun.api.types.v2.MachineState
-	(NetIfaceDriver)(0),                 // 1: kvmrun.api.types.v2.NetIfaceDriver
-	(NetIfaceLinkState)(0),              // 2: kvmrun.api.types.v2.NetIfaceLinkState
-	(DiskDriver)(0),                     // 3: kvmrun.api.types.v2.DiskDriver
-	(CdromDriver)(0),                    // 4: kvmrun.api.types.v2.CdromDriver
-	(CloudInitDriver)(0),                // 5: kvmrun.api.types.v2.CloudInitDriver
-	(InputDeviceType)(0),                // 6: kvmrun.api.types.v2.InputDeviceType
-	(*MachineOpts)(nil),                 // 7: kvmrun.api.types.v2.MachineOpts
-	(*Machine)(nil),                     // 8: kvmrun.api.types.v2.Machine
-	(*MigrationOverrides)(nil),          // 9: kvmrun.api.types.v2.MigrationOverrides
-	(*IncomingMigrationRequisites)(nil), // 10: kvmrun.api.types.v2.IncomingMigrationRequisites
-	(*VNCRequisites)(nil),               // 11: kvmrun.api.types.v2.VNCRequisites
-	(*MachineEvent)(nil),                // 12: kvmrun.api.types.v2.MachineEvent
-	(*MachineOpts_Firmware)(nil),        // 13: kvmrun.api.types.v2.MachineOpts.Firmware
-	(*MachineOpts_Memory)(nil),          // 14: kvmrun.api.types.v2.MachineOpts.Memory
-	(*MachineOpts_CPU)(nil),             // 15: kvmrun.api.types.v2.MachineOpts.CPU
-	(*MachineOpts_InputDevice)(nil),     // 16: kvmrun.api.types.v2.MachineOpts.InputDevice
-	(*MachineOpts_Cdrom)(nil),           // 17: kvmrun.api.types.v2.MachineOpts.Cdrom
-	(*MachineOpts_Disk)(nil),            // 18: kvmrun.api.types.v2.MachineOpts.Disk
-	(*MachineOpts_NetIface)(nil),        // 19: kvmrun.api.types.v2.MachineOpts.NetIface
-	(*MachineOpts_ChannelVSock)(nil),    // 20: kvmrun.api.types.v2.MachineOpts.ChannelVSock
-	(*MachineOpts_CloudInit)(nil),       // 21: kvmrun.api.types.v2.MachineOpts.CloudInit
-	(*MachineOpts_Kernel)(nil),          // 22: kvmrun.api.types.v2.MachineOpts.Kernel
-	(*MachineOpts_HostDevice)(nil),      // 23: kvmrun.api.types.v2.MachineOpts.HostDevice
-	nil,                                 // 24: kvmrun.api.types.v2.MigrationOverrides.DisksEntry
-	nil,                                 // 25: kvmrun.api.types.v2.MigrationOverrides.NetIfacesEntry
-	(*MachineEvent_Timestamp)(nil),      // 26: kvmrun.api.types.v2.MachineEvent.Timestamp
+	(MachineState)(0),                    // 0: kvmrun.api.types.v2.MachineState
+	(MigrationTLS)(0),                    // 1: kvmrun.api.types.v2.MigrationTLS
+	(NetIfaceDriver)(0),                  // 2: kvmrun.api.types.v2.NetIfaceDriver
+	(NetIfaceLinkState)(0),               // 3: kvmrun.api.types.v2.NetIfaceLinkState
+	(DiskDriver)(0),                      // 4: kvmrun.api.types.v2.DiskDriver
+	(CdromDriver)(0),                     // 5: kvmrun.api.types.v2.CdromDriver
+	(CloudInitDriver)(0),                 // 6: kvmrun.api.types.v2.CloudInitDriver
+	(InputDeviceType)(0),                 // 7: kvmrun.api.types.v2.InputDeviceType
+	(*MachineOpts)(nil),                  // 8: kvmrun.api.types.v2.MachineOpts
+	(*Machine)(nil),                      // 9: kvmrun.api.types.v2.Machine
+	(*MigrationTuning)(nil),              // 10: kvmrun.api.types.v2.MigrationTuning
+	(*MigrationCheckIssue)(nil),          // 11: kvmrun.api.types.v2.MigrationCheckIssue
+	(*MigrationOverrides)(nil),           // 12: kvmrun.api.types.v2.MigrationOverrides
+	(*IncomingMigrationRequisites)(nil),  // 13: kvmrun.api.types.v2.IncomingMigrationRequisites
+	(*VNCRequisites)(nil),                // 14: kvmrun.api.types.v2.VNCRequisites
+	(*DiskSnapshot)(nil),                 // 15: kvmrun.api.types.v2.DiskSnapshot
+	(*BackupPolicy)(nil),                 // 16: kvmrun.api.types.v2.BackupPolicy
+	(*BackupCatalogEntry)(nil),           // 17: kvmrun.api.types.v2.BackupCatalogEntry
+	(*MachineEvent)(nil),                 // 18: kvmrun.api.types.v2.MachineEvent
+	(*MachineOpts_Firmware)(nil),         // 19: kvmrun.api.types.v2.MachineOpts.Firmware
+	(*MachineOpts_Memory)(nil),           // 20: kvmrun.api.types.v2.MachineOpts.Memory
+	(*MachineOpts_CPU)(nil),              // 21: kvmrun.api.types.v2.MachineOpts.CPU
+	(*MachineOpts_InputDevice)(nil),      // 22: kvmrun.api.types.v2.MachineOpts.InputDevice
+	(*MachineOpts_Cdrom)(nil),            // 23: kvmrun.api.types.v2.MachineOpts.Cdrom
+	(*MachineOpts_Disk)(nil),             // 24: kvmrun.api.types.v2.MachineOpts.Disk
+	(*MachineOpts_NetIface)(nil),         // 25: kvmrun.api.types.v2.MachineOpts.NetIface
+	(*MachineOpts_ChannelVSock)(nil),     // 26: kvmrun.api.types.v2.MachineOpts.ChannelVSock
+	(*MachineOpts_CloudInit)(nil),        // 27: kvmrun.api.types.v2.MachineOpts.CloudInit
+	(*MachineOpts_Kernel)(nil),           // 28: kvmrun.api.types.v2.MachineOpts.Kernel
+	(*MachineOpts_HostDevice)(nil),       // 29: kvmrun.api.types.v2.MachineOpts.HostDevice
+	(*MachineOpts_Disk_BackupState)(nil), // 30: kvmrun.api.types.v2.MachineOpts.Disk.BackupState
+	nil,                                  // 31: kvmrun.api.types.v2.MigrationOverrides.DisksEntry
+	nil,                                  // 32: kvmrun.api.types.v2.MigrationOverrides.NetIfacesEntry
+	(*MachineEvent_Timestamp)(nil),       // 33: kvmrun.api.types.v2.MachineEvent.Timestamp
 }
 var file_types_v2_machines_proto_depIdxs = []int32{
-	13, // 0: kvmrun.api.types.v2.MachineOpts.firmware:type_name -> kvmrun.api.types.v2.MachineOpts.Firmware
-	14, // 1: kvmrun.api.types.v2.MachineOpts.memory:type_name -> kvmrun.api.types.v2.MachineOpts.Memory
-	15, // 2: kvmrun.api.types.v2.MachineOpts.cpu:type_name -> kvmrun.api.types.v2.MachineOpts.CPU
-	16, // 3: kvmrun.api.types.v2.MachineOpts.inputs:type_name -> kvmrun.api.types.v2.MachineOpts.InputDevice
-	17, // 4: kvmrun.api.types.v2.MachineOpts.cdrom:type_name -> kvmrun.api.types.v2.MachineOpts.Cdrom
-	18, // 5: kvmrun.api.types.v2.MachineOpts.storage:type_name -> kvmrun.api.types.v2.MachineOpts.Disk
-	19, // 6: kvmrun.api.types.v2.MachineOpts.network:type_name -> kvmrun.api.types.v2.MachineOpts.NetIface
-	20, // 7: kvmrun.api.types.v2.MachineOpts.vsock_device:type_name -> kvmrun.api.types.v2.MachineOpts.ChannelVSock
-	21, // 8: kvmrun.api.types.v2.MachineOpts.cloudinit_drive:type_name -> kvmrun.api.types.v2.MachineOpts.CloudInit
-	22, // 9: kvmrun.api.types.v2.MachineOpts.kernel:type_name -> kvmrun.api.types.v2.MachineOpts.Kernel
-	23, // 10: kvmrun.api.types.v2.MachineOpts.hostpci:type_name -> kvmrun.api.types.v2.MachineOpts.HostDevice
-	7,  // 11: kvmrun.api.types.v2.Machine.config:type_name -> kvmrun.api.types.v2.MachineOpts
-	7,  // 12: kvmrun.api.types.v2.Machine.runtime:type_name -> kvmrun.api.types.v2.MachineOpts
+	19, // 0: kvmrun.api.types.v2.MachineOpts.firmware:type_name -> kvmrun.api.types.v2.MachineOpts.Firmware
+	20, // 1: kvmrun.api.types.v2.MachineOpts.memory:type_name -> kvmrun.api.types.v2.MachineOpts.Memory
+	21, // 2: kvmrun.api.types.v2.MachineOpts.cpu:type_name -> kvmrun.api.types.v2.MachineOpts.CPU
+	22, // 3: kvmrun.api.types.v2.MachineOpts.inputs:type_name -> kvmrun.api.types.v2.MachineOpts.InputDevice
+	23, // 4: kvmrun.api.types.v2.MachineOpts.cdrom:type_name -> kvmrun.api.types.v2.MachineOpts.Cdrom
+	24, // 5: kvmrun.api.types.v2.MachineOpts.storage:type_name -> kvmrun.api.types.v2.MachineOpts.Disk
+	25, // 6: kvmrun.api.types.v2.MachineOpts.network:type_name -> kvmrun.api.types.v2.MachineOpts.NetIface
+	26, // 7: kvmrun.api.types.v2.MachineOpts.vsock_device:type_name -> kvmrun.api.types.v2.MachineOpts.ChannelVSock
+	27, // 8: kvmrun.api.types.v2.MachineOpts.cloudinit_drive:type_name -> kvmrun.api.types.v2.MachineOpts.CloudInit
+	28, // 9: kvmrun.api.types.v2.MachineOpts.kernel:type_name -> kvmrun.api.types.v2.MachineOpts.Kernel
+	29, // 10: kvmrun.api.types.v2.MachineOpts.hostpci:type_name -> kvmrun.api.types.v2.MachineOpts.HostDevice
+	8,  // 11: kvmrun.api.types.v2.Machine.config:type_name -> kvmrun.api.types.v2.MachineOpts
+	8,  // 12: kvmrun.api.types.v2.Machine.runtime:type_name -> kvmrun.api.types.v2.MachineOpts
 	0,  // 13: kvmrun.api.types.v2.Machine.state:type_name -> kvmrun.api.types.v2.MachineState
-	24, // 14: kvmrun.api.types.v2.MigrationOverrides.disks:type_name -> kvmrun.api.types.v2.MigrationOverrides.DisksEntry
-	25, // 15: kvmrun.api.types.v2.MigrationOverrides.net_ifaces:type_name -> kvmrun.api.types.v2.MigrationOverrides.NetIfacesEntry
-	26, // 16: kvmrun.api.types.v2.MachineEvent.timestamp:type_name -> kvmrun.api.types.v2.MachineEvent.Timestamp
-	17, // [17:17] is the sub-list for method output_type
-	17, // [17:17] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	31, // 14: kvmrun.api.types.v2.MigrationOverrides.disks:type_name -> kvmrun.api.types.v2.MigrationOverrides.DisksEntry
+	32, // 15: kvmrun.api.types.v2.MigrationOverrides.net_ifaces:type_name -> kvmrun.api.types.v2.MigrationOverrides.NetIfacesEntry
+	33, // 16: kvmrun.api.types.v2.MachineEvent.timestamp:type_name -> kvmrun.api.types.v2.MachineEvent.Timestamp
+	30, // 17: kvmrun.api.types.v2.MachineOpts.Disk.backup:type_name -> kvmrun.api.types.v2.MachineOpts.Disk.BackupState
+	18, // [18:18] is the sub-list for method output_type
+	18, // [18:18] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_types_v2_machines_proto_init() }
@@ -2014,7 +2728,7 @@ func file_types_v2_machines_proto_init() {
 			}
 		}
 		file_types_v2_machines_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MigrationOverrides); i {
+			switch v := v.(*MigrationTuning); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2026,7 +2740,7 @@ func file_types_v2_machines_proto_init() {
 			}
 		}
 		file_types_v2_machines_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IncomingMigrationRequisites); i {
+			switch v := v.(*MigrationCheckIssue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2038,7 +2752,7 @@ func file_types_v2_machines_proto_init() {
 			}
 		}
 		file_types_v2_machines_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VNCRequisites); i {
+			switch v := v.(*MigrationOverrides); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2050,7 +2764,7 @@ func file_types_v2_machines_proto_init() {
 			}
 		}
 		file_types_v2_machines_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MachineEvent); i {
+			switch v := v.(*IncomingMigrationRequisites); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2062,7 +2776,7 @@ func file_types_v2_machines_proto_init() {
 			}
 		}
 		file_types_v2_machines_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MachineOpts_Firmware); i {
+			switch v := v.(*VNCRequisites); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2074,7 +2788,7 @@ func file_types_v2_machines_proto_init() {
 			}
 		}
 		file_types_v2_machines_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MachineOpts_Memory); i {
+			switch v := v.(*DiskSnapshot); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2086,7 +2800,7 @@ func file_types_v2_machines_proto_init() {
 			}
 		}
 		file_types_v2_machines_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MachineOpts_CPU); i {
+			switch v := v.(*BackupPolicy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2098,7 +2812,7 @@ func file_types_v2_machines_proto_init() {
 			}
 		}
 		file_types_v2_machines_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MachineOpts_InputDevice); i {
+			switch v := v.(*BackupCatalogEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2110,7 +2824,7 @@ func file_types_v2_machines_proto_init() {
 			}
 		}
 		file_types_v2_machines_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MachineOpts_Cdrom); i {
+			switch v := v.(*MachineEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2122,7 +2836,7 @@ func file_types_v2_machines_proto_init() {
 			}
 		}
 		file_types_v2_machines_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MachineOpts_Disk); i {
+			switch v := v.(*MachineOpts_Firmware); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2134,7 +2848,7 @@ func file_types_v2_machines_proto_init() {
 			}
 		}
 		file_types_v2_machines_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MachineOpts_NetIface); i {
+			switch v := v.(*MachineOpts_Memory); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2146,7 +2860,7 @@ func file_types_v2_machines_proto_init() {
 			}
 		}
 		file_types_v2_machines_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MachineOpts_ChannelVSock); i {
+			switch v := v.(*MachineOpts_CPU); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2158,7 +2872,7 @@ func file_types_v2_machines_proto_init() {
 			}
 		}
 		file_types_v2_machines_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MachineOpts_CloudInit); i {
+			switch v := v.(*MachineOpts_InputDevice); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2170,7 +2884,7 @@ func file_types_v2_machines_proto_init() {
 			}
 		}
 		file_types_v2_machines_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MachineOpts_Kernel); i {
+			switch v := v.(*MachineOpts_Cdrom); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2182,7 +2896,31 @@ func file_types_v2_machines_proto_init() {
 			}
 		}
 		file_types_v2_machines_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MachineOpts_HostDevice); i {
+			switch v := v.(*MachineOpts_Disk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v2_machines_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MachineOpts_NetIface); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v2_machines_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MachineOpts_ChannelVSock); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2194,6 +2932,54 @@ func file_types_v2_machines_proto_init() {
 			}
 		}
 		file_types_v2_machines_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MachineOpts_CloudInit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v2_machines_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MachineOpts_Kernel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v2_machines_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MachineOpts_HostDevice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v2_machines_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MachineOpts_Disk_BackupState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v2_machines_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MachineEvent_Timestamp); i {
 			case 0:
 				return &v.state
@@ -2211,8 +2997,8 @@ func file_types_v2_machines_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_types_v2_machines_proto_rawDesc,
-			NumEnums:      7,
-			NumMessages:   20,
+			NumEnums:      8,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -84,6 +84,11 @@ type TaskInfo struct {
 	// Types that are assignable to Stat:
 	//
 	//	*TaskInfo_Migration
+	//	*TaskInfo_Evacuation
+	//	*TaskInfo_Suspend
+	//	*TaskInfo_Backup
+	//	*TaskInfo_Restore
+	//	*TaskInfo_Move
 	Stat isTaskInfo_Stat `protobuf_oneof:"stat"`
 }
 
@@ -161,6 +166,41 @@ func (x *TaskInfo) GetMigration() *TaskInfo_MigrationInfo {
 	return nil
 }
 
+func (x *TaskInfo) GetEvacuation() *TaskInfo_EvacuationInfo {
+	if x, ok := x.GetStat().(*TaskInfo_Evacuation); ok {
+		return x.Evacuation
+	}
+	return nil
+}
+
+func (x *TaskInfo) GetSuspend() *TaskInfo_SuspendInfo {
+	if x, ok := x.GetStat().(*TaskInfo_Suspend); ok {
+		return x.Suspend
+	}
+	return nil
+}
+
+func (x *TaskInfo) GetBackup() *TaskInfo_BackupInfo {
+	if x, ok := x.GetStat().(*TaskInfo_Backup); ok {
+		return x.Backup
+	}
+	return nil
+}
+
+func (x *TaskInfo) GetRestore() *TaskInfo_RestoreInfo {
+	if x, ok := x.GetStat().(*TaskInfo_Restore); ok {
+		return x.Restore
+	}
+	return nil
+}
+
+func (x *TaskInfo) GetMove() *TaskInfo_DiskMoveInfo {
+	if x, ok := x.GetStat().(*TaskInfo_Move); ok {
+		return x.Move
+	}
+	return nil
+}
+
 type isTaskInfo_Stat interface {
 	isTaskInfo_Stat()
 }
@@ -169,16 +209,48 @@ type TaskInfo_Migration struct {
 	Migration *TaskInfo_MigrationInfo `protobuf:"bytes,10,opt,name=migration,proto3,oneof"`
 }
 
+type TaskInfo_Evacuation struct {
+	Evacuation *TaskInfo_EvacuationInfo `protobuf:"bytes,11,opt,name=evacuation,proto3,oneof"`
+}
+
+type TaskInfo_Suspend struct {
+	Suspend *TaskInfo_SuspendInfo `protobuf:"bytes,12,opt,name=suspend,proto3,oneof"`
+}
+
+type TaskInfo_Backup struct {
+	Backup *TaskInfo_BackupInfo `protobuf:"bytes,13,opt,name=backup,proto3,oneof"`
+}
+
+type TaskInfo_Restore struct {
+	Restore *TaskInfo_RestoreInfo `protobuf:"bytes,14,opt,name=restore,proto3,oneof"`
+}
+
+type TaskInfo_Move struct {
+	Move *TaskInfo_DiskMoveInfo `protobuf:"bytes,15,opt,name=move,proto3,oneof"`
+}
+
 func (*TaskInfo_Migration) isTaskInfo_Stat() {}
 
+func (*TaskInfo_Evacuation) isTaskInfo_Stat() {}
+
+func (*TaskInfo_Suspend) isTaskInfo_Stat() {}
+
+func (*TaskInfo_Backup) isTaskInfo_Stat() {}
+
+func (*TaskInfo_Restore) isTaskInfo_Stat() {}
+
+func (*TaskInfo_Move) isTaskInfo_Stat() {}
+
 type TaskInfo_MigrationInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DstServer string                                  `protobuf:"bytes,1,opt,name=dst_server,json=dstServer,proto3" json:"dst_server,omitempty"`
-	Qemu      *TaskInfo_MigrationInfo_Stat            `protobuf:"bytes,2,opt,name=qemu,proto3" json:"qemu,omitempty"`
-	Disks     map[string]*TaskInfo_MigrationInfo_Stat `protobuf:"bytes,3,rep,name=disks,proto3" json:"disks,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	DstServer        string                                  `protobuf:"bytes,1,opt,name=dst_server,json=dstServer,proto3" json:"dst_server,omitempty"`
+	Qemu             *TaskInfo_MigrationInfo_Stat            `protobuf:"bytes,2,opt,name=qemu,proto3" json:"qemu,omitempty"`
+	Disks            map[string]*TaskInfo_MigrationInfo_Stat `protobuf:"bytes,3,rep,name=disks,proto3" json:"disks,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	PostcopyActive   bool                                    `protobuf:"varint,4,opt,name=postcopy_active,json=postcopyActive,proto3" json:"postcopy_active,omitempty"`
+	PostcopyRequests uint64                                  `protobuf:"varint,5,opt,name=postcopy_requests,json=postcopyRequests,proto3" json:"postcopy_requests,omitempty"`
 }
 
 func (x *TaskInfo_MigrationInfo) Reset() {
@@ -234,6 +306,303 @@ func (x *TaskInfo_MigrationInfo) GetDisks() map[string]*TaskInfo_MigrationInfo_S
 	return nil
 }
 
+func (x *TaskInfo_MigrationInfo) GetPostcopyActive() bool {
+	if x != nil {
+		return x.PostcopyActive
+	}
+	return false
+}
+
+func (x *TaskInfo_MigrationInfo) GetPostcopyRequests() uint64 {
+	if x != nil {
+		return x.PostcopyRequests
+	}
+	return 0
+}
+
+type TaskInfo_EvacuationInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Machines map[string]*TaskInfo_EvacuationInfo_Machine `protobuf:"bytes,1,rep,name=machines,proto3" json:"machines,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *TaskInfo_EvacuationInfo) Reset() {
+	*x = TaskInfo_EvacuationInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v2_tasks_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskInfo_EvacuationInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskInfo_EvacuationInfo) ProtoMessage() {}
+
+func (x *TaskInfo_EvacuationInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v2_tasks_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskInfo_EvacuationInfo.ProtoReflect.Descriptor instead.
+func (*TaskInfo_EvacuationInfo) Descriptor() ([]byte, []int) {
+	return file_types_v2_tasks_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *TaskInfo_EvacuationInfo) GetMachines() map[string]*TaskInfo_EvacuationInfo_Machine {
+	if x != nil {
+		return x.Machines
+	}
+	return nil
+}
+
+type TaskInfo_SuspendInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vmstate *TaskInfo_MigrationInfo_Stat `protobuf:"bytes,1,opt,name=vmstate,proto3" json:"vmstate,omitempty"`
+}
+
+func (x *TaskInfo_SuspendInfo) Reset() {
+	*x = TaskInfo_SuspendInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v2_tasks_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskInfo_SuspendInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskInfo_SuspendInfo) ProtoMessage() {}
+
+func (x *TaskInfo_SuspendInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v2_tasks_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskInfo_SuspendInfo.ProtoReflect.Descriptor instead.
+func (*TaskInfo_SuspendInfo) Descriptor() ([]byte, []int) {
+	return file_types_v2_tasks_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *TaskInfo_SuspendInfo) GetVmstate() *TaskInfo_MigrationInfo_Stat {
+	if x != nil {
+		return x.Vmstate
+	}
+	return nil
+}
+
+type TaskInfo_BackupInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Disks        map[string]*TaskInfo_MigrationInfo_Stat `protobuf:"bytes,1,rep,name=disks,proto3" json:"disks,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	FsConsistent bool                                    `protobuf:"varint,2,opt,name=fs_consistent,json=fsConsistent,proto3" json:"fs_consistent,omitempty"`
+}
+
+func (x *TaskInfo_BackupInfo) Reset() {
+	*x = TaskInfo_BackupInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v2_tasks_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskInfo_BackupInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskInfo_BackupInfo) ProtoMessage() {}
+
+func (x *TaskInfo_BackupInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v2_tasks_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskInfo_BackupInfo.ProtoReflect.Descriptor instead.
+func (*TaskInfo_BackupInfo) Descriptor() ([]byte, []int) {
+	return file_types_v2_tasks_proto_rawDescGZIP(), []int{0, 3}
+}
+
+func (x *TaskInfo_BackupInfo) GetDisks() map[string]*TaskInfo_MigrationInfo_Stat {
+	if x != nil {
+		return x.Disks
+	}
+	return nil
+}
+
+func (x *TaskInfo_BackupInfo) GetFsConsistent() bool {
+	if x != nil {
+		return x.FsConsistent
+	}
+	return false
+}
+
+type TaskInfo_RestoreInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DiskName string                       `protobuf:"bytes,1,opt,name=disk_name,json=diskName,proto3" json:"disk_name,omitempty"`
+	Source   string                       `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Disk     *TaskInfo_MigrationInfo_Stat `protobuf:"bytes,3,opt,name=disk,proto3" json:"disk,omitempty"`
+}
+
+func (x *TaskInfo_RestoreInfo) Reset() {
+	*x = TaskInfo_RestoreInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v2_tasks_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskInfo_RestoreInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskInfo_RestoreInfo) ProtoMessage() {}
+
+func (x *TaskInfo_RestoreInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v2_tasks_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskInfo_RestoreInfo.ProtoReflect.Descriptor instead.
+func (*TaskInfo_RestoreInfo) Descriptor() ([]byte, []int) {
+	return file_types_v2_tasks_proto_rawDescGZIP(), []int{0, 4}
+}
+
+func (x *TaskInfo_RestoreInfo) GetDiskName() string {
+	if x != nil {
+		return x.DiskName
+	}
+	return ""
+}
+
+func (x *TaskInfo_RestoreInfo) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *TaskInfo_RestoreInfo) GetDisk() *TaskInfo_MigrationInfo_Stat {
+	if x != nil {
+		return x.Disk
+	}
+	return nil
+}
+
+type TaskInfo_DiskMoveInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DiskName string                       `protobuf:"bytes,1,opt,name=disk_name,json=diskName,proto3" json:"disk_name,omitempty"`
+	Source   string                       `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Target   string                       `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	Disk     *TaskInfo_MigrationInfo_Stat `protobuf:"bytes,4,opt,name=disk,proto3" json:"disk,omitempty"`
+}
+
+func (x *TaskInfo_DiskMoveInfo) Reset() {
+	*x = TaskInfo_DiskMoveInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v2_tasks_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskInfo_DiskMoveInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskInfo_DiskMoveInfo) ProtoMessage() {}
+
+func (x *TaskInfo_DiskMoveInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v2_tasks_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskInfo_DiskMoveInfo.ProtoReflect.Descriptor instead.
+func (*TaskInfo_DiskMoveInfo) Descriptor() ([]byte, []int) {
+	return file_types_v2_tasks_proto_rawDescGZIP(), []int{0, 5}
+}
+
+func (x *TaskInfo_DiskMoveInfo) GetDiskName() string {
+	if x != nil {
+		return x.DiskName
+	}
+	return ""
+}
+
+func (x *TaskInfo_DiskMoveInfo) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *TaskInfo_DiskMoveInfo) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *TaskInfo_DiskMoveInfo) GetDisk() *TaskInfo_MigrationInfo_Stat {
+	if x != nil {
+		return x.Disk
+	}
+	return nil
+}
+
 type TaskInfo_MigrationInfo_Stat struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -249,7 +618,7 @@ type TaskInfo_MigrationInfo_Stat struct {
 func (x *TaskInfo_MigrationInfo_Stat) Reset() {
 	*x = TaskInfo_MigrationInfo_Stat{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_v2_tasks_proto_msgTypes[2]
+		mi := &file_types_v2_tasks_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -262,7 +631,7 @@ func (x *TaskInfo_MigrationInfo_Stat) String() string {
 func (*TaskInfo_MigrationInfo_Stat) ProtoMessage() {}
 
 func (x *TaskInfo_MigrationInfo_Stat) ProtoReflect() protoreflect.Message {
-	mi := &file_types_v2_tasks_proto_msgTypes[2]
+	mi := &file_types_v2_tasks_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -313,12 +682,83 @@ func (x *TaskInfo_MigrationInfo_Stat) GetSpeed() uint32 {
 	return 0
 }
 
+type TaskInfo_EvacuationInfo_Machine struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DstServer string `protobuf:"bytes,1,opt,name=dst_server,json=dstServer,proto3" json:"dst_server,omitempty"`
+	State     string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	Progress  uint32 `protobuf:"varint,3,opt,name=progress,proto3" json:"progress,omitempty"`
+	Error     string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *TaskInfo_EvacuationInfo_Machine) Reset() {
+	*x = TaskInfo_EvacuationInfo_Machine{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_v2_tasks_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskInfo_EvacuationInfo_Machine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskInfo_EvacuationInfo_Machine) ProtoMessage() {}
+
+func (x *TaskInfo_EvacuationInfo_Machine) ProtoReflect() protoreflect.Message {
+	mi := &file_types_v2_tasks_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskInfo_EvacuationInfo_Machine.ProtoReflect.Descriptor instead.
+func (*TaskInfo_EvacuationInfo_Machine) Descriptor() ([]byte, []int) {
+	return file_types_v2_tasks_proto_rawDescGZIP(), []int{0, 1, 0}
+}
+
+func (x *TaskInfo_EvacuationInfo_Machine) GetDstServer() string {
+	if x != nil {
+		return x.DstServer
+	}
+	return ""
+}
+
+func (x *TaskInfo_EvacuationInfo_Machine) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *TaskInfo_EvacuationInfo_Machine) GetProgress() uint32 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *TaskInfo_EvacuationInfo_Machine) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 var File_types_v2_tasks_proto protoreflect.FileDescriptor
 
 var file_types_v2_tasks_proto_rawDesc = []byte{
 	0x0a, 0x14, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x32, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x73,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x22, 0xf6, 0x05, 0x0a, 0x08,
+	0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x22, 0xf5, 0x10, 0x0a, 0x08,
 	0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b,
 	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49,
 	0x64, 0x12, 0x3d, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
@@ -333,43 +773,131 @@ var file_types_v2_tasks_proto_rawDesc = []byte{
 	0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65,
 	0x73, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4d, 0x69,
 	0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x09, 0x6d,
-	0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0xbf, 0x03, 0x0a, 0x0d, 0x4d, 0x69, 0x67,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x73,
-	0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x64, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x04, 0x71, 0x65, 0x6d,
-	0x75, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e,
+	0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4e, 0x0a, 0x0a, 0x65, 0x76, 0x61, 0x63,
+	0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6b,
+	0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x76, 0x32, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x45, 0x76, 0x61, 0x63,
+	0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x0a, 0x65, 0x76,
+	0x61, 0x63, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x07, 0x73, 0x75, 0x73, 0x70,
+	0x65, 0x6e, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6b, 0x76, 0x6d, 0x72,
+	0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e,
+	0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64,
+	0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x07, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x12,
+	0x42, 0x0a, 0x06, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x42,
+	0x61, 0x63, 0x6b, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x06, 0x62, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x12, 0x45, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x49,
+	0x6e, 0x66, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x48,
+	0x00, 0x52, 0x07, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x40, 0x0a, 0x04, 0x6d, 0x6f,
+	0x76, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75,
+	0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x54,
+	0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x4d, 0x6f, 0x76, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x04, 0x6d, 0x6f, 0x76, 0x65, 0x1a, 0x95, 0x04, 0x0a,
+	0x0d, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d,
+	0x0a, 0x0a, 0x64, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x64, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x44, 0x0a,
+	0x04, 0x71, 0x65, 0x6d, 0x75, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6b, 0x76,
+	0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76,
+	0x32, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4d, 0x69, 0x67, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x52, 0x04, 0x71,
+	0x65, 0x6d, 0x75, 0x12, 0x4c, 0x0a, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66,
+	0x6f, 0x2e, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x2e,
+	0x44, 0x69, 0x73, 0x6b, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x64, 0x69, 0x73, 0x6b,
+	0x73, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x6f, 0x73, 0x74, 0x63, 0x6f, 0x70, 0x79, 0x5f, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x70, 0x6f, 0x73, 0x74,
+	0x63, 0x6f, 0x70, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x6f,
+	0x73, 0x74, 0x63, 0x6f, 0x70, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x70, 0x6f, 0x73, 0x74, 0x63, 0x6f, 0x70, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x1a, 0x8e, 0x01, 0x0a, 0x04, 0x53, 0x74, 0x61, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e,
+	0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69,
+	0x6e, 0x69, 0x6e, 0x67, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x1a, 0x6a, 0x0a, 0x0a, 0x44, 0x69, 0x73, 0x6b,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x46, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e,
 	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x61,
 	0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x52, 0x04, 0x71, 0x65, 0x6d, 0x75, 0x12,
-	0x4c, 0x0a, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36,
-	0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4d, 0x69,
-	0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x44, 0x69, 0x73, 0x6b,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x1a, 0x8e, 0x01,
-	0x0a, 0x04, 0x53, 0x74, 0x61, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09,
-	0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08,
-	0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
-	0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65,
-	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x1a, 0x6a,
+	0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0xcd, 0x02, 0x0a, 0x0e, 0x45, 0x76, 0x61, 0x63, 0x75, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x56, 0x0a, 0x08, 0x6d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x6b, 0x76, 0x6d, 0x72,
+	0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e,
+	0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x45, 0x76, 0x61, 0x63, 0x75, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x1a,
+	0x70, 0x0a, 0x07, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x73,
+	0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x64, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x1a, 0x71, 0x0a, 0x0d, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x4a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e,
+	0x66, 0x6f, 0x2e, 0x45, 0x76, 0x61, 0x63, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66,
+	0x6f, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x59, 0x0a, 0x0b, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x4a, 0x0a, 0x07, 0x76, 0x6d, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x49,
+	0x6e, 0x66, 0x6f, 0x2e, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66,
+	0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x52, 0x07, 0x76, 0x6d, 0x73, 0x74, 0x61, 0x74, 0x65, 0x1a,
+	0xe8, 0x01, 0x0a, 0x0a, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x49,
+	0x0a, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e,
+	0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x76, 0x32, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x42, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x73, 0x5f,
+	0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0c, 0x66, 0x73, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x1a, 0x6a,
 	0x0a, 0x0a, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
 	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x46,
 	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e,
 	0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
 	0x2e, 0x76, 0x32, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4d, 0x69, 0x67,
 	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x40, 0x0a, 0x09, 0x54, 0x61,
-	0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
-	0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10,
-	0x01, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x02,
-	0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x42, 0x06, 0x0a, 0x04,
-	0x73, 0x74, 0x61, 0x74, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x30, 0x78, 0x65, 0x66, 0x35, 0x33, 0x2f, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x32, 0x3b, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x88, 0x01, 0x0a, 0x0b, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69,
+	0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64,
+	0x69, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x44, 0x0a, 0x04, 0x64, 0x69, 0x73, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e,
+	0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x76, 0x32, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4d, 0x69, 0x67,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x52,
+	0x04, 0x64, 0x69, 0x73, 0x6b, 0x1a, 0xa1, 0x01, 0x0a, 0x0c, 0x44, 0x69, 0x73, 0x6b, 0x4d, 0x6f,
+	0x76, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x12, 0x44, 0x0a, 0x04, 0x64, 0x69, 0x73, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x30, 0x2e, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f,
+	0x2e, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x52, 0x04, 0x64, 0x69, 0x73, 0x6b, 0x22, 0x40, 0x0a, 0x09, 0x54, 0x61, 0x73,
+	0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
+	0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01,
+	0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12,
+	0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x42, 0x06, 0x0a, 0x04, 0x73,
+	0x74, 0x61, 0x74, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x30, 0x78, 0x65, 0x66, 0x35, 0x33, 0x2f, 0x6b, 0x76, 0x6d, 0x72, 0x75, 0x6e, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x32, 0x3b, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -385,25 +913,45 @@ func file_types_v2_tasks_proto_rawDescGZIP() []byte {
 }
 
 var file_types_v2_tasks_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_types_v2_tasks_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_types_v2_tasks_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_types_v2_tasks_proto_goTypes = []interface{}{
-	(TaskInfo_TaskState)(0),             // 0: kvmrun.api.types.v2.TaskInfo.TaskState
-	(*TaskInfo)(nil),                    // 1: kvmrun.api.types.v2.TaskInfo
-	(*TaskInfo_MigrationInfo)(nil),      // 2: kvmrun.api.types.v2.TaskInfo.MigrationInfo
-	(*TaskInfo_MigrationInfo_Stat)(nil), // 3: kvmrun.api.types.v2.TaskInfo.MigrationInfo.Stat
-	nil,                                 // 4: kvmrun.api.types.v2.TaskInfo.MigrationInfo.DisksEntry
+	(TaskInfo_TaskState)(0),                 // 0: kvmrun.api.types.v2.TaskInfo.TaskState
+	(*TaskInfo)(nil),                        // 1: kvmrun.api.types.v2.TaskInfo
+	(*TaskInfo_MigrationInfo)(nil),          // 2: kvmrun.api.types.v2.TaskInfo.MigrationInfo
+	(*TaskInfo_EvacuationInfo)(nil),         // 3: kvmrun.api.types.v2.TaskInfo.EvacuationInfo
+	(*TaskInfo_SuspendInfo)(nil),            // 4: kvmrun.api.types.v2.TaskInfo.SuspendInfo
+	(*TaskInfo_BackupInfo)(nil),             // 5: kvmrun.api.types.v2.TaskInfo.BackupInfo
+	(*TaskInfo_RestoreInfo)(nil),            // 6: kvmrun.api.types.v2.TaskInfo.RestoreInfo
+	(*TaskInfo_DiskMoveInfo)(nil),           // 7: kvmrun.api.types.v2.TaskInfo.DiskMoveInfo
+	(*TaskInfo_MigrationInfo_Stat)(nil),     // 8: kvmrun.api.types.v2.TaskInfo.MigrationInfo.Stat
+	nil,                                     // 9: kvmrun.api.types.v2.TaskInfo.MigrationInfo.DisksEntry
+	(*TaskInfo_EvacuationInfo_Machine)(nil), // 10: kvmrun.api.types.v2.TaskInfo.EvacuationInfo.Machine
+	nil,                                     // 11: kvmrun.api.types.v2.TaskInfo.EvacuationInfo.MachinesEntry
+	nil,                                     // 12: kvmrun.api.types.v2.TaskInfo.BackupInfo.DisksEntry
 }
 var file_types_v2_tasks_proto_depIdxs = []int32{
-	0, // 0: kvmrun.api.types.v2.TaskInfo.state:type_name -> kvmrun.api.types.v2.TaskInfo.TaskState
-	2, // 1: kvmrun.api.types.v2.TaskInfo.migration:type_name -> kvmrun.api.types.v2.TaskInfo.MigrationInfo
-	3, // 2: kvmrun.api.types.v2.TaskInfo.MigrationInfo.qemu:type_name -> kvmrun.api.types.v2.TaskInfo.MigrationInfo.Stat
-	4, // 3: kvmrun.api.types.v2.TaskInfo.MigrationInfo.disks:type_name -> kvmrun.api.types.v2.TaskInfo.MigrationInfo.DisksEntry
-	3, // 4: kvmrun.api.types.v2.TaskInfo.MigrationInfo.DisksEntry.value:type_name -> kvmrun.api.types.v2.TaskInfo.MigrationInfo.Stat
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	0,  // 0: kvmrun.api.types.v2.TaskInfo.state:type_name -> kvmrun.api.types.v2.TaskInfo.TaskState
+	2,  // 1: kvmrun.api.types.v2.TaskInfo.migration:type_name -> kvmrun.api.types.v2.TaskInfo.MigrationInfo
+	3,  // 2: kvmrun.api.types.v2.TaskInfo.evacuation:type_name -> kvmrun.api.types.v2.TaskInfo.EvacuationInfo
+	4,  // 3: kvmrun.api.types.v2.TaskInfo.suspend:type_name -> kvmrun.api.types.v2.TaskInfo.SuspendInfo
+	5,  // 4: kvmrun.api.types.v2.TaskInfo.backup:type_name -> kvmrun.api.types.v2.TaskInfo.BackupInfo
+	6,  // 5: kvmrun.api.types.v2.TaskInfo.restore:type_name -> kvmrun.api.types.v2.TaskInfo.RestoreInfo
+	7,  // 6: kvmrun.api.types.v2.TaskInfo.move:type_name -> kvmrun.api.types.v2.TaskInfo.DiskMoveInfo
+	8,  // 7: kvmrun.api.types.v2.TaskInfo.MigrationInfo.qemu:type_name -> kvmrun.api.types.v2.TaskInfo.MigrationInfo.Stat
+	9,  // 8: kvmrun.api.types.v2.TaskInfo.MigrationInfo.disks:type_name -> kvmrun.api.types.v2.TaskInfo.MigrationInfo.DisksEntry
+	11, // 9: kvmrun.api.types.v2.TaskInfo.EvacuationInfo.machines:type_name -> kvmrun.api.types.v2.TaskInfo.EvacuationInfo.MachinesEntry
+	8,  // 10: kvmrun.api.types.v2.TaskInfo.SuspendInfo.vmstate:type_name -> kvmrun.api.types.v2.TaskInfo.MigrationInfo.Stat
+	12, // 11: kvmrun.api.types.v2.TaskInfo.BackupInfo.disks:type_name -> kvmrun.api.types.v2.TaskInfo.BackupInfo.DisksEntry
+	8,  // 12: kvmrun.api.types.v2.TaskInfo.RestoreInfo.disk:type_name -> kvmrun.api.types.v2.TaskInfo.MigrationInfo.Stat
+	8,  // 13: kvmrun.api.types.v2.TaskInfo.DiskMoveInfo.disk:type_name -> kvmrun.api.types.v2.TaskInfo.MigrationInfo.Stat
+	8,  // 14: kvmrun.api.types.v2.TaskInfo.MigrationInfo.DisksEntry.value:type_name -> kvmrun.api.types.v2.TaskInfo.MigrationInfo.Stat
+	10, // 15: kvmrun.api.types.v2.TaskInfo.EvacuationInfo.MachinesEntry.value:type_name -> kvmrun.api.types.v2.TaskInfo.EvacuationInfo.Machine
+	8,  // 16: kvmrun.api.types.v2.TaskInfo.BackupInfo.DisksEntry.value:type_name -> kvmrun.api.types.v2.TaskInfo.MigrationInfo.Stat
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_types_v2_tasks_proto_init() }
@@ -437,6 +985,66 @@ func file_types_v2_tasks_proto_init() {
 			}
 		}
 		file_types_v2_tasks_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskInfo_EvacuationInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v2_tasks_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskInfo_SuspendInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v2_tasks_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskInfo_BackupInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v2_tasks_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskInfo_RestoreInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v2_tasks_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskInfo_DiskMoveInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_v2_tasks_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TaskInfo_MigrationInfo_Stat); i {
 			case 0:
 				return &v.state
@@ -448,9 +1056,26 @@ func file_types_v2_tasks_proto_init() {
 				return nil
 			}
 		}
+		file_types_v2_tasks_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskInfo_EvacuationInfo_Machine); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_types_v2_tasks_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*TaskInfo_Migration)(nil),
+		(*TaskInfo_Evacuation)(nil),
+		(*TaskInfo_Suspend)(nil),
+		(*TaskInfo_Backup)(nil),
+		(*TaskInfo_Restore)(nil),
+		(*TaskInfo_Move)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -458,7 +1083,7 @@ func file_types_v2_tasks_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_types_v2_tasks_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   4,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"sort"
+	"strings"
 	"time"
 
 	pb_machines "github.com/0xef53/kvmrun/api/services/machines/v2"
@@ -31,11 +33,39 @@ func BackupProcessStart(ctx context.Context, vmname string, c *cli.Command, grpc
 			Target:      c.Args().Tail()[1],
 			Incremental: c.Bool("incremental"),
 			ClearBitmap: c.Bool("clear-bitmap"),
+			FsFreeze:    c.Bool("fs-freeze"),
+			Speed:       uint64(c.Int("speed")) << 20,
 		}
 
 		_, err = grpcClient.Machines().StartDiskBackupProcess(ctx, &req)
 	} else {
-		err = fmt.Errorf("machine backup is not implemented")
+		req := pb_machines.StartMachineBackupRequest{
+			Name:        vmname,
+			Targets:     make([]*pb_machines.StartMachineBackupRequest_Target, 0, len(c.StringSlice("disk"))),
+			Incremental: c.Bool("incremental"),
+			ClearBitmap: c.Bool("clear-bitmap"),
+			FsFreeze:    c.Bool("fs-freeze"),
+			Speed:       uint64(c.Int("speed")) << 20,
+		}
+
+		for _, v := range c.StringSlice("disk") {
+			ff := strings.SplitN(v, "=", 2)
+
+			if len(ff) != 2 || len(ff[0]) == 0 || len(ff[1]) == 0 {
+				return fmt.Errorf("invalid disk backup target (expected DISKNAME=TARGET): %s", v)
+			}
+
+			req.Targets = append(req.Targets, &pb_machines.StartMachineBackupRequest_Target{
+				DiskName: ff[0],
+				Target:   ff[1],
+			})
+		}
+
+		if len(req.Targets) == 0 {
+			return fmt.Errorf("no disks specified: use DISKNAME TARGET or --disk DISKNAME=TARGET")
+		}
+
+		_, err = grpcClient.Machines().StartMachineBackupProcess(ctx, &req)
 	}
 
 	if err != nil {
@@ -76,8 +106,16 @@ func BackupProcessShowStatus(ctx context.Context, vmname string, c *cli.Command,
 		labels = append(labels, vmname+"/disk-backup/"+filepath.Base(d.Path))
 	}
 
+	var machineTask *pb_types.TaskInfo
+
+	if resp, err := grpcClient.Tasks().Get(ctx, &pb_tasks.GetRequest{Key: vmname + "/machine-backup"}); err == nil {
+		machineTask = resp.Task
+	} else if grpc_status.Code(err) != grpc_codes.NotFound {
+		return fmt.Errorf("cannot request data: %w", err)
+	}
+
 	req := pb_tasks.ListRequest{
-		Keys: labels,
+		Keys: append(labels, vmname+"/machine-backup"),
 	}
 
 	if resp, err := grpcClient.Tasks().List(ctx, &req); err == nil {
@@ -97,6 +135,12 @@ func BackupProcessShowStatus(ctx context.Context, vmname string, c *cli.Command,
 
 			return nil
 		}
+
+		// A multi-disk backup is shown if it is still running
+		// or if there are no other backup tasks
+		if machineTask != nil && (machineTask.State == pb_types.TaskInfo_RUNNING || len(resp.Tasks) == 1) {
+			return machineBackupShowStatus(ctx, vmname, machineTask, grpcClient)
+		}
 	} else {
 		/*
 			TODO: нужно проверить, что будет, если таски нет. Возможно тут стоит добавить
@@ -187,13 +231,304 @@ func BackupProcessShowStatus(ctx context.Context, vmname string, c *cli.Command,
 	return nil
 }
 
-func BackupProcessCancel(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
-	req := pb_tasks.CancelRequest{}
+func machineBackupShowStatus(ctx context.Context, vmname string, t *pb_types.TaskInfo, grpcClient *grpc_interfaces.Kvmrun) error {
+	// The task details are not available for completed tasks
+	if t.GetBackup() == nil || t.State != pb_types.TaskInfo_RUNNING {
+		switch t.State {
+		case pb_types.TaskInfo_COMPLETED:
+			fmt.Println("Successfully completed")
+		case pb_types.TaskInfo_FAILED:
+			return fmt.Errorf("%s", t.StateDesc)
+		default:
+			fmt.Printf("Backup is in progress: %d%%\n", t.Progress)
+		}
+
+		return nil
+	}
+
+	disknames := make([]string, 0, len(t.GetBackup().Disks))
+
+	for n := range t.GetBackup().Disks {
+		disknames = append(disknames, n)
+	}
+
+	sort.Strings(disknames)
+
+	var terr error
+	var fsConsistent bool
+
+	collect := func(ctx context.Context, update func(name string, p int)) error {
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+
+		for {
+			resp, err := grpcClient.Tasks().Get(ctx, &pb_tasks.GetRequest{Key: vmname + "/machine-backup"})
+			if err != nil {
+				if grpc_status.Code(err) != grpc_codes.NotFound {
+					terr = err
+				}
+
+				return nil
+			}
+
+			switch resp.Task.State {
+			case pb_types.TaskInfo_COMPLETED:
+				for _, n := range disknames {
+					update(n, 100)
+				}
+
+				return nil
+			case pb_types.TaskInfo_FAILED:
+				for _, n := range disknames {
+					update(n, -1)
+				}
+
+				terr = fmt.Errorf("%s", resp.Task.StateDesc)
+
+				return nil
+			}
 
+			if bi := resp.Task.GetBackup(); bi != nil {
+				fsConsistent = bi.FsConsistent
+
+				for _, n := range disknames {
+					if st, ok := bi.Disks[n]; ok {
+						update(n, int(st.Progress))
+					}
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+			}
+		}
+	}
+
+	progressBar := bar.NewProgressBar(collect, disknames...)
+
+	progressBar.Show()
+
+	if terr != nil {
+		return terr
+	}
+
+	if fsConsistent {
+		fmt.Println("Successfully completed (filesystem-consistent)")
+	} else {
+		fmt.Println("Successfully completed")
+	}
+
+	return nil
+}
+
+// backupTaskKey returns the label of a disk backup task
+// if the disk name is specified, and of a machine backup task otherwise.
+func backupTaskKey(vmname string, c *cli.Command) string {
 	if len(c.Args().Tail()) >= 1 {
-		req.Key = vmname + "/disk-backup/" + c.Args().Tail()[0]
+		return vmname + "/disk-backup/" + c.Args().Tail()[0]
+	}
+
+	return vmname + "/machine-backup"
+}
+
+func BackupProcessCancel(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	_, err := grpcClient.Tasks().Cancel(ctx, &pb_tasks.CancelRequest{Key: backupTaskKey(vmname, c)})
+
+	return err
+}
+
+func BackupProcessPause(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	_, err := grpcClient.Tasks().Pause(ctx, &pb_tasks.PauseRequest{Key: backupTaskKey(vmname, c)})
+
+	return err
+}
+
+func BackupProcessResume(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	_, err := grpcClient.Tasks().Resume(ctx, &pb_tasks.ResumeRequest{Key: backupTaskKey(vmname, c)})
+
+	return err
+}
+
+func BackupProcessSetSpeed(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	req := pb_tasks.SetSpeedRequest{
+		Key:   backupTaskKey(vmname, c),
+		Speed: uint64(c.Int("speed")) << 20,
+	}
+
+	_, err := grpcClient.Tasks().SetSpeed(ctx, &req)
+
+	return err
+}
+
+func BackupExportStart(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	req := pb_machines.StartBackupExportRequest{
+		Name:        vmname,
+		Disks:       c.Args().Tail(),
+		ListenAddr:  c.String("listen"),
+		Tls:         c.Bool("tls"),
+		Incremental: c.Bool("incremental"),
+		FsFreeze:    c.Bool("fs-freeze"),
+		Timeout:     uint32(c.Uint("timeout")),
+	}
+
+	if len(req.Disks) == 0 {
+		return fmt.Errorf("no disks specified")
+	}
+
+	resp, err := grpcClient.Machines().StartBackupExport(ctx, &req)
+	if err != nil {
+		return err
+	}
+
+	if c.Bool("json") {
+		return printJSON(resp)
+	}
+
+	for _, e := range resp.Exports {
+		if len(e.Bitmap) > 0 {
+			fmt.Printf("%-16s %s  (bitmap: %s)\n", e.DiskName, e.Uri, e.Bitmap)
+		} else {
+			fmt.Printf("%-16s %s\n", e.DiskName, e.Uri)
+		}
+	}
+
+	if resp.FsConsistent {
+		fmt.Println("Exports are filesystem-consistent")
+	}
+
+	fmt.Println("Use this command to release the exports after reading:")
+	fmt.Println("vmm backup finish-export", vmname)
+
+	return nil
+}
+
+func BackupExportFinish(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	req := pb_machines.FinishBackupExportRequest{
+		Name:    vmname,
+		Success: !c.Bool("failed"),
+	}
+
+	_, err := grpcClient.Machines().FinishBackupExport(ctx, &req)
+
+	return err
+}
+
+func BackupRestoreStart(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	if len(c.Args().Tail()) < 2 {
+		return fmt.Errorf("not enough arguments: DISKNAME and SOURCE are required")
+	}
+
+	req := pb_machines.StartDiskRestoreRequest{
+		Name:     vmname,
+		DiskName: c.Args().Tail()[0],
+		Source:   c.Args().Tail()[1],
+	}
+
+	resp, err := grpcClient.Machines().StartDiskRestoreProcess(ctx, &req)
+	if err != nil {
+		return err
+	}
+
+	if len(resp.AttachedDisk) > 0 {
+		fmt.Println("Machine is running, the backup has been attached in read-only mode:", resp.AttachedDisk)
+		fmt.Println("The disk will be detached when the machine is restarted")
+
+		return nil
+	}
+
+	if c.Bool("watch") {
+		return BackupRestoreShowStatus(ctx, vmname, c, grpcClient)
 	} else {
-		return fmt.Errorf("method is not implemented")
+		fmt.Println("Process has started and will continue in the background")
+		fmt.Println("Use this command to see the progress:")
+		fmt.Println("vmm backup restore-status", vmname, req.DiskName)
+	}
+
+	return nil
+}
+
+func BackupRestoreShowStatus(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	if len(c.Args().Tail()) < 1 {
+		return fmt.Errorf("not enough arguments: DISKNAME is required")
+	}
+
+	diskname := c.Args().Tail()[0]
+	label := vmname + "/disk-restore/" + diskname
+
+	if c.Bool("json") {
+		resp, err := grpcClient.Tasks().Get(ctx, &pb_tasks.GetRequest{Key: label})
+		if err != nil {
+			return err
+		}
+
+		return printJSON(resp.Task)
+	}
+
+	var terr error
+
+	collect := func(ctx context.Context, update func(name string, p int)) error {
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+
+		for {
+			resp, err := grpcClient.Tasks().Get(ctx, &pb_tasks.GetRequest{Key: label})
+			if err != nil {
+				if grpc_status.Code(err) == grpc_codes.NotFound {
+					terr = fmt.Errorf("no one restore task found for %s", diskname)
+				} else {
+					terr = err
+				}
+
+				update(diskname, -1)
+
+				return nil
+			}
+
+			switch resp.Task.State {
+			case pb_types.TaskInfo_COMPLETED:
+				update(diskname, 100)
+
+				return nil
+			case pb_types.TaskInfo_FAILED:
+				update(diskname, -1)
+
+				terr = fmt.Errorf("%s", resp.Task.StateDesc)
+
+				return nil
+			}
+
+			update(diskname, int(resp.Task.Progress))
+
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+			}
+		}
+	}
+
+	progressBar := bar.NewProgressBar(collect, diskname)
+
+	progressBar.Show()
+
+	if terr != nil {
+		return terr
+	}
+
+	fmt.Println("Successfully completed")
+
+	return nil
+}
+
+func BackupRestoreCancel(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	if len(c.Args().Tail()) < 1 {
+		return fmt.Errorf("not enough arguments: DISKNAME is required")
+	}
+
+	req := pb_tasks.CancelRequest{
+		Key: vmname + "/disk-restore/" + c.Args().Tail()[0],
 	}
 
 	_, err := grpcClient.Tasks().Cancel(ctx, &req)
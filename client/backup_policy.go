@@ -0,0 +1,109 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	pb_machines "github.com/0xef53/kvmrun/api/services/machines/v2"
+	pb_types "github.com/0xef53/kvmrun/api/types/v2"
+
+	grpc_interfaces "github.com/0xef53/kvmrun/internal/grpc/interfaces"
+
+	cli "github.com/urfave/cli/v3"
+)
+
+func BackupPolicySet(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	req := pb_machines.BackupPolicySetRequest{
+		Name: vmname,
+		Policy: &pb_types.BackupPolicy{
+			Schedule:       c.String("schedule"),
+			Disks:          c.StringSlice("disk"),
+			FullEvery:      uint32(c.Uint("full-every")),
+			TargetTemplate: c.String("target"),
+			KeepChains:     uint32(c.Uint("keep-chains")),
+			KeepDays:       uint32(c.Uint("keep-days")),
+			FsFreeze:       c.Bool("fs-freeze"),
+			Disabled:       c.Bool("disabled"),
+		},
+	}
+
+	_, err := grpcClient.Machines().BackupPolicySet(ctx, &req)
+
+	return err
+}
+
+func BackupPolicyShow(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	resp, err := grpcClient.Machines().BackupPolicyGet(ctx, &pb_machines.BackupPolicyGetRequest{Name: vmname})
+	if err != nil {
+		return err
+	}
+
+	if c.Bool("json") {
+		return printJSON(resp)
+	}
+
+	p := resp.Policy
+
+	disks := "all"
+
+	if len(p.Disks) > 0 {
+		disks = strings.Join(p.Disks, ", ")
+	}
+
+	fullEvery := "always"
+
+	if p.FullEvery > 1 {
+		fullEvery = fmt.Sprintf("every %d backups", p.FullEvery)
+	}
+
+	nextRun := "disabled"
+
+	if resp.NextRun > 0 {
+		nextRun = time.Unix(int64(resp.NextRun), 0).Format("2006-01-02 15:04:05")
+	}
+
+	fmt.Printf("%-16s %s\n", "Schedule:", p.Schedule)
+	fmt.Printf("%-16s %s\n", "Next run:", nextRun)
+	fmt.Printf("%-16s %s\n", "Disks:", disks)
+	fmt.Printf("%-16s %s\n", "Full backup:", fullEvery)
+	fmt.Printf("%-16s %s\n", "Target:", p.TargetTemplate)
+	fmt.Printf("%-16s %d chains, %d days\n", "Keep:", p.KeepChains, p.KeepDays)
+	fmt.Printf("%-16s %t\n", "FS freeze:", p.FsFreeze)
+
+	return nil
+}
+
+func BackupPolicyRemove(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	_, err := grpcClient.Machines().BackupPolicyRemove(ctx, &pb_machines.BackupPolicyRemoveRequest{Name: vmname})
+
+	return err
+}
+
+func BackupCatalogList(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	req := pb_machines.BackupCatalogListRequest{
+		Name: vmname,
+	}
+
+	if len(c.Args().Tail()) >= 1 {
+		req.DiskName = c.Args().Tail()[0]
+	}
+
+	resp, err := grpcClient.Machines().BackupCatalogList(ctx, &req)
+	if err != nil {
+		return err
+	}
+
+	if c.Bool("json") {
+		return printJSON(resp.Entries)
+	}
+
+	for _, e := range resp.Entries {
+		created := time.Unix(int64(e.Created), 0).Format("2006-01-02 15:04:05")
+
+		fmt.Printf("%-16s %s  %-11s %12d  %s\n", e.DiskName, created, e.Type, e.Size, e.Path)
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	pb_blockjobs "github.com/0xef53/kvmrun/api/services/blockjobs/v2"
+
+	grpc_interfaces "github.com/0xef53/kvmrun/internal/grpc/interfaces"
+
+	cli "github.com/urfave/cli/v3"
+)
+
+func BlockJobList(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	resp, err := grpcClient.BlockJobs().List(ctx, &pb_blockjobs.ListRequest{Name: vmname})
+	if err != nil {
+		return err
+	}
+
+	if c.Bool("json") {
+		return printJSON(resp.Jobs)
+	}
+
+	if len(resp.Jobs) == 0 {
+		fmt.Println("No jobs found")
+
+		return nil
+	}
+
+	fmt.Printf("%-24s %-10s %-10s %9s %12s  %s\n", "ID", "TYPE", "STATUS", "PROGRESS", "SPEED LIMIT", "TASK")
+
+	for _, j := range resp.Jobs {
+		progress := "-"
+
+		if j.Length > 0 {
+			progress = fmt.Sprintf("%d%%", j.Offset*100/j.Length)
+		}
+
+		speed := "unlimited"
+
+		if j.Speed > 0 {
+			speed = fmt.Sprintf("%d MiB/s", j.Speed>>20)
+		}
+
+		status := j.Status
+
+		if j.Paused && status != "paused" {
+			status += " (paused)"
+		}
+
+		owner := "-"
+
+		if len(j.TaskKey) > 0 {
+			owner = j.TaskKey
+		}
+
+		fmt.Printf("%-24s %-10s %-10s %9s %12s  %s\n", j.Id, j.Type, status, progress, speed, owner)
+
+		if len(j.Error) > 0 {
+			fmt.Printf("    error: %s\n", j.Error)
+		}
+	}
+
+	return nil
+}
+
+// blockJobID returns the job ID from the command arguments
+func blockJobID(c *cli.Command) (string, error) {
+	if len(c.Args().Tail()) < 1 {
+		return "", fmt.Errorf("not enough arguments: JOBID is required")
+	}
+
+	return c.Args().Tail()[0], nil
+}
+
+func BlockJobCancel(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	jobID, err := blockJobID(c)
+	if err != nil {
+		return err
+	}
+
+	_, err = grpcClient.BlockJobs().Cancel(ctx, &pb_blockjobs.CancelRequest{Name: vmname, JobID: jobID})
+
+	return err
+}
+
+func BlockJobPause(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	jobID, err := blockJobID(c)
+	if err != nil {
+		return err
+	}
+
+	_, err = grpcClient.BlockJobs().Pause(ctx, &pb_blockjobs.PauseRequest{Name: vmname, JobID: jobID})
+
+	return err
+}
+
+func BlockJobResume(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	jobID, err := blockJobID(c)
+	if err != nil {
+		return err
+	}
+
+	_, err = grpcClient.BlockJobs().Resume(ctx, &pb_blockjobs.ResumeRequest{Name: vmname, JobID: jobID})
+
+	return err
+}
+
+func BlockJobComplete(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	jobID, err := blockJobID(c)
+	if err != nil {
+		return err
+	}
+
+	_, err = grpcClient.BlockJobs().Complete(ctx, &pb_blockjobs.CompleteRequest{Name: vmname, JobID: jobID})
+
+	return err
+}
+
+func BlockJobDismiss(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	jobID, err := blockJobID(c)
+	if err != nil {
+		return err
+	}
+
+	_, err = grpcClient.BlockJobs().Dismiss(ctx, &pb_blockjobs.DismissRequest{Name: vmname, JobID: jobID})
+
+	return err
+}
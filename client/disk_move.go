@@ -0,0 +1,137 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	pb_machines "github.com/0xef53/kvmrun/api/services/machines/v2"
+	pb_tasks "github.com/0xef53/kvmrun/api/services/tasks/v2"
+	pb_types "github.com/0xef53/kvmrun/api/types/v2"
+
+	bar "github.com/0xef53/kvmrun/client/progress_bar"
+
+	grpc_interfaces "github.com/0xef53/kvmrun/internal/grpc/interfaces"
+
+	grpc_codes "google.golang.org/grpc/codes"
+	grpc_status "google.golang.org/grpc/status"
+
+	cli "github.com/urfave/cli/v3"
+)
+
+func MachineDiskMoveStart(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	if len(c.Args().Tail()) < 2 {
+		return fmt.Errorf("not enough arguments: DISK and TARGET are required")
+	}
+
+	req := pb_machines.StartDiskMoveRequest{
+		Name:         vmname,
+		DiskName:     c.Args().Tail()[0],
+		Target:       c.Args().Tail()[1],
+		CreateTarget: c.Bool("create-target"),
+		RemoveSource: c.Bool("remove-source"),
+		Speed:        uint64(c.Int("speed")) << 20,
+	}
+
+	if _, err := grpcClient.Machines().StartDiskMoveProcess(ctx, &req); err != nil {
+		return err
+	}
+
+	if c.Bool("watch") {
+		return MachineDiskMoveShowStatus(ctx, vmname, c, grpcClient)
+	} else {
+		fmt.Println("Process has started and will continue in the background")
+		fmt.Println("Use this command to see the progress:")
+		fmt.Println("vmm storage move-status", vmname, req.DiskName)
+	}
+
+	return nil
+}
+
+func MachineDiskMoveShowStatus(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	if len(c.Args().Tail()) < 1 {
+		return fmt.Errorf("not enough arguments: DISK is required")
+	}
+
+	diskname := filepath.Base(c.Args().Tail()[0])
+	label := vmname + "/disk-move/" + diskname
+
+	if c.Bool("json") {
+		resp, err := grpcClient.Tasks().Get(ctx, &pb_tasks.GetRequest{Key: label})
+		if err != nil {
+			return err
+		}
+
+		return printJSON(resp.Task)
+	}
+
+	var terr error
+
+	collect := func(ctx context.Context, update func(name string, p int)) error {
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+
+		for {
+			resp, err := grpcClient.Tasks().Get(ctx, &pb_tasks.GetRequest{Key: label})
+			if err != nil {
+				if grpc_status.Code(err) == grpc_codes.NotFound {
+					terr = fmt.Errorf("no one move task found for %s", diskname)
+				} else {
+					terr = err
+				}
+
+				update(diskname, -1)
+
+				return nil
+			}
+
+			switch resp.Task.State {
+			case pb_types.TaskInfo_COMPLETED:
+				update(diskname, 100)
+
+				return nil
+			case pb_types.TaskInfo_FAILED:
+				update(diskname, -1)
+
+				terr = fmt.Errorf("%s", resp.Task.StateDesc)
+
+				return nil
+			}
+
+			update(diskname, int(resp.Task.Progress))
+
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+			}
+		}
+	}
+
+	progressBar := bar.NewProgressBar(collect, diskname)
+
+	progressBar.Show()
+
+	if terr != nil {
+		return terr
+	}
+
+	fmt.Println("Successfully completed")
+
+	return nil
+}
+
+func MachineDiskMoveCancel(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	if len(c.Args().Tail()) < 1 {
+		return fmt.Errorf("not enough arguments: DISK is required")
+	}
+
+	req := pb_tasks.CancelRequest{
+		Key: vmname + "/disk-move/" + filepath.Base(c.Args().Tail()[0]),
+	}
+
+	_, err := grpcClient.Tasks().Cancel(ctx, &req)
+
+	return err
+}
@@ -2,6 +2,10 @@ package client
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
 
 	pb_machines "github.com/0xef53/kvmrun/api/services/machines/v2"
 	pb_types "github.com/0xef53/kvmrun/api/types/v2"
@@ -19,6 +23,7 @@ func MachineDiskAttach(ctx context.Context, vmname string, c *cli.Command, grpcC
 		IopsWr:    uint32(c.Int("iops-wr")),
 		Position:  int32(c.Int("position")),
 		Bootindex: int32(c.Int("bootindex")),
+		Format:    c.String("format"),
 		Live:      c.Bool("live"),
 	}
 
@@ -95,6 +100,10 @@ func MachineDiskSetWriteLimit(ctx context.Context, vmname string, c *cli.Command
 }
 
 func MachineDiskResizeQemuBlockdev(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	if c.IsSet("size") {
+		return MachineDiskGrow(ctx, vmname, c, grpcClient)
+	}
+
 	req := pb_machines.DiskResizeQemuBlockdevRequest{
 		Name:     vmname,
 		DiskName: c.Args().Tail()[0],
@@ -104,3 +113,65 @@ func MachineDiskResizeQemuBlockdev(ctx context.Context, vmname string, c *cli.Co
 
 	return err
 }
+
+func MachineDiskGrow(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	size, err := parseDiskSize(c.String("size"))
+	if err != nil {
+		return err
+	}
+
+	req := pb_machines.DiskGrowRequest{
+		Name:     vmname,
+		DiskName: c.Args().Tail()[0],
+		Size:     size,
+	}
+
+	resp, err := grpcClient.Machines().DiskGrow(ctx, &req)
+	if err != nil {
+		return err
+	}
+
+	if c.Bool("json") {
+		return printJSON(resp)
+	}
+
+	fmt.Printf("New size of %s: %d bytes\n", req.DiskName, resp.Size)
+
+	return nil
+}
+
+// parseDiskSize parses the size in bytes or with
+// a binary suffix K, M, G or T (e.g. 512M, 20G).
+func parseDiskSize(value string) (uint64, error) {
+	s := strings.ToUpper(strings.TrimSpace(value))
+
+	var shift uint
+
+	if len(s) > 0 {
+		switch s[len(s)-1] {
+		case 'K':
+			shift = 10
+		case 'M':
+			shift = 20
+		case 'G':
+			shift = 30
+		case 'T':
+			shift = 40
+		}
+
+		if shift > 0 {
+			s = s[:len(s)-1]
+		}
+	}
+
+	v, err := strconv.ParseUint(s, 10, 64)
+	if err != nil || v == 0 {
+		return 0, fmt.Errorf("invalid size value: %q", value)
+	}
+
+	if v > math.MaxUint64>>shift {
+		return 0, fmt.Errorf("size value is too large: %q", value)
+	}
+
+	return v << shift, nil
+}
@@ -0,0 +1,50 @@
+package client
+
+import (
+	"testing"
+)
+
+func TestParseDiskSize(t *testing.T) {
+	tests := []struct {
+		input string
+		want  uint64
+		fail  bool
+	}{
+		{input: "1048576", want: 1 << 20},
+		{input: "512K", want: 512 << 10},
+		{input: "512m", want: 512 << 20},
+		{input: " 20G ", want: 20 << 30},
+		{input: "2T", want: 2 << 40},
+		{input: "16777215T", want: 16777215 << 40},
+		{input: "18446744073709551615", want: 18446744073709551615},
+		{input: "16777216T", fail: true},
+		{input: "18446744073709551616", fail: true},
+		{input: "0", fail: true},
+		{input: "0G", fail: true},
+		{input: "", fail: true},
+		{input: "G", fail: true},
+		{input: "-1G", fail: true},
+		{input: "1.5G", fail: true},
+		{input: "10P", fail: true},
+	}
+
+	for _, tc := range tests {
+		got, err := parseDiskSize(tc.input)
+
+		if tc.fail {
+			if err == nil {
+				t.Fatalf("%q: expected error, got %d", tc.input, got)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("%q: got unexpected error: %s", tc.input, err)
+		}
+
+		if got != tc.want {
+			t.Fatalf("%q: got unexpected result: want %d, got %d", tc.input, tc.want, got)
+		}
+	}
+}
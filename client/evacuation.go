@@ -0,0 +1,206 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	pb_machines "github.com/0xef53/kvmrun/api/services/machines/v2"
+	pb_tasks "github.com/0xef53/kvmrun/api/services/tasks/v2"
+	pb_types "github.com/0xef53/kvmrun/api/types/v2"
+
+	bar "github.com/0xef53/kvmrun/client/progress_bar"
+
+	grpc_interfaces "github.com/0xef53/kvmrun/internal/grpc/interfaces"
+
+	grpc_codes "google.golang.org/grpc/codes"
+	grpc_status "google.golang.org/grpc/status"
+
+	cli "github.com/urfave/cli/v3"
+)
+
+func EvacuationProcessStart(ctx context.Context, _ string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	if c.Int("concurrency") < 1 {
+		return fmt.Errorf("invalid --concurrency value: must be greater than zero")
+	}
+
+	tuning, err := migrationTuningFromFlags(c)
+	if err != nil {
+		return err
+	}
+
+	req := pb_machines.StartEvacuationRequest{
+		DstServers:     c.Args().Slice(),
+		Concurrency:    uint32(c.Int("concurrency")),
+		WithLocalDisks: c.Bool("with-local-disks"),
+		CreateDisks:    c.Bool("create-disks"),
+		RemoveAfter:    false,
+		Tls:            migrationTLSFromFlags(c),
+		Tuning:         tuning,
+	}
+
+	if _, err := grpcClient.Machines().StartEvacuationProcess(ctx, &req); err != nil {
+		return err
+	}
+
+	if c.Bool("watch") {
+		return EvacuationProcessShowStatus(ctx, "", c, grpcClient)
+	} else {
+		fmt.Println("Process has started and will continue in the background")
+		fmt.Println("Use this command to see the progress:")
+		fmt.Println("=> vmm evacuation status")
+	}
+
+	return nil
+}
+
+func EvacuationProcessShowStatus(ctx context.Context, _ string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	req := pb_tasks.GetRequest{
+		Key: "evacuation",
+	}
+
+	var barNames []string
+
+	if resp, err := grpcClient.Tasks().Get(ctx, &req); err == nil {
+		details := resp.Task.GetEvacuation()
+
+		if details == nil {
+			return fmt.Errorf("unexpected: incorrect stat structure")
+		}
+
+		req.Key = resp.Task.TaskID
+
+		if c.Bool("json") {
+			b, err := json.MarshalIndent(details, "", "    ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("%s\n", b)
+
+			return nil
+		}
+
+		for vmname := range details.Machines {
+			barNames = append(barNames, vmname)
+		}
+
+		sort.Strings(barNames)
+	} else {
+		if grpc_status.Code(err) == grpc_codes.NotFound {
+			if c.Bool("json") {
+				fmt.Println("{}")
+			} else {
+				fmt.Println("No evacuation process found")
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("cannot request data: %w", err)
+	}
+
+	if len(barNames) == 0 {
+		fmt.Println("No machines to evacuate")
+
+		return nil
+	}
+
+	// Machines that failed to migrate and their last errors
+	failed := make(map[string]string)
+
+	collect := func(ctx context.Context, update func(name string, p int)) error {
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+
+		for {
+			resp, err := grpcClient.Tasks().Get(ctx, &req)
+
+			if err == nil {
+				details := resp.Task.GetEvacuation()
+
+				if details != nil {
+					finished := resp.Task.State == pb_types.TaskInfo_COMPLETED || resp.Task.State == pb_types.TaskInfo_FAILED
+
+					for vmname, st := range details.Machines {
+						if _, ok := failed[vmname]; ok {
+							continue
+						}
+
+						// Until the end of the process a failed machine
+						// may still be migrated to the next destination server
+						if st.State == "failed" && finished {
+							failed[vmname] = st.Error
+
+							update(vmname, -1)
+
+							continue
+						}
+
+						update(vmname, int(st.Progress))
+					}
+				}
+
+				if resp.Task.State == pb_types.TaskInfo_COMPLETED {
+					break
+				}
+
+				if resp.Task.State == pb_types.TaskInfo_FAILED {
+					err = fmt.Errorf("%s", resp.Task.StateDesc)
+				}
+			}
+
+			if err != nil {
+				if grpc_status.Code(err) == grpc_codes.NotFound {
+					err = fmt.Errorf("unexpected: task not found")
+				}
+
+				for _, name := range barNames {
+					if _, ok := failed[name]; !ok {
+						update(name, -1)
+					}
+				}
+
+				return err
+			}
+
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+			}
+		}
+
+		return nil
+	}
+
+	progressBar := bar.NewProgressBar(collect, barNames...)
+
+	progressBar.Show()
+
+	for _, vmname := range barNames {
+		if e, ok := failed[vmname]; ok {
+			fmt.Printf("%s: %s\n", vmname, e)
+		}
+	}
+
+	if progressBar.Err() == nil {
+		fmt.Println("Successfully completed")
+	} else {
+		return progressBar.Err()
+	}
+
+	return nil
+}
+
+func EvacuationProcessCancel(ctx context.Context, _ string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	req := pb_tasks.CancelRequest{
+		Key: "evacuation",
+	}
+
+	_, err := grpcClient.Tasks().Cancel(ctx, &req)
+
+	return err
+}
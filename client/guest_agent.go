@@ -0,0 +1,269 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	pb_guestagent "github.com/0xef53/kvmrun/api/services/guestagent/v2"
+	pb_types "github.com/0xef53/kvmrun/api/types/v2"
+
+	grpc_interfaces "github.com/0xef53/kvmrun/internal/grpc/interfaces"
+
+	cli "github.com/urfave/cli/v3"
+)
+
+func printJSON(v interface{}) error {
+	b, err := json.MarshalIndent(v, "", "    ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", b)
+
+	return nil
+}
+
+func GuestAgentPing(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	req := pb_guestagent.PingRequest{
+		Name:    vmname,
+		Timeout: uint32(c.Uint("timeout")),
+	}
+
+	if _, err := grpcClient.GuestAgent().Ping(ctx, &req); err != nil {
+		return err
+	}
+
+	fmt.Println("Guest agent is responding")
+
+	return nil
+}
+
+func GuestAgentInfo(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	req := pb_guestagent.GetInfoRequest{
+		Name:    vmname,
+		Timeout: uint32(c.Uint("timeout")),
+	}
+
+	resp, err := grpcClient.GuestAgent().GetInfo(ctx, &req)
+	if err != nil {
+		return err
+	}
+
+	if c.Bool("json") {
+		return printJSON(resp.Info)
+	}
+
+	fmt.Printf("Version: %s\n", resp.Info.Version)
+	fmt.Println("Supported commands:")
+
+	for _, cmd := range resp.Info.SupportedCommands {
+		state := "enabled"
+
+		if !cmd.Enabled {
+			state = "disabled"
+		}
+
+		fmt.Printf("  %-40s %s\n", cmd.Name, state)
+	}
+
+	return nil
+}
+
+func GuestAgentOSInfo(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	req := pb_guestagent.GetOSInfoRequest{
+		Name:    vmname,
+		Timeout: uint32(c.Uint("timeout")),
+	}
+
+	resp, err := grpcClient.GuestAgent().GetOSInfo(ctx, &req)
+	if err != nil {
+		return err
+	}
+
+	if c.Bool("json") {
+		return printJSON(resp.Info)
+	}
+
+	fmt.Printf("%-16s %s\n", "Name:", resp.Info.PrettyName)
+	fmt.Printf("%-16s %s\n", "ID:", resp.Info.Id)
+	fmt.Printf("%-16s %s\n", "Version:", resp.Info.Version)
+	fmt.Printf("%-16s %s\n", "Kernel release:", resp.Info.KernelRelease)
+	fmt.Printf("%-16s %s\n", "Kernel version:", resp.Info.KernelVersion)
+	fmt.Printf("%-16s %s\n", "Machine:", resp.Info.Machine)
+
+	return nil
+}
+
+func GuestAgentInterfaces(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	req := pb_guestagent.GetNetworkInterfacesRequest{
+		Name:    vmname,
+		Timeout: uint32(c.Uint("timeout")),
+	}
+
+	resp, err := grpcClient.GuestAgent().GetNetworkInterfaces(ctx, &req)
+	if err != nil {
+		return err
+	}
+
+	if c.Bool("json") {
+		return printJSON(resp.Interfaces)
+	}
+
+	for _, iface := range resp.Interfaces {
+		addrs := make([]string, 0, len(iface.IpAddresses))
+
+		for _, a := range iface.IpAddresses {
+			addrs = append(addrs, fmt.Sprintf("%s/%d", a.Address, a.Prefix))
+		}
+
+		fmt.Printf("%-16s %-17s  %s\n", iface.Name, iface.HardwareAddress, strings.Join(addrs, ", "))
+	}
+
+	return nil
+}
+
+func GuestAgentFSInfo(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	req := pb_guestagent.GetFSInfoRequest{
+		Name:    vmname,
+		Timeout: uint32(c.Uint("timeout")),
+	}
+
+	resp, err := grpcClient.GuestAgent().GetFSInfo(ctx, &req)
+	if err != nil {
+		return err
+	}
+
+	if c.Bool("json") {
+		return printJSON(resp.Filesystems)
+	}
+
+	for _, fs := range resp.Filesystems {
+		devs := make([]string, 0, len(fs.Disks))
+
+		for _, d := range fs.Disks {
+			devs = append(devs, d.Dev)
+		}
+
+		fmt.Printf("%-24s %-8s %12d / %-12d  %s\n", fs.Mountpoint, fs.Type, fs.UsedBytes, fs.TotalBytes, strings.Join(devs, ", "))
+	}
+
+	return nil
+}
+
+func GuestAgentSetPassword(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	password := c.String("password")
+
+	if len(password) == 0 {
+		line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		if err != nil && err != io.EOF {
+			return err
+		}
+
+		password = strings.TrimRight(line, "\r\n")
+	}
+
+	req := pb_guestagent.SetUserPasswordRequest{
+		Name:     vmname,
+		Timeout:  uint32(c.Uint("timeout")),
+		Username: c.Args().Tail()[0],
+		Password: password,
+		Crypted:  c.Bool("crypted"),
+	}
+
+	_, err := grpcClient.GuestAgent().SetUserPassword(ctx, &req)
+
+	return err
+}
+
+func GuestAgentExec(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	req := pb_guestagent.ExecRequest{
+		Name:    vmname,
+		Timeout: uint32(c.Uint("timeout")),
+		Path:    c.Args().Tail()[0],
+		Args:    c.Args().Tail()[1:],
+		Env:     c.StringSlice("env"),
+		NoWait:  c.Bool("no-wait"),
+	}
+
+	if c.Bool("stdin") {
+		b, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return err
+		}
+
+		req.Input = b
+	}
+
+	resp, err := grpcClient.GuestAgent().Exec(ctx, &req)
+	if err != nil {
+		return err
+	}
+
+	if c.Bool("json") {
+		return printJSON(resp.Status)
+	}
+
+	if req.NoWait {
+		fmt.Println("Process has started in the guest, PID:", resp.Status.PID)
+		fmt.Println("Use this command to get the result:")
+		fmt.Println("vmm guest exec-status", vmname, resp.Status.PID)
+
+		return nil
+	}
+
+	return printGuestExecStatus(resp.Status)
+}
+
+func GuestAgentExecStatus(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	pid, err := strconv.ParseInt(c.Args().Tail()[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid PID: %s", c.Args().Tail()[0])
+	}
+
+	req := pb_guestagent.ExecStatusRequest{
+		Name:    vmname,
+		Timeout: uint32(c.Uint("timeout")),
+		PID:     pid,
+	}
+
+	resp, err := grpcClient.GuestAgent().ExecStatus(ctx, &req)
+	if err != nil {
+		return err
+	}
+
+	if c.Bool("json") {
+		return printJSON(resp.Status)
+	}
+
+	if !resp.Status.Exited {
+		fmt.Printf("Process is still running (PID = %d)\n", resp.Status.PID)
+
+		return nil
+	}
+
+	return printGuestExecStatus(resp.Status)
+}
+
+func printGuestExecStatus(st *pb_types.GuestExecStatus) error {
+	os.Stdout.Write(st.Stdout)
+	os.Stderr.Write(st.Stderr)
+
+	if st.StdoutTruncated || st.StderrTruncated {
+		fmt.Fprintln(os.Stderr, "Warning: the output was truncated by the guest agent")
+	}
+
+	switch {
+	case st.Signal != 0:
+		return fmt.Errorf("process was terminated by signal %d", st.Signal)
+	case st.ExitCode != 0:
+		return fmt.Errorf("process exited with code %d", st.ExitCode)
+	}
+
+	return nil
+}
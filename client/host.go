@@ -0,0 +1,23 @@
+package client
+
+import (
+	"context"
+
+	pb_system "github.com/0xef53/kvmrun/api/services/system/v2"
+
+	grpc_interfaces "github.com/0xef53/kvmrun/internal/grpc/interfaces"
+
+	cli "github.com/urfave/cli/v3"
+)
+
+func HostSetDrainMode(ctx context.Context, _ string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	_, err := grpcClient.System().HostSetDrainMode(ctx, &pb_system.HostSetDrainModeRequest{Enabled: true})
+
+	return err
+}
+
+func HostUnsetDrainMode(ctx context.Context, _ string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	_, err := grpcClient.System().HostSetDrainMode(ctx, &pb_system.HostSetDrainModeRequest{Enabled: false})
+
+	return err
+}
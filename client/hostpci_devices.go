@@ -22,6 +22,7 @@ func MachineHostDeviceAttach(ctx context.Context, vmname string, c *cli.Command,
 		Name:       vmname,
 		PCIAddr:    addr.String(),
 		StrictMode: !c.Bool("no-check-device"),
+		Live:       c.Bool("live"),
 	}
 
 	if c.Value("multifunction") != nil {
@@ -50,6 +51,7 @@ func MachineHostDeviceDetach(ctx context.Context, vmname string, c *cli.Command,
 	req := pb_machines.HostDeviceDetachRequest{
 		Name:    vmname,
 		PCIAddr: addr.String(),
+		Live:    c.Bool("live"),
 	}
 
 	_, err = grpcClient.Machines().HostDeviceDetach(ctx, &req)
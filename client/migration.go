@@ -21,12 +21,42 @@ import (
 )
 
 func MigrationProcessStart(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	req, err := migrationRequestFromFlags(ctx, vmname, c, grpcClient)
+	if err != nil {
+		return err
+	}
+
+	if _, err := grpcClient.Machines().StartMigrationProcess(ctx, req); err != nil {
+		return err
+	}
+
+	if c.Bool("watch") {
+		return MigrationProcessShowStatus(ctx, vmname, c, grpcClient)
+	} else {
+		fmt.Println("Process has started and will continue in the background")
+		fmt.Println("Use this command to see the progress:")
+		fmt.Println("=> vmm migration status", vmname)
+	}
+
+	return nil
+}
+
+// migrationRequestFromFlags builds a migration request
+// from the command line flags common to the start and check commands.
+func migrationRequestFromFlags(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) (*pb_machines.StartMigrationRequest, error) {
+	tuning, err := migrationTuningFromFlags(c)
+	if err != nil {
+		return nil, err
+	}
+
 	req := pb_machines.StartMigrationRequest{
 		Name:        vmname,
 		DstServer:   c.Args().Tail()[0],
 		Overrides:   new(pb_types.MigrationOverrides),
 		CreateDisks: c.Bool("create-disks"),
 		RemoveAfter: false,
+		Tls:         migrationTLSFromFlags(c),
+		Tuning:      tuning,
 	}
 
 	if c.IsSet("override-name") {
@@ -49,13 +79,13 @@ func MigrationProcessStart(ctx context.Context, vmname string, c *cli.Command, g
 	case c.Bool("with-local-disks"):
 		resp, err := grpcClient.Machines().Get(ctx, &pb_machines.GetRequest{Name: vmname})
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		switch resp.Machine.State {
 		case pb_types.MachineState_PAUSED, pb_types.MachineState_RUNNING:
 		default:
-			return fmt.Errorf("unable to start migration process: machine is not running: %s", vmname)
+			return nil, fmt.Errorf("machine is not running: %s", vmname)
 		}
 
 		for _, d := range resp.Machine.Runtime.Storage {
@@ -65,19 +95,47 @@ func MigrationProcessStart(ctx context.Context, vmname string, c *cli.Command, g
 		req.Disks = append(req.Disks, c.StringSlice("with-disk")...)
 	}
 
-	if _, err := grpcClient.Machines().StartMigrationProcess(ctx, &req); err != nil {
-		return err
+	return &req, nil
+}
+
+// migrationTLSFromFlags returns the TLS mode set by the --tls flag.
+func migrationTLSFromFlags(c *cli.Command) pb_types.MigrationTLS {
+	if c.IsSet("tls") {
+		if c.Bool("tls") {
+			return pb_types.MigrationTLS_TLS_ON
+		}
+
+		return pb_types.MigrationTLS_TLS_OFF
 	}
 
-	if c.Bool("watch") {
-		return MigrationProcessShowStatus(ctx, vmname, c, grpcClient)
-	} else {
-		fmt.Println("Process has started and will continue in the background")
-		fmt.Println("Use this command to see the progress:")
-		fmt.Println("=> vmm migration status", vmname)
+	return pb_types.MigrationTLS_DEFAULT_TLS
+}
+
+// migrationTuningFromFlags builds a set of migration parameters
+// from the command line flags.
+func migrationTuningFromFlags(c *cli.Command) (*pb_types.MigrationTuning, error) {
+	tuning := pb_types.MigrationTuning{
+		MaxBandwidth:         uint64(c.Int("max-bandwidth")) << 20,
+		DowntimeLimit:        uint64(c.Int("downtime-limit")),
+		MultifdChannels:      uint32(c.Int("multifd")),
+		MultifdCompression:   c.String("compression"),
+		DisableXbzrle:        c.Bool("no-xbzrle"),
+		XbzrleCacheSize:      uint64(c.Int("xbzrle-cache-size")) << 20,
+		DisableAutoConverge:  c.Bool("no-auto-converge"),
+		CPUThrottleInitial:   uint32(c.Int("cpu-throttle-initial")),
+		CPUThrottleIncrement: uint32(c.Int("cpu-throttle-increment")),
+		Postcopy:             c.Bool("postcopy"),
+		PostcopyAfterPasses:  uint32(c.Int("postcopy-after-passes")),
+		PostcopyAfterSeconds: uint32(c.Int("postcopy-after-seconds")),
 	}
 
-	return nil
+	for _, name := range []string{"max-bandwidth", "downtime-limit", "multifd", "xbzrle-cache-size", "cpu-throttle-initial", "cpu-throttle-increment", "postcopy-after-passes", "postcopy-after-seconds"} {
+		if c.Int(name) < 0 {
+			return nil, fmt.Errorf("invalid --%s value: must be a positive number", name)
+		}
+	}
+
+	return &tuning, nil
 }
 
 func MigrationProcessShowStatus(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
@@ -196,3 +254,75 @@ func MigrationProcessCancel(ctx context.Context, vmname string, c *cli.Command,
 
 	return err
 }
+
+func MigrationProcessPause(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	_, err := grpcClient.Tasks().Pause(ctx, &pb_tasks.PauseRequest{Key: vmname + "/migration"})
+
+	return err
+}
+
+func MigrationProcessResume(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	_, err := grpcClient.Tasks().Resume(ctx, &pb_tasks.ResumeRequest{Key: vmname + "/migration"})
+
+	return err
+}
+
+func MigrationProcessSetSpeed(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	req := pb_tasks.SetSpeedRequest{
+		Key:   vmname + "/migration",
+		Speed: uint64(c.Int("speed")) << 20,
+	}
+
+	_, err := grpcClient.Tasks().SetSpeed(ctx, &req)
+
+	return err
+}
+
+func MigrationProcessCheck(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	startReq, err := migrationRequestFromFlags(ctx, vmname, c, grpcClient)
+	if err != nil {
+		return err
+	}
+
+	req := pb_machines.MigrationCheckRequest{
+		Name:        startReq.Name,
+		DstServer:   startReq.DstServer,
+		Disks:       startReq.Disks,
+		Overrides:   startReq.Overrides,
+		CreateDisks: startReq.CreateDisks,
+		Tls:         startReq.Tls,
+		Tuning:      startReq.Tuning,
+	}
+
+	resp, err := grpcClient.Machines().MigrationCheck(ctx, &req)
+	if err != nil {
+		return err
+	}
+
+	if c.Bool("json") {
+		b, err := json.MarshalIndent(resp, "", "    ")
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("%s\n", b)
+	} else {
+		for _, issue := range resp.Blockers {
+			fmt.Printf("ERROR   [%s] %s\n", issue.Subject, issue.Desc)
+		}
+
+		for _, issue := range resp.Warnings {
+			fmt.Printf("WARNING [%s] %s\n", issue.Subject, issue.Desc)
+		}
+	}
+
+	if len(resp.Blockers) > 0 {
+		return fmt.Errorf("migration is not possible: %d problem(s) found", len(resp.Blockers))
+	}
+
+	if !c.Bool("json") {
+		fmt.Println("Migration is possible")
+	}
+
+	return nil
+}
@@ -12,8 +12,9 @@ import (
 
 func MachineStart(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
 	req := pb_mahines.StartRequest{
-		Name:         vmname,
-		WaitInterval: uint32(c.Uint("wait")),
+		Name:              vmname,
+		WaitInterval:      uint32(c.Uint("wait")),
+		DiscardSavedState: c.Bool("discard-saved-state"),
 	}
 
 	_, err := grpcClient.Machines().Start(ctx, &req)
@@ -53,3 +54,23 @@ func MachineReset(ctx context.Context, vmname string, c *cli.Command, grpcClient
 
 	return err
 }
+
+func MachinePause(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	req := pb_mahines.PauseRequest{
+		Name: vmname,
+	}
+
+	_, err := grpcClient.Machines().Pause(ctx, &req)
+
+	return err
+}
+
+func MachineResume(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	req := pb_mahines.ResumeRequest{
+		Name: vmname,
+	}
+
+	_, err := grpcClient.Machines().Resume(ctx, &req)
+
+	return err
+}
@@ -0,0 +1,80 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	pb_machines "github.com/0xef53/kvmrun/api/services/machines/v2"
+
+	grpc_interfaces "github.com/0xef53/kvmrun/internal/grpc/interfaces"
+
+	cli "github.com/urfave/cli/v3"
+)
+
+func MachineDiskSnapshotCreate(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	req := pb_machines.DiskSnapshotCreateRequest{
+		Name:         vmname,
+		SnapshotName: c.Args().Tail()[0],
+		Disks:        c.StringSlice("disk"),
+	}
+
+	_, err := grpcClient.Machines().DiskSnapshotCreate(ctx, &req)
+
+	return err
+}
+
+func MachineDiskSnapshotList(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	req := pb_machines.DiskSnapshotListRequest{
+		Name: vmname,
+	}
+
+	resp, err := grpcClient.Machines().DiskSnapshotList(ctx, &req)
+	if err != nil {
+		return err
+	}
+
+	if c.Bool("json") {
+		b, err := json.MarshalIndent(resp, "", "    ")
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("%s\n", b)
+
+		return nil
+	}
+
+	for _, snap := range resp.Snapshots {
+		created := time.Unix(int64(snap.Created), 0).Format("2006-01-02 15:04:05")
+
+		fmt.Printf("%-32s  %s  %s\n", snap.Name, created, strings.Join(snap.Disks, ", "))
+	}
+
+	return nil
+}
+
+func MachineDiskSnapshotDelete(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	req := pb_machines.DiskSnapshotDeleteRequest{
+		Name:         vmname,
+		SnapshotName: c.Args().Tail()[0],
+		Disks:        c.StringSlice("disk"),
+	}
+
+	_, err := grpcClient.Machines().DiskSnapshotDelete(ctx, &req)
+
+	return err
+}
+
+func MachineDiskSnapshotRevert(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	req := pb_machines.DiskSnapshotRevertRequest{
+		Name:         vmname,
+		SnapshotName: c.Args().Tail()[0],
+	}
+
+	_, err := grpcClient.Machines().DiskSnapshotRevert(ctx, &req)
+
+	return err
+}
@@ -0,0 +1,94 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb_machines "github.com/0xef53/kvmrun/api/services/machines/v2"
+	pb_tasks "github.com/0xef53/kvmrun/api/services/tasks/v2"
+	pb_types "github.com/0xef53/kvmrun/api/types/v2"
+
+	bar "github.com/0xef53/kvmrun/client/progress_bar"
+
+	grpc_interfaces "github.com/0xef53/kvmrun/internal/grpc/interfaces"
+
+	grpc_codes "google.golang.org/grpc/codes"
+	grpc_status "google.golang.org/grpc/status"
+
+	cli "github.com/urfave/cli/v3"
+)
+
+func MachineSuspend(ctx context.Context, vmname string, c *cli.Command, grpcClient *grpc_interfaces.Kvmrun) error {
+	resp, err := grpcClient.Machines().StartSuspendProcess(ctx, &pb_machines.StartSuspendRequest{Name: vmname})
+	if err != nil {
+		return err
+	}
+
+	if !c.Bool("watch") {
+		fmt.Println("Process has started and will continue in the background")
+		fmt.Println("Use this command to see the current state:")
+		fmt.Println("=> vmm list", vmname)
+
+		return nil
+	}
+
+	req := pb_tasks.GetRequest{
+		Key: resp.TaskKey,
+	}
+
+	collect := func(ctx context.Context, update func(name string, p int)) error {
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+
+		for {
+			resp, err := grpcClient.Tasks().Get(ctx, &req)
+
+			if err == nil {
+				if details := resp.Task.GetSuspend(); details != nil && details.Vmstate != nil {
+					update(vmname, int(details.Vmstate.Progress))
+				}
+
+				if resp.Task.State == pb_types.TaskInfo_COMPLETED {
+					update(vmname, 100)
+
+					break
+				}
+
+				if resp.Task.State == pb_types.TaskInfo_FAILED {
+					err = fmt.Errorf("%s", resp.Task.StateDesc)
+				}
+			}
+
+			if err != nil {
+				if grpc_status.Code(err) == grpc_codes.NotFound {
+					err = fmt.Errorf("unexpected: task not found")
+				}
+
+				update(vmname, -1)
+
+				return err
+			}
+
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+			}
+		}
+
+		return nil
+	}
+
+	progressBar := bar.NewProgressBar(collect, vmname)
+
+	progressBar.Show()
+
+	if progressBar.Err() == nil {
+		fmt.Println("Successfully suspended")
+	} else {
+		return progressBar.Err()
+	}
+
+	return nil
+}
@@ -14,7 +14,9 @@ import (
 	"github.com/0xef53/kvmrun/services"
 	"github.com/0xef53/kvmrun/services/interceptors"
 
+	_ "github.com/0xef53/kvmrun/services/blockjobs"
 	_ "github.com/0xef53/kvmrun/services/cloudinit"
+	_ "github.com/0xef53/kvmrun/services/guestagent"
 	_ "github.com/0xef53/kvmrun/services/hardware"
 	_ "github.com/0xef53/kvmrun/services/machines"
 	_ "github.com/0xef53/kvmrun/services/network"
@@ -88,6 +90,9 @@ func run(ctx context.Context, c *cli.Command) error {
 		return fmt.Errorf("pre-start error: %w", err)
 	}
 
+	// Regular backups according to the machine policies
+	go baseHandler.Machine.RunBackupScheduler(ctx)
+
 	// GRPC Server
 	ui := []grpc.UnaryServerInterceptor{
 		interceptors.MapErrorsUnaryServerInterceptor(),
@@ -105,6 +105,11 @@ func (l *launcher) Cleanup() error {
 		}
 	}
 
+	// Image files are mapped to the chroot using bind mounts
+	if err := file.UnbindAll(chrootDir); err != nil {
+		Error.Println("cleanup: failed to unmount image files:", err)
+	}
+
 	if err := os.RemoveAll(chrootDir); err != nil {
 		Error.Println("cleanup: failed to remove chroot environment:", err)
 	}
@@ -57,15 +57,25 @@ func (l *launcher) Start() error {
 		return err
 	}
 
+	// The machine state could be saved to a file by the suspend operation.
+	// In this case QEMU will be started in the incoming mode
+	// to restore the state from this file.
+	var savedState *kvmrun.SavedState
+
+	if !vmconf.IsIncoming() {
+		if savedState = checkSavedState(vmconf); savedState != nil {
+			// The state can only be loaded into the same version of the machine type
+			if err := vmconf.MachineTypeSet(savedState.MachineType); err != nil {
+				return err
+			}
+		}
+	}
+
 	// Build command line
 	features := kvmrun.CommandLineFeatures{
 		NoReboot: os.Getenv("USE_NOREBOOT") != "",
 	}
 
-	if v, ok := os.LookupEnv("INCOMING_HOST"); ok {
-		features.IncomingHost = v
-	}
-
 	if v, ok := os.LookupEnv("VNC_HOST"); ok {
 		features.VNCHost = v
 	}
@@ -85,11 +95,12 @@ func (l *launcher) Start() error {
 		return nil
 	}
 
-	var qemuRootDir string
+	var qemuRootDir, certDir string
 
 	// AppConf with global Kvmrun options
 	if resp, err := l.client.GetAppConf(l.ctx, new(empty.Empty)); err == nil {
 		qemuRootDir = resp.AppConf.Kvmrun.QemuRootdir
+		certDir = resp.AppConf.Kvmrun.CertDir
 	} else {
 		return fmt.Errorf("failed to request global Kvmrun configuration: %w", err)
 	}
@@ -132,7 +143,7 @@ func (l *launcher) Start() error {
 
 	// Check all the PCI devices and detach them from the host
 	if devs := vmconf.HostDeviceGetList(); len(devs) > 0 {
-		if err := loadVfioModule(); err != nil {
+		if err := pci.LoadVFIOModule(); err != nil {
 			return err
 		}
 
@@ -167,7 +178,7 @@ func (l *launcher) Start() error {
 	}
 
 	// Prepare chroot environment
-	if err := prepareChroot(vmconf, qemuRootDir); err != nil {
+	if err := prepareChroot(vmconf, qemuRootDir, certDir); err != nil {
 		if errors.Is(err, errNotFatal) {
 			Error.Println(err.Error())
 		} else {
@@ -223,6 +234,25 @@ func (l *launcher) Start() error {
 		}
 	}
 
+	if savedState != nil {
+		if fd, err := openSavedState(l.vmname, savedState); err == nil {
+			Info.Printf("machine state will be restored from the file (saved at %s)\n", savedState.Created.Format("2006-01-02 15:04:05"))
+
+			args = append(args, "-incoming", fmt.Sprintf("fd:%d", fd))
+
+			// The machine was paused when its state was saved
+			if savedState.Paused {
+				args = append(args, "-S")
+			}
+		} else {
+			Error.Printf("unable to restore machine state, it will be removed: %s\n", err)
+
+			if err := kvmrun.RemoveSavedState(l.vmname); err != nil {
+				return err
+			}
+		}
+	}
+
 	// Run QEMU process
 	Info.Printf("starting QEMU process: pid = %d\n", os.Getpid())
 
@@ -239,7 +269,7 @@ func (l *launcher) Start() error {
 	return syscall.Exec(qemuBinary, args, os.Environ())
 }
 
-func prepareChroot(vmconf kvmrun.Instance, qemuRootDir string) error {
+func prepareChroot(vmconf kvmrun.Instance, qemuRootDir, certDir string) error {
 	vmChrootDir := filepath.Join(kvmrun.CHROOTDIR, vmconf.Name())
 
 	if err := os.MkdirAll(filepath.Join(vmChrootDir, "dev/net"), 0755); err != nil {
@@ -271,6 +301,28 @@ func prepareChroot(vmconf kvmrun.Instance, qemuRootDir string) error {
 			continue
 		}
 
+		// Snapshot overlays are mapped using bind mount
+		// to the same paths that are written in their headers
+		for _, snap := range disk.Snapshots {
+			overlay, err := file.New(snap.Overlay)
+			if err != nil {
+				return err
+			}
+
+			if err := overlay.BindTo(filepath.Join(vmChrootDir, snap.Overlay), vmconf.UID()); err != nil {
+				return fmt.Errorf("bind %s: %s", snap.Overlay, err)
+			}
+		}
+
+		// Image files are mapped using bind mount
+		if b, ok := disk.Backend.(*file.Device); ok {
+			if err := b.BindTo(filepath.Join(vmChrootDir, disk.Path), vmconf.UID()); err != nil {
+				return fmt.Errorf("bind %s: %s", disk.Path, err)
+			}
+
+			continue
+		}
+
 		if err := os.MkdirAll(filepath.Join(vmChrootDir, filepath.Dir(disk.Path)), 0755); err != nil {
 			return err
 		}
@@ -389,92 +441,162 @@ func prepareChroot(vmconf kvmrun.Instance, qemuRootDir string) error {
 		}
 	}
 
-	err := func() error {
-		possibleDirs := []string{
-			filepath.Join(qemuRootDir, "usr/lib/x86_64-linux-gnu/qemu"),
+	var errs []error
+
+	// TLS credentials for the encrypted NBD disks.
+	// The credentials for the migration and backup streams
+	// are issued by kvmrund on demand
+	for _, disk := range vmconf.DiskGetList() {
+		if disk.UseTLS() {
+			if err := kvmrun.PrepareTLSCreds(vmconf.Name(), vmconf.UID(), certDir); err != nil {
+				return err
+			}
+
+			Info.Printf("(tls: %s) Issued for %s\n", certDir, filepath.Join(vmChrootDir, "tls"))
+
+			break
 		}
+	}
 
-		libname := "block-iscsi.so"
+	if err := copyBlockModule("block-iscsi.so", "iscsi", qemuRootDir, vmChrootDir); err != nil {
+		errs = append(errs, fmt.Errorf("unable to prepare iSCSI libs: %w", err))
+	}
 
-		var libpath string
+	if err := copyBlockModule("block-rbd.so", "rbd", qemuRootDir, vmChrootDir); err != nil {
+		errs = append(errs, fmt.Errorf("unable to prepare RBD libs: %w", err))
+	}
 
-		// Check in current work directory
-		if _, err := os.Stat(libname); err == nil {
-			libpath = libname
+	if len(errs) > 0 {
+		return fmt.Errorf("%w: %w", errNotFatal, errors.Join(errs...))
+	}
 
-			Info.Printf("(iscsi: %s) Found in current working directory\n", libname)
-		} else {
-			if _, p, err := utils.LookForFile(libname, possibleDirs...); err == nil {
-				libpath = p
+	return nil
+}
 
-				Info.Printf("(iscsi: %s) Found at %s\n", libname, libpath)
-			} else {
-				return fmt.Errorf("unable to find: %s", libname)
-			}
-		}
+// copyBlockModule copies the QEMU block driver module
+// and all its dependencies to a chroot.
+func copyBlockModule(libname, tag, qemuRootDir, vmChrootDir string) error {
+	possibleDirs := []string{
+		filepath.Join(qemuRootDir, "usr/lib/x86_64-linux-gnu/qemu"),
+	}
 
-		if p, err := filepath.Rel(qemuRootDir, libpath); err == nil {
-			dstname := filepath.Join(vmChrootDir, p)
+	var libpath string
 
-			if err := fsutil.Copy(libpath, dstname); err != nil {
-				return err
-			}
+	// Check in current work directory
+	if _, err := os.Stat(libname); err == nil {
+		libpath = libname
 
-			Info.Printf("(iscsi: %s) Copy to %s\n", libname, dstname)
+		Info.Printf("(%s: %s) Found in current working directory\n", tag, libname)
+	} else {
+		if _, p, err := utils.LookForFile(libname, possibleDirs...); err == nil {
+			libpath = p
+
+			Info.Printf("(%s: %s) Found at %s\n", tag, libname, libpath)
 		} else {
-			return err
+			return fmt.Errorf("unable to find: %s", libname)
 		}
+	}
 
-		// Copy dependencies
-		lddBinary, err := exec.LookPath("ldd")
-		if err != nil {
-			return err
-		}
+	if p, err := filepath.Rel(qemuRootDir, libpath); err == nil {
+		dstname := filepath.Join(vmChrootDir, p)
 
-		out, err := exec.Command(lddBinary, libpath).CombinedOutput()
-		if err != nil {
+		if err := fsutil.Copy(libpath, dstname); err != nil {
 			return err
 		}
 
-		lines := strings.Split(string(out), "\n")
+		Info.Printf("(%s: %s) Copy to %s\n", tag, libname, dstname)
+	} else {
+		return err
+	}
 
-		for _, line := range lines {
-			if !strings.Contains(line, " => ") {
-				continue
-			}
+	// Copy dependencies
+	lddBinary, err := exec.LookPath("ldd")
+	if err != nil {
+		return err
+	}
 
-			parts := strings.Fields(line)
+	out, err := exec.Command(lddBinary, libpath).CombinedOutput()
+	if err != nil {
+		return err
+	}
 
-			if strings.Contains(line, "not found") {
-				return fmt.Errorf("unmet dependencies: %s", parts[0])
-			}
+	lines := strings.Split(string(out), "\n")
 
-			dstname := filepath.Join(vmChrootDir, parts[2])
+	for _, line := range lines {
+		if !strings.Contains(line, " => ") {
+			continue
+		}
 
-			if err := fsutil.Copy(filepath.Join(qemuRootDir, parts[2]), dstname); err != nil {
-				return err
-			}
-			Info.Printf("(iscsi: %s) Copy to %s\n", filepath.Base(parts[2]), dstname)
+		parts := strings.Fields(line)
+
+		if strings.Contains(line, "not found") {
+			return fmt.Errorf("unmet dependencies: %s", parts[0])
 		}
 
-		return nil
-	}()
-	if err != nil {
-		return fmt.Errorf("%w: unable to prepare iSCSI libs: %w", errNotFatal, err)
+		dstname := filepath.Join(vmChrootDir, parts[2])
+
+		if err := fsutil.Copy(filepath.Join(qemuRootDir, parts[2]), dstname); err != nil {
+			return err
+		}
+		Info.Printf("(%s: %s) Copy to %s\n", tag, filepath.Base(parts[2]), dstname)
 	}
 
 	return nil
 }
 
-func loadVfioModule() error {
-	if _, err := os.Stat("/sys/bus/pci/drivers/vfio-pci"); err == nil {
-		return nil
+// checkSavedState returns the description of the machine state
+// saved by the suspend operation if the state can be restored
+// using the given configuration. Otherwise, the saved state is removed.
+func checkSavedState(vmconf kvmrun.Instance) *kvmrun.SavedState {
+	st, err := kvmrun.GetSavedState(vmconf.Name())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+
+		Error.Printf("unable to load saved state description: %s\n", err)
+	} else {
+		if st.Restoring {
+			Error.Println("previous attempt to restore the saved state has failed")
+		} else if err = st.CheckCompatibility(vmconf); err == nil {
+			return st
+		} else {
+			Error.Printf("saved state is not compatible with the current configuration: %s\n", err)
+		}
 	}
 
-	// Try to load anyway
-	if _, err := exec.Command("modprobe", "vfio-pci").CombinedOutput(); err != nil {
-		return fmt.Errorf("could not load vfio-pci module: modprobe failed with %s", err)
+	Info.Println("saved state will be removed, the machine will boot from scratch")
+
+	if err := kvmrun.RemoveSavedState(vmconf.Name()); err != nil {
+		Error.Printf("failed to remove saved state: %s\n", err)
 	}
 
 	return nil
 }
+
+// openSavedState verifies the saved state file and opens it
+// so that the descriptor is inherited by the QEMU process.
+// The state is marked as restoring: kvmrund removes it as soon as
+// QEMU reports that the state is loaded. If QEMU fails to load it,
+// the marked state will be removed on the next start.
+func openSavedState(vmname string, st *kvmrun.SavedState) (int, error) {
+	if err := st.VerifyChecksum(vmname); err != nil {
+		return -1, err
+	}
+
+	// Without O_CLOEXEC
+	fd, err := syscall.Open(kvmrun.SavedStateFile(vmname), syscall.O_RDONLY, 0)
+	if err != nil {
+		return -1, err
+	}
+
+	st.Restoring = true
+
+	if err := st.Save(vmname); err != nil {
+		syscall.Close(fd)
+
+		return -1, err
+	}
+
+	return fd, nil
+}
@@ -19,18 +19,31 @@ var BackupCommands = &cli.Command{
 		CommandBackupProcessStart,
 		CommandBackupProcessShowStatus,
 		CommandBackupProcessCancel,
+		CommandBackupProcessPause,
+		CommandBackupProcessResume,
+		CommandBackupProcessSetSpeed,
+		CommandBackupExportStart,
+		CommandBackupExportFinish,
+		CommandBackupRestoreStart,
+		CommandBackupRestoreShowStatus,
+		CommandBackupRestoreCancel,
+		CommandBackupPolicy,
+		CommandBackupCatalogList,
 	},
 }
 
 var CommandBackupProcessStart = &cli.Command{
 	Name:      "start",
 	Usage:     "start backup of a virtual machine or disk",
-	ArgsUsage: "VMNAME [DISKNAME] TARGET",
+	ArgsUsage: "VMNAME [DISKNAME TARGET]",
 	HideHelp:  true,
 	Flags: []cli.Flag{
 		&cli.BoolFlag{Name: "watch", Aliases: []string{"w"}, Usage: "watch the process"},
+		&cli.StringSliceFlag{Name: "disk", Usage: "back up several disks at the same point in time (DISKNAME=TARGET)"},
 		&cli.BoolFlag{Name: "incremental", Aliases: []string{"inc", "i"}, Usage: "only copy data described by a dirty bitmap (if exists)"},
 		&cli.BoolFlag{Name: "clear-bitmap", Usage: "clear/reset a dirty bitmap (if exists) before starting"},
+		&cli.BoolFlag{Name: "fs-freeze", Usage: "freeze guest filesystems using the guest agent while the backup is starting"},
+		&cli.IntFlag{Name: "speed", DefaultText: "unlimited", Usage: "maximum backup speed of each disk in `MiB` per second"},
 	},
 	Action: func(ctx context.Context, c *cli.Command) error {
 		return grpc_client.CommandGRPC(ctx, c, client.BackupProcessStart)
@@ -56,3 +69,160 @@ var CommandBackupProcessCancel = &cli.Command{
 		return grpc_client.CommandGRPC(ctx, c, client.BackupProcessCancel)
 	},
 }
+
+var CommandBackupProcessPause = &cli.Command{
+	Name:      "pause",
+	Usage:     "pause a running backup process",
+	ArgsUsage: "VMNAME [DISKNAME]",
+	HideHelp:  true,
+	Action: func(ctx context.Context, c *cli.Command) error {
+		return grpc_client.CommandGRPC(ctx, c, client.BackupProcessPause)
+	},
+}
+
+var CommandBackupProcessResume = &cli.Command{
+	Name:      "resume",
+	Usage:     "resume a paused backup process",
+	ArgsUsage: "VMNAME [DISKNAME]",
+	HideHelp:  true,
+	Action: func(ctx context.Context, c *cli.Command) error {
+		return grpc_client.CommandGRPC(ctx, c, client.BackupProcessResume)
+	},
+}
+
+var CommandBackupProcessSetSpeed = &cli.Command{
+	Name:      "set-speed",
+	Usage:     "change the speed limit of a running backup process",
+	ArgsUsage: "VMNAME [DISKNAME]",
+	HideHelp:  true,
+	Flags: []cli.Flag{
+		&cli.IntFlag{Name: "speed", Required: true, Usage: "maximum backup speed of each disk in `MiB` per second (0 means unlimited)"},
+	},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		return grpc_client.CommandGRPC(ctx, c, client.BackupProcessSetSpeed)
+	},
+}
+
+var CommandBackupExportStart = &cli.Command{
+	Name:      "export",
+	Usage:     "export a point-in-time view of disks via NBD to be pulled by an external tool",
+	ArgsUsage: "VMNAME DISKNAME [DISKNAME...]",
+	HideHelp:  true,
+	Flags: []cli.Flag{
+		&cli.StringFlag{Name: "listen", Value: "127.0.0.1", Usage: "local address for the NBD server"},
+		&cli.BoolFlag{Name: "tls", Usage: "encrypt the NBD connection using the machine TLS credentials"},
+		&cli.BoolFlag{Name: "incremental", Aliases: []string{"inc", "i"}, Usage: "export a dirty bitmap with changes since the previous backup (if exists)"},
+		&cli.BoolFlag{Name: "fs-freeze", Usage: "freeze guest filesystems using the guest agent while the exports are being created"},
+		&cli.UintFlag{Name: "timeout", Usage: "release the exports as failed after this number of `SECONDS` (default: 6 hours)"},
+	},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		return grpc_client.CommandGRPC(ctx, c, client.BackupExportStart)
+	},
+}
+
+var CommandBackupExportFinish = &cli.Command{
+	Name:      "finish-export",
+	Usage:     "release the NBD exports of a virtual machine",
+	ArgsUsage: "VMNAME",
+	HideHelp:  true,
+	Flags: []cli.Flag{
+		&cli.BoolFlag{Name: "failed", Usage: "the backup has failed, keep the exported changes for the next incremental backup"},
+	},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		return grpc_client.CommandGRPC(ctx, c, client.BackupExportFinish)
+	},
+}
+
+var CommandBackupRestoreStart = &cli.Command{
+	Name:      "restore",
+	Usage:     "restore a disk from a backup (attach the backup read-only if the machine is running)",
+	ArgsUsage: "VMNAME DISKNAME SOURCE",
+	HideHelp:  true,
+	Flags: []cli.Flag{
+		&cli.BoolFlag{Name: "watch", Aliases: []string{"w"}, Usage: "watch the process"},
+	},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		return grpc_client.CommandGRPC(ctx, c, client.BackupRestoreStart)
+	},
+}
+
+var CommandBackupRestoreShowStatus = &cli.Command{
+	Name:      "restore-status",
+	Usage:     "check the progress of a disk restore",
+	ArgsUsage: "VMNAME DISKNAME",
+	HideHelp:  true,
+	Action: func(ctx context.Context, c *cli.Command) error {
+		return grpc_client.CommandGRPC(ctx, c, client.BackupRestoreShowStatus)
+	},
+}
+
+var CommandBackupRestoreCancel = &cli.Command{
+	Name:      "restore-cancel",
+	Usage:     "cancel a running disk restore process",
+	ArgsUsage: "VMNAME DISKNAME",
+	HideHelp:  true,
+	Action: func(ctx context.Context, c *cli.Command) error {
+		return grpc_client.CommandGRPC(ctx, c, client.BackupRestoreCancel)
+	},
+}
+
+var CommandBackupPolicy = &cli.Command{
+	Name:     "policy",
+	Usage:    "manage regular backups performed by the server",
+	HideHelp: true,
+	Commands: []*cli.Command{
+		CommandBackupPolicySet,
+		CommandBackupPolicyShow,
+		CommandBackupPolicyRemove,
+	},
+}
+
+var CommandBackupPolicySet = &cli.Command{
+	Name:      "set",
+	Usage:     "set or replace a backup policy of a virtual machine",
+	ArgsUsage: "VMNAME",
+	HideHelp:  true,
+	Flags: []cli.Flag{
+		&cli.StringFlag{Name: "schedule", Required: true, Usage: "cron `expression` (e.g. \"0 3 * * *\" or @daily)"},
+		&cli.StringSliceFlag{Name: "disk", DefaultText: "all disks", Usage: "back up specified disks only"},
+		&cli.UintFlag{Name: "full-every", Usage: "make every `N`-th backup full, the others incremental (default: only full backups)"},
+		&cli.StringFlag{Name: "target", Required: true, Usage: "target file `template` with placeholders {vm}, {disk}, {date}, {time} and {type}"},
+		&cli.UintFlag{Name: "keep-chains", Usage: "keep `N` newest backup chains (a full backup and its incremental backups)"},
+		&cli.UintFlag{Name: "keep-days", Usage: "remove backup chains older than `N` days"},
+		&cli.BoolFlag{Name: "fs-freeze", Usage: "freeze guest filesystems using the guest agent while the backup is starting"},
+		&cli.BoolFlag{Name: "disabled", Usage: "save the policy, but do not perform backups"},
+	},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		return grpc_client.CommandGRPC(ctx, c, client.BackupPolicySet)
+	},
+}
+
+var CommandBackupPolicyShow = &cli.Command{
+	Name:      "show",
+	Usage:     "print a backup policy of a virtual machine",
+	ArgsUsage: "VMNAME",
+	HideHelp:  true,
+	Action: func(ctx context.Context, c *cli.Command) error {
+		return grpc_client.CommandGRPC(ctx, c, client.BackupPolicyShow)
+	},
+}
+
+var CommandBackupPolicyRemove = &cli.Command{
+	Name:      "remove",
+	Usage:     "remove a backup policy (existing backups are kept)",
+	ArgsUsage: "VMNAME",
+	HideHelp:  true,
+	Action: func(ctx context.Context, c *cli.Command) error {
+		return grpc_client.CommandGRPC(ctx, c, client.BackupPolicyRemove)
+	},
+}
+
+var CommandBackupCatalogList = &cli.Command{
+	Name:      "catalog",
+	Usage:     "print a list of backups performed according to the policy",
+	ArgsUsage: "VMNAME [DISKNAME]",
+	HideHelp:  true,
+	Action: func(ctx context.Context, c *cli.Command) error {
+		return grpc_client.CommandGRPC(ctx, c, client.BackupCatalogList)
+	},
+}
@@ -0,0 +1,86 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/0xef53/kvmrun/client"
+
+	grpc_client "github.com/0xef53/kvmrun/client/grpcclient"
+
+	cli "github.com/urfave/cli/v3"
+)
+
+var BlockJobCommands = &cli.Command{
+	Name:     "jobs",
+	Usage:    "manage QEMU block jobs (including those left behind by kvmrund)",
+	HideHelp: true,
+	Category: "Migration & Backup",
+	Commands: []*cli.Command{
+		cmdBlockJobList,
+		cmdBlockJobCancel,
+		cmdBlockJobPause,
+		cmdBlockJobResume,
+		cmdBlockJobComplete,
+		cmdBlockJobDismiss,
+	},
+}
+
+var cmdBlockJobList = &cli.Command{
+	Name:      "list",
+	Usage:     "print a list of block jobs of a virtual machine",
+	ArgsUsage: "VMNAME",
+	HideHelp:  true,
+	Action: func(ctx context.Context, c *cli.Command) error {
+		return grpc_client.CommandGRPC(ctx, c, client.BlockJobList)
+	},
+}
+
+var cmdBlockJobCancel = &cli.Command{
+	Name:      "cancel",
+	Usage:     "cancel a block job that is not owned by a running task",
+	ArgsUsage: "VMNAME JOBID",
+	HideHelp:  true,
+	Action: func(ctx context.Context, c *cli.Command) error {
+		return grpc_client.CommandGRPC(ctx, c, client.BlockJobCancel)
+	},
+}
+
+var cmdBlockJobPause = &cli.Command{
+	Name:      "pause",
+	Usage:     "pause a running block job",
+	ArgsUsage: "VMNAME JOBID",
+	HideHelp:  true,
+	Action: func(ctx context.Context, c *cli.Command) error {
+		return grpc_client.CommandGRPC(ctx, c, client.BlockJobPause)
+	},
+}
+
+var cmdBlockJobResume = &cli.Command{
+	Name:      "resume",
+	Usage:     "resume a paused block job",
+	ArgsUsage: "VMNAME JOBID",
+	HideHelp:  true,
+	Action: func(ctx context.Context, c *cli.Command) error {
+		return grpc_client.CommandGRPC(ctx, c, client.BlockJobResume)
+	},
+}
+
+var cmdBlockJobComplete = &cli.Command{
+	Name:      "complete",
+	Usage:     "complete an orphaned block job in the ready state (e.g. switch to the mirror target)",
+	ArgsUsage: "VMNAME JOBID",
+	HideHelp:  true,
+	Action: func(ctx context.Context, c *cli.Command) error {
+		return grpc_client.CommandGRPC(ctx, c, client.BlockJobComplete)
+	},
+}
+
+var cmdBlockJobDismiss = &cli.Command{
+	Name:      "dismiss",
+	Usage:     "remove an orphaned concluded block job from the list",
+	ArgsUsage: "VMNAME JOBID",
+	HideHelp:  true,
+	Action: func(ctx context.Context, c *cli.Command) error {
+		return grpc_client.CommandGRPC(ctx, c, client.BlockJobDismiss)
+	},
+}
@@ -21,6 +21,10 @@ var DiskCommands = &cli.Command{
 		CommandDiskDetach,
 		CommandDiskSetParameters,
 		CommandDiskResizeQemuBlockdev,
+		CommandDiskMoveStart,
+		CommandDiskMoveShowStatus,
+		CommandDiskMoveCancel,
+		CommandDiskSnapshot,
 	},
 }
 
@@ -35,6 +39,7 @@ var CommandDiskAttach = &cli.Command{
 		&cli.IntFlag{Name: "iops-wr", DefaultText: "not set", Usage: "write I/O operations `limit` per second (0 - unlimited)"},
 		&cli.IntFlag{Name: "position", Value: -1, DefaultText: "not set", Usage: "position `number` in device list"},
 		&cli.IntFlag{Name: "bootindex", Value: 0, DefaultText: "not set", Usage: "boot `priority` for a device (lower value = higher priority)"},
+		&cli.StringFlag{Name: "format", DefaultText: "detected once on attach", Usage: "image `format` of a disk file (raw or qcow2)"},
 		&cli.BoolFlag{Name: "live", Usage: "affect running machine"},
 	},
 	Action: func(ctx context.Context, c *cli.Command) error {
@@ -76,7 +81,104 @@ var CommandDiskResizeQemuBlockdev = &cli.Command{
 	Usage:     "resize a disk device and send an event to the guest",
 	ArgsUsage: "VMNAME DISK",
 	HideHelp:  true,
+	Flags: []cli.Flag{
+		&cli.StringFlag{Name: "size", DefaultText: "not set", Usage: "grow the logical volume or image file to the new `size` in bytes or with a suffix K, M, G, T (e.g. 20G)"},
+	},
 	Action: func(ctx context.Context, c *cli.Command) error {
 		return grpc_client.CommandGRPC(ctx, c, client.MachineDiskResizeQemuBlockdev)
 	},
 }
+
+var CommandDiskMoveStart = &cli.Command{
+	Name:      "move",
+	Usage:     "move a disk of a running machine to another local device or image file",
+	ArgsUsage: "VMNAME DISK TARGET",
+	HideHelp:  true,
+	Flags: []cli.Flag{
+		&cli.BoolFlag{Name: "create-target", Usage: "create a new logical volume (/dev/VG/LV) or image file of the source size"},
+		&cli.BoolFlag{Name: "remove-source", Usage: "remove the source logical volume or image file on success"},
+		&cli.IntFlag{Name: "speed", DefaultText: "unlimited", Usage: "maximum copying speed in `MiB` per second"},
+		&cli.BoolFlag{Name: "watch", Aliases: []string{"w"}, Usage: "watch the process"},
+	},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		return grpc_client.CommandGRPC(ctx, c, client.MachineDiskMoveStart)
+	},
+}
+
+var CommandDiskMoveShowStatus = &cli.Command{
+	Name:      "move-status",
+	Usage:     "check the progress of a disk move",
+	ArgsUsage: "VMNAME DISK",
+	HideHelp:  true,
+	Action: func(ctx context.Context, c *cli.Command) error {
+		return grpc_client.CommandGRPC(ctx, c, client.MachineDiskMoveShowStatus)
+	},
+}
+
+var CommandDiskMoveCancel = &cli.Command{
+	Name:      "move-cancel",
+	Usage:     "cancel a running disk move process",
+	ArgsUsage: "VMNAME DISK",
+	HideHelp:  true,
+	Action: func(ctx context.Context, c *cli.Command) error {
+		return grpc_client.CommandGRPC(ctx, c, client.MachineDiskMoveCancel)
+	},
+}
+
+var CommandDiskSnapshot = &cli.Command{
+	Name:     "snapshot",
+	Usage:    "manage external disk snapshots",
+	HideHelp: true,
+	Commands: []*cli.Command{
+		CommandDiskSnapshotCreate,
+		CommandDiskSnapshotList,
+		CommandDiskSnapshotDelete,
+		CommandDiskSnapshotRevert,
+	},
+}
+
+var CommandDiskSnapshotCreate = &cli.Command{
+	Name:      "create",
+	Usage:     "create a crash-consistent snapshot of the machine disks",
+	ArgsUsage: "VMNAME SNAPSHOT",
+	HideHelp:  true,
+	Flags: []cli.Flag{
+		&cli.StringSliceFlag{Name: "disk", DefaultText: "all local disks", Usage: "create a snapshot of specified disks only"},
+	},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		return grpc_client.CommandGRPC(ctx, c, client.MachineDiskSnapshotCreate)
+	},
+}
+
+var CommandDiskSnapshotList = &cli.Command{
+	Name:      "list",
+	Usage:     "print a list of disk snapshots",
+	ArgsUsage: "VMNAME",
+	HideHelp:  true,
+	Action: func(ctx context.Context, c *cli.Command) error {
+		return grpc_client.CommandGRPC(ctx, c, client.MachineDiskSnapshotList)
+	},
+}
+
+var CommandDiskSnapshotDelete = &cli.Command{
+	Name:      "delete",
+	Usage:     "delete a snapshot by merging its changes into the image below (disk contents are not changed)",
+	ArgsUsage: "VMNAME SNAPSHOT",
+	HideHelp:  true,
+	Flags: []cli.Flag{
+		&cli.StringSliceFlag{Name: "disk", DefaultText: "all disks", Usage: "delete a snapshot of specified disks only"},
+	},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		return grpc_client.CommandGRPC(ctx, c, client.MachineDiskSnapshotDelete)
+	},
+}
+
+var CommandDiskSnapshotRevert = &cli.Command{
+	Name:      "revert",
+	Usage:     "discard all disk changes made since the snapshot (the machine must be powered off)",
+	ArgsUsage: "VMNAME SNAPSHOT",
+	HideHelp:  true,
+	Action: func(ctx context.Context, c *cli.Command) error {
+		return grpc_client.CommandGRPC(ctx, c, client.MachineDiskSnapshotRevert)
+	},
+}
@@ -0,0 +1,60 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/0xef53/kvmrun/client"
+
+	grpc_client "github.com/0xef53/kvmrun/client/grpcclient"
+
+	cli "github.com/urfave/cli/v3"
+)
+
+var EvacuationCommands = &cli.Command{
+	Name:     "evacuation",
+	Usage:    "migrate all virtual machines off this host",
+	HideHelp: true,
+	Category: "Migration & Backup",
+	Commands: []*cli.Command{
+		CommandEvacuationProcessStart,
+		CommandEvacuationProcessShowStatus,
+		CommandEvacuationProcessCancel,
+	},
+}
+
+var CommandEvacuationProcessStart = &cli.Command{
+	Name:      "start",
+	Usage:     "start migration of all running virtual machines to other hosts (the host is switched to drain mode)",
+	ArgsUsage: "DSTSERVER [DSTSERVER...]",
+	HideHelp:  true,
+	Flags: append(
+		[]cli.Flag{
+			&cli.BoolFlag{Name: "watch", Aliases: []string{"w"}, Usage: "watch the evacuation process"},
+			&cli.IntFlag{Name: "concurrency", Value: 1, Usage: "maximum `number` of machines migrated at the same time"},
+			&cli.BoolFlag{Name: "with-local-disks", Usage: "enable live storage migration for all local disks"},
+			&cli.BoolFlag{Name: "create-disks", Usage: "create logical volumes in the same group on the destination server"},
+		},
+		migrationTuningFlags()...,
+	),
+	Action: func(ctx context.Context, c *cli.Command) error {
+		return grpc_client.CommandGRPC(ctx, c, client.EvacuationProcessStart)
+	},
+}
+
+var CommandEvacuationProcessShowStatus = &cli.Command{
+	Name:     "status",
+	Usage:    "check the progress of an evacuation",
+	HideHelp: true,
+	Action: func(ctx context.Context, c *cli.Command) error {
+		return grpc_client.CommandGRPC(ctx, c, client.EvacuationProcessShowStatus)
+	},
+}
+
+var CommandEvacuationProcessCancel = &cli.Command{
+	Name:     "cancel",
+	Usage:    "cancel a running evacuation process (the host remains in drain mode)",
+	HideHelp: true,
+	Action: func(ctx context.Context, c *cli.Command) error {
+		return grpc_client.CommandGRPC(ctx, c, client.EvacuationProcessCancel)
+	},
+}
@@ -0,0 +1,139 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/0xef53/kvmrun/client"
+
+	grpc_client "github.com/0xef53/kvmrun/client/grpcclient"
+
+	cli "github.com/urfave/cli/v3"
+)
+
+var GuestAgentCommands = &cli.Command{
+	Name:     "guest",
+	Usage:    "interact with a guest using the QEMU guest agent",
+	HideHelp: true,
+	Category: "Control",
+	Commands: []*cli.Command{
+		cmdGuestAgentPing,
+		cmdGuestAgentInfo,
+		cmdGuestAgentOSInfo,
+		cmdGuestAgentInterfaces,
+		cmdGuestAgentFSInfo,
+		cmdGuestAgentSetPassword,
+		cmdGuestAgentExec,
+		cmdGuestAgentExecStatus,
+	},
+}
+
+var guestAgentTimeoutFlag = &cli.UintFlag{Name: "timeout", Aliases: []string{"t"}, DefaultText: "10 (60 for exec)", Usage: "wait up to a given `seconds` for the agent response"}
+
+var cmdGuestAgentPing = &cli.Command{
+	Name:      "ping",
+	Usage:     "check whether the guest agent is responding",
+	ArgsUsage: "VMNAME",
+	HideHelp:  true,
+	Flags: []cli.Flag{
+		guestAgentTimeoutFlag,
+	},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		return grpc_client.CommandGRPC(ctx, c, client.GuestAgentPing)
+	},
+}
+
+var cmdGuestAgentInfo = &cli.Command{
+	Name:      "info",
+	Usage:     "print the guest agent version and supported commands",
+	ArgsUsage: "VMNAME",
+	HideHelp:  true,
+	Flags: []cli.Flag{
+		guestAgentTimeoutFlag,
+	},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		return grpc_client.CommandGRPC(ctx, c, client.GuestAgentInfo)
+	},
+}
+
+var cmdGuestAgentOSInfo = &cli.Command{
+	Name:      "osinfo",
+	Usage:     "print the guest operating system information",
+	ArgsUsage: "VMNAME",
+	HideHelp:  true,
+	Flags: []cli.Flag{
+		guestAgentTimeoutFlag,
+	},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		return grpc_client.CommandGRPC(ctx, c, client.GuestAgentOSInfo)
+	},
+}
+
+var cmdGuestAgentInterfaces = &cli.Command{
+	Name:      "interfaces",
+	Usage:     "print the guest network interfaces",
+	ArgsUsage: "VMNAME",
+	HideHelp:  true,
+	Flags: []cli.Flag{
+		guestAgentTimeoutFlag,
+	},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		return grpc_client.CommandGRPC(ctx, c, client.GuestAgentInterfaces)
+	},
+}
+
+var cmdGuestAgentFSInfo = &cli.Command{
+	Name:      "fsinfo",
+	Usage:     "print the mounted guest filesystems",
+	ArgsUsage: "VMNAME",
+	HideHelp:  true,
+	Flags: []cli.Flag{
+		guestAgentTimeoutFlag,
+	},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		return grpc_client.CommandGRPC(ctx, c, client.GuestAgentFSInfo)
+	},
+}
+
+var cmdGuestAgentSetPassword = &cli.Command{
+	Name:      "set-password",
+	Usage:     "set a password for the guest user account",
+	ArgsUsage: "VMNAME USERNAME",
+	HideHelp:  true,
+	Flags: []cli.Flag{
+		guestAgentTimeoutFlag,
+		&cli.StringFlag{Name: "password", Aliases: []string{"p"}, DefaultText: "read from stdin", Usage: "new `secret` passphrase"},
+		&cli.BoolFlag{Name: "crypted", Usage: "the password is already hashed (e.g. by crypt)"},
+	},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		return grpc_client.CommandGRPC(ctx, c, client.GuestAgentSetPassword)
+	},
+}
+
+var cmdGuestAgentExec = &cli.Command{
+	Name:      "exec",
+	Usage:     "run a command in the guest and print its output",
+	ArgsUsage: "VMNAME PATH [ARGS...]",
+	HideHelp:  true,
+	Flags: []cli.Flag{
+		guestAgentTimeoutFlag,
+		&cli.StringSliceFlag{Name: "env", Aliases: []string{"e"}, Usage: "set an environment `variable` (NAME=VALUE)"},
+		&cli.BoolFlag{Name: "stdin", Aliases: []string{"i"}, Usage: "pass the local stdin to the command"},
+		&cli.BoolFlag{Name: "no-wait", Usage: "do not wait for the command to exit, just print its PID"},
+	},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		return grpc_client.CommandGRPC(ctx, c, client.GuestAgentExec)
+	},
+}
+
+var cmdGuestAgentExecStatus = &cli.Command{
+	Name:      "exec-status",
+	Usage:     "print the status and output of a command started with --no-wait",
+	ArgsUsage: "VMNAME PID",
+	HideHelp:  true,
+	Flags: []cli.Flag{
+		guestAgentTimeoutFlag,
+	},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		return grpc_client.CommandGRPC(ctx, c, client.GuestAgentExecStatus)
+	},
+}
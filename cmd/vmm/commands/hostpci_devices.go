@@ -32,6 +32,7 @@ var CommandHostDeviceAttach = &cli.Command{
 		&cli.GenericFlag{Name: "multifunction", Value: flag_types.NewStringBool(), Usage: "enable multifunction capability (on/off)"},
 		&cli.GenericFlag{Name: "primary-gpu", Value: flag_types.NewStringBool(), Usage: "use as primary GPU instead of standard Cirrus video card (on/off)"},
 		&cli.BoolFlag{Name: "no-check-device", Usage: "don't check device accessibility"},
+		&cli.BoolFlag{Name: "live", Usage: "affect running machine"},
 	},
 	Action: func(ctx context.Context, c *cli.Command) error {
 		return grpc_client.CommandGRPC(ctx, c, client.MachineHostDeviceAttach)
@@ -43,6 +44,9 @@ var CommandDeviceDetach = &cli.Command{
 	Usage:     "detach an existing host PCI device",
 	ArgsUsage: "VMNAME PCIADDR",
 	HideHelp:  true,
+	Flags: []cli.Flag{
+		&cli.BoolFlag{Name: "live", Usage: "affect running machine"},
+	},
 	Action: func(ctx context.Context, c *cli.Command) error {
 		return grpc_client.CommandGRPC(ctx, c, client.MachineHostDeviceDetach)
 	},
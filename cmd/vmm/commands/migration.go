@@ -18,8 +18,12 @@ var MigrationCommands = &cli.Command{
 	Category: "Migration & Backup",
 	Commands: []*cli.Command{
 		CommandMigrationProcessStart,
+		CommandMigrationProcessCheck,
 		CommandMigrationProcessShowStatus,
 		CommandMigrationProcessCancel,
+		CommandMigrationProcessPause,
+		CommandMigrationProcessResume,
+		CommandMigrationProcessSetSpeed,
 	},
 }
 
@@ -28,18 +32,59 @@ var CommandMigrationProcessStart = &cli.Command{
 	Usage:     "start migration of the virtual machine to another host",
 	ArgsUsage: "VMNAME DSTSERVER",
 	HideHelp:  true,
-	Flags: []cli.Flag{
-		&cli.BoolFlag{Name: "watch", Aliases: []string{"w"}, Usage: "watch the migration process"},
+	Flags: append(
+		[]cli.Flag{
+			&cli.BoolFlag{Name: "watch", Aliases: []string{"w"}, Usage: "watch the migration process"},
+		},
+		migrationFlags()...,
+	),
+	Action: func(ctx context.Context, c *cli.Command) error {
+		return grpc_client.CommandGRPC(ctx, c, client.MigrationProcessStart)
+	},
+}
+
+var CommandMigrationProcessCheck = &cli.Command{
+	Name:      "check",
+	Usage:     "check if the virtual machine can be migrated to another host (nothing is started)",
+	ArgsUsage: "VMNAME DSTSERVER",
+	HideHelp:  true,
+	Flags:     migrationFlags(),
+	Action: func(ctx context.Context, c *cli.Command) error {
+		return grpc_client.CommandGRPC(ctx, c, client.MigrationProcessCheck)
+	},
+}
+
+// migrationFlags returns a set of flags common
+// to the "start" and "check" commands.
+func migrationFlags() []cli.Flag {
+	return append([]cli.Flag{
 		&cli.BoolFlag{Name: "with-local-disks", Usage: "enable live storage migration for all local disks (conflicts with --with-disk)"},
 		&cli.StringSliceFlag{Name: "with-disk", Usage: "enable live storage migration for specified disks (conflicts with --with-local-disks)"},
 		&cli.StringFlag{Name: "override-name", Value: "", DefaultText: "not set", Usage: "override machine name on the destination server"},
 		&cli.GenericFlag{Name: "override-disk", Value: flag_types.NewStringMap(":"), DefaultText: "not set", Usage: "override disk path/name on the destination server"},
 		&cli.GenericFlag{Name: "override-net", Value: flag_types.NewStringMap(":"), DefaultText: "not set", Usage: "override net interface name on the destination server"},
 		&cli.BoolFlag{Name: "create-disks", Usage: "create logical volumes in the same group on the destination server"},
-	},
-	Action: func(ctx context.Context, c *cli.Command) error {
-		return grpc_client.CommandGRPC(ctx, c, client.MigrationProcessStart)
-	},
+	}, migrationTuningFlags()...)
+}
+
+// migrationTuningFlags returns a set of flags that control
+// the migration process itself. They are also used for the host evacuation.
+func migrationTuningFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.BoolFlag{Name: "tls", DefaultText: "from kvmrun.ini", Usage: "encrypt the machine state stream using TLS (use --tls=false to disable)"},
+		&cli.IntFlag{Name: "max-bandwidth", DefaultText: "8192", Usage: "maximum migration speed in `MiB` per second"},
+		&cli.IntFlag{Name: "downtime-limit", DefaultText: "from QEMU", Usage: "maximum tolerated downtime in `milliseconds`"},
+		&cli.IntFlag{Name: "multifd", DefaultText: "not set", Usage: "transfer the machine state using the specified `number` of parallel channels"},
+		&cli.StringFlag{Name: "compression", DefaultText: "not set", Usage: "compression `method` for multifd channels (zlib, zstd, ...)"},
+		&cli.BoolFlag{Name: "no-xbzrle", Usage: "disable XBZRLE page compression (always disabled with --multifd)"},
+		&cli.IntFlag{Name: "xbzrle-cache-size", DefaultText: "512", Usage: "XBZRLE cache size in `MiB`"},
+		&cli.BoolFlag{Name: "no-auto-converge", Usage: "disable auto-converge CPU throttling"},
+		&cli.IntFlag{Name: "cpu-throttle-initial", DefaultText: "from QEMU", Usage: "initial CPU throttling in `percent` when auto-converge is triggered"},
+		&cli.IntFlag{Name: "cpu-throttle-increment", DefaultText: "from QEMU", Usage: "CPU throttling increment in `percent` on each iteration"},
+		&cli.BoolFlag{Name: "postcopy", Usage: "switch to the post-copy mode if the migration does not converge (not compatible with storage migration)"},
+		&cli.IntFlag{Name: "postcopy-after-passes", DefaultText: "1", Usage: "switch to the post-copy mode after the specified `number` of passes over RAM"},
+		&cli.IntFlag{Name: "postcopy-after-seconds", DefaultText: "not set", Usage: "switch to the post-copy mode after the specified number of `seconds`"},
+	}
 }
 
 var CommandMigrationProcessShowStatus = &cli.Command{
@@ -61,3 +106,36 @@ var CommandMigrationProcessCancel = &cli.Command{
 		return grpc_client.CommandGRPC(ctx, c, client.MigrationProcessCancel)
 	},
 }
+
+var CommandMigrationProcessPause = &cli.Command{
+	Name:      "pause",
+	Usage:     "pause the disks mirroring of a running migration process",
+	ArgsUsage: "VMNAME",
+	HideHelp:  true,
+	Action: func(ctx context.Context, c *cli.Command) error {
+		return grpc_client.CommandGRPC(ctx, c, client.MigrationProcessPause)
+	},
+}
+
+var CommandMigrationProcessResume = &cli.Command{
+	Name:      "resume",
+	Usage:     "resume the paused disks mirroring of a migration process",
+	ArgsUsage: "VMNAME",
+	HideHelp:  true,
+	Action: func(ctx context.Context, c *cli.Command) error {
+		return grpc_client.CommandGRPC(ctx, c, client.MigrationProcessResume)
+	},
+}
+
+var CommandMigrationProcessSetSpeed = &cli.Command{
+	Name:      "set-speed",
+	Usage:     "change the speed limit of the disks mirroring of a running migration process",
+	ArgsUsage: "VMNAME",
+	HideHelp:  true,
+	Flags: []cli.Flag{
+		&cli.IntFlag{Name: "speed", Required: true, Usage: "maximum mirroring speed of each disk in `MiB` per second (0 means unlimited)"},
+	},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		return grpc_client.CommandGRPC(ctx, c, client.MigrationProcessSetSpeed)
+	},
+}
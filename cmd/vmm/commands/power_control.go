@@ -18,6 +18,7 @@ var CommandStart = &cli.Command{
 	Category:  "Control",
 	Flags: []cli.Flag{
 		&cli.UintFlag{Name: "wait", Aliases: []string{"w"}, Usage: "wait up to a given `seconds` for the command to take effect"},
+		&cli.BoolFlag{Name: "discard-saved-state", Usage: "remove the state saved by the suspend command and boot from scratch"},
 	},
 	Action: func(ctx context.Context, c *cli.Command) error {
 		return grpc_client.CommandGRPC(ctx, c, client.MachineStart)
@@ -63,3 +64,39 @@ var CommandReset = &cli.Command{
 		return grpc_client.CommandGRPC(ctx, c, client.MachineReset)
 	},
 }
+
+var CommandPause = &cli.Command{
+	Name:      "pause",
+	Usage:     "pause a running virtual machine (emulation is stopped, QEMU process remains)",
+	ArgsUsage: "VMNAME",
+	HideHelp:  true,
+	Category:  "Control",
+	Action: func(ctx context.Context, c *cli.Command) error {
+		return grpc_client.CommandGRPC(ctx, c, client.MachinePause)
+	},
+}
+
+var CommandResume = &cli.Command{
+	Name:      "resume",
+	Usage:     "resume a paused virtual machine",
+	ArgsUsage: "VMNAME",
+	HideHelp:  true,
+	Category:  "Control",
+	Action: func(ctx context.Context, c *cli.Command) error {
+		return grpc_client.CommandGRPC(ctx, c, client.MachineResume)
+	},
+}
+
+var CommandSuspend = &cli.Command{
+	Name:      "suspend",
+	Usage:     "save the state of a running virtual machine to a file and stop it (the state is restored by the start command)",
+	ArgsUsage: "VMNAME",
+	HideHelp:  true,
+	Category:  "Control",
+	Flags: []cli.Flag{
+		&cli.BoolFlag{Name: "watch", Aliases: []string{"w"}, Usage: "watch the process of saving the state"},
+	},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		return grpc_client.CommandGRPC(ctx, c, client.MachineSuspend)
+	},
+}
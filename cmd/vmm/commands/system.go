@@ -19,6 +19,8 @@ var SystemCommands = &cli.Command{
 	Commands: []*cli.Command{
 		CommandPrintTasks,
 		CommandPrintPCI,
+		CommandHostDrain,
+		CommandHostUndrain,
 	},
 }
 
@@ -39,3 +41,21 @@ var CommandPrintPCI = &cli.Command{
 		return grpc_client.CommandGRPC(ctx, c, client.PCI_PrintList)
 	},
 }
+
+var CommandHostDrain = &cli.Command{
+	Name:     "drain",
+	Usage:    "refuse to start new machines and to accept incoming migrations",
+	HideHelp: true,
+	Action: func(ctx context.Context, c *cli.Command) error {
+		return grpc_client.CommandGRPC(ctx, c, client.HostSetDrainMode)
+	},
+}
+
+var CommandHostUndrain = &cli.Command{
+	Name:     "undrain",
+	Usage:    "switch the host back to normal mode",
+	HideHelp: true,
+	Action: func(ctx context.Context, c *cli.Command) error {
+		return grpc_client.CommandGRPC(ctx, c, client.HostUnsetDrainMode)
+	},
+}
@@ -74,9 +74,15 @@ func main() {
 		commands.CommandStop,
 		commands.CommandRestart,
 		commands.CommandReset,
+		commands.CommandPause,
+		commands.CommandResume,
+		commands.CommandSuspend,
+		commands.GuestAgentCommands,
 		// migration & backup actions
 		commands.BackupCommands,
 		commands.MigrationCommands,
+		commands.EvacuationCommands,
+		commands.BlockJobCommands,
 		// other actions
 		{
 			Name:     "version",
@@ -13,15 +13,26 @@ import (
 	"gopkg.in/gcfg.v1"
 )
 
+// MachineCertUnit is the organizational unit of the certificates
+// issued for the virtual machines. Such certificates are used only
+// by QEMU and are not accepted by kvmrund and its clients.
+const MachineCertUnit = "Kvmrun Machines"
+
 type KvmrunConfig struct {
 	QemuRootDir string `gcfg:"qemu-rootdir"`
 	CertDir     string `gcfg:"cert-dir"`
 }
 
+type MigrationConfig struct {
+	// TLS enables the encryption of the machine state stream by default
+	TLS bool `gcfg:"tls"`
+}
+
 // Config represents the Kvmrun configuration
 type Config struct {
-	Kvmrun KvmrunConfig      `gcfg:"common"`
-	Server grpcserver.Config `gcfg:"server"`
+	Kvmrun    KvmrunConfig      `gcfg:"common"`
+	Server    grpcserver.Config `gcfg:"server"`
+	Migration MigrationConfig   `gcfg:"migration"`
 
 	TLSConfig *tls.Config `gcfg:"-"`
 
@@ -132,5 +143,22 @@ func tlsConfig(certFile, keyFile string) (*tls.Config, error) {
 		PreferServerCipherSuites: true,
 		ClientSessionCache:       tls.NewLRUClientSessionCache(0),
 		NextProtos:               []string{"h2", "http/1.1"},
+		VerifyConnection:         rejectMachineCert,
 	}, nil
 }
+
+// rejectMachineCert does not allow the peers to use the certificates
+// of the virtual machines, since their keys are available to QEMU.
+func rejectMachineCert(cs tls.ConnectionState) error {
+	if len(cs.PeerCertificates) == 0 {
+		return nil
+	}
+
+	for _, v := range cs.PeerCertificates[0].Subject.OrganizationalUnit {
+		if v == MachineCertUnit {
+			return fmt.Errorf("certificate of the virtual machine is not allowed: %s", cs.PeerCertificates[0].Subject.CommonName)
+		}
+	}
+
+	return nil
+}
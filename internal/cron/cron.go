@@ -0,0 +1,198 @@
+package cron
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrInvalidExpression = errors.New("invalid cron expression")
+
+var aliases = map[string]string{
+	"@hourly":  "0 * * * *",
+	"@daily":   "0 0 * * *",
+	"@weekly":  "0 0 * * 0",
+	"@monthly": "0 0 1 * *",
+	"@yearly":  "0 0 1 1 *",
+}
+
+type field struct {
+	name     string
+	min, max int
+}
+
+var fields = []field{
+	{"minute", 0, 59},
+	{"hour", 0, 23},
+	{"day of month", 1, 31},
+	{"month", 1, 12},
+	{"day of week", 0, 7},
+}
+
+// Schedule is a parsed cron expression in the standard five-field format:
+// minute, hour, day of month, month and day of week.
+type Schedule struct {
+	minute uint64
+	hour   uint64
+	dom    uint64
+	month  uint64
+	dow    uint64
+
+	// The day of month / day of week field is "*"
+	domStar bool
+	dowStar bool
+
+	expr string
+}
+
+// Parse parses the cron expression. Each field can be "*",
+// a number, a range ("1-5"), a list ("1,3,5") and can have
+// a step ("*/15", "0-30/10"). The aliases @hourly, @daily,
+// @weekly, @monthly and @yearly are also supported.
+func Parse(expr string) (*Schedule, error) {
+	expr = strings.TrimSpace(expr)
+
+	s := Schedule{expr: expr}
+
+	if v, ok := aliases[expr]; ok {
+		expr = v
+	}
+
+	parts := strings.Fields(expr)
+
+	if len(parts) != len(fields) {
+		return nil, fmt.Errorf("%w: expected %d fields: %q", ErrInvalidExpression, len(fields), s.expr)
+	}
+
+	bits := make([]uint64, len(fields))
+
+	for idx, part := range parts {
+		v, err := parseField(part, fields[idx])
+		if err != nil {
+			return nil, err
+		}
+
+		bits[idx] = v
+	}
+
+	s.minute, s.hour, s.dom, s.month, s.dow = bits[0], bits[1], bits[2], bits[3], bits[4]
+
+	// Both 0 and 7 mean Sunday
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1
+	}
+
+	s.domStar = strings.HasPrefix(parts[2], "*")
+	s.dowStar = strings.HasPrefix(parts[4], "*")
+
+	return &s, nil
+}
+
+func parseField(s string, f field) (uint64, error) {
+	var bits uint64
+
+	for _, item := range strings.Split(s, ",") {
+		rng, step := item, 1
+
+		if idx := strings.Index(item, "/"); idx != -1 {
+			v, err := strconv.Atoi(item[idx+1:])
+			if err != nil || v <= 0 {
+				return 0, fmt.Errorf("%w: invalid step in the %s field: %q", ErrInvalidExpression, f.name, item)
+			}
+
+			rng, step = item[:idx], v
+		}
+
+		var first, last int
+
+		switch {
+		case rng == "*":
+			first, last = f.min, f.max
+		case strings.Contains(rng, "-"):
+			ff := strings.SplitN(rng, "-", 2)
+
+			a, err1 := strconv.Atoi(ff[0])
+			b, err2 := strconv.Atoi(ff[1])
+
+			if err1 != nil || err2 != nil {
+				return 0, fmt.Errorf("%w: invalid range in the %s field: %q", ErrInvalidExpression, f.name, item)
+			}
+
+			first, last = a, b
+		default:
+			v, err := strconv.Atoi(rng)
+			if err != nil {
+				return 0, fmt.Errorf("%w: invalid value in the %s field: %q", ErrInvalidExpression, f.name, item)
+			}
+
+			first, last = v, v
+
+			// "5/10" means "5-max/10"
+			if step > 1 {
+				last = f.max
+			}
+		}
+
+		if first < f.min || last > f.max || first > last {
+			return 0, fmt.Errorf("%w: value out of range (%d-%d) in the %s field: %q", ErrInvalidExpression, f.min, f.max, f.name, item)
+		}
+
+		for i := first; i <= last; i += step {
+			bits |= 1 << uint(i)
+		}
+	}
+
+	return bits, nil
+}
+
+func (s *Schedule) String() string {
+	return s.expr
+}
+
+func (s *Schedule) dayMatch(t time.Time) bool {
+	dom := s.dom&(1<<uint(t.Day())) != 0
+	dow := s.dow&(1<<uint(t.Weekday())) != 0
+
+	// If both fields are restricted, the day matches either of them
+	if !s.domStar && !s.dowStar {
+		return dom || dow
+	}
+
+	return dom && dow
+}
+
+// Match reports whether the schedule fires at the minute of t.
+func (s *Schedule) Match(t time.Time) bool {
+	return s.minute&(1<<uint(t.Minute())) != 0 &&
+		s.hour&(1<<uint(t.Hour())) != 0 &&
+		s.month&(1<<uint(t.Month())) != 0 &&
+		s.dayMatch(t)
+}
+
+// Next returns the first time after t when the schedule fires.
+// The zero time is returned if there is no such time
+// within the next five years (e.g. "0 0 30 2 *").
+func (s *Schedule) Next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+
+	limit := t.AddDate(5, 0, 0)
+
+	for t.Before(limit) {
+		switch {
+		case s.month&(1<<uint(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+		case !s.dayMatch(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+		case s.hour&(1<<uint(t.Hour())) == 0:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+		case s.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+
+	return time.Time{}
+}
@@ -0,0 +1,71 @@
+package cron
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseBadValues(t *testing.T) {
+	badValues := []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"a * * * *",
+		"@never",
+	}
+
+	for _, v := range badValues {
+		if _, err := Parse(v); !errors.Is(err, ErrInvalidExpression) {
+			t.Fatalf("got unexpected error (orig value = %q):\nwant error:\tErrInvalidExpression\ngot error:\t%v", v, err)
+		}
+	}
+}
+
+func TestNext(t *testing.T) {
+	// Friday
+	start := time.Date(2024, time.March, 15, 10, 30, 0, 0, time.UTC)
+
+	tests := map[string]time.Time{
+		"* * * * *":         time.Date(2024, time.March, 15, 10, 31, 0, 0, time.UTC),
+		"*/15 * * * *":      time.Date(2024, time.March, 15, 10, 45, 0, 0, time.UTC),
+		"0 3 * * *":         time.Date(2024, time.March, 16, 3, 0, 0, 0, time.UTC),
+		"@daily":            time.Date(2024, time.March, 16, 0, 0, 0, 0, time.UTC),
+		"30 10 * * *":       time.Date(2024, time.March, 16, 10, 30, 0, 0, time.UTC),
+		"0 0 * * 0":         time.Date(2024, time.March, 17, 0, 0, 0, 0, time.UTC),
+		"0 0 * * 7":         time.Date(2024, time.March, 17, 0, 0, 0, 0, time.UTC),
+		"0 12 * * 1-5":      time.Date(2024, time.March, 15, 12, 0, 0, 0, time.UTC),
+		"0 0 1 * *":         time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC),
+		"0 0 29 2 *":        time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC),
+		"0 0 20 * 1":        time.Date(2024, time.March, 18, 0, 0, 0, 0, time.UTC),
+		"15,45 1-3/2 * * *": time.Date(2024, time.March, 16, 1, 15, 0, 0, time.UTC),
+	}
+
+	for expr, want := range tests {
+		s, err := Parse(expr)
+		if err != nil {
+			t.Fatalf("got unexpected error (orig value = %q):\nerror:\t%v", expr, err)
+		}
+
+		if got := s.Next(start); !got.Equal(want) {
+			t.Fatalf("got invalid result (orig value = %q):\nwant:\t%s\ngot:\t%s", expr, want, got)
+		}
+
+		if !s.Match(want) {
+			t.Fatalf("schedule does not match its next time (orig value = %q): %s", expr, want)
+		}
+	}
+
+	s, _ := Parse("0 0 30 2 *")
+
+	if got := s.Next(start); !got.IsZero() {
+		t.Fatalf("got invalid result for an impossible date: %s", got)
+	}
+}
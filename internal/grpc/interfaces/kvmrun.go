@@ -1,7 +1,9 @@
 package interfaces
 
 import (
+	pb_blockjobs "github.com/0xef53/kvmrun/api/services/blockjobs/v2"
 	pb_cloudinit "github.com/0xef53/kvmrun/api/services/cloudinit/v2"
+	pb_guestagent "github.com/0xef53/kvmrun/api/services/guestagent/v2"
 	pb_hardware "github.com/0xef53/kvmrun/api/services/hardware/v2"
 	pb_machines "github.com/0xef53/kvmrun/api/services/machines/v2"
 	pb_network "github.com/0xef53/kvmrun/api/services/network/v2"
@@ -12,22 +14,26 @@ import (
 )
 
 type Kvmrun struct {
-	Client_Machines  pb_machines.MachineServiceClient
-	Client_System    pb_system.SystemServiceClient
-	Client_Network   pb_network.NetworkServiceClient
-	Client_Tasks     pb_tasks.TaskServiceClient
-	Client_CloudInit pb_cloudinit.CloudInitServiceClient
-	Client_Hardware  pb_hardware.HardwareServiceClient
+	Client_Machines   pb_machines.MachineServiceClient
+	Client_System     pb_system.SystemServiceClient
+	Client_Network    pb_network.NetworkServiceClient
+	Client_Tasks      pb_tasks.TaskServiceClient
+	Client_CloudInit  pb_cloudinit.CloudInitServiceClient
+	Client_Hardware   pb_hardware.HardwareServiceClient
+	Client_GuestAgent pb_guestagent.GuestAgentServiceClient
+	Client_BlockJobs  pb_blockjobs.BlockJobServiceClient
 }
 
 func NewKvmrunInterface(conn *grpc.ClientConn) *Kvmrun {
 	return &Kvmrun{
-		Client_Machines:  pb_machines.NewMachineServiceClient(conn),
-		Client_System:    pb_system.NewSystemServiceClient(conn),
-		Client_Network:   pb_network.NewNetworkServiceClient(conn),
-		Client_Tasks:     pb_tasks.NewTaskServiceClient(conn),
-		Client_CloudInit: pb_cloudinit.NewCloudInitServiceClient(conn),
-		Client_Hardware:  pb_hardware.NewHardwareServiceClient(conn),
+		Client_Machines:   pb_machines.NewMachineServiceClient(conn),
+		Client_System:     pb_system.NewSystemServiceClient(conn),
+		Client_Network:    pb_network.NewNetworkServiceClient(conn),
+		Client_Tasks:      pb_tasks.NewTaskServiceClient(conn),
+		Client_CloudInit:  pb_cloudinit.NewCloudInitServiceClient(conn),
+		Client_Hardware:   pb_hardware.NewHardwareServiceClient(conn),
+		Client_GuestAgent: pb_guestagent.NewGuestAgentServiceClient(conn),
+		Client_BlockJobs:  pb_blockjobs.NewBlockJobServiceClient(conn),
 	}
 }
 
@@ -54,3 +60,11 @@ func (k *Kvmrun) CloudInit() pb_cloudinit.CloudInitServiceClient {
 func (k *Kvmrun) Hardware() pb_hardware.HardwareServiceClient {
 	return k.Client_Hardware
 }
+
+func (k *Kvmrun) GuestAgent() pb_guestagent.GuestAgentServiceClient {
+	return k.Client_GuestAgent
+}
+
+func (k *Kvmrun) BlockJobs() pb_blockjobs.BlockJobServiceClient {
+	return k.Client_BlockJobs
+}
@@ -0,0 +1,298 @@
+package guestagent
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"sync"
+	"time"
+)
+
+// delimiter is a special byte that is used to reset
+// the agent's parser and to mark the beginning of the sync response.
+const delimiter = 0xFF
+
+var ErrNotResponding = errors.New("guest agent is not responding")
+
+// Error represents an error returned by the guest agent.
+type Error struct {
+	Class string `json:"class"`
+	Desc  string `json:"desc"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("guest agent error: %s: %s", e.Class, e.Desc)
+}
+
+type command struct {
+	Execute   string      `json:"execute"`
+	Arguments interface{} `json:"arguments,omitempty"`
+}
+
+type response struct {
+	Return *json.RawMessage `json:"return"`
+	Error  *Error           `json:"error"`
+}
+
+// Client is a connection to the QEMU guest agent.
+//
+// The agent serves only one client at a time,
+// so the connection should be closed as soon as possible.
+type Client struct {
+	mu sync.Mutex
+
+	conn net.Conn
+	dec  *json.Decoder
+}
+
+// Dial connects to the agent socket and synchronizes the protocol state.
+func Dial(ctx context.Context, path string) (*Client, error) {
+	var d net.Dialer
+
+	conn, err := d.DialContext(ctx, "unix", path)
+	if err != nil {
+		return nil, err
+	}
+
+	c := Client{conn: conn}
+
+	if err := c.sync(ctx); err != nil {
+		conn.Close()
+
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+// Close closes the connection.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+func (c *Client) setDeadline(ctx context.Context) {
+	if deadline, ok := ctx.Deadline(); ok {
+		c.conn.SetDeadline(deadline)
+	} else {
+		c.conn.SetDeadline(time.Time{})
+	}
+}
+
+// sync discards any stale data in the channel using
+// the guest-sync-delimited command. If the agent is not running,
+// the function blocks until the context is closed.
+func (c *Client) sync(ctx context.Context) error {
+	c.setDeadline(ctx)
+
+	id := rand.Int63n(1 << 31)
+
+	b, err := json.Marshal(&command{Execute: "guest-sync-delimited", Arguments: map[string]int64{"id": id}})
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.conn.Write(append([]byte{delimiter}, b...)); err != nil {
+		return err
+	}
+
+	reader := bufio.NewReader(c.conn)
+
+	for {
+		if _, err := reader.ReadBytes(delimiter); err != nil {
+			return fmt.Errorf("%w: sync failed: %w", ErrNotResponding, err)
+		}
+
+		dec := json.NewDecoder(reader)
+
+		var resp struct {
+			Return int64 `json:"return"`
+		}
+
+		if err := dec.Decode(&resp); err != nil {
+			return fmt.Errorf("%w: sync failed: %w", ErrNotResponding, err)
+		}
+
+		if resp.Return == id {
+			c.dec = dec
+
+			return nil
+		}
+	}
+}
+
+// Run executes a guest agent command and stores the result in the value pointed to by res.
+func (c *Client) Run(ctx context.Context, name string, args interface{}, res interface{}) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.setDeadline(ctx)
+
+	b, err := json.Marshal(&command{Execute: name, Arguments: args})
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.conn.Write(b); err != nil {
+		return wrapTimeout(err)
+	}
+
+	var resp response
+
+	if err := c.dec.Decode(&resp); err != nil {
+		return wrapTimeout(err)
+	}
+
+	if resp.Error != nil {
+		return resp.Error
+	}
+
+	if res == nil || resp.Return == nil {
+		return nil
+	}
+
+	return json.Unmarshal(*resp.Return, res)
+}
+
+func wrapTimeout(err error) error {
+	if e, ok := err.(net.Error); ok && e.Timeout() {
+		return fmt.Errorf("%w: %w", ErrNotResponding, err)
+	}
+
+	return err
+}
+
+// Ping checks whether the guest agent is responding.
+func (c *Client) Ping(ctx context.Context) error {
+	return c.Run(ctx, "guest-ping", nil, nil)
+}
+
+// FSFreeze freezes all freezable guest filesystems and returns
+// the number of frozen filesystems.
+func (c *Client) FSFreeze(ctx context.Context) (int, error) {
+	var n int
+
+	if err := c.Run(ctx, "guest-fsfreeze-freeze", nil, &n); err != nil {
+		return 0, err
+	}
+
+	return n, nil
+}
+
+// FSThaw unfreezes all frozen guest filesystems and returns
+// the number of thawed filesystems.
+func (c *Client) FSThaw(ctx context.Context) (int, error) {
+	var n int
+
+	if err := c.Run(ctx, "guest-fsfreeze-thaw", nil, &n); err != nil {
+		return 0, err
+	}
+
+	return n, nil
+}
+
+// FSFreezeStatus returns the freeze status of the guest filesystems:
+// "thawed" or "frozen".
+func (c *Client) FSFreezeStatus(ctx context.Context) (string, error) {
+	var status string
+
+	if err := c.Run(ctx, "guest-fsfreeze-status", nil, &status); err != nil {
+		return "", err
+	}
+
+	return status, nil
+}
+
+// Info returns the agent version and the list of supported commands.
+func (c *Client) Info(ctx context.Context) (*Info, error) {
+	var info Info
+
+	if err := c.Run(ctx, "guest-info", nil, &info); err != nil {
+		return nil, err
+	}
+
+	return &info, nil
+}
+
+// GetOSInfo returns the guest operating system information.
+func (c *Client) GetOSInfo(ctx context.Context) (*OSInfo, error) {
+	var info OSInfo
+
+	if err := c.Run(ctx, "guest-get-osinfo", nil, &info); err != nil {
+		return nil, err
+	}
+
+	return &info, nil
+}
+
+// NetworkGetInterfaces returns the list of guest network interfaces.
+func (c *Client) NetworkGetInterfaces(ctx context.Context) ([]*NetworkInterface, error) {
+	ifaces := make([]*NetworkInterface, 0)
+
+	if err := c.Run(ctx, "guest-network-get-interfaces", nil, &ifaces); err != nil {
+		return nil, err
+	}
+
+	return ifaces, nil
+}
+
+// GetFSInfo returns the list of mounted guest filesystems.
+func (c *Client) GetFSInfo(ctx context.Context) ([]*Filesystem, error) {
+	fslist := make([]*Filesystem, 0)
+
+	if err := c.Run(ctx, "guest-get-fsinfo", nil, &fslist); err != nil {
+		return nil, err
+	}
+
+	return fslist, nil
+}
+
+// SetUserPassword sets a new password for the guest user account.
+// If crypted is true, the password is expected to be already hashed.
+func (c *Client) SetUserPassword(ctx context.Context, username string, password []byte, crypted bool) error {
+	args := struct {
+		Username string `json:"username"`
+		Password []byte `json:"password"`
+		Crypted  bool   `json:"crypted"`
+	}{
+		Username: username,
+		Password: password,
+		Crypted:  crypted,
+	}
+
+	return c.Run(ctx, "guest-set-user-password", &args, nil)
+}
+
+// Exec starts a process in the guest and returns its PID.
+// The process status can be requested using ExecStatus.
+func (c *Client) Exec(ctx context.Context, opts *ExecOptions) (int, error) {
+	var res struct {
+		PID int `json:"pid"`
+	}
+
+	if err := c.Run(ctx, "guest-exec", opts, &res); err != nil {
+		return 0, err
+	}
+
+	return res.PID, nil
+}
+
+// ExecStatus returns the status of a process started by Exec.
+func (c *Client) ExecStatus(ctx context.Context, pid int) (*ExecStatus, error) {
+	args := struct {
+		PID int `json:"pid"`
+	}{
+		PID: pid,
+	}
+
+	var st ExecStatus
+
+	if err := c.Run(ctx, "guest-exec-status", &args, &st); err != nil {
+		return nil, err
+	}
+
+	return &st, nil
+}
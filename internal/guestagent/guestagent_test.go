@@ -0,0 +1,162 @@
+package guestagent
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// fakeAgent emulates the guest agent side of the protocol.
+// It sends a stale response before the sync reply to check
+// that the client discards it.
+func fakeAgent(t *testing.T, l net.Listener) {
+	conn, err := l.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	reader := bufio.NewReader(conn)
+
+	// Parser reset byte
+	if b, err := reader.ReadByte(); err != nil || b != delimiter {
+		t.Errorf("expected delimiter byte, got %x (%v)", b, err)
+
+		return
+	}
+
+	dec := json.NewDecoder(reader)
+
+	for {
+		var cmd struct {
+			Execute   string          `json:"execute"`
+			Arguments json.RawMessage `json:"arguments"`
+		}
+
+		if err := dec.Decode(&cmd); err != nil {
+			return
+		}
+
+		switch cmd.Execute {
+		case "guest-sync-delimited":
+			var args struct {
+				ID int64 `json:"id"`
+			}
+
+			json.Unmarshal(cmd.Arguments, &args)
+
+			fmt.Fprintf(conn, "{\"return\": 0}\n%s{\"return\": %d}\n", []byte{delimiter}, args.ID)
+		case "guest-fsfreeze-freeze":
+			fmt.Fprintf(conn, "{\"return\": 2}\n")
+		case "guest-fsfreeze-status":
+			fmt.Fprintf(conn, "{\"return\": \"frozen\"}\n")
+		case "guest-hang":
+			// Never replies
+		default:
+			fmt.Fprintf(conn, "{\"error\": {\"class\": \"CommandNotFound\", \"desc\": \"unknown command\"}}\n")
+		}
+	}
+}
+
+func TestClient(t *testing.T) {
+	sockpath := filepath.Join(t.TempDir(), "test.qga")
+
+	l, err := net.Listen("unix", sockpath)
+	if err != nil {
+		t.Fatalf("cannot listen: %s", err)
+	}
+	defer l.Close()
+
+	go fakeAgent(t, l)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	c, err := Dial(ctx, sockpath)
+	if err != nil {
+		t.Fatalf("got unexpected error: %s", err)
+	}
+	defer c.Close()
+
+	if n, err := c.FSFreeze(ctx); err != nil {
+		t.Fatalf("got unexpected error: %s", err)
+	} else if n != 2 {
+		t.Fatalf("got invalid result:\nwant:\t%d\ngot:\t%d", 2, n)
+	}
+
+	if s, err := c.FSFreezeStatus(ctx); err != nil {
+		t.Fatalf("got unexpected error: %s", err)
+	} else if s != "frozen" {
+		t.Fatalf("got invalid result:\nwant:\t%q\ngot:\t%q", "frozen", s)
+	}
+
+	var agentErr *Error
+
+	if err := c.Ping(ctx); !errors.As(err, &agentErr) {
+		t.Fatalf("got unexpected error:\nwant error:\t*Error\ngot error:\t%v", err)
+	} else if agentErr.Class != "CommandNotFound" {
+		t.Fatalf("got invalid error class:\nwant:\t%q\ngot:\t%q", "CommandNotFound", agentErr.Class)
+	}
+}
+
+func TestPoolRunUrgent(t *testing.T) {
+	sockdir := t.TempDir()
+
+	l, err := net.Listen("unix", filepath.Join(sockdir, "test.qga"))
+	if err != nil {
+		t.Fatalf("cannot listen: %s", err)
+	}
+	defer l.Close()
+
+	go func() {
+		for i := 0; i < 2; i++ {
+			fakeAgent(t, l)
+		}
+	}()
+
+	pool := NewPool(sockdir)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	started := make(chan struct{})
+	holderErr := make(chan error, 1)
+
+	// The holder keeps the connection until the context expires
+	go func() {
+		holderErr <- pool.Run(ctx, "test", func(c *Client) error {
+			close(started)
+
+			return c.Run(ctx, "guest-hang", nil, nil)
+		})
+	}()
+
+	<-started
+
+	urgentCtx, urgentCancel := context.WithTimeout(context.Background(), time.Second)
+	defer urgentCancel()
+
+	err = pool.RunUrgent(urgentCtx, "test", func(c *Client) error {
+		_, err := c.FSFreezeStatus(urgentCtx)
+
+		return err
+	})
+	if err != nil {
+		t.Fatalf("got unexpected error: %s", err)
+	}
+
+	if err := <-holderErr; err == nil {
+		t.Fatalf("expected the holder to be interrupted")
+	}
+
+	// The lock is removed when the last caller releases it
+	if n := len(pool.locks); n != 0 {
+		t.Fatalf("got unexpected number of locks: want 0, got %d", n)
+	}
+}
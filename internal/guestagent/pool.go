@@ -0,0 +1,153 @@
+package guestagent
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sync"
+)
+
+// Pool provides serialized access to the guest agents
+// of virtual machines by machine name.
+type Pool struct {
+	mu      sync.Mutex
+	sockdir string
+	locks   map[string]*agentLock
+}
+
+// agentLock controls access to the agent of one machine.
+// Regular callers queue up on the queue channel, so at most one of them
+// is waiting for the connection. This allows urgent callers to get
+// the connection right after the current holder releases it.
+type agentLock struct {
+	queue chan struct{}
+	conn  chan struct{}
+
+	// The number of callers using this lock.
+	// Protected by the mutex of the pool
+	users int
+
+	// The connection of the current holder
+	// and the number of waiting urgent callers
+	mu     sync.Mutex
+	client *Client
+	urgent int
+}
+
+func NewPool(sockdir string) *Pool {
+	return &Pool{
+		sockdir: sockdir,
+		locks:   make(map[string]*agentLock),
+	}
+}
+
+func (p *Pool) get(vmname string) *agentLock {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	l, ok := p.locks[vmname]
+	if !ok {
+		l = &agentLock{
+			queue: make(chan struct{}, 1),
+			conn:  make(chan struct{}, 1),
+		}
+		p.locks[vmname] = l
+	}
+
+	l.users++
+
+	return l
+}
+
+// put releases the lock obtained by get. The lock is removed
+// from the pool when the last caller releases it.
+func (p *Pool) put(vmname string, l *agentLock) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	l.users--
+
+	if l.users == 0 {
+		delete(p.locks, vmname)
+	}
+}
+
+func acquire(ctx context.Context, ch chan struct{}) error {
+	select {
+	case ch <- struct{}{}:
+	case <-ctx.Done():
+		return fmt.Errorf("%w: agent is busy: %w", ErrNotResponding, ctx.Err())
+	}
+
+	return nil
+}
+
+// Run connects to the guest agent of the specified machine
+// and calls fn with the established connection. Only one connection
+// to the agent of the same machine can exist at a time.
+// The context deadline applies to the whole operation.
+func (p *Pool) Run(ctx context.Context, vmname string, fn func(*Client) error) error {
+	l := p.get(vmname)
+	defer p.put(vmname, l)
+
+	if err := acquire(ctx, l.queue); err != nil {
+		return err
+	}
+	defer func() { <-l.queue }()
+
+	return p.run(ctx, vmname, l, false, fn)
+}
+
+// RunUrgent is like Run, but bypasses the queue of other callers
+// and interrupts the current holder of the connection by closing it.
+// It is intended for the commands that must not be delayed,
+// e.g. to thaw the guest filesystems.
+func (p *Pool) RunUrgent(ctx context.Context, vmname string, fn func(*Client) error) error {
+	l := p.get(vmname)
+	defer p.put(vmname, l)
+
+	l.mu.Lock()
+	l.urgent++
+	if l.client != nil {
+		l.client.Close()
+	}
+	l.mu.Unlock()
+
+	defer func() {
+		l.mu.Lock()
+		l.urgent--
+		l.mu.Unlock()
+	}()
+
+	return p.run(ctx, vmname, l, true, fn)
+}
+
+func (p *Pool) run(ctx context.Context, vmname string, l *agentLock, urgent bool, fn func(*Client) error) error {
+	if err := acquire(ctx, l.conn); err != nil {
+		return err
+	}
+	defer func() { <-l.conn }()
+
+	c, err := Dial(ctx, filepath.Join(p.sockdir, vmname+".qga"))
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	l.mu.Lock()
+	if l.urgent > 0 && !urgent {
+		l.mu.Unlock()
+
+		return fmt.Errorf("%w: connection was interrupted by an urgent command", ErrNotResponding)
+	}
+	l.client = c
+	l.mu.Unlock()
+
+	defer func() {
+		l.mu.Lock()
+		l.client = nil
+		l.mu.Unlock()
+	}()
+
+	return fn(c)
+}
@@ -0,0 +1,71 @@
+package guestagent
+
+// Info describes the guest agent and the commands it supports.
+type Info struct {
+	Version           string `json:"version"`
+	SupportedCommands []struct {
+		Name            string `json:"name"`
+		Enabled         bool   `json:"enabled"`
+		SuccessResponse bool   `json:"success-response"`
+	} `json:"supported_commands"`
+}
+
+// OSInfo is a result of the guest-get-osinfo command.
+type OSInfo struct {
+	KernelRelease string `json:"kernel-release"`
+	KernelVersion string `json:"kernel-version"`
+	Machine       string `json:"machine"`
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	PrettyName    string `json:"pretty-name"`
+	Version       string `json:"version"`
+	VersionID     string `json:"version-id"`
+	Variant       string `json:"variant"`
+	VariantID     string `json:"variant-id"`
+}
+
+// NetworkInterface is an element of the guest-network-get-interfaces result.
+type NetworkInterface struct {
+	Name            string `json:"name"`
+	HardwareAddress string `json:"hardware-address"`
+	IPAddresses     []struct {
+		Type    string `json:"ip-address-type"`
+		Address string `json:"ip-address"`
+		Prefix  int    `json:"prefix"`
+	} `json:"ip-addresses"`
+}
+
+// Filesystem is an element of the guest-get-fsinfo result.
+type Filesystem struct {
+	Name       string `json:"name"`
+	Mountpoint string `json:"mountpoint"`
+	Type       string `json:"type"`
+	UsedBytes  uint64 `json:"used-bytes"`
+	TotalBytes uint64 `json:"total-bytes"`
+	Disks      []struct {
+		BusType string `json:"bus-type"`
+		Dev     string `json:"dev"`
+		Serial  string `json:"serial"`
+	} `json:"disk"`
+}
+
+// ExecOptions is a set of parameters for the guest-exec command.
+type ExecOptions struct {
+	Path          string   `json:"path"`
+	Args          []string `json:"arg,omitempty"`
+	Env           []string `json:"env,omitempty"`
+	InputData     []byte   `json:"input-data,omitempty"`
+	CaptureOutput bool     `json:"capture-output,omitempty"`
+}
+
+// ExecStatus is a result of the guest-exec-status command.
+// The output data is decoded from base64 automatically.
+type ExecStatus struct {
+	Exited       bool   `json:"exited"`
+	ExitCode     int    `json:"exitcode"`
+	Signal       int    `json:"signal"`
+	OutData      []byte `json:"out-data"`
+	ErrData      []byte `json:"err-data"`
+	OutTruncated bool   `json:"out-truncated"`
+	ErrTruncated bool   `json:"err-truncated"`
+}
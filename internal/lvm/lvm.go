@@ -5,6 +5,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"golang.org/x/sys/unix"
@@ -81,3 +82,66 @@ func RemoveVolume(devpath string) error {
 
 	return nil
 }
+
+// ExtendVolume extends the logical volume to the specified size in bytes.
+// The size is rounded up to the extent size of the volume group.
+func ExtendVolume(devpath string, size uint64) error {
+	if !strings.HasPrefix(devpath, "/dev/") {
+		devpath = filepath.Join("/dev/", devpath)
+	}
+
+	var st unix.Stat_t
+
+	switch err := unix.Stat(devpath, &st); {
+	case err == nil:
+		if (st.Mode & unix.S_IFMT) != unix.S_IFBLK { // S_IFMT -- type of file
+			return fmt.Errorf("path exists but is not a block device: %s", devpath)
+		}
+	case os.IsNotExist(err):
+		return &os.PathError{Op: "lvextend", Path: devpath, Err: os.ErrNotExist}
+	default:
+		return err
+	}
+
+	out, err := exec.Command(
+		"/sbin/lvm",
+		"lvextend",
+		"--size", fmt.Sprintf("%dB", size),
+		devpath,
+	).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("lvextend failed (%s): %s", err, strings.TrimSpace(string(out)))
+	}
+
+	return nil
+}
+
+// VolumeGroupFree returns the free space of the volume group in bytes.
+func VolumeGroupFree(vgname string) (uint64, error) {
+	out, err := exec.Command(
+		"/sbin/lvm",
+		"vgs",
+		"--noheadings",
+		"--nosuffix",
+		"--units", "b",
+		"-o", "vg_free",
+		vgname,
+	).CombinedOutput()
+	if err != nil {
+		return 0, fmt.Errorf("vgs failed (%s): %s", err, strings.TrimSpace(string(out)))
+	}
+
+	return parseVolumeGroupFree(out)
+}
+
+func parseVolumeGroupFree(out []byte) (uint64, error) {
+	// The output may contain warnings printed before the value
+	lines := strings.Split(strings.TrimSpace(string(out)), "\n")
+
+	v, err := strconv.ParseUint(strings.TrimSpace(lines[len(lines)-1]), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("unexpected vgs output: %s", strings.TrimSpace(string(out)))
+	}
+
+	return v, nil
+}
@@ -0,0 +1,29 @@
+package lvm
+
+import (
+	"testing"
+)
+
+func TestParseVolumeGroupFree(t *testing.T) {
+	testCases := map[string]uint64{
+		"  107369988096\n": 107369988096,
+		"  WARNING: Not using device /dev/sdc for PV abc.\n  0\n": 0,
+	}
+
+	for out, want := range testCases {
+		got, err := parseVolumeGroupFree([]byte(out))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got != want {
+			t.Fatalf("got unexpected free space (out = %q): want %d, got %d", out, want, got)
+		}
+	}
+
+	for _, out := range []string{"", "  Volume group \"vg0\" not found\n"} {
+		if _, err := parseVolumeGroupFree([]byte(out)); err == nil {
+			t.Fatalf("expected an error for the output %q", out)
+		}
+	}
+}
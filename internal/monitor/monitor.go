@@ -102,6 +102,16 @@ func (p *Pool) RunTransaction(vmname string, cmds []qmp.Command, res interface{}
 	return m.RunTransaction(cmds, res, nil)
 }
 
+// Block jobs started by a grouped transaction succeed or fail together
+func (p *Pool) RunGroupedTransaction(vmname string, cmds []qmp.Command, res interface{}) error {
+	m, err := p.getMonitor(vmname)
+	if err != nil {
+		return err
+	}
+
+	return m.RunTransaction(cmds, res, &qmp.TransactionProperties{CompletionMode: "grouped"})
+}
+
 func (p *Pool) GetEvents(vmname string, ctx context.Context, t string, after uint64) ([]qmp.Event, error) {
 	m, err := p.getMonitor(vmname)
 	if err != nil {
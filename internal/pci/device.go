@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"runtime"
 	"sort"
@@ -296,6 +297,17 @@ func (d *Device) AssignDriver(n string) error {
 	return nil
 }
 
+// IOMMUGroup returns the number of the IOMMU group the device belongs to.
+// The group is available to userspace as /dev/vfio/<group>.
+func (d *Device) IOMMUGroup() (string, error) {
+	s, err := filepath.EvalSymlinks(filepath.Join(d.FullPath(), "iommu_group"))
+	if err != nil {
+		return "", fmt.Errorf("cannot determine IOMMU group: %w", err)
+	}
+
+	return filepath.Base(s), nil
+}
+
 func (d *Device) UnbindDriver() error {
 	d.mu.Lock()
 	defer d.mu.Unlock()
@@ -425,3 +437,17 @@ func readUint(dirname, fname string, base, bits int) (uint64, error) {
 
 	return strconv.ParseUint(strings.TrimPrefix(s, "0x"), base, bits)
 }
+
+// LoadVFIOModule loads the vfio-pci kernel module if it is not loaded yet.
+func LoadVFIOModule() error {
+	if _, err := os.Stat("/sys/bus/pci/drivers/vfio-pci"); err == nil {
+		return nil
+	}
+
+	// Try to load anyway
+	if _, err := exec.Command("modprobe", "vfio-pci").CombinedOutput(); err != nil {
+		return fmt.Errorf("could not load vfio-pci module: modprobe failed with %s", err)
+	}
+
+	return nil
+}
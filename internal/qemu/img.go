@@ -0,0 +1,173 @@
+package qemu
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	qemu_types "github.com/0xef53/kvmrun/internal/qemu/types"
+)
+
+const imgBinary = "/usr/bin/qemu-img"
+
+// Image describes a source image for the qemu-img commands.
+type Image struct {
+	// File name or a full option string if ImageOpts is true
+	Path      string
+	Format    string
+	ImageOpts bool
+
+	// Definitions of the additional objects, such as TLS credentials
+	Objects []string
+}
+
+func (img *Image) args() []string {
+	args := make([]string, 0, 2*len(img.Objects)+3)
+
+	for _, o := range img.Objects {
+		args = append(args, "--object", o)
+	}
+
+	switch {
+	case img.ImageOpts:
+		args = append(args, "--image-opts")
+	case len(img.Format) > 0:
+		args = append(args, "-f", img.Format)
+	}
+
+	return append(args, img.Path)
+}
+
+// CreateImage creates a new empty image of the specified format.
+func CreateImage(fname, format string, size uint64) error {
+	return runImg("create", "-q", "-f", format, fname, strconv.FormatUint(size, 10))
+}
+
+// ResizeImage changes the virtual size of the image.
+// The image must not be in use by a running QEMU instance.
+func ResizeImage(fname, format string, size uint64) error {
+	return runImg("resize", "-q", "-f", format, fname, strconv.FormatUint(size, 10))
+}
+
+// CreateOverlay creates a new qcow2 image that uses
+// the backing image as a base. The backing image is not opened,
+// so it may be in use by a running QEMU instance.
+func CreateOverlay(overlay, backing, backingFormat string, size uint64) error {
+	return runImg("create", "-q", "-f", "qcow2", "-u", "-b", backing, "-F", backingFormat, overlay, strconv.FormatUint(size, 10))
+}
+
+// CommitOverlay merges the changes from the overlay image
+// into its backing image. The overlay itself is left unchanged.
+func CommitOverlay(overlay string) error {
+	return runImg("commit", "-q", "-d", overlay)
+}
+
+// RebaseOverlay changes the backing image of the overlay
+// without any data conversion (the so-called unsafe mode).
+// The new backing image must have the same content as the old one.
+func RebaseOverlay(overlay, backing, backingFormat string) error {
+	return runImg("rebase", "-q", "-u", "-f", "qcow2", "-b", backing, "-F", backingFormat, overlay)
+}
+
+// GetImageInfo returns the information about the image
+// and its backing chain.
+func GetImageInfo(img *Image) (*qemu_types.ImageInfo, error) {
+	args := append([]string{"info", "--output=json", "--backing-chain"}, img.args()...)
+
+	out, err := exec.Command(imgBinary, args...).Output()
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			return nil, fmt.Errorf("qemu-img info failed (%s): %s", err, strings.TrimSpace(string(exitErr.Stderr)))
+		}
+
+		return nil, fmt.Errorf("qemu-img info failed: %w", err)
+	}
+
+	// The first element describes the image itself
+	chain := make([]*qemu_types.ImageInfo, 0, 1)
+
+	if err := json.Unmarshal(out, &chain); err != nil {
+		return nil, fmt.Errorf("cannot parse qemu-img output: %w", err)
+	}
+
+	if len(chain) == 0 {
+		return nil, fmt.Errorf("qemu-img info returned an empty result")
+	}
+
+	for i := 0; i < len(chain)-1; i++ {
+		chain[i].BackingImage = chain[i+1]
+	}
+
+	return chain[0], nil
+}
+
+// Convert copies the contents of the source image to the existing target image
+// converting the format if necessary. The progress function is called
+// with the percentage of completion. The process is killed
+// if the context is canceled.
+func Convert(ctx context.Context, src *Image, target, targetFormat string, progress func(float64)) error {
+	args := append([]string{"convert", "-p", "-n"}, src.args()...)
+	args = append(args, "-O", targetFormat, target)
+
+	cmd := exec.CommandContext(ctx, imgBinary, args...)
+
+	var stderr bytes.Buffer
+
+	cmd.Stderr = &stderr
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	// The progress is printed as "    (12.34/100%)\r"
+	scanner := bufio.NewScanner(stdout)
+
+	scanner.Split(func(data []byte, atEOF bool) (int, []byte, error) {
+		if i := bytes.IndexAny(data, "\r\n"); i >= 0 {
+			return i + 1, data[:i], nil
+		}
+
+		if atEOF && len(data) > 0 {
+			return len(data), data, nil
+		}
+
+		return 0, nil, nil
+	})
+
+	for scanner.Scan() {
+		var v float64
+
+		if _, err := fmt.Sscanf(strings.TrimSpace(scanner.Text()), "(%f/100%%)", &v); err == nil && progress != nil {
+			progress(v)
+		}
+	}
+
+	if err := cmd.Wait(); err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		return fmt.Errorf("qemu-img convert failed (%s): %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	return nil
+}
+
+func runImg(args ...string) error {
+	out, err := exec.Command(imgBinary, args...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("qemu-img %s failed (%s): %s", args[0], err, strings.TrimSpace(string(out)))
+	}
+
+	return nil
+}
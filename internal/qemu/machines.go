@@ -12,26 +12,26 @@ type machineType struct {
 }
 
 var machines = map[int][]machineType{
-	30100: {{"pc", true}, {"pc-i440fx-3.1", true}},
-	40000: {{"pc", true}, {"pc-i440fx-4.0", true}},
-	40001: {{"pc", true}, {"pc-i440fx-4.0.1", true}},
-	40100: {{"pc", true}, {"pc-i440fx-4.1", true}},
-	40200: {{"pc", true}, {"pc-i440fx-4.2", true}},
-	50000: {{"pc", true}, {"pc-i440fx-5.0", true}},
-	50100: {{"pc", true}, {"pc-i440fx-5.1", true}},
-	50200: {{"pc", true}, {"pc-i440fx-5.2", true}},
-	60000: {{"pc", true}, {"pc-i440fx-6.0", true}},
-	60100: {{"pc", true}, {"pc-i440fx-6.1", true}},
-	60200: {{"pc", true}, {"pc-i440fx-6.2", true}},
-	70000: {{"pc", true}, {"pc-i440fx-7.0", true}},
-	70100: {{"pc", true}, {"pc-i440fx-7.1", true}},
-	70200: {{"pc", true}, {"pc-i440fx-7.2", true}},
-	80000: {{"pc", true}, {"pc-i440fx-8.0", true}},
-	80100: {{"pc", true}, {"pc-i440fx-8.1", true}},
-	80200: {{"pc", true}, {"pc-i440fx-8.2", true}},
-	90000: {{"pc", true}, {"pc-i440fx-9.0", true}},
-	90100: {{"pc", true}, {"pc-i440fx-9.1", true}},
-	90200: {{"pc", true}, {"pc-i440fx-9.2", true}},
+	30100: {{"pc", true}, {"pc-i440fx-3.1", true}, {"q35", true}, {"pc-q35-3.1", true}},
+	40000: {{"pc", true}, {"pc-i440fx-4.0", true}, {"q35", true}, {"pc-q35-4.0", true}},
+	40001: {{"pc", true}, {"pc-i440fx-4.0.1", true}, {"q35", true}, {"pc-q35-4.0.1", true}},
+	40100: {{"pc", true}, {"pc-i440fx-4.1", true}, {"q35", true}, {"pc-q35-4.1", true}},
+	40200: {{"pc", true}, {"pc-i440fx-4.2", true}, {"q35", true}, {"pc-q35-4.2", true}},
+	50000: {{"pc", true}, {"pc-i440fx-5.0", true}, {"q35", true}, {"pc-q35-5.0", true}},
+	50100: {{"pc", true}, {"pc-i440fx-5.1", true}, {"q35", true}, {"pc-q35-5.1", true}},
+	50200: {{"pc", true}, {"pc-i440fx-5.2", true}, {"q35", true}, {"pc-q35-5.2", true}},
+	60000: {{"pc", true}, {"pc-i440fx-6.0", true}, {"q35", true}, {"pc-q35-6.0", true}},
+	60100: {{"pc", true}, {"pc-i440fx-6.1", true}, {"q35", true}, {"pc-q35-6.1", true}},
+	60200: {{"pc", true}, {"pc-i440fx-6.2", true}, {"q35", true}, {"pc-q35-6.2", true}},
+	70000: {{"pc", true}, {"pc-i440fx-7.0", true}, {"q35", true}, {"pc-q35-7.0", true}},
+	70100: {{"pc", true}, {"pc-i440fx-7.1", true}, {"q35", true}, {"pc-q35-7.1", true}},
+	70200: {{"pc", true}, {"pc-i440fx-7.2", true}, {"q35", true}, {"pc-q35-7.2", true}},
+	80000: {{"pc", true}, {"pc-i440fx-8.0", true}, {"q35", true}, {"pc-q35-8.0", true}},
+	80100: {{"pc", true}, {"pc-i440fx-8.1", true}, {"q35", true}, {"pc-q35-8.1", true}},
+	80200: {{"pc", true}, {"pc-i440fx-8.2", true}, {"q35", true}, {"pc-q35-8.2", true}},
+	90000: {{"pc", true}, {"pc-i440fx-9.0", true}, {"q35", true}, {"pc-q35-9.0", true}},
+	90100: {{"pc", true}, {"pc-i440fx-9.1", true}, {"q35", true}, {"pc-q35-9.1", true}},
+	90200: {{"pc", true}, {"pc-i440fx-9.2", true}, {"q35", true}, {"pc-q35-9.2", true}},
 }
 
 func GetDefaultMachineType(strver string) (*machineType, error) {
@@ -42,8 +42,8 @@ func GetDefaultMachineType(strver string) (*machineType, error) {
 
 	if mtypes := getSuitableTypes(v); mtypes != nil {
 		for _, t := range mtypes {
-			if t.Name == "pc" {
-				// this is alias to default
+			if t.Name == "pc" || t.Name == "q35" {
+				// these are aliases to default
 				continue
 			}
 			if t.Default {
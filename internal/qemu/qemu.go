@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os/exec"
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/0xef53/kvmrun/internal/version"
@@ -58,3 +59,86 @@ func GetVersion(rootdir, binary string) (*version.Version, error) {
 
 	return nil, fmt.Errorf("could not determine QEMU version: bad output")
 }
+
+// GetMachineTypes returns a list of machine types
+// supported by the QEMU binary, including aliases.
+func GetMachineTypes(rootdir, binary string) ([]string, error) {
+	out, err := runHelp(rootdir, binary, "-machine", "help")
+	if err != nil {
+		return nil, err
+	}
+
+	return parseMachineTypes(out)
+}
+
+func parseMachineTypes(out []byte) ([]string, error) {
+	types := make([]string, 0, 64)
+
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+
+		// Skip the header line "Supported machines are:"
+		if len(fields) < 2 || strings.HasSuffix(scanner.Text(), ":") {
+			continue
+		}
+
+		types = append(types, fields[0])
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return types, nil
+}
+
+// GetCPUModels returns a list of CPU models
+// supported by the QEMU binary.
+func GetCPUModels(rootdir, binary string) ([]string, error) {
+	out, err := runHelp(rootdir, binary, "-cpu", "help")
+	if err != nil {
+		return nil, err
+	}
+
+	return parseCPUModels(out)
+}
+
+func parseCPUModels(out []byte) ([]string, error) {
+	models := []string{"host", "max"}
+
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		// The list of models is followed by the list of CPUID flags
+		if len(line) == 0 && len(models) > 2 {
+			break
+		}
+
+		// Lines look like "x86 Skylake-Server  Intel Xeon Processor (Skylake)"
+		// "host" and "max" are also listed by the modern versions
+		if fields := strings.Fields(line); len(fields) >= 2 && fields[0] == "x86" && !slices.Contains(models, fields[1]) {
+			models = append(models, fields[1])
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return models, nil
+}
+
+func runHelp(rootdir, binary string, args ...string) ([]byte, error) {
+	qemuCommand := exec.Command(binary, args...)
+
+	qemuCommand.Env = append(qemuCommand.Environ(), fmt.Sprintf("QEMU_ROOTDIR=%s", rootdir))
+
+	out, err := qemuCommand.CombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("QEMU binary failed (%s): %s", err, strings.TrimSpace(string(out)))
+	}
+
+	return out, nil
+}
@@ -0,0 +1,96 @@
+package qemu
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseMachineTypes(t *testing.T) {
+	testCases := []struct {
+		out  string
+		want []string
+	}{
+		{
+			out: `Supported machines are:
+microvm              microvm (i386)
+pc                   Standard PC (i440FX + PIIX, 1996) (alias of pc-i440fx-8.2)
+pc-i440fx-8.2        Standard PC (i440FX + PIIX, 1996) (default)
+q35                  Standard PC (Q35 + ICH9, 2009) (alias of pc-q35-8.2)
+pc-q35-8.2           Standard PC (Q35 + ICH9, 2009)
+isapc                ISA-only PC
+none                 empty machine
+`,
+			want: []string{"microvm", "pc", "pc-i440fx-8.2", "q35", "pc-q35-8.2", "isapc", "none"},
+		},
+		{
+			out: `Supported machines are:
+pc                   Standard PC (i440FX + PIIX, 1996) (alias of pc-i440fx-2.11)
+pc-i440fx-2.11       Standard PC (i440FX + PIIX, 1996) (default)
+`,
+			want: []string{"pc", "pc-i440fx-2.11"},
+		},
+		{
+			out:  "",
+			want: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		got, err := parseMachineTypes([]byte(tc.out))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if strings.Join(got, " ") != strings.Join(tc.want, " ") {
+			t.Fatalf("got unexpected machine types:\n\twant:\t%q\n\tgot:\t%q", tc.want, got)
+		}
+	}
+}
+
+func TestParseCPUModels(t *testing.T) {
+	testCases := []struct {
+		out  string
+		want []string
+	}{
+		{
+			out: `Available CPUs:
+x86 486                   (alias configured by machine type)
+x86 486-v1
+x86 Skylake-Server        (alias configured by machine type)
+x86 Skylake-Server-v1     Intel Xeon Processor (Skylake)
+x86 base                  base CPU model type with no features enabled
+x86 host                  processor with all supported host features
+x86 max                   Enables all features supported by the accelerator in the current host
+
+Recognized CPUID flags:
+  3dnow 3dnowext 3dnowprefetch abm ace2 aes avx avx2
+`,
+			want: []string{"host", "max", "486", "486-v1", "Skylake-Server", "Skylake-Server-v1", "base"},
+		},
+		{
+			out: `x86           qemu64  QEMU Virtual CPU version 2.5+
+x86           phenom  AMD Phenom(tm) 9550 Quad-Core Processor
+x86             host  KVM processor with all supported host features (only available in KVM mode)
+
+Recognized CPUID flags:
+  pbe ia64 tm ht ss sse2 sse fxsr mmx acpi ds clflush pn pse36 pat cmov mca pge mtrr sep apic cx8 mce pae msr tsc pse de vme fpu
+`,
+			want: []string{"host", "max", "qemu64", "phenom"},
+		},
+		{
+			out:  "",
+			want: []string{"host", "max"},
+		},
+	}
+
+	for _, tc := range testCases {
+		got, err := parseCPUModels([]byte(tc.out))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if strings.Join(got, " ") != strings.Join(tc.want, " ") {
+			t.Fatalf("got unexpected CPU models:\n\twant:\t%q\n\tgot:\t%q", tc.want, got)
+		}
+	}
+}
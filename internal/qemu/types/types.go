@@ -40,18 +40,32 @@ type QomQuery struct {
 
 // PCIInfo describes the PCI bus and all its devices.
 type PCIInfo struct {
-	Bus     int `json:"bus"`
-	Devices []struct {
-		QdevID    string `json:"qdev_id"`
-		Slot      int    `json:"slot"`
-		ClassInfo struct {
-			Class int `json:"class"`
-		} `json:"class_info"`
-		ID struct {
-			Device int `json:"device"`
-			Vendor int `json:"vendor"`
-		} `json:"id"`
-	} `json:"devices"`
+	Bus     int             `json:"bus"`
+	Devices []PCIDeviceInfo `json:"devices"`
+}
+
+// PCIDeviceInfo describes a single device on the PCI bus.
+type PCIDeviceInfo struct {
+	QdevID    string `json:"qdev_id"`
+	Slot      int    `json:"slot"`
+	Function  int    `json:"function"`
+	ClassInfo struct {
+		Class int `json:"class"`
+	} `json:"class_info"`
+	ID struct {
+		Device int `json:"device"`
+		Vendor int `json:"vendor"`
+	} `json:"id"`
+	PCIBridge *PCIBridgeInfo `json:"pci_bridge,omitempty"`
+}
+
+// PCIBridgeInfo describes a PCI bridge (or a PCIe root port)
+// and the devices behind it.
+type PCIBridgeInfo struct {
+	Bus struct {
+		Number int `json:"number"`
+	} `json:"bus"`
+	Devices []PCIDeviceInfo `json:"devices"`
 }
 
 // NetdevTapOptions describes a TAP based guest networking device
@@ -69,33 +83,38 @@ type NetdevTapOptions struct {
 type BlockInfo struct {
 	Device       string `json:"device"`
 	DirtyBitmaps []struct {
-		Name string `json:"name"`
+		Name         string `json:"name"`
+		Persistent   bool   `json:"persistent"`
+		Inconsistent bool   `json:"inconsistent"`
 	} `json:"dirty-bitmaps"`
 	Inserted struct {
-		File             string `json:"file"`
-		BackingFile      string `json:"backing_file"`
-		BackingFileDepth int    `json:"backing_file_depth"`
-		ReadOnly         bool   `json:"ro"`
-		IopsRd           int    `json:"iops_rd"`
-		IopsWr           int    `json:"iops_wr"`
-		Image            struct {
-			Filename        string `json:"filename"`
-			Format          string `json:"format"`
-			ActualSize      uint64 `json:"actual-size"`
-			VirtualSize     uint64 `json:"virtual-size"`
-			BackingFilename string `json:"backing-filename"`
-			BackingImage    struct {
-				Filename    string `json:"filename"`
-				VirtualSize uint64 `json:"virtual-size"`
-			} `json:"backing-image"`
-		}
-		DirtyBitmaps []struct {
-			Name string `json:"name"`
+		NodeName         string    `json:"node-name"`
+		File             string    `json:"file"`
+		BackingFile      string    `json:"backing_file"`
+		BackingFileDepth int       `json:"backing_file_depth"`
+		ReadOnly         bool      `json:"ro"`
+		IopsRd           int       `json:"iops_rd"`
+		IopsWr           int       `json:"iops_wr"`
+		Image            ImageInfo `json:"image"`
+		DirtyBitmaps     []struct {
+			Name         string `json:"name"`
+			Persistent   bool   `json:"persistent"`
+			Inconsistent bool   `json:"inconsistent"`
 		} `json:"dirty-bitmaps"`
 	} `json:"inserted"`
 	QdevPath string `json:"qdev"`
 }
 
+// ImageInfo describes a disk image and its backing chain.
+type ImageInfo struct {
+	Filename        string     `json:"filename"`
+	Format          string     `json:"format"`
+	ActualSize      uint64     `json:"actual-size"`
+	VirtualSize     uint64     `json:"virtual-size"`
+	BackingFilename string     `json:"backing-filename"`
+	BackingImage    *ImageInfo `json:"backing-image"`
+}
+
 type InsertedFileOptions struct {
 	Driver string `json:"driver"`
 	File   struct {
@@ -107,9 +126,32 @@ type InsertedFileOptions struct {
 		Lun           string `json:"lun"`
 		User          string `json:"user"`
 		Password      string `json:"password"`
+		// RBD specific options
+		Pool          string `json:"pool"`
+		Namespace     string `json:"namespace"`
+		Image         string `json:"image"`
+		Conf          string `json:"conf"`
+		KeySecret     string `json:"key-secret"`
+		KeyValuePairs string `json:"=keyvalue-pairs"`
+		// NBD specific options
+		Server struct {
+			Host string `json:"host"`
+			Port string `json:"port"`
+		} `json:"server"`
+		Export   string `json:"export"`
+		TLSCreds string `json:"tls-creds"`
 	} `json:"file"`
 }
 
+// TLSCredsX509Options is a set of parameters for the new tls-creds-x509 object.
+type TLSCredsX509Options struct {
+	QomType    string `json:"qom-type"`
+	ID         string `json:"id"`
+	Endpoint   string `json:"endpoint"`
+	Dir        string `json:"dir"`
+	VerifyPeer bool   `json:"verify-peer"`
+}
+
 // BlockIOThrottle represents a set of parameters describing block device throttling.
 type BlockIOThrottle struct {
 	Device string `json:"device"`
@@ -198,6 +240,23 @@ type InetSocketAddressBase struct {
 	Port string `json:"port"`
 }
 
+// InetSocketAddress represents an address of an inet socket
+// in the flat SocketAddress notation.
+type InetSocketAddress struct {
+	Type string `json:"type"`
+	Host string `json:"host"`
+	Port string `json:"port"`
+}
+
+// NBDBlockdevOptions is a set of parameters of the nbd block driver.
+type NBDBlockdevOptions struct {
+	Driver      string            `json:"driver"`
+	Server      InetSocketAddress `json:"server"`
+	Export      string            `json:"export"`
+	TLSCreds    string            `json:"tls-creds,omitempty"`
+	TLSHostname string            `json:"tls-hostname,omitempty"`
+}
+
 // CPUDeviceOptions represents a set of common parameters of CPU devices.
 type CPUDeviceOptions struct {
 	Driver   string `json:"driver"`
@@ -210,6 +269,7 @@ type CPUDeviceOptions struct {
 type VSockDeviceOptions struct {
 	Driver   string `json:"driver"`
 	ID       string `json:"id"`
+	Bus      string `json:"bus,omitempty"`
 	GuestCID uint32 `json:"guest-cid,omitempty"`
 }
 
@@ -217,6 +277,7 @@ type VSockDeviceOptions struct {
 type SCSIHostBusDeviceOptions struct {
 	Driver string `json:"driver"`
 	ID     string `json:"id"`
+	Bus    string `json:"bus,omitempty"`
 }
 
 // CdromDeviceOptions is a set of common parameters for a CD-ROM compatible storage device.
@@ -245,31 +306,54 @@ type BlockDeviceOptions struct {
 type NetDeviceOptions struct {
 	Driver  string `json:"driver"`
 	ID      string `json:"id"`
+	Bus     string `json:"bus,omitempty"`
 	Netdev  string `json:"netdev,omitempty"`
 	Mac     string `json:"mac,omitempty"`
 	MQ      bool   `json:"mq,omitempty"`
 	Vectors int    `json:"vectors,omitempty"`
 }
 
+// HostPCIDeviceOptions represents a set of various "vfio-pci" parameters.
+type HostPCIDeviceOptions struct {
+	Driver        string `json:"driver"`
+	ID            string `json:"id"`
+	Host          string `json:"host"`
+	Bus           string `json:"bus,omitempty"`
+	Addr          string `json:"addr,omitempty"`
+	Multifunction bool   `json:"multifunction,omitempty"`
+	XVGA          bool   `json:"x-vga,omitempty"`
+}
+
 // MigrationCapabilityStatus describes the state (enabled/disabled) of migration capability.
 type MigrationCapabilityStatus struct {
 	Capability string `json:"capability"`
 	State      bool   `json:"state"`
 }
 
-// MigrateSetParameters represents a set of various migration parameters.
-type MigrateSetParameters struct {
-	MaxBandwidth    int `json:"max-bandwidth"`
-	XbzrleCacheSize int `json:"xbzrle-cache-size"`
+// MigrationParameters represents a set of various migration parameters.
+// It is used both to query (query-migrate-parameters)
+// and to change (migrate-set-parameters) them.
+type MigrationParameters struct {
+	MaxBandwidth         uint64 `json:"max-bandwidth,omitempty"`
+	DowntimeLimit        uint64 `json:"downtime-limit,omitempty"`
+	XbzrleCacheSize      uint64 `json:"xbzrle-cache-size,omitempty"`
+	MultifdChannels      uint32 `json:"multifd-channels,omitempty"`
+	MultifdCompression   string `json:"multifd-compression,omitempty"`
+	CPUThrottleInitial   uint32 `json:"cpu-throttle-initial,omitempty"`
+	CPUThrottleIncrement uint32 `json:"cpu-throttle-increment,omitempty"`
+	TLSCreds             string `json:"tls-creds"`
+	TLSHostname          string `json:"tls-hostname,omitempty"`
 }
 
 // MigrationInfo describes a running migration process.
 type MigrationInfo struct {
 	Status string `json:"status"`
 	Ram    struct {
-		Total     uint64  `json:"total"`
-		Remaining uint64  `json:"remaining"`
-		Speed     float64 `json:"mbps"`
+		Total            uint64  `json:"total"`
+		Remaining        uint64  `json:"remaining"`
+		Speed            float64 `json:"mbps"`
+		DirtySyncCount   uint64  `json:"dirty-sync-count"`
+		PostcopyRequests uint64  `json:"postcopy-requests"`
 	} `json:"ram"`
 	ErrDesc string `json:"error-desc"`
 }
@@ -298,6 +382,33 @@ type DriveBackupOptions struct {
 	Speed  uint64 `json:"speed,omitempty"`
 }
 
+// BlockdevBackupOptions is a set of parameters for setting up a new backup process
+// to an existing block node.
+type BlockdevBackupOptions struct {
+	JobID  string `json:"job-id,omitempty"`
+	Device string `json:"device"`
+	Target string `json:"target"`
+	Sync   string `json:"sync"`
+}
+
+// BlockdevSnapshotSyncOptions is a set of parameters for creating
+// a new external snapshot of a block device.
+type BlockdevSnapshotSyncOptions struct {
+	Device       string `json:"device"`
+	SnapshotFile string `json:"snapshot-file"`
+	Format       string `json:"format,omitempty"`
+	Mode         string `json:"mode,omitempty"`
+}
+
+// BlockCommitOptions is a set of parameters for merging
+// an overlay image into its backing image.
+type BlockCommitOptions struct {
+	JobID  string `json:"job-id,omitempty"`
+	Device string `json:"device"`
+	Top    string `json:"top,omitempty"`
+	Base   string `json:"base,omitempty"`
+}
+
 // BlockJobInfo describes a long-running block device operation.
 type BlockJobInfo struct {
 	Type   string `json:"type"`
@@ -308,6 +419,22 @@ type BlockJobInfo struct {
 	Paused bool   `json:"paused"`
 	Speed  uint64 `json:"speed"`
 	Ready  bool   `json:"ready"`
+	Status string `json:"status"`
+}
+
+// JobInfo describes a QEMU background job of any type.
+type JobInfo struct {
+	ID              string `json:"id"`
+	Type            string `json:"type"`
+	Status          string `json:"status"`
+	CurrentProgress uint64 `json:"current-progress"`
+	TotalProgress   uint64 `json:"total-progress"`
+	Error           string `json:"error,omitempty"`
+}
+
+// JobOptions is a common structure for operations with QEMU background jobs.
+type JobOptions struct {
+	ID string `json:"id"`
 }
 
 // BlockDirtyBitmapOptions is a common structure for operations with dirty bitmaps.
@@ -315,3 +442,21 @@ type BlockDirtyBitmapOptions struct {
 	Node string `json:"node"`
 	Name string `json:"name"`
 }
+
+// BlockDirtyBitmapAddOptions is a set of parameters for creating a new dirty bitmap.
+type BlockDirtyBitmapAddOptions struct {
+	Node     string `json:"node"`
+	Name     string `json:"name"`
+	Disabled bool   `json:"disabled,omitempty"`
+
+	// The bitmap is stored in the qcow2 image when the image is closed
+	Persistent bool `json:"persistent,omitempty"`
+}
+
+// BlockDirtyBitmapMergeOptions is a set of parameters for merging
+// dirty bitmaps into the target one.
+type BlockDirtyBitmapMergeOptions struct {
+	Node    string   `json:"node"`
+	Target  string   `json:"target"`
+	Bitmaps []string `json:"bitmaps"`
+}
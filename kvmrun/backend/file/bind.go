@@ -0,0 +1,92 @@
+package file
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/sys/unix"
+)
+
+// BindTo makes the file available at the target path using a bind mount.
+// This is the way to map an image file to a chroot environment.
+// The file owner is changed to uid.
+func (d *Device) BindTo(target string, uid int) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return err
+	}
+
+	fd, err := os.OpenFile(target, os.O_RDONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	fd.Close()
+
+	if err := unix.Mount(d.Path, target, "", unix.MS_BIND, ""); err != nil {
+		os.Remove(target)
+
+		return &os.PathError{Op: "mount", Path: target, Err: err}
+	}
+
+	return os.Chown(d.Path, uid, 0)
+}
+
+// Unbind unmounts the bind mount created by BindTo
+// and removes the mount point.
+func Unbind(target string) error {
+	if err := unix.Unmount(target, unix.MNT_DETACH); err != nil && err != unix.EINVAL && err != unix.ENOENT {
+		return &os.PathError{Op: "umount", Path: target, Err: err}
+	}
+
+	if err := os.Remove(target); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
+
+// UnbindAll unmounts all mount points inside the dir.
+// It is used to clean up the chroot environment before removing.
+func UnbindAll(dir string) error {
+	fd, err := os.Open("/proc/self/mounts")
+	if err != nil {
+		return err
+	}
+	defer fd.Close()
+
+	prefix := filepath.Clean(dir) + "/"
+
+	targets := make([]string, 0, 4)
+
+	scanner := bufio.NewScanner(fd)
+
+	for scanner.Scan() {
+		// /dev/sda1 /var/lib/kvmrun/chroot/alice/var/lib/images/alice.qcow2 ext4 rw,relatime 0 0
+		ff := strings.Fields(scanner.Text())
+
+		if len(ff) < 2 {
+			continue
+		}
+
+		if strings.HasPrefix(ff[1], prefix) {
+			targets = append(targets, ff[1])
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	// Nested mount points first
+	sort.Sort(sort.Reverse(sort.StringSlice(targets)))
+
+	for _, t := range targets {
+		if err := unix.Unmount(t, unix.MNT_DETACH); err != nil && err != unix.EINVAL {
+			return &os.PathError{Op: "umount", Path: t, Err: err}
+		}
+	}
+
+	return nil
+}
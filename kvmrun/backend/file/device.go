@@ -1,13 +1,24 @@
 package file
 
 import (
+	"bytes"
+	"encoding/binary"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 
 	"github.com/0xef53/kvmrun/kvmrun/backend"
 )
 
+const (
+	FormatRaw   = "raw"
+	FormatQcow2 = "qcow2"
+)
+
+// qcow2Magic is the first 4 bytes of any qcow2 image: "QFI\xfb".
+var qcow2Magic = []byte{'Q', 'F', 'I', 0xfb}
+
 type Device struct {
 	Path string
 }
@@ -33,21 +44,98 @@ func (d *Device) BaseName() string {
 	return filepath.Base(d.Path)
 }
 
+// Size returns the size of the image file. The virtual size
+// of a qcow2 image can be obtained using VirtualSize.
 func (d *Device) Size() (uint64, error) {
+	fi, err := d.stat()
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(fi.Size()), nil
+}
+
+// VirtualSize returns the virtual disk size of the image
+// of the specified format.
+func (d *Device) VirtualSize(format string) (uint64, error) {
+	if format != FormatQcow2 {
+		return d.Size()
+	}
+
+	if _, err := d.stat(); err != nil {
+		return 0, err
+	}
+
+	hdr, err := readHeader(d.Path)
+	if err != nil {
+		return 0, err
+	}
+
+	if !isQcow2(hdr) {
+		return 0, fmt.Errorf("not a qcow2 image: %s", d.Path)
+	}
+
+	// The virtual disk size of qcow2 image is stored
+	// in the header at offset 24 as a big-endian uint64
+	return binary.BigEndian.Uint64(hdr[24:32]), nil
+}
+
+func (d *Device) stat() (os.FileInfo, error) {
 	fi, err := os.Lstat(d.Path)
 	if err != nil {
 		if os.IsNotExist(err) {
-			return 0, &os.PathError{Op: "stat", Path: d.Path, Err: os.ErrNotExist}
+			return nil, &os.PathError{Op: "stat", Path: d.Path, Err: os.ErrNotExist}
 		} else {
-			return 0, err
+			return nil, err
 		}
 	}
 
-	if fi.Mode().IsRegular() {
-		return uint64(fi.Size()), nil
+	if !fi.Mode().IsRegular() {
+		return nil, fmt.Errorf("not a regular file: %s", d.Path)
 	}
 
-	return 0, fmt.Errorf("not a regular file: %s", d.Path)
+	return fi, nil
+}
+
+// DetectFormat detects the image format using the file header.
+// It returns "qcow2" or "raw" if the format could not be recognized.
+//
+// The guest can write anything to the beginning of its raw disk,
+// including a qcow2 header with an arbitrary backing file.
+// So the format must be detected only once, when the image is attached
+// or created, and never for images the guest can write to.
+func DetectFormat(p string) (string, error) {
+	hdr, err := readHeader(p)
+	if err != nil {
+		return "", err
+	}
+
+	if isQcow2(hdr) {
+		return FormatQcow2, nil
+	}
+
+	return FormatRaw, nil
+}
+
+func readHeader(p string) ([]byte, error) {
+	fd, err := os.Open(p)
+	if err != nil {
+		return nil, err
+	}
+	defer fd.Close()
+
+	hdr := make([]byte, 32)
+
+	n, err := io.ReadFull(fd, hdr)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return nil, err
+	}
+
+	return hdr[:n], nil
+}
+
+func isQcow2(hdr []byte) bool {
+	return len(hdr) >= 32 && bytes.Equal(hdr[:4], qcow2Magic)
 }
 
 func (d *Device) IsLocal() bool {
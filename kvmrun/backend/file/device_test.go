@@ -0,0 +1,52 @@
+package file
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestVirtualSize(t *testing.T) {
+	// A raw image with a qcow2 header written by the guest
+	hdr := make([]byte, 4096)
+
+	copy(hdr, qcow2Magic)
+
+	binary.BigEndian.PutUint64(hdr[24:32], 1<<30)
+
+	fname := filepath.Join(t.TempDir(), "disk.img")
+
+	if err := os.WriteFile(fname, hdr, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	d, err := New(fname)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]uint64{
+		FormatRaw:   4096,
+		FormatQcow2: 1 << 30,
+	}
+
+	for format, want := range testCases {
+		got, err := d.VirtualSize(format)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got != want {
+			t.Fatalf("got unexpected size (format = %s): want %d, got %d", format, want, got)
+		}
+	}
+
+	if got, err := d.Size(); err != nil || got != 4096 {
+		t.Fatalf("got unexpected file size: want 4096, got %d (err = %v)", got, err)
+	}
+
+	if got, err := DetectFormat(fname); err != nil || got != FormatQcow2 {
+		t.Fatalf("got unexpected format: want %s, got %s (err = %v)", FormatQcow2, got, err)
+	}
+}
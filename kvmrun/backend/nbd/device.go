@@ -1,6 +1,8 @@
 package nbd
 
 import (
+	"fmt"
+
 	"github.com/0xef53/kvmrun/kvmrun/backend"
 )
 
@@ -55,3 +57,27 @@ func (d *Device) Copy() backend.DiskBackend {
 		URI:  &_uri,
 	}
 }
+
+// TLS returns true if the connection to the NBD server
+// should be encrypted (nbds:// scheme).
+func (d *Device) TLS() bool {
+	return d.URI.Scheme == "nbds"
+}
+
+// DriveOptions returns a set of the -drive options that define
+// the block backend of the NBD export. The tlsCredsID is the ID
+// of the QEMU tls-creds-x509 object and is used only if TLS is required.
+func (d *Device) DriveOptions(tlsCredsID string) []string {
+	if !d.TLS() {
+		return []string{"file=" + d.Path}
+	}
+
+	return []string{
+		"file.driver=nbd",
+		"file.server.type=inet",
+		"file.server.host=" + d.URI.Host,
+		fmt.Sprintf("file.server.port=%d", d.URI.Port),
+		"file.export=" + d.URI.ExportName,
+		"file.tls-creds=" + tlsCredsID,
+	}
+}
@@ -0,0 +1,187 @@
+package rbd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/0xef53/kvmrun/kvmrun/backend"
+)
+
+type Device struct {
+	Path string
+	URI  *URI
+}
+
+func New(p string) (*Device, error) {
+	u, err := ParseURI(p)
+	if err != nil {
+		return nil, err
+	}
+
+	d := Device{
+		Path: p,
+		URI:  u,
+	}
+
+	return &d, nil
+}
+
+func (d *Device) QdevID() string {
+	return "blk_" + d.URI.Image
+}
+
+func (d *Device) FullPath() string {
+	return d.Path
+}
+
+func (d *Device) BaseName() string {
+	return d.URI.Image
+}
+
+func (d *Device) Size() (uint64, error) {
+	return 0, backend.ErrNotImplemented
+}
+
+// IsLocal returns false because an RBD image is located in the shared
+// Ceph storage and available from any host of the cluster.
+func (d *Device) IsLocal() bool {
+	return false
+}
+
+func (d *Device) IsAvailable() (bool, error) {
+	return true, nil
+}
+
+func (d *Device) Copy() backend.DiskBackend {
+	_uri := *d.URI
+
+	return &Device{
+		Path: d.Path,
+		URI:  &_uri,
+	}
+}
+
+// SecretID returns the ID of the QEMU secret object
+// that holds the Ceph client key.
+func (d *Device) SecretID() string {
+	return "sec_" + d.URI.Image
+}
+
+// ConfigFiles returns a list of Ceph configuration files
+// that should be available to the QEMU process.
+func (d *Device) ConfigFiles() []string {
+	files := make([]string, 0, 2)
+
+	if len(d.URI.Conf) > 0 {
+		files = append(files, d.URI.Conf)
+	} else {
+		files = append(files, DefaultConf)
+	}
+
+	if len(d.URI.Keyring) > 0 {
+		files = append(files, d.URI.Keyring)
+	}
+
+	return files
+}
+
+// DriveOptions returns a set of the -drive options
+// that define the block backend of the RBD image.
+//
+// If the secret file is specified, the key is passed via
+// the QEMU secret object, and the structured options are used.
+// Otherwise the legacy rbd: filename is used, because it is the only way
+// to pass a keyring path to librados.
+func (d *Device) DriveOptions() []string {
+	if len(d.URI.Secret) > 0 {
+		opts := []string{
+			"file.driver=rbd",
+			"file.pool=" + escapeOptValue(d.URI.Pool),
+		}
+
+		if len(d.URI.Namespace) > 0 {
+			opts = append(opts, "file.namespace="+escapeOptValue(d.URI.Namespace))
+		}
+
+		opts = append(opts, "file.image="+escapeOptValue(d.URI.Image))
+
+		if len(d.URI.User) > 0 {
+			opts = append(opts, "file.user="+escapeOptValue(d.URI.User))
+		}
+		if len(d.URI.Conf) > 0 {
+			opts = append(opts, "file.conf="+escapeOptValue(d.URI.Conf))
+		}
+
+		return append(opts, "file.key-secret="+d.SecretID())
+	}
+
+	// rbd:pool/[namespace/]image[:id=user][:conf=path][:keyring=path]
+	var b strings.Builder
+
+	b.WriteString("rbd:" + escapeFilename(d.URI.Pool) + "/")
+
+	if len(d.URI.Namespace) > 0 {
+		b.WriteString(escapeFilename(d.URI.Namespace) + "/")
+	}
+
+	b.WriteString(escapeFilename(d.URI.Image))
+
+	if len(d.URI.User) > 0 {
+		b.WriteString(":id=" + escapeFilename(d.URI.User))
+	}
+	if len(d.URI.Conf) > 0 {
+		b.WriteString(":conf=" + escapeFilename(d.URI.Conf))
+	}
+	if len(d.URI.Keyring) > 0 {
+		b.WriteString(":keyring=" + escapeFilename(d.URI.Keyring))
+	}
+
+	return []string{"file=" + escapeOptValue(b.String())}
+}
+
+// ObjectArgs returns the command line arguments to define
+// the QEMU secret object, or nil if the secret file is not specified.
+//
+// The secret file should contain the base64-encoded Ceph client key
+// without a trailing newline (as the "ceph auth get-key" prints it).
+func (d *Device) ObjectArgs() []string {
+	if len(d.URI.Secret) == 0 {
+		return nil
+	}
+
+	return []string{"-object", fmt.Sprintf("secret,id=%s,file=%s,format=base64", d.SecretID(), escapeOptValue(d.URI.Secret))}
+}
+
+// SecretObjectOptions returns a set of arguments for the QMP object-add command
+// that defines the QEMU secret object. Since the QEMU process runs in a chroot
+// environment, the key is passed as a value rather than a file path.
+func (d *Device) SecretObjectOptions() (map[string]string, error) {
+	if len(d.URI.Secret) == 0 {
+		return nil, nil
+	}
+
+	b, err := os.ReadFile(d.URI.Secret)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := map[string]string{
+		"qom-type": "secret",
+		"id":       d.SecretID(),
+		"data":     strings.TrimSpace(string(b)),
+		"format":   "base64",
+	}
+
+	return opts, nil
+}
+
+// escapeFilename escapes the special characters of the legacy rbd: filename.
+func escapeFilename(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `:`, `\:`, `@`, `\@`).Replace(s)
+}
+
+// escapeOptValue escapes the commas of the QEMU option value.
+func escapeOptValue(s string) string {
+	return strings.ReplaceAll(s, ",", ",,")
+}
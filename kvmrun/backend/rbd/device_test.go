@@ -0,0 +1,79 @@
+package rbd
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func TestDeviceArgs(t *testing.T) {
+	testCases := map[string]string{
+		"basic":   "rbd://rbd/vm-disk",
+		"keyring": "rbd://alice@volumes/ns1/vm-disk?conf=/etc/ceph/ceph.conf&keyring=/etc/ceph/ceph.client.alice.keyring",
+		"secret":  "rbd://alice@volumes/ns1/vm-disk?conf=/etc/ceph/ceph.conf&secret=/etc/kvmrun/ceph/alice.key",
+		"escape":  "rbd://rbd/vm-disk?conf=/etc/ceph/ceph:test,1.conf",
+	}
+
+	for name, s := range testCases {
+		d, err := New(s)
+		if err != nil {
+			t.Fatal(resultStr(s, nil, err))
+		}
+
+		args := append(d.ObjectArgs(), "-drive", strings.Join(d.DriveOptions(), ","))
+
+		got := strings.Join(args, "\n") + "\n"
+
+		golden := filepath.Join("testdata", name+".golden")
+
+		if *update {
+			if err := os.WriteFile(golden, []byte(got), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		want, err := os.ReadFile(golden)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got != string(want) {
+			t.Fatal(resultStr(s, string(want), got))
+		}
+	}
+}
+
+func TestDeviceSecretObject(t *testing.T) {
+	keyfile := filepath.Join(t.TempDir(), "alice.key")
+
+	if err := os.WriteFile(keyfile, []byte("AQBdZkFjAAAAABAAfakefakefakefakefakefake==\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	d, err := New("rbd://alice@volumes/vm-disk?secret=" + keyfile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opts, err := d.SecretObjectOptions()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{
+		"qom-type": "secret",
+		"id":       "sec_vm-disk",
+		"data":     "AQBdZkFjAAAAABAAfakefakefakefakefakefake==",
+		"format":   "base64",
+	}
+
+	for k, v := range want {
+		if opts[k] != v {
+			t.Fatal(resultStr(k, v, opts[k]))
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package rbd
+
+import (
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"strings"
+)
+
+// DefaultConf is the Ceph configuration file that is used
+// when no conf parameter is specified.
+const DefaultConf = "/etc/ceph/ceph.conf"
+
+type URI struct {
+	User      string
+	Pool      string
+	Namespace string
+	Image     string
+	Conf      string
+	Keyring   string
+	Secret    string
+}
+
+// rbd://[user@]pool[/namespace]/image[?conf=<path>&keyring=<path>|secret=<path>]
+func ParseURI(rawuri string) (*URI, error) {
+	u, err := url.Parse(rawuri)
+	if err != nil {
+		return nil, err
+	}
+
+	if u.Scheme != "rbd" {
+		return nil, fmt.Errorf("unknown RBD scheme: %s", rawuri)
+	}
+
+	rbdURI := URI{
+		Pool: u.Host,
+	}
+
+	if u.User != nil {
+		rbdURI.User = u.User.Username()
+	}
+
+	if len(rbdURI.Pool) == 0 {
+		return nil, fmt.Errorf("RBD pool name is not specified: %s", rawuri)
+	}
+
+	switch ff := strings.Split(strings.Trim(u.Path, "/"), "/"); len(ff) {
+	case 1:
+		rbdURI.Image = ff[0]
+	case 2:
+		rbdURI.Namespace = ff[0]
+		rbdURI.Image = ff[1]
+	default:
+		return nil, fmt.Errorf("invalid RBD image path: %s", u.Path)
+	}
+
+	if len(rbdURI.Image) == 0 {
+		return nil, fmt.Errorf("RBD image name is not specified: %s", rawuri)
+	}
+
+	for k, v := range u.Query() {
+		if len(v) != 1 || len(v[0]) == 0 {
+			return nil, fmt.Errorf("RBD parameter requires a single value: %s", k)
+		}
+
+		if !filepath.IsAbs(v[0]) {
+			return nil, fmt.Errorf("RBD parameter must be an absolute path: %s", k)
+		}
+
+		switch k {
+		case "conf":
+			rbdURI.Conf = v[0]
+		case "keyring":
+			rbdURI.Keyring = v[0]
+		case "secret":
+			rbdURI.Secret = v[0]
+		default:
+			return nil, fmt.Errorf("unknown RBD parameter: %s", k)
+		}
+	}
+
+	if len(rbdURI.Keyring) > 0 && len(rbdURI.Secret) > 0 {
+		return nil, fmt.Errorf("RBD keyring and secret are mutually exclusive")
+	}
+
+	return &rbdURI, nil
+}
+
+// String returns the canonical form of the RBD URI.
+func (u *URI) String() string {
+	var b strings.Builder
+
+	b.WriteString("rbd://")
+
+	if len(u.User) > 0 {
+		b.WriteString(u.User + "@")
+	}
+
+	b.WriteString(u.Pool + "/")
+
+	if len(u.Namespace) > 0 {
+		b.WriteString(u.Namespace + "/")
+	}
+
+	b.WriteString(u.Image)
+
+	params := make([]string, 0, 2)
+
+	if len(u.Conf) > 0 {
+		params = append(params, "conf="+u.Conf)
+	}
+	if len(u.Keyring) > 0 {
+		params = append(params, "keyring="+u.Keyring)
+	}
+	if len(u.Secret) > 0 {
+		params = append(params, "secret="+u.Secret)
+	}
+
+	if len(params) > 0 {
+		b.WriteString("?" + strings.Join(params, "&"))
+	}
+
+	return b.String()
+}
@@ -0,0 +1,71 @@
+package rbd
+
+import (
+	"fmt"
+	"testing"
+)
+
+func resultStr(value string, want, got interface{}) string {
+	return fmt.Sprintf("got unexpected result:\n\tvalue:\t%s\n\twant:\t%v\n\tgot:\t%v", value, want, got)
+}
+
+func TestParseURI(t *testing.T) {
+	validCases := map[string]URI{
+		"rbd://rbd/vm-disk": {
+			Pool:  "rbd",
+			Image: "vm-disk",
+		},
+		"rbd://alice@volumes/ns1/vm-disk": {
+			User:      "alice",
+			Pool:      "volumes",
+			Namespace: "ns1",
+			Image:     "vm-disk",
+		},
+		"rbd://alice@volumes/vm-disk?conf=/etc/ceph/ceph.conf&keyring=/etc/ceph/ceph.client.alice.keyring": {
+			User:    "alice",
+			Pool:    "volumes",
+			Image:   "vm-disk",
+			Conf:    "/etc/ceph/ceph.conf",
+			Keyring: "/etc/ceph/ceph.client.alice.keyring",
+		},
+		"rbd://alice@volumes/vm-disk?secret=/etc/kvmrun/ceph/alice.key": {
+			User:   "alice",
+			Pool:   "volumes",
+			Image:  "vm-disk",
+			Secret: "/etc/kvmrun/ceph/alice.key",
+		},
+	}
+
+	for s, want := range validCases {
+		got, err := ParseURI(s)
+		if err != nil {
+			t.Fatal(resultStr(s, nil, err))
+		}
+
+		if *got != want {
+			t.Fatal(resultStr(s, want, *got))
+		}
+
+		// Canonical form should be parsed to the same value
+		if again, err := ParseURI(got.String()); err != nil || *again != want {
+			t.Fatal(resultStr(got.String(), want, again))
+		}
+	}
+
+	invalidCases := []string{
+		"nbd://rbd/vm-disk",
+		"rbd:///vm-disk",
+		"rbd://rbd/",
+		"rbd://rbd/ns1/group/vm-disk",
+		"rbd://rbd/vm-disk?conf=ceph.conf",
+		"rbd://rbd/vm-disk?pool=rbd",
+		"rbd://rbd/vm-disk?conf=/etc/ceph/a.conf&conf=/etc/ceph/b.conf",
+		"rbd://rbd/vm-disk?keyring=/etc/ceph/keyring&secret=/etc/kvmrun/ceph/alice.key",
+	}
+
+	for _, s := range invalidCases {
+		if _, err := ParseURI(s); err == nil {
+			t.Fatal(resultStr(s, "error", nil))
+		}
+	}
+}
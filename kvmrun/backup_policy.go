@@ -0,0 +1,274 @@
+package kvmrun
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/0xef53/kvmrun/internal/cron"
+)
+
+// BackupPolicy describes the regular backups of a machine
+// that are performed by kvmrund. The policy is stored
+// in the machine config directory next to the description.
+type BackupPolicy struct {
+	// Cron expression in the standard five-field format
+	Schedule string `json:"schedule"`
+
+	// Names of the disks to back up. All disks are used if empty
+	Disks []string `json:"disks,omitempty"`
+
+	// Every N-th backup is full, the others are incremental.
+	// Values less than 2 mean that only full backups are performed
+	FullEvery int `json:"full_every,omitempty"`
+
+	// Path of the target image file with the placeholders:
+	// {vm}, {disk}, {date} (20060102), {time} (20060102-150405)
+	// and {type} (full or inc). Images with the .qcow2 extension
+	// are created in qcow2 format, all others are raw
+	TargetTemplate string `json:"target_template"`
+
+	// Number of the newest backup chains (a full backup and
+	// its incremental backups) to keep
+	KeepChains int `json:"keep_chains,omitempty"`
+
+	// Chains whose newest backup is older than this number of days
+	// are removed. The newest chain is never removed
+	KeepDays int `json:"keep_days,omitempty"`
+
+	FSFreeze bool `json:"fs_freeze,omitempty"`
+	Disabled bool `json:"disabled,omitempty"`
+}
+
+func (p *BackupPolicy) Validate(strict bool) error {
+	p.Schedule = strings.TrimSpace(p.Schedule)
+
+	if _, err := cron.Parse(p.Schedule); err != nil {
+		return err
+	}
+
+	p.TargetTemplate = strings.TrimSpace(p.TargetTemplate)
+
+	if !filepath.IsAbs(p.TargetTemplate) {
+		return fmt.Errorf("target template must be an absolute path: %s", p.TargetTemplate)
+	}
+
+	// Each disk and each backup must have its own target
+	for _, s := range []string{"{disk}", "{time}"} {
+		if !strings.Contains(p.TargetTemplate, s) {
+			return fmt.Errorf("target template must contain %s: %s", s, p.TargetTemplate)
+		}
+	}
+
+	for idx := range p.Disks {
+		p.Disks[idx] = strings.TrimSpace(p.Disks[idx])
+
+		if len(p.Disks[idx]) == 0 {
+			return fmt.Errorf("empty disk name")
+		}
+	}
+
+	switch {
+	case p.FullEvery < 0:
+		return fmt.Errorf("invalid full_every value: %d", p.FullEvery)
+	case p.KeepChains < 0:
+		return fmt.Errorf("invalid keep_chains value: %d", p.KeepChains)
+	case p.KeepDays < 0:
+		return fmt.Errorf("invalid keep_days value: %d", p.KeepDays)
+	}
+
+	if p.KeepChains == 0 && p.KeepDays == 0 {
+		return fmt.Errorf("at least one retention rule must be set: keep_chains or keep_days")
+	}
+
+	return nil
+}
+
+// Due reports whether a backup is scheduled at the minute of t.
+func (p *BackupPolicy) Due(t time.Time) bool {
+	sched, err := cron.Parse(p.Schedule)
+	if err != nil {
+		return false
+	}
+
+	return sched.Match(t)
+}
+
+// NextRun returns the time of the next scheduled backup after t.
+func (p *BackupPolicy) NextRun(t time.Time) time.Time {
+	sched, err := cron.Parse(p.Schedule)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return sched.Next(t)
+}
+
+// Target returns the path of the target image file for the disk.
+func (p *BackupPolicy) Target(vmname, diskname, kind string, t time.Time) string {
+	if kind == BackupTypeIncremental {
+		kind = "inc"
+	}
+
+	r := strings.NewReplacer(
+		"{vm}", vmname,
+		"{disk}", diskname,
+		"{date}", t.Format("20060102"),
+		"{time}", t.Format("20060102-150405"),
+		"{type}", kind,
+	)
+
+	return filepath.Clean(r.Replace(p.TargetTemplate))
+}
+
+func backupPolicyFile(vmname string) string {
+	return filepath.Join(CONFDIR, vmname, "backup_policy.json")
+}
+
+// GetBackupPolicy returns the backup policy of the machine.
+// If there is no policy, os.ErrNotExist is returned.
+func GetBackupPolicy(vmname string) (*BackupPolicy, error) {
+	b, err := os.ReadFile(backupPolicyFile(vmname))
+	if err != nil {
+		return nil, err
+	}
+
+	p := BackupPolicy{}
+
+	if err := json.Unmarshal(b, &p); err != nil {
+		return nil, fmt.Errorf("invalid backup policy: %w", err)
+	}
+
+	return &p, nil
+}
+
+func (p *BackupPolicy) Save(vmname string) error {
+	b, err := json.MarshalIndent(p, "", "    ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(backupPolicyFile(vmname), append(b, '\n'), 0644)
+}
+
+// RemoveBackupPolicy removes the backup policy of the machine.
+// The backup catalog is left untouched.
+func RemoveBackupPolicy(vmname string) error {
+	if err := os.Remove(backupPolicyFile(vmname)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
+
+const (
+	BackupTypeFull        = "full"
+	BackupTypeIncremental = "incremental"
+)
+
+// BackupCatalogEntry describes a backup image created by the scheduler.
+type BackupCatalogEntry struct {
+	DiskName string    `json:"disk_name"`
+	Path     string    `json:"path"`
+	Type     string    `json:"type"`
+	Size     uint64    `json:"size"`
+	TaskID   string    `json:"task_id"`
+	Created  time.Time `json:"created"`
+}
+
+// BackupCatalog is a list of backups created by the scheduler
+// ordered by creation time.
+type BackupCatalog struct {
+	Entries []*BackupCatalogEntry `json:"entries"`
+}
+
+func backupCatalogFile(vmname string) string {
+	return filepath.Join(CONFDIR, vmname, "backup_catalog.json")
+}
+
+// GetBackupCatalog returns the backup catalog of the machine.
+// An empty catalog is returned if the machine has no backups.
+func GetBackupCatalog(vmname string) (*BackupCatalog, error) {
+	b, err := os.ReadFile(backupCatalogFile(vmname))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return new(BackupCatalog), nil
+		}
+
+		return nil, err
+	}
+
+	c := BackupCatalog{}
+
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, fmt.Errorf("invalid backup catalog: %w", err)
+	}
+
+	sort.SliceStable(c.Entries, func(i, j int) bool {
+		return c.Entries[i].Created.Before(c.Entries[j].Created)
+	})
+
+	return &c, nil
+}
+
+func (c *BackupCatalog) Save(vmname string) error {
+	b, err := json.MarshalIndent(c, "", "    ")
+	if err != nil {
+		return err
+	}
+
+	fname := backupCatalogFile(vmname)
+
+	// The catalog can be read at the same time by API requests
+	if err := os.WriteFile(fname+".tmp", append(b, '\n'), 0644); err != nil {
+		return err
+	}
+
+	return os.Rename(fname+".tmp", fname)
+}
+
+// Disk returns the backups of the disk ordered by creation time.
+func (c *BackupCatalog) Disk(diskname string) []*BackupCatalogEntry {
+	entries := make([]*BackupCatalogEntry, 0, len(c.Entries))
+
+	for _, e := range c.Entries {
+		if e.DiskName == diskname {
+			entries = append(entries, e)
+		}
+	}
+
+	return entries
+}
+
+// Chains splits the backups of the disk into chains. Each chain
+// starts with a full backup followed by its incremental backups.
+func (c *BackupCatalog) Chains(diskname string) [][]*BackupCatalogEntry {
+	chains := make([][]*BackupCatalogEntry, 0)
+
+	for _, e := range c.Disk(diskname) {
+		if e.Type == BackupTypeFull || len(chains) == 0 {
+			chains = append(chains, []*BackupCatalogEntry{e})
+		} else {
+			chains[len(chains)-1] = append(chains[len(chains)-1], e)
+		}
+	}
+
+	return chains
+}
+
+// Remove removes the entry with the specified path from the catalog.
+func (c *BackupCatalog) Remove(fname string) {
+	entries := make([]*BackupCatalogEntry, 0, len(c.Entries))
+
+	for _, e := range c.Entries {
+		if e.Path != fname {
+			entries = append(entries, e)
+		}
+	}
+
+	c.Entries = entries
+}
@@ -0,0 +1,120 @@
+package kvmrun
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// BackupState describes the dirty bitmap that tracks the changes
+// of a disk since the last backup, and the chain of image files
+// created by the backups. It is stored in the machine config directory,
+// so it outlives the QEMU process and allows to detect that
+// the bitmap has been lost.
+type BackupState struct {
+	// Path of the disk for which the bitmap was created
+	Disk string `json:"disk"`
+
+	// The bitmap is stored in the qcow2 image and survives restarts.
+	// Bitmaps of raw images and block devices exist only in QEMU memory.
+	Persistent bool `json:"persistent"`
+
+	// The reason why the bitmap cannot be trusted anymore
+	Broken string `json:"broken,omitempty"`
+
+	// The last full backup and all subsequent incremental backups
+	Images []string `json:"images,omitempty"`
+
+	Updated time.Time `json:"updated"`
+}
+
+func backupStateFile(vmname, diskname string) string {
+	return filepath.Join(CONFDIR, vmname, "backups", diskname+".json")
+}
+
+// GetBackupState returns the backup state of the disk.
+// If there is no state, os.ErrNotExist is returned.
+func GetBackupState(vmname, diskname string) (*BackupState, error) {
+	b, err := os.ReadFile(backupStateFile(vmname, diskname))
+	if err != nil {
+		return nil, err
+	}
+
+	st := BackupState{}
+
+	if err := json.Unmarshal(b, &st); err != nil {
+		return nil, fmt.Errorf("invalid backup state: %w", err)
+	}
+
+	return &st, nil
+}
+
+func (st *BackupState) Save(vmname, diskname string) error {
+	fname := backupStateFile(vmname, diskname)
+
+	if err := os.MkdirAll(filepath.Dir(fname), 0755); err != nil {
+		return err
+	}
+
+	st.Updated = time.Now()
+
+	b, err := json.MarshalIndent(st, "", "    ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(fname, append(b, '\n'), 0644)
+}
+
+// RemoveBackupState removes the backup state of the disk.
+func RemoveBackupState(vmname, diskname string) error {
+	if err := os.Remove(backupStateFile(vmname, diskname)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
+
+// InvalidateBackupState marks the dirty bitmap of the disk as broken,
+// so the next backup cannot be incremental. It is used when the disk
+// is modified bypassing the bitmap, e.g. when it is restored from a backup.
+func InvalidateBackupState(vmname, diskname, reason string) error {
+	st, err := GetBackupState(vmname, diskname)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	if len(st.Broken) == 0 {
+		st.Broken = reason
+	}
+
+	return st.Save(vmname, diskname)
+}
+
+// CheckIncremental returns an error if the next backup of the disk
+// cannot be incremental. For a running machine the runtime disk
+// must be passed, since only it has the actual bitmap properties.
+func (st *BackupState) CheckIncremental(d *Disk, running bool) error {
+	switch {
+	case len(st.Broken) > 0:
+		return fmt.Errorf("%w: %s", ErrBackupChainBroken, st.Broken)
+	case st.Disk != d.Path:
+		return fmt.Errorf("%w: disk has been replaced: %s", ErrBackupChainBroken, st.Disk)
+	case running && d.BitmapInconsistent:
+		return fmt.Errorf("%w: dirty bitmap is inconsistent (QEMU was not stopped properly)", ErrBackupChainBroken)
+	case running && !d.HasBitmap && st.Persistent:
+		return fmt.Errorf("%w: dirty bitmap is missing", ErrBackupChainBroken)
+	case running && !d.HasBitmap:
+		return fmt.Errorf("%w: dirty bitmap of a non-qcow2 disk was lost when the machine was restarted or migrated", ErrBackupChainBroken)
+	case !running && !st.Persistent:
+		return fmt.Errorf("%w: dirty bitmap of a non-qcow2 disk is lost when the machine stops", ErrBackupChainBroken)
+	}
+
+	return nil
+}
@@ -5,9 +5,8 @@ import (
 )
 
 type CommandLineFeatures struct {
-	NoReboot     bool
-	IncomingHost string
-	VNCHost      string
+	NoReboot bool
+	VNCHost  string
 }
 
 type qemuCommandLine struct {
@@ -15,14 +14,6 @@ type qemuCommandLine struct {
 	features *CommandLineFeatures
 }
 
-func (b qemuCommandLine) IncomingHost() string {
-	if b.features != nil && len(b.features.IncomingHost) > 0 {
-		return b.features.IncomingHost
-	}
-
-	return "0.0.0.0"
-}
-
 func (b qemuCommandLine) VNCHost() string {
 	if b.features != nil && len(b.features.VNCHost) > 0 {
 		return b.features.VNCHost
@@ -39,6 +30,10 @@ func GetCommandLine(vmi Instance, features *CommandLineFeatures) ([]string, erro
 	switch vmi.MachineTypeGet().Chipset {
 	case QEMU_CHIPSET_I440FX:
 		return (&qemuCommandLine_i440fx{&qemuCommandLine{vmi, features}}).gen()
+	case QEMU_CHIPSET_Q35:
+		return (&qemuCommandLine_q35{qemuCommandLine: &qemuCommandLine{vmi, features}}).gen()
+	case QEMU_CHIPSET_MICROVM:
+		return (&qemuCommandLine_microvm{&qemuCommandLine{vmi, features}}).gen()
 	}
 
 	return nil, fmt.Errorf("unsupported machine type: %s", vmi.MachineTypeGet())
@@ -67,16 +67,19 @@ func (b *qemuCommandLine_i440fx) cdromArgs(dev *Cdrom) []string {
 }
 
 func (b *qemuCommandLine_i440fx) diskArgs(disk *Disk) []string {
-	backendOpts := []string{
-		fmt.Sprintf("file=%s", disk.Path),
+	backendOpts := append(disk.driveOptions(), []string{
 		fmt.Sprintf("id=%s", disk.BaseName()),
-		"format=raw",
+		fmt.Sprintf("format=%s", disk.Format()),
 		"if=none",
 		"aio=native",
 		"cache=none",
 		"detect-zeroes=on",
 		fmt.Sprintf("iops_rd=%d", disk.IopsRd),
 		fmt.Sprintf("iops_wr=%d", disk.IopsWr),
+	}...)
+
+	if disk.Readonly {
+		backendOpts = append(backendOpts, "readonly")
 	}
 
 	deviceOpts := []string{
@@ -110,7 +113,10 @@ func (b *qemuCommandLine_i440fx) diskArgs(disk *Disk) []string {
 		deviceOpts = append(deviceOpts, fmt.Sprintf("bootindex=%d", disk.Bootindex))
 	}
 
-	return []string{"-drive", strings.Join(backendOpts, ","), "-device", strings.Join(deviceOpts, ",")}
+	// Objects such as secrets must be defined before the block backend
+	args := append(disk.objectArgs(), "-drive", strings.Join(backendOpts, ","), "-device", strings.Join(deviceOpts, ","))
+
+	return args
 }
 
 func (b *qemuCommandLine_i440fx) netIfaceArgs(iface *NetIface) []string {
@@ -377,12 +383,19 @@ func (b *qemuCommandLine_i440fx) gen() ([]string, error) {
 		args = append(args, b.cdromArgs(dev)...)
 	}
 
+	// TLS credentials for the encrypted NBD disks
+	args = append(args, b.diskTLSArgs()...)
+
 	// Disks
 	for _, disk := range b.vmconf.DiskGetList() {
 		if err := disk.Validate(true); err != nil {
 			return nil, fmt.Errorf("disk '%s' validation error: %w", disk.Path, err)
 		}
 
+		if disk.Driver() == DiskDriverType_VIRTIO_BLK_DEVICE {
+			return nil, fmt.Errorf("disk '%s': driver is supported only by microvm: %s", disk.Path, disk.Driver())
+		}
+
 		if disk.Driver() == DiskDriverType_SCSI_HD {
 			busName, busAddr, _ := ParseSCSIAddr(disk.QemuAddr)
 
@@ -431,6 +444,10 @@ func (b *qemuCommandLine_i440fx) gen() ([]string, error) {
 				return nil, fmt.Errorf("net interface '%s' validation error: %w", n.Ifname, err)
 			}
 
+			if n.Driver() == NetDriverType_VIRTIO_NET_DEVICE {
+				return nil, fmt.Errorf("net interface '%s': driver is supported only by microvm: %s", n.Ifname, n.Driver())
+			}
+
 			args = append(args, b.netIfaceArgs(n)...)
 		}
 	} else {
@@ -452,9 +469,11 @@ func (b *qemuCommandLine_i440fx) gen() ([]string, error) {
 	// Run as a non-privileged user
 	args = append(args, "-runas", b.vmconf.Name())
 
-	// For migration
+	// For migration.
+	// The listener is started by kvmrund using the migrate-incoming command,
+	// because some parameters (such as TLS credentials) must be set before
 	if b.vmconf.IsIncoming() {
-		args = append(args, "-incoming", fmt.Sprintf("tcp:%s:%d", b.IncomingHost(), FIRST_INCOMING_PORT+b.vmconf.UID()))
+		args = append(args, "-incoming", "defer")
 	}
 
 	// Extra args from extra file
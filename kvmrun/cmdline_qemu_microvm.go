@@ -0,0 +1,260 @@
+package kvmrun
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+type qemuCommandLine_microvm struct {
+	*qemuCommandLine
+}
+
+func (b *qemuCommandLine_microvm) diskArgs(disk *Disk) ([]string, error) {
+	if disk.Driver() != DiskDriverType_VIRTIO_BLK_DEVICE {
+		return nil, fmt.Errorf("disk driver is not supported by microvm: %s", disk.Driver())
+	}
+
+	backendOpts := append(disk.driveOptions(), []string{
+		fmt.Sprintf("id=%s", disk.BaseName()),
+		fmt.Sprintf("format=%s", disk.Format()),
+		"if=none",
+		"aio=native",
+		"cache=none",
+		"detect-zeroes=on",
+		fmt.Sprintf("iops_rd=%d", disk.IopsRd),
+		fmt.Sprintf("iops_wr=%d", disk.IopsWr),
+	}...)
+
+	if disk.Readonly {
+		backendOpts = append(backendOpts, "readonly")
+	}
+
+	deviceOpts := []string{
+		disk.Driver().String(),
+		fmt.Sprintf("drive=%s", disk.BaseName()),
+		fmt.Sprintf("id=%s", disk.QdevID()),
+	}
+
+	// Objects such as secrets must be defined before the block backend
+	args := append(disk.objectArgs(), "-drive", strings.Join(backendOpts, ","), "-device", strings.Join(deviceOpts, ","))
+
+	return args, nil
+}
+
+func (b *qemuCommandLine_microvm) netIfaceArgs(iface *NetIface) ([]string, error) {
+	if iface.Driver() != NetDriverType_VIRTIO_NET_DEVICE {
+		return nil, fmt.Errorf("net interface driver is not supported by microvm: %s", iface.Driver())
+	}
+
+	backendOpts := []string{
+		"tap",
+		fmt.Sprintf("ifname=%s", iface.Ifname),
+		fmt.Sprintf("id=%s", iface.Ifname),
+		"vhost=on",
+		fmt.Sprintf("script=%s", VMNETINIT),
+		"downscript=no",
+	}
+
+	deviceOpts := []string{
+		iface.Driver().String(),
+		fmt.Sprintf("netdev=%s", iface.Ifname),
+		fmt.Sprintf("id=%s", iface.QdevID()),
+		fmt.Sprintf("mac=%s", iface.HwAddr),
+	}
+
+	// Enable multi-queue on virtio-net-device interface
+	if iface.Queues > 1 {
+		// "iface.Queues" -- is the number of queue pairs.
+		backendOpts = append(backendOpts, fmt.Sprintf("queues=%d", 2*iface.Queues))
+		// There are no MSI-X vectors on the virtio-mmio transport,
+		// so only multi-queue flag is passed to the device.
+		deviceOpts = append(deviceOpts, "mq=on")
+	}
+
+	return []string{"-netdev", strings.Join(backendOpts, ","), "-device", strings.Join(deviceOpts, ",")}, nil
+}
+
+func (b *qemuCommandLine_microvm) gen() ([]string, error) {
+	args := make([]string, 0, 64)
+
+	args = append(args, QEMU_BINARY, "-machine", "accel=kvm:tcg", "-name", b.vmconf.Name())
+
+	// Machine type.
+	// There is no option ROMs, ISA serial port and RTC to speed up the boot process
+	args = append(args, "-M", fmt.Sprintf("%s,x-option-roms=off,isa-serial=off,rtc=off", b.vmconf.MachineTypeGet()))
+
+	// Disable default devices
+	args = append(args, "-nodefaults", "-no-user-config")
+
+	// Firmware
+	if fw := b.vmconf.FirmwareGet(); fw != nil && len(fw.Image) > 0 {
+		return nil, fmt.Errorf("firmware: %w by microvm", ErrNotSupported)
+	}
+
+	// Memory
+	args = append(args, "-m", fmt.Sprintf("%dM", b.vmconf.MemoryGetTotal()))
+
+	// CPU model
+	if model := b.vmconf.CPUGetModel(); len(model) > 0 {
+		args = append(args, "-cpu", model)
+	}
+
+	// CPUs
+	if total := b.vmconf.CPUGetTotal(); total > 1 {
+		if sockets := b.vmconf.CPUGetSockets(); sockets > 0 {
+			if total%sockets != 0 {
+				return nil, fmt.Errorf("total CPU count must be multiple of socket count: %d %% %d != 0", total, sockets)
+			}
+			args = append(args, "-smp", fmt.Sprintf("cpus=%d,sockets=%d,cores=%d", total, sockets, total/sockets))
+		} else {
+			args = append(args, "-smp", fmt.Sprintf("cpus=%d", total))
+		}
+	}
+
+	// Memory ballooning
+	args = append(args, "-device", "virtio-balloon-device,id=balloon0")
+
+	// Common virtio serial device
+	args = append(args, "-device", "virtio-serial-device")
+
+	// Virtual console
+	args = append(args, "-chardev", fmt.Sprintf("socket,id=virtcon,path=%s.virtcon,server,nowait", filepath.Join(QMPMONDIR, b.vmconf.Name())))
+	args = append(args, "-device", "virtconsole,chardev=virtcon,name=console.0")
+
+	// There is no PCI bus, USB controller, IDE and floppy
+	// on this machine type
+	if devices := b.vmconf.HostDeviceGetList(); len(devices) > 0 {
+		return nil, fmt.Errorf("host-pci devices: %w by microvm", ErrNotSupported)
+	}
+
+	if devices := b.vmconf.InputDeviceGetList(); len(devices) > 0 {
+		return nil, fmt.Errorf("input devices: %w by microvm", ErrNotSupported)
+	}
+
+	if devices := b.vmconf.CdromGetList(); len(devices) > 0 {
+		return nil, fmt.Errorf("cdrom devices: %w by microvm", ErrNotSupported)
+	}
+
+	if cidrive := b.vmconf.CloudInitGetDrive(); cidrive != nil {
+		return nil, fmt.Errorf("cloud-init drive: %w by microvm", ErrNotSupported)
+	}
+
+	// Channels: default virtio serial port
+	args = append(args, "-chardev", fmt.Sprintf("socket,id=qga0,path=%s.qga,server,nowait", filepath.Join(QMPMONDIR, b.vmconf.Name())))
+	args = append(args, "-device", "virtio-serial-device,id=virtio-serial-qga0")
+	args = append(args, "-device", "virtserialport,chardev=qga0,name=org.guest-agent.0")
+
+	// Channels: virtio vsock device
+	if vsockDev := b.vmconf.VSockDeviceGet(); vsockDev != nil {
+		if err := vsockDev.Validate(true); err != nil {
+			return nil, fmt.Errorf("virtio-vsock validation error: %w", err)
+		}
+
+		var cid uint32
+
+		if vsockDev.ContextID == 0 {
+			cid = uint32(os.Getpid())
+		} else {
+			cid = vsockDev.ContextID
+		}
+
+		args = append(args, "-device", fmt.Sprintf("vhost-vsock-device,id=vsock_device,guest-cid=%d", cid))
+	}
+
+	// iSCSI parameters
+	args = append(args, "-iscsi", "initiator-name=iqn.2008-11.org.linux-kvm:kvmrun")
+
+	// TLS credentials for the encrypted NBD disks
+	args = append(args, b.diskTLSArgs()...)
+
+	// Disks
+	for _, disk := range b.vmconf.DiskGetList() {
+		if err := disk.Validate(true); err != nil {
+			return nil, fmt.Errorf("disk '%s' validation error: %w", disk.Path, err)
+		}
+
+		if diskArgs, err := b.diskArgs(disk); err == nil {
+			args = append(args, diskArgs...)
+		} else {
+			return nil, fmt.Errorf("disk '%s': %w", disk.Path, err)
+		}
+	}
+
+	// External Kernel.
+	// This is the only way to boot the microvm machine
+	if kernImage := b.vmconf.KernelGetImage(); len(kernImage) > 0 {
+		args = append(args, "-kernel", filepath.Join(KERNELSDIR, kernImage))
+
+		if initrd := b.vmconf.KernelGetInitrd(); len(initrd) > 0 {
+			args = append(args, "-initrd", filepath.Join(KERNELSDIR, initrd))
+		}
+
+		kparams := []string{"root=/dev/vda"}
+
+		if cmdline := b.vmconf.KernelGetCmdline(); len(cmdline) > 0 {
+			kparams = append(kparams, strings.Replace(cmdline, ";", " ", -1))
+		}
+
+		args = append(args, "-append", strings.Join(kparams, " "))
+
+		if modiso := b.vmconf.KernelGetModiso(); len(modiso) > 0 {
+			args = append(args, "-drive", fmt.Sprintf("file=%s,if=none,media=cdrom,id=modiso,format=raw,aio=native,cache=none", filepath.Join(MODULESDIR, modiso)))
+			args = append(args, "-device", "virtio-blk-device,drive=modiso,id=modiso")
+		}
+	} else {
+		return nil, fmt.Errorf("external kernel image is required to run microvm")
+	}
+
+	// Network devices
+	if netIfaces := b.vmconf.NetIfaceGetList(); len(netIfaces) > 0 {
+		for _, n := range netIfaces {
+			if err := n.Validate(true); err != nil {
+				return nil, fmt.Errorf("net interface '%s' validation error: %w", n.Ifname, err)
+			}
+
+			if netArgs, err := b.netIfaceArgs(n); err == nil {
+				args = append(args, netArgs...)
+			} else {
+				return nil, fmt.Errorf("net interface '%s': %w", n.Ifname, err)
+			}
+		}
+	} else {
+		args = append(args, "-net", "none")
+	}
+
+	// There is no display device on this machine type,
+	// so VNC is not used. The virtual console is the only way
+	// to access the guest
+
+	// QMP monitor
+	args = append(args, "-qmp", fmt.Sprintf("unix:%s.qmp0,server,nowait", filepath.Join(QMPMONDIR, b.vmconf.Name())))
+	args = append(args, "-qmp", fmt.Sprintf("unix:%s.qmp1,server,nowait", filepath.Join(QMPMONDIR, b.vmconf.Name())))
+
+	// Other options
+	if b.features.NoReboot {
+		args = append(args, "-no-reboot")
+	}
+
+	// Run as a non-privileged user
+	args = append(args, "-runas", b.vmconf.Name())
+
+	// For migration.
+	// The listener is started by kvmrund using the migrate-incoming command,
+	// because some parameters (such as TLS credentials) must be set before
+	if b.vmconf.IsIncoming() {
+		args = append(args, "-incoming", "defer")
+	}
+
+	// Extra args from extra file
+	if _, err := os.Stat("extra"); err == nil {
+		b, err := os.ReadFile("extra")
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, strings.Split(string(b), "\n")...)
+	}
+
+	return args, nil
+}
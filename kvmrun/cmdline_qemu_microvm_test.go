@@ -0,0 +1,109 @@
+package kvmrun
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func hasArg(args []string, name, value string) bool {
+	for i := 0; i+1 < len(args); i++ {
+		if args[i] == name && strings.HasPrefix(args[i+1], value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func newTestMicrovmConf(t *testing.T) *InstanceConf {
+	vmc := newInstanceConf("alice")
+
+	if err := vmc.MachineTypeSet("microvm"); err != nil {
+		t.Fatalf("got unexpected error: %s", err)
+	}
+
+	vmc.KernelSetImage("vmlinuz")
+
+	if err := vmc.DiskAppend(DiskProperties{Path: "/var/lib/kvmrun/alice_sda.img"}); err != nil {
+		t.Fatalf("got unexpected error: %s", err)
+	}
+
+	if err := vmc.NetIfaceAppend(NetIfaceProperties{Ifname: "alice_eth0", HwAddr: "02:00:00:00:00:0a"}); err != nil {
+		t.Fatalf("got unexpected error: %s", err)
+	}
+
+	return vmc
+}
+
+func TestCommandLineMicrovm(t *testing.T) {
+	args, err := GetCommandLine(newTestMicrovmConf(t), nil)
+	if err != nil {
+		t.Fatalf("got unexpected error: %s", err)
+	}
+
+	wanted := []struct {
+		name  string
+		value string
+	}{
+		{"-M", "microvm,"},
+		{"-kernel", "/"},
+		{"-device", "virtio-blk-device,drive=alice_sda.img"},
+		{"-device", "virtio-net-device,netdev=alice_eth0"},
+	}
+
+	for _, w := range wanted {
+		if !hasArg(args, w.name, w.value) {
+			t.Fatalf("argument not found: %s %s...", w.name, w.value)
+		}
+	}
+
+	// There is no display device and firmware.
+	// And "-net none" is not used, since there is a network interface
+	for _, name := range []string{"-vnc", "-bios", "-pflash", "-net"} {
+		if hasArg(args, name, "") {
+			t.Fatalf("got unexpected argument: %s", name)
+		}
+	}
+}
+
+func TestCommandLineMicrovmUnsupported(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*InstanceConf)
+	}{
+		{
+			name:   "no kernel",
+			modify: func(vmc *InstanceConf) { vmc.KernelSetImage("") },
+		},
+		{
+			name:   "virtio-blk-pci disk",
+			modify: func(vmc *InstanceConf) { vmc.Disks.Get("alice_sda.img").driver = DiskDriverType_VIRTIO_BLK_PCI },
+		},
+		{
+			name:   "virtio-net-pci interface",
+			modify: func(vmc *InstanceConf) { vmc.NetIfaces.Get("alice_eth0").driver = NetDriverType_VIRTIO_NET_PCI },
+		},
+	}
+
+	for _, tc := range tests {
+		vmc := newTestMicrovmConf(t)
+
+		tc.modify(vmc)
+
+		if _, err := GetCommandLine(vmc, nil); err == nil {
+			t.Fatalf("%s: expected error, got nil", tc.name)
+		}
+	}
+
+	// Devices are rejected by the configuration
+	vmc := newTestMicrovmConf(t)
+
+	if err := vmc.CdromAppend(CdromProperties{Name: "cd", Media: "/tmp/alice.iso"}); !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("got unexpected error: want %v, got %v", ErrNotSupported, err)
+	}
+
+	if err := vmc.DiskAppend(DiskProperties{Path: "/var/lib/kvmrun/alice_sdb.img", Driver: "virtio-blk-pci"}); !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("got unexpected error: want %v, got %v", ErrNotSupported, err)
+	}
+}
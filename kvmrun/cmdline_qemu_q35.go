@@ -0,0 +1,580 @@
+package kvmrun
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/0xef53/kvmrun/internal/pci"
+)
+
+type qemuCommandLine_q35 struct {
+	*qemuCommandLine
+
+	rootPorts *pcieRootPortPool
+}
+
+func (b *qemuCommandLine_q35) rootPortArgs(num int) []string {
+	// Root ports are placed as functions of the multifunction devices
+	// starting from slot 0x10 of the pcie.0 bus: 8 ports per slot.
+	slot := 0x10 + (num-1)/8
+	fn := (num - 1) % 8
+
+	opts := []string{
+		"pcie-root-port",
+		fmt.Sprintf("id=%s", PCIeRootPortName(num)),
+		fmt.Sprintf("port=0x%x", num),
+		fmt.Sprintf("chassis=%d", num),
+		"bus=pcie.0",
+		fmt.Sprintf("addr=0x%x.0x%x", slot, fn),
+	}
+
+	if fn == 0 {
+		opts = append(opts, "multifunction=on")
+	}
+
+	return []string{"-device", strings.Join(opts, ",")}
+}
+
+func (b *qemuCommandLine_q35) scsiBusArgs(busName, busAddr string) ([]string, error) {
+	port, err := b.rootPorts.Get(busAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return []string{"-device", fmt.Sprintf("virtio-scsi-pci,id=%s,bus=%s", busName, port)}, nil
+}
+
+func (b *qemuCommandLine_q35) cdromArgs(dev *Cdrom) []string {
+	var backendOpts []string
+
+	if media := strings.TrimSpace(dev.Media); len(media) > 0 {
+		backendOpts = []string{
+			fmt.Sprintf("file=%s", dev.Media),
+			fmt.Sprintf("id=%s", dev.Name),
+			"format=raw",
+			"if=none",
+			"aio=native",
+			"cache=none",
+			"detect-zeroes=on",
+		}
+	} else {
+		backendOpts = []string{
+			fmt.Sprintf("id=%s", dev.Name),
+			"if=none",
+			"aio=native",
+			"detect-zeroes=on",
+		}
+	}
+
+	if dev.Readonly {
+		backendOpts = append(backendOpts, "readonly")
+	}
+
+	deviceOpts := []string{
+		dev.Driver().String(),
+		fmt.Sprintf("drive=%s", dev.Name),
+		fmt.Sprintf("id=%s", dev.QdevID()),
+	}
+
+	switch dev.Driver() {
+	case CdromDriverType_SCSI_CD:
+		// SCSI devices have channel, scsi-id, and lun parameters
+		deviceOpts = append(deviceOpts, "channel=0,scsi-id=1")
+
+		bus, _, lun := ParseSCSIAddr(dev.QemuAddr)
+
+		deviceOpts = append(deviceOpts, fmt.Sprintf("bus=%s.0", bus))
+
+		if lun != "" {
+			deviceOpts = append(deviceOpts, fmt.Sprintf("lun=%s", lun))
+		}
+	}
+
+	if dev.Bootindex > 0 {
+		deviceOpts = append(deviceOpts, fmt.Sprintf("bootindex=%d", dev.Bootindex))
+	}
+
+	return []string{"-drive", strings.Join(backendOpts, ","), "-device", strings.Join(deviceOpts, ",")}
+}
+
+func (b *qemuCommandLine_q35) diskArgs(disk *Disk) ([]string, error) {
+	if disk.Driver() == DiskDriverType_VIRTIO_BLK_DEVICE {
+		return nil, fmt.Errorf("disk driver is supported only by microvm: %s", disk.Driver())
+	}
+
+	backendOpts := append(disk.driveOptions(), []string{
+		fmt.Sprintf("id=%s", disk.BaseName()),
+		fmt.Sprintf("format=%s", disk.Format()),
+		"if=none",
+		"aio=native",
+		"cache=none",
+		"detect-zeroes=on",
+		fmt.Sprintf("iops_rd=%d", disk.IopsRd),
+		fmt.Sprintf("iops_wr=%d", disk.IopsWr),
+	}...)
+
+	if disk.Readonly {
+		backendOpts = append(backendOpts, "readonly")
+	}
+
+	deviceOpts := []string{
+		disk.Driver().String(),
+		fmt.Sprintf("drive=%s", disk.BaseName()),
+		fmt.Sprintf("id=%s", disk.QdevID()),
+	}
+
+	switch disk.Driver() {
+	case DiskDriverType_VIRTIO_BLK_PCI:
+		// Each PCIe device is placed on its own root port
+		port, err := b.rootPorts.Get(disk.QemuAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		deviceOpts = append(deviceOpts, fmt.Sprintf("bus=%s", port))
+	case DiskDriverType_SCSI_HD:
+		// SCSI devices have channel, scsi-id, and lun parameters
+		deviceOpts = append(deviceOpts, "channel=0,scsi-id=1")
+
+		bus, _, lun := ParseSCSIAddr(disk.QemuAddr)
+
+		deviceOpts = append(deviceOpts, fmt.Sprintf("bus=%s.0", bus))
+
+		if lun != "" {
+			deviceOpts = append(deviceOpts, fmt.Sprintf("lun=%s", lun))
+		}
+	}
+
+	if disk.Bootindex > 0 {
+		deviceOpts = append(deviceOpts, fmt.Sprintf("bootindex=%d", disk.Bootindex))
+	}
+
+	// Objects such as secrets must be defined before the block backend
+	args := append(disk.objectArgs(), "-drive", strings.Join(backendOpts, ","), "-device", strings.Join(deviceOpts, ","))
+
+	return args, nil
+}
+
+func (b *qemuCommandLine_q35) netIfaceArgs(iface *NetIface) ([]string, error) {
+	if iface.Driver() == NetDriverType_VIRTIO_NET_DEVICE {
+		return nil, fmt.Errorf("net interface driver is supported only by microvm: %s", iface.Driver())
+	}
+
+	backendOpts := []string{
+		"tap",
+		fmt.Sprintf("ifname=%s", iface.Ifname),
+		fmt.Sprintf("id=%s", iface.Ifname),
+		"vhost=on",
+		fmt.Sprintf("script=%s", VMNETINIT),
+		"downscript=no",
+	}
+
+	deviceOpts := []string{
+		iface.Driver().String(),
+		fmt.Sprintf("netdev=%s", iface.Ifname),
+		fmt.Sprintf("id=%s", iface.QdevID()),
+		fmt.Sprintf("mac=%s", iface.HwAddr),
+	}
+
+	if iface.Driver().HotPluggable() {
+		// Each PCIe device is placed on its own root port
+		port, err := b.rootPorts.Get(iface.QemuAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		deviceOpts = append(deviceOpts, fmt.Sprintf("bus=%s", port))
+	} else {
+		// Legacy PCI devices are integrated into the root complex
+		deviceOpts = append(deviceOpts, "bus=pcie.0")
+
+		if iface.QemuAddr != "" {
+			deviceOpts = append(deviceOpts, fmt.Sprintf("addr=%s", iface.QemuAddr))
+		}
+	}
+
+	if iface.Bootindex > 0 {
+		deviceOpts = append(deviceOpts, fmt.Sprintf("bootindex=%d", iface.Bootindex))
+	}
+
+	// Enable multi-queue on virtio-net-pci interface
+	if iface.Driver() == NetDriverType_VIRTIO_NET_PCI && iface.Queues > 1 {
+		// "iface.Queues" -- is the number of queue pairs.
+		backendOpts = append(backendOpts, fmt.Sprintf("queues=%d", 2*iface.Queues))
+		// "iface.Queues" count vectors for TX (transmit) queues, the same for RX (receive) queues,
+		// one for configuration purposes, and one for possible VQ (vector quantization) control.
+		deviceOpts = append(deviceOpts, fmt.Sprintf("mq=on,vectors=%d", 2*iface.Queues+2))
+	}
+
+	return []string{"-netdev", strings.Join(backendOpts, ","), "-device", strings.Join(deviceOpts, ",")}, nil
+}
+
+func (b *qemuCommandLine_q35) hostpciArgs(dev *HostDevice, backend *pci.Device) ([]string, error) {
+	// Each host device is placed on its own root port
+	port, err := b.rootPorts.Next()
+	if err != nil {
+		return nil, err
+	}
+
+	num, _ := ParsePCIeRootPortName(port)
+
+	opts := func(hexaddr string, fn uint8) []string {
+		v := []string{
+			"vfio-pci",
+			fmt.Sprintf("host=%s", hexaddr),
+			fmt.Sprintf("bus=%s", port),
+		}
+
+		if dev.Multifunction {
+			v = append(v, fmt.Sprintf("id=hostpci%d.%d", num, fn))
+			v = append(v, fmt.Sprintf("addr=0x0.0x%x", fn))
+		} else {
+			v = append(v, fmt.Sprintf("id=hostpci%d", num))
+			v = append(v, "addr=0x0")
+		}
+
+		if fn == 0 {
+			if dev.PrimaryGPU {
+				v = append(v, "x-vga=on")
+			}
+			if dev.Multifunction {
+				v = append(v, "multifunction=on")
+			}
+		}
+
+		return v
+	}
+
+	subdevices := backend.Subdevices()
+
+	args := make([]string, 0, 2*(len(subdevices)+1))
+
+	args = append(args, "-device", strings.Join(opts(backend.String(), 0), ","))
+
+	if dev.Multifunction {
+		for _, sub := range subdevices {
+			args = append(args, "-device", strings.Join(opts(sub.String(), sub.AddrFunction()), ","))
+		}
+	}
+
+	return args, nil
+}
+
+func (b *qemuCommandLine_q35) gen() ([]string, error) {
+	args := make([]string, 0, 128)
+
+	args = append(args, QEMU_BINARY, "-machine", "accel=kvm:tcg", "-name", b.vmconf.Name())
+
+	// Machine type
+	if t := b.vmconf.MachineTypeGet(); len(t.String()) > 0 {
+		args = append(args, "-M", t.String())
+	}
+
+	// Disable default devices
+	args = append(args, "-nodefaults", "-no-user-config")
+
+	// Firmware
+	if fw := b.vmconf.FirmwareGet(); fw != nil && len(fw.Image) > 0 {
+		args = append(args, "-drive", fmt.Sprintf("if=pflash,unit=0,id=fwloader,format=raw,readonly=on,file=%s", fw.Image))
+
+		if fwflash := b.vmconf.FirmwareGetFlash(); fwflash != nil {
+			args = append(args, "-drive", fmt.Sprintf("if=pflash,unit=1,id=fwflash,format=raw,file=%s", fwflash.Path))
+		}
+	}
+
+	// Memory
+	args = append(args, "-m", fmt.Sprintf("%dM", b.vmconf.MemoryGetTotal()))
+
+	// CPU model
+	if model := b.vmconf.CPUGetModel(); len(model) > 0 {
+		args = append(args, "-cpu", model)
+	}
+
+	// CPUs
+	if total := b.vmconf.CPUGetTotal(); total > 1 {
+		if sockets := b.vmconf.CPUGetSockets(); sockets > 0 {
+			if total%sockets != 0 {
+				return nil, fmt.Errorf("total CPU count must be multiple of socket count: %d %% %d != 0", total, sockets)
+			}
+			args = append(args, "-smp", fmt.Sprintf("cpus=%d,sockets=%d,cores=%d,maxcpus=%d", b.vmconf.CPUGetActual(), sockets, total/sockets, total))
+		} else {
+			args = append(args, "-smp", fmt.Sprintf("cpus=%d,maxcpus=%d", b.vmconf.CPUGetActual(), total))
+		}
+	}
+
+	// PCIe root ports.
+	// The PCIe root complex (pcie.0) does not support hot-plugging,
+	// so a fixed set of root ports is always created. This keeps the PCI topology
+	// the same on the source and destination sides of a migration.
+	for num := 1; num <= QEMU_Q35_ROOT_PORTS; num++ {
+		args = append(args, b.rootPortArgs(num)...)
+	}
+
+	// The addresses of already placed devices must be reserved first
+	b.rootPorts = newPCIeRootPortPool()
+
+	for _, dev := range b.vmconf.CdromGetList() {
+		if dev.Driver() == CdromDriverType_SCSI_CD {
+			_, busAddr, _ := ParseSCSIAddr(dev.QemuAddr)
+			b.rootPorts.Reserve(busAddr)
+		}
+	}
+
+	for _, disk := range b.vmconf.DiskGetList() {
+		switch disk.Driver() {
+		case DiskDriverType_VIRTIO_BLK_PCI:
+			b.rootPorts.Reserve(disk.QemuAddr)
+		case DiskDriverType_SCSI_HD:
+			_, busAddr, _ := ParseSCSIAddr(disk.QemuAddr)
+			b.rootPorts.Reserve(busAddr)
+		}
+	}
+
+	for _, iface := range b.vmconf.NetIfaceGetList() {
+		if iface.Driver().HotPluggable() {
+			b.rootPorts.Reserve(iface.QemuAddr)
+		}
+	}
+
+	if vsockDev := b.vmconf.VSockDeviceGet(); vsockDev != nil {
+		b.rootPorts.Reserve(vsockDev.QemuAddr)
+	}
+
+	// Memory ballooning
+	args = append(args, "-device", "virtio-balloon-pci,id=balloon0,bus=pcie.0,addr=0x3")
+
+	// Common virtio serial pci
+	args = append(args, "-device", "virtio-serial-pci,bus=pcie.0,addr=0x4")
+
+	// Virtual console
+	args = append(args, "-chardev", fmt.Sprintf("socket,id=virtcon,path=%s.virtcon,server,nowait", filepath.Join(QMPMONDIR, b.vmconf.Name())))
+	args = append(args, "-device", "virtconsole,chardev=virtcon,name=console.0")
+
+	var hasPrimaryGPU bool
+
+	// PCI passthrough
+	for _, dev := range b.vmconf.HostDeviceGetList() {
+		if err := dev.Validate(true); err != nil {
+			return nil, fmt.Errorf("host-pci device '%s' validation error: %w", dev.PCIAddr, err)
+		}
+
+		be, err := pci.LookupDevice(dev.PCIAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		if dev.Multifunction && !be.HasMultifunctionFeature() {
+			return nil, fmt.Errorf("multifunction is not supported: %s", be.String())
+		}
+
+		if hostpciArgs, err := b.hostpciArgs(dev, be); err == nil {
+			args = append(args, hostpciArgs...)
+		} else {
+			return nil, fmt.Errorf("host-pci device '%s': %w", dev.PCIAddr, err)
+		}
+
+		if dev.PrimaryGPU {
+			hasPrimaryGPU = true
+		}
+	}
+
+	// VGA
+	if hasPrimaryGPU {
+		args = append(args, "-vga", "none", "-nographic")
+	} else {
+		args = append(args, "-vga", "std")
+	}
+
+	// Input devices
+	for _, dev := range b.vmconf.InputDeviceGetList() {
+		switch dev.Type {
+		case "usb-tablet":
+			args = append(args, "-device", "qemu-xhci,id=xhci,bus=pcie.0,addr=0x6")
+			args = append(args, "-device", "usb-tablet,id=tablet,bus=xhci.0")
+		}
+	}
+
+	// CloudInit drive
+	if cidrive := b.vmconf.CloudInitGetDrive(); cidrive != nil {
+		if cidrive.Driver() == DriverType_UNKNOWN {
+			// q35 has no floppy controller, so IDE CD-ROM is the only option
+			cidrive.driver = CloudInitDriverType_IDE_CD
+		}
+
+		if err := cidrive.Validate(true); err != nil {
+			return nil, fmt.Errorf("cloud-init validation error: %w", err)
+		}
+
+		args = append(args, "-smbios", "type=1,serial=ds=nocloud")
+		args = append(args, "-drive", fmt.Sprintf("file=%s,id=cidata,format=raw,media=cdrom,if=none,aio=native,cache=none,readonly", cidrive.Media))
+
+		switch cidrive.Driver() {
+		case CloudInitDriverType_IDE_CD:
+			// The last port of the integrated AHCI controller
+			args = append(args, "-device", "ide-cd,bus=ide.5,drive=cidata,id=cidata")
+		default:
+			return nil, fmt.Errorf("cloud-init driver is not supported by q35 machine: %s", cidrive.Driver())
+		}
+	}
+
+	// Channels: default virtio serial port
+	args = append(args, "-chardev", fmt.Sprintf("socket,id=qga0,path=%s.qga,server,nowait", filepath.Join(QMPMONDIR, b.vmconf.Name())))
+	args = append(args, "-device", "virtio-serial-pci,id=virtio-serial-qga0,bus=pcie.0,addr=0x5")
+	args = append(args, "-device", "virtserialport,chardev=qga0,name=org.guest-agent.0")
+
+	// Channels: virtio vsock device
+	if vsockDev := b.vmconf.VSockDeviceGet(); vsockDev != nil {
+		if err := vsockDev.Validate(true); err != nil {
+			return nil, fmt.Errorf("virtio-vsock validation error: %w", err)
+		}
+
+		var cid uint32
+
+		if vsockDev.ContextID == 0 {
+			cid = uint32(os.Getpid())
+		} else {
+			cid = vsockDev.ContextID
+		}
+
+		port, err := b.rootPorts.Get(vsockDev.QemuAddr)
+		if err != nil {
+			return nil, fmt.Errorf("virtio-vsock: %w", err)
+		}
+
+		args = append(args, "-device", fmt.Sprintf("vhost-vsock-pci,id=vsock_device,guest-cid=%d,bus=%s", cid, port))
+	}
+
+	// iSCSI parameters
+	args = append(args, "-iscsi", "initiator-name=iqn.2008-11.org.linux-kvm:kvmrun")
+
+	// Common SCSI bus
+	scsiBuses := make(map[string]struct{})
+
+	// Cdrom devices
+	for _, dev := range b.vmconf.CdromGetList() {
+		if err := dev.Validate(true); err != nil {
+			return nil, fmt.Errorf("cdrom '%s' validation error: %w", dev.Name, err)
+		}
+
+		if dev.Driver() == CdromDriverType_SCSI_CD {
+			busName, busAddr, _ := ParseSCSIAddr(dev.QemuAddr)
+
+			if _, ok := scsiBuses[busName]; !ok {
+				if busArgs, err := b.scsiBusArgs(busName, busAddr); err == nil {
+					args = append(args, busArgs...)
+				} else {
+					return nil, fmt.Errorf("SCSI bus '%s': %w", busName, err)
+				}
+
+				scsiBuses[busName] = struct{}{}
+			}
+		}
+
+		args = append(args, b.cdromArgs(dev)...)
+	}
+
+	// TLS credentials for the encrypted NBD disks
+	args = append(args, b.diskTLSArgs()...)
+
+	// Disks
+	for _, disk := range b.vmconf.DiskGetList() {
+		if err := disk.Validate(true); err != nil {
+			return nil, fmt.Errorf("disk '%s' validation error: %w", disk.Path, err)
+		}
+
+		if disk.Driver() == DiskDriverType_SCSI_HD {
+			busName, busAddr, _ := ParseSCSIAddr(disk.QemuAddr)
+
+			if _, ok := scsiBuses[busName]; !ok {
+				if busArgs, err := b.scsiBusArgs(busName, busAddr); err == nil {
+					args = append(args, busArgs...)
+				} else {
+					return nil, fmt.Errorf("SCSI bus '%s': %w", busName, err)
+				}
+
+				scsiBuses[busName] = struct{}{}
+			}
+		}
+
+		if diskArgs, err := b.diskArgs(disk); err == nil {
+			args = append(args, diskArgs...)
+		} else {
+			return nil, fmt.Errorf("disk '%s': %w", disk.Path, err)
+		}
+	}
+
+	// External Kernel
+	if kernImage := b.vmconf.KernelGetImage(); len(kernImage) > 0 {
+		args = append(args, "-kernel", filepath.Join(KERNELSDIR, kernImage))
+
+		if initrd := b.vmconf.KernelGetInitrd(); len(initrd) > 0 {
+			args = append(args, "-initrd", filepath.Join(KERNELSDIR, initrd))
+		}
+
+		kparams := []string{"root=/dev/vda"}
+
+		if cmdline := b.vmconf.KernelGetCmdline(); len(cmdline) > 0 {
+			kparams = append(kparams, strings.Replace(cmdline, ";", " ", -1))
+		}
+
+		args = append(args, "-append", strings.Join(kparams, " "))
+
+		if modiso := b.vmconf.KernelGetModiso(); len(modiso) > 0 {
+			// Slot 0x1f of the pcie.0 bus is occupied by the ICH9 controllers
+			args = append(args, "-drive", fmt.Sprintf("file=%s,if=none,media=cdrom,id=modiso,format=raw,aio=native,cache=none", filepath.Join(MODULESDIR, modiso)))
+			args = append(args, "-device", "virtio-blk-pci,drive=modiso,id=modiso,bus=pcie.0,addr=0x1e")
+		}
+	}
+
+	// Network devices
+	if netIfaces := b.vmconf.NetIfaceGetList(); len(netIfaces) > 0 {
+		for _, n := range netIfaces {
+			if err := n.Validate(true); err != nil {
+				return nil, fmt.Errorf("net interface '%s' validation error: %w", n.Ifname, err)
+			}
+
+			if netArgs, err := b.netIfaceArgs(n); err == nil {
+				args = append(args, netArgs...)
+			} else {
+				return nil, fmt.Errorf("net interface '%s': %w", n.Ifname, err)
+			}
+		}
+	} else {
+		args = append(args, "-net", "none")
+	}
+
+	// VNC
+	args = append(args, "-vnc", fmt.Sprintf("%s:%d,password,websocket=%d", b.VNCHost(), b.vmconf.UID(), FIRST_WS_PORT+b.vmconf.UID()))
+
+	// QMP monitor
+	args = append(args, "-qmp", fmt.Sprintf("unix:%s.qmp0,server,nowait", filepath.Join(QMPMONDIR, b.vmconf.Name())))
+	args = append(args, "-qmp", fmt.Sprintf("unix:%s.qmp1,server,nowait", filepath.Join(QMPMONDIR, b.vmconf.Name())))
+
+	// Other options
+	if b.features.NoReboot {
+		args = append(args, "-no-reboot")
+	}
+
+	// Run as a non-privileged user
+	args = append(args, "-runas", b.vmconf.Name())
+
+	// For migration.
+	// The listener is started by kvmrund using the migrate-incoming command,
+	// because some parameters (such as TLS credentials) must be set before
+	if b.vmconf.IsIncoming() {
+		args = append(args, "-incoming", "defer")
+	}
+
+	// Extra args from extra file
+	if _, err := os.Stat("extra"); err == nil {
+		b, err := os.ReadFile("extra")
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, strings.Split(string(b), "\n")...)
+	}
+
+	return args, nil
+}
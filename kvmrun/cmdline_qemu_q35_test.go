@@ -0,0 +1,88 @@
+package kvmrun
+
+import (
+	"strings"
+	"testing"
+)
+
+// deviceBus returns the bus option of the -device argument
+// that starts with the given prefix.
+func deviceBus(args []string, prefix string) string {
+	for i := 0; i+1 < len(args); i++ {
+		if args[i] != "-device" || !strings.HasPrefix(args[i+1], prefix) {
+			continue
+		}
+
+		for _, opt := range strings.Split(args[i+1], ",") {
+			if v, ok := strings.CutPrefix(opt, "bus="); ok {
+				return v
+			}
+		}
+	}
+
+	return ""
+}
+
+func TestCommandLineQ35(t *testing.T) {
+	vmc := newInstanceConf("alice")
+
+	if err := vmc.MachineTypeSet("q35"); err != nil {
+		t.Fatalf("got unexpected error: %s", err)
+	}
+
+	for _, p := range []string{"/var/lib/kvmrun/alice_sda.img", "/var/lib/kvmrun/alice_sdb.img", "/var/lib/kvmrun/alice_sdc.img"} {
+		if err := vmc.DiskAppend(DiskProperties{Path: p, Driver: "virtio-blk-pci"}); err != nil {
+			t.Fatalf("got unexpected error: %s", err)
+		}
+	}
+
+	if err := vmc.NetIfaceAppend(NetIfaceProperties{Ifname: "alice_eth0", HwAddr: "02:00:00:00:00:0a", Driver: "virtio-net-pci"}); err != nil {
+		t.Fatalf("got unexpected error: %s", err)
+	}
+
+	// The pinned address is kept, the duplicated one is replaced
+	vmc.Disks.Get("alice_sdb.img").QemuAddr = "pcie.1"
+	vmc.Disks.Get("alice_sdc.img").QemuAddr = "pcie.1"
+
+	args, err := GetCommandLine(vmc, nil)
+	if err != nil {
+		t.Fatalf("got unexpected error: %s", err)
+	}
+
+	var ports int
+
+	for i := 0; i+1 < len(args); i++ {
+		if args[i] == "-device" && strings.HasPrefix(args[i+1], "pcie-root-port,") {
+			ports++
+		}
+	}
+
+	if ports != QEMU_Q35_ROOT_PORTS {
+		t.Fatalf("got unexpected number of root ports: want %d, got %d", QEMU_Q35_ROOT_PORTS, ports)
+	}
+
+	if want, got := "pcie.1", deviceBus(args, "virtio-blk-pci,drive=alice_sdb.img"); want != got {
+		t.Fatalf("got unexpected bus of the pinned disk: want %s, got %s", want, got)
+	}
+
+	seen := make(map[string]string)
+
+	for _, prefix := range []string{
+		"virtio-blk-pci,drive=alice_sda.img",
+		"virtio-blk-pci,drive=alice_sdb.img",
+		"virtio-blk-pci,drive=alice_sdc.img",
+		"virtio-net-pci,netdev=alice_eth0",
+	} {
+		bus := deviceBus(args, prefix)
+
+		if _, ok := ParsePCIeRootPortName(bus); !ok {
+			t.Fatalf("%s: got unexpected bus: %q", prefix, bus)
+		}
+
+		if other, ok := seen[bus]; ok {
+			t.Fatalf("%s: root port %s is already used by %s", prefix, bus, other)
+		}
+
+		seen[bus] = prefix
+	}
+}
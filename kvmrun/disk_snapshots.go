@@ -0,0 +1,130 @@
+package kvmrun
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	qemu_types "github.com/0xef53/kvmrun/internal/qemu/types"
+	"github.com/0xef53/kvmrun/kvmrun/backend/block"
+	"github.com/0xef53/kvmrun/kvmrun/backend/file"
+)
+
+var snapshotNameRe = regexp.MustCompile(`^[0-9A-Za-z_\-\.]{1,32}$`)
+
+// DiskSnapshot describes an external snapshot of the disk.
+//
+// The disk state at the moment of the snapshot creation is stored
+// in the image below the overlay (the disk itself for the first snapshot),
+// and the overlay accumulates all changes made after that moment.
+// The overlay of the last snapshot is the active layer of the disk.
+type DiskSnapshot struct {
+	Name    string    `json:"name"`
+	Overlay string    `json:"overlay"`
+	Created time.Time `json:"created"`
+}
+
+func (s *DiskSnapshot) Validate(_ bool) error {
+	if err := ValidateSnapshotName(s.Name); err != nil {
+		return err
+	}
+
+	if !filepath.IsAbs(s.Overlay) {
+		return fmt.Errorf("invalid overlay path: must be absolute: %s", s.Overlay)
+	}
+
+	return nil
+}
+
+func ValidateSnapshotName(name string) error {
+	if !snapshotNameRe.MatchString(name) {
+		return fmt.Errorf("invalid snapshot name: only [0-9A-Za-z_-.] are allowed, min length is 1 and max length is 32")
+	}
+
+	return nil
+}
+
+// SnapshotOverlayPath returns the path to the overlay image
+// that is created for the disk snapshot.
+func SnapshotOverlayPath(vmname, diskname, snapname string) string {
+	return filepath.Join(SNAPSHOTSDIR, vmname, diskname+"@"+snapname+".qcow2")
+}
+
+// SupportsSnapshots returns true if the disk backend can be used
+// as a base for snapshot overlays. Overlays are stored on the local
+// file system, so only local disks are supported.
+func (d *Disk) SupportsSnapshots() bool {
+	switch d.Backend.(type) {
+	case *block.Device, *file.Device:
+		return true
+	}
+
+	return false
+}
+
+// ActivePath returns the path to the active layer of the disk,
+// i.e. the overlay of the last snapshot or the disk itself.
+func (d *Disk) ActivePath() string {
+	if n := len(d.Snapshots); n > 0 {
+		return d.Snapshots[n-1].Overlay
+	}
+
+	return d.Path
+}
+
+// SnapshotIndex returns the index of the snapshot with the given name
+// or -1 if there is no such snapshot.
+func (d *Disk) SnapshotIndex(name string) int {
+	for idx, s := range d.Snapshots {
+		if s.Name == name {
+			return idx
+		}
+	}
+
+	return -1
+}
+
+// SnapshotBacking returns the path and the format of the image
+// below the overlay of the snapshot with the given index.
+// This image contains the disk state at the moment of the snapshot creation.
+func (d *Disk) SnapshotBacking(idx int) (string, string) {
+	if idx <= 0 {
+		return d.Path, d.BaseFormat()
+	}
+
+	return d.Snapshots[idx-1].Overlay, file.FormatQcow2
+}
+
+// backingChain returns the image at the bottom of the backing chain
+// and the filenames of the images above it from the bottom up.
+func backingChain(img *qemu_types.ImageInfo) (*qemu_types.ImageInfo, []string) {
+	overlays := make([]string, 0, 4)
+
+	for img.BackingImage != nil {
+		overlays = append([]string{img.Filename}, overlays...)
+
+		img = img.BackingImage
+	}
+
+	return img, overlays
+}
+
+// snapshotsFromChain restores the list of disk snapshots
+// from the filenames of the overlay images.
+func snapshotsFromChain(diskname string, overlays []string) []*DiskSnapshot {
+	if len(overlays) == 0 {
+		return nil
+	}
+
+	snapshots := make([]*DiskSnapshot, 0, len(overlays))
+
+	for _, p := range overlays {
+		name := strings.TrimSuffix(strings.TrimPrefix(filepath.Base(p), diskname+"@"), ".qcow2")
+
+		snapshots = append(snapshots, &DiskSnapshot{Name: name, Overlay: p})
+	}
+
+	return snapshots
+}
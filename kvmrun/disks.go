@@ -8,8 +8,10 @@ import (
 
 	"github.com/0xef53/kvmrun/kvmrun/backend"
 	"github.com/0xef53/kvmrun/kvmrun/backend/block"
+	"github.com/0xef53/kvmrun/kvmrun/backend/file"
 	"github.com/0xef53/kvmrun/kvmrun/backend/iscsi"
 	"github.com/0xef53/kvmrun/kvmrun/backend/nbd"
+	"github.com/0xef53/kvmrun/kvmrun/backend/rbd"
 	"github.com/0xef53/kvmrun/kvmrun/internal/pool"
 )
 
@@ -19,6 +21,7 @@ const (
 	DiskDriverType_VIRTIO_BLK_PCI DiskDriverType = iota + 1
 	DiskDriverType_SCSI_HD
 	DiskDriverType_IDE_HD
+	DiskDriverType_VIRTIO_BLK_DEVICE
 )
 
 func (t DiskDriverType) String() string {
@@ -29,6 +32,8 @@ func (t DiskDriverType) String() string {
 		return "scsi-hd"
 	case DiskDriverType_IDE_HD:
 		return "ide-hd"
+	case DiskDriverType_VIRTIO_BLK_DEVICE:
+		return "virtio-blk-device"
 	}
 
 	return "UNKNOWN"
@@ -53,6 +58,8 @@ func DiskDriverTypeValue(s string) DiskDriverType {
 		return DiskDriverType_SCSI_HD
 	case "ide-hd":
 		return DiskDriverType_IDE_HD
+	case "virtio-blk-device":
+		return DiskDriverType_VIRTIO_BLK_DEVICE
 	}
 
 	return DriverType_UNKNOWN
@@ -64,10 +71,14 @@ func DefaultDiskDriver() DiskDriverType {
 
 type DiskProperties struct {
 	Path      string `json:"path"`
+	Format    string `json:"format,omitempty"`
 	Driver    string `json:"driver"`
 	IopsRd    int    `json:"iops_rd"`
 	IopsWr    int    `json:"iops_wr"`
 	Bootindex int    `json:"bootindex,omitempty"`
+	Readonly  bool   `json:"readonly,omitempty"`
+
+	Snapshots []*DiskSnapshot `json:"snapshots,omitempty"`
 }
 
 func (p *DiskProperties) Validate(strict bool) error {
@@ -91,6 +102,14 @@ func (p *DiskProperties) Validate(strict bool) error {
 		}
 	}
 
+	p.Format = strings.TrimSpace(p.Format)
+
+	switch p.Format {
+	case "", file.FormatRaw, file.FormatQcow2:
+	default:
+		return fmt.Errorf("unknown image format: %s", p.Format)
+	}
+
 	switch {
 	case p.IopsRd < 0 || p.IopsWr < 0:
 		return fmt.Errorf("invalid iops value: cannot be less than 0")
@@ -98,6 +117,20 @@ func (p *DiskProperties) Validate(strict bool) error {
 		return fmt.Errorf("invalid bootindex value: cannot be less than 0")
 	}
 
+	names := make(map[string]struct{}, len(p.Snapshots))
+
+	for _, s := range p.Snapshots {
+		if err := s.Validate(strict); err != nil {
+			return err
+		}
+
+		if _, ok := names[s.Name]; ok {
+			return fmt.Errorf("duplicate snapshot name: %s", s.Name)
+		}
+
+		names[s.Name] = struct{}{}
+	}
+
 	return nil
 }
 
@@ -107,10 +140,15 @@ func NewDiskBackend(p string) (backend.DiskBackend, error) {
 	switch {
 	case strings.HasPrefix(p, "iscsi://"):
 		return iscsi.New(p)
-	case strings.HasPrefix(p, "nbd://"):
+	case strings.HasPrefix(p, "nbd://"), strings.HasPrefix(p, "nbds://"):
 		return nbd.New(p)
+	case strings.HasPrefix(p, "rbd://"):
+		return rbd.New(p)
 	case strings.HasPrefix(p, "/dev/"):
 		return block.New(p)
+	case strings.HasPrefix(p, "/"):
+		// Regular files with raw or qcow2 images
+		return file.New(p)
 	}
 
 	return nil, &backend.UnknownBackendError{Path: p}
@@ -125,6 +163,9 @@ type Disk struct {
 	QemuAddr        string              `json:"addr,omitempty"`
 	QemuVirtualSize uint64              `json:"-"`
 	HasBitmap       bool                `json:"-"`
+
+	// The dirty bitmap exists but cannot be used
+	BitmapInconsistent bool `json:"-"`
 }
 
 func NewDisk(p string) (*Disk, error) {
@@ -151,6 +192,16 @@ func NewDisk(p string) (*Disk, error) {
 func (d *Disk) Copy() *Disk {
 	v := Disk{DiskProperties: d.DiskProperties}
 
+	if d.Snapshots != nil {
+		v.Snapshots = make([]*DiskSnapshot, 0, len(d.Snapshots))
+
+		for _, s := range d.Snapshots {
+			x := *s
+
+			v.Snapshots = append(v.Snapshots, &x)
+		}
+	}
+
 	v.driver = d.driver
 
 	if d.Backend != nil {
@@ -160,6 +211,7 @@ func (d *Disk) Copy() *Disk {
 	v.QemuAddr = d.QemuAddr
 	v.QemuVirtualSize = d.QemuVirtualSize
 	v.HasBitmap = d.HasBitmap
+	v.BitmapInconsistent = d.BitmapInconsistent
 
 	return &v
 }
@@ -184,6 +236,96 @@ func (d *Disk) IsAvailable() (bool, error) {
 	return d.Backend.IsAvailable()
 }
 
+// Format returns the image format of the active layer of the disk.
+// Snapshot overlays are always qcow2 images.
+func (d *Disk) Format() string {
+	if len(d.Snapshots) > 0 {
+		return file.FormatQcow2
+	}
+
+	return d.BaseFormat()
+}
+
+// BaseFormat returns the image format of the disk itself.
+// Only regular files can contain non-raw images. The format
+// is never detected here, because the guest could change it
+// by writing a qcow2 header to its raw disk (see InitFormat).
+func (d *Disk) BaseFormat() string {
+	if _, ok := d.Backend.(*file.Device); ok && len(d.DiskProperties.Format) > 0 {
+		return d.DiskProperties.Format
+	}
+
+	return file.FormatRaw
+}
+
+// InitFormat detects and stores the image format of the disk file
+// if it has not been set explicitly. It must be called only when
+// the disk is attached or created, i.e. before the guest gets access to it.
+func (p *DiskProperties) InitFormat() error {
+	if len(p.Format) > 0 {
+		return nil
+	}
+
+	be, err := NewDiskBackend(p.Path)
+	if err != nil {
+		return err
+	}
+
+	if b, ok := be.(*file.Device); ok {
+		format, err := file.DetectFormat(b.Path)
+		if err != nil {
+			return err
+		}
+
+		p.Format = format
+	}
+
+	return nil
+}
+
+// Size returns the virtual size of the disk
+// according to its stored image format.
+func (d *Disk) Size() (uint64, error) {
+	if b, ok := d.Backend.(*file.Device); ok {
+		return b.VirtualSize(d.BaseFormat())
+	}
+
+	return d.Backend.Size()
+}
+
+// driveOptions returns a set of the -drive options
+// that define the location of the disk image.
+func (d *Disk) driveOptions() []string {
+	switch b := d.Backend.(type) {
+	case *rbd.Device:
+		return b.DriveOptions()
+	case *nbd.Device:
+		return b.DriveOptions(TLSCredsID(TLSEndpointClient))
+	}
+
+	return []string{fmt.Sprintf("file=%s", d.ActivePath())}
+}
+
+// objectArgs returns the command line arguments to define
+// the additional objects required by the disk backend.
+func (d *Disk) objectArgs() []string {
+	if b, ok := d.Backend.(*rbd.Device); ok {
+		return b.ObjectArgs()
+	}
+
+	return nil
+}
+
+// UseTLS returns true if the disk backend requires
+// the TLS client credentials to be defined.
+func (d *Disk) UseTLS() bool {
+	if b, ok := d.Backend.(*nbd.Device); ok {
+		return b.TLS()
+	}
+
+	return false
+}
+
 type DiskPool struct {
 	pool.Pool
 }
@@ -10,6 +10,10 @@ var (
 	ErrNotFound       = errors.New("not found")
 	ErrNotRunning     = errors.New("machine not running")
 	ErrNotImplemented = errors.New("not implemented")
+	ErrNotSupported   = errors.New("not supported")
+	ErrHostDrained    = errors.New("host is in drain mode")
+
+	ErrBackupChainBroken = errors.New("backup chain is broken")
 )
 
 type AlreadyConnectedError struct {
@@ -66,6 +66,8 @@ type Instance interface {
 	DiskSetWriteIops(string, int) error
 	DiskResizeQemuBlockdev(string) error
 	DiskRemoveQemuBitmap(string) error
+	DiskSetSnapshots(string, []*DiskSnapshot) error
+	DiskSetPath(string, string, string) error
 
 	NetIfaceGet(string) *NetIface
 	NetIfaceGetList(...string) []*NetIface
@@ -156,12 +156,57 @@ func (c *InstanceConf) MachineTypeSet(typename string) error {
 		return err
 	}
 
+	if t.Chipset == QEMU_CHIPSET_MICROVM {
+		if err := c.checkMicrovmDevices(); err != nil {
+			return err
+		}
+	}
+
 	c.MachineType = t.name
 
 	return nil
 }
 
+func (c *InstanceConf) isMicrovm() bool {
+	return c.MachineTypeGet().Chipset == QEMU_CHIPSET_MICROVM
+}
+
+// checkMicrovmDevices returns an error if the configuration
+// has devices that cannot be used with the microvm machine type.
+func (c *InstanceConf) checkMicrovmDevices() error {
+	switch {
+	case c.Firmware != nil && len(c.Firmware.Image) > 0:
+		return fmt.Errorf("firmware: %w by microvm", ErrNotSupported)
+	case len(c.HostDeviceGetList()) > 0:
+		return fmt.Errorf("host-pci devices: %w by microvm", ErrNotSupported)
+	case len(c.InputDeviceGetList()) > 0:
+		return fmt.Errorf("input devices: %w by microvm", ErrNotSupported)
+	case len(c.CdromGetList()) > 0:
+		return fmt.Errorf("cdrom devices: %w by microvm", ErrNotSupported)
+	case c.CloudInitDrive != nil:
+		return fmt.Errorf("cloud-init drive: %w by microvm", ErrNotSupported)
+	}
+
+	for _, d := range c.DiskGetList() {
+		if d.Driver() != DiskDriverType_VIRTIO_BLK_DEVICE {
+			return fmt.Errorf("disk driver: %w by microvm: %s (%s)", ErrNotSupported, d.Driver(), d.Path)
+		}
+	}
+
+	for _, n := range c.NetIfaceGetList() {
+		if n.Driver() != NetDriverType_VIRTIO_NET_DEVICE {
+			return fmt.Errorf("net interface driver: %w by microvm: %s (%s)", ErrNotSupported, n.Driver(), n.Ifname)
+		}
+	}
+
+	return nil
+}
+
 func (c *InstanceConf) FirmwareSetImage(image string) error {
+	if c.isMicrovm() {
+		return fmt.Errorf("firmware: %w by microvm", ErrNotSupported)
+	}
+
 	if c.Firmware == nil {
 		if fw, err := NewFirmware(image, ""); err == nil {
 			c.Firmware = fw
@@ -216,6 +261,10 @@ func (c *InstanceConf) CPUSetQuota(value int) error {
 }
 
 func (c *InstanceConf) InputDeviceAppend(opts InputDeviceProperties) error {
+	if c.isMicrovm() {
+		return fmt.Errorf("input devices: %w by microvm", ErrNotSupported)
+	}
+
 	if err := opts.Validate(true); err != nil {
 		return err
 	}
@@ -244,6 +293,10 @@ func (c *InstanceConf) InputDeviceRemove(devtype string) error {
 }
 
 func (c *InstanceConf) CdromAppend(opts CdromProperties) error {
+	if c.isMicrovm() {
+		return fmt.Errorf("cdrom devices: %w by microvm", ErrNotSupported)
+	}
+
 	if err := opts.Validate(true); err != nil {
 		return err
 	}
@@ -350,11 +403,29 @@ func (c *InstanceConf) CdromRemoveMedia(devname string) error {
 	return nil
 }
 
-func (c *InstanceConf) DiskAppend(opts DiskProperties) error {
+// validateDiskOpts validates the disk properties. The microvm machine
+// supports only virtio-blk-device, so it is used by default.
+func (c *InstanceConf) validateDiskOpts(opts *DiskProperties) error {
+	if c.isMicrovm() && len(strings.TrimSpace(opts.Driver)) == 0 {
+		opts.Driver = DiskDriverType_VIRTIO_BLK_DEVICE.String()
+	}
+
 	if err := opts.Validate(true); err != nil {
 		return err
 	}
 
+	if c.isMicrovm() && DiskDriverTypeValue(opts.Driver) != DiskDriverType_VIRTIO_BLK_DEVICE {
+		return fmt.Errorf("disk driver: %w by microvm: %s", ErrNotSupported, opts.Driver)
+	}
+
+	return nil
+}
+
+func (c *InstanceConf) DiskAppend(opts DiskProperties) error {
+	if err := c.validateDiskOpts(&opts); err != nil {
+		return err
+	}
+
 	d := Disk{
 		DiskProperties: opts,
 		driver:         DiskDriverTypeValue(opts.Driver),
@@ -377,7 +448,7 @@ func (c *InstanceConf) DiskAppend(opts DiskProperties) error {
 }
 
 func (c *InstanceConf) DiskInsert(opts DiskProperties, position int) error {
-	if err := opts.Validate(true); err != nil {
+	if err := c.validateDiskOpts(&opts); err != nil {
 		return err
 	}
 
@@ -444,6 +515,56 @@ func (c *InstanceConf) DiskSetWriteIops(diskname string, iops int) error {
 	return nil
 }
 
+// DiskSetPath replaces the path and the image format of the disk keeping
+// all its other properties and position. The base name of the new path
+// must be the same, because it is used as the disk name.
+func (c *InstanceConf) DiskSetPath(diskname, newpath, format string) error {
+	d := c.Disks.Get(diskname)
+
+	if d == nil {
+		return &NotConnectedError{"instance_conf", diskname}
+	}
+
+	be, err := NewDiskBackend(newpath)
+	if err != nil {
+		return err
+	}
+
+	if be.BaseName() != d.BaseName() {
+		return fmt.Errorf("base name of the new path differs from the disk name: %s", newpath)
+	}
+
+	d.Path = newpath
+	d.DiskProperties.Format = format
+	d.Backend = be
+
+	return nil
+}
+
+// DiskSetSnapshots replaces the snapshot chain of the disk.
+// The overlay images must be created or removed separately.
+func (c *InstanceConf) DiskSetSnapshots(diskname string, snapshots []*DiskSnapshot) error {
+	d := c.Disks.Get(diskname)
+
+	if d == nil {
+		return &NotConnectedError{"instance_conf", diskname}
+	}
+
+	for _, s := range snapshots {
+		if err := s.Validate(true); err != nil {
+			return err
+		}
+	}
+
+	if len(snapshots) == 0 {
+		d.Snapshots = nil
+	} else {
+		d.Snapshots = snapshots
+	}
+
+	return nil
+}
+
 func (c InstanceConf) DiskRemoveQemuBitmap(_ string) error {
 	return ErrNotImplemented
 }
@@ -453,10 +574,20 @@ func (c InstanceConf) DiskResizeQemuBlockdev(_ string) error {
 }
 
 func (c *InstanceConf) NetIfaceAppend(opts NetIfaceProperties) error {
+	// The microvm machine supports only virtio-net-device,
+	// so it is used by default
+	if c.isMicrovm() && len(strings.TrimSpace(opts.Driver)) == 0 {
+		opts.Driver = NetDriverType_VIRTIO_NET_DEVICE.String()
+	}
+
 	if err := opts.Validate(true); err != nil {
 		return err
 	}
 
+	if c.isMicrovm() && NetDriverTypeValue(opts.Driver) != NetDriverType_VIRTIO_NET_DEVICE {
+		return fmt.Errorf("net interface driver: %w by microvm: %s", ErrNotSupported, opts.Driver)
+	}
+
 	n := NetIface{
 		NetIfaceProperties: opts,
 		driver:             NetDriverTypeValue(opts.Driver),
@@ -573,6 +704,10 @@ func (c *InstanceConf) VSockDeviceRemove() error {
 }
 
 func (c *InstanceConf) CloudInitSetMedia(media string) error {
+	if c.isMicrovm() {
+		return fmt.Errorf("cloud-init drive: %w by microvm", ErrNotSupported)
+	}
+
 	newdrive, err := NewCloudInitDrive(media)
 	if err != nil {
 		return err
@@ -638,6 +773,10 @@ func (c *InstanceConf) KernelRemoveConf() error {
 }
 
 func (c *InstanceConf) HostDeviceAppend(opts HostDeviceProperties) error {
+	if c.isMicrovm() {
+		return fmt.Errorf("host-pci devices: %w by microvm", ErrNotSupported)
+	}
+
 	dev, err := NewHostDevice(opts.PCIAddr)
 	if err != nil {
 		return err
@@ -4,9 +4,11 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"net"
 	"os"
 	"os/user"
 	"path/filepath"
@@ -17,11 +19,13 @@ import (
 	"time"
 
 	cg "github.com/0xef53/kvmrun/internal/cgroups"
+	"github.com/0xef53/kvmrun/internal/fsutil"
 	qemu_types "github.com/0xef53/kvmrun/internal/qemu/types"
 	"github.com/0xef53/kvmrun/internal/version"
 	"github.com/0xef53/kvmrun/kvmrun/backend"
 	"github.com/0xef53/kvmrun/kvmrun/backend/block"
 	"github.com/0xef53/kvmrun/kvmrun/backend/file"
+	"github.com/0xef53/kvmrun/kvmrun/backend/rbd"
 
 	qmp "github.com/0xef53/go-qmp/v2"
 	"golang.org/x/sync/errgroup"
@@ -86,6 +90,10 @@ func GetInstanceQemu(vmname string, mon *qmp.Monitor) (Instance, error) {
 	switch t := inner.MachineTypeGet(); t.Chipset {
 	case QEMU_CHIPSET_I440FX:
 		vmi = &InstanceQemu_i440fx{InstanceQemu: &inner}
+	case QEMU_CHIPSET_Q35:
+		vmi = &InstanceQemu_q35{InstanceQemu_i440fx: &InstanceQemu_i440fx{InstanceQemu: &inner}}
+	case QEMU_CHIPSET_MICROVM:
+		vmi = &InstanceQemu_microvm{InstanceQemu: &inner}
 	default:
 		return nil, fmt.Errorf("unsupported machine type: %s", t)
 	}
@@ -710,6 +718,97 @@ func (r *InstanceQemu) DiskRemove(_ string) error {
 	return ErrNotImplemented
 }
 
+// rbdDevicePath restores the rbd:// path of the disk
+// using the options of the QEMU block node.
+func (r *InstanceQemu) rbdDevicePath(drive string, opts *qemu_types.InsertedFileOptions) string {
+	// The secret file path is unknown to QEMU,
+	// so the startup configuration is preferred
+	if d := r.startupConf.DiskGet(drive); d != nil {
+		if _, ok := d.Backend.(*rbd.Device); ok {
+			return d.Path
+		}
+	}
+
+	u := rbd.URI{
+		User:      opts.File.User,
+		Pool:      opts.File.Pool,
+		Namespace: opts.File.Namespace,
+		Image:     opts.File.Image,
+		Conf:      opts.File.Conf,
+	}
+
+	// The legacy rbd: filename passes the keyring path
+	// as a list of key/value pairs: ["keyring", "/path"]
+	if len(opts.File.KeyValuePairs) > 0 {
+		pairs := make([]string, 0, 2)
+
+		if err := json.Unmarshal([]byte(opts.File.KeyValuePairs), &pairs); err == nil {
+			for i := 0; i+1 < len(pairs); i += 2 {
+				if pairs[i] == "keyring" {
+					u.Keyring = pairs[i+1]
+				}
+			}
+		}
+	}
+
+	return u.String()
+}
+
+// nbdDevicePath restores the nbd:// or nbds:// path of the disk
+// using the options of the QEMU block node.
+func nbdDevicePath(opts *qemu_types.InsertedFileOptions) string {
+	scheme := "nbd"
+
+	if len(opts.File.TLSCreds) > 0 {
+		scheme = "nbds"
+	}
+
+	return fmt.Sprintf("%s://%s/%s", scheme, net.JoinHostPort(opts.File.Server.Host, opts.File.Server.Port), opts.File.Export)
+}
+
+// rbdAttach prepares the RBD image to be hot-plugged: copies the Ceph
+// configuration files to a chroot and defines the secret object if required.
+func (r *InstanceQemu) rbdAttach(b *rbd.Device) error {
+	for _, fname := range b.ConfigFiles() {
+		chrootPath := filepath.Join(CHROOTDIR, r.name, fname)
+
+		if err := fsutil.Copy(fname, chrootPath); err != nil {
+			return err
+		}
+
+		if err := os.Chown(chrootPath, r.uid, 0); err != nil {
+			return err
+		}
+	}
+
+	if opts, err := b.SecretObjectOptions(); err == nil {
+		if opts != nil {
+			if err := r.mon.Run(qmp.Command{Name: "object-add", Arguments: opts}, nil); err != nil {
+				return fmt.Errorf("object-add failed: %s", err)
+			}
+		}
+	} else {
+		return err
+	}
+
+	return nil
+}
+
+// rbdDetach removes the secret object of the RBD image if it exists.
+// The Ceph configuration files are left in a chroot
+// because they may be shared between several disks.
+func (r *InstanceQemu) rbdDetach(b *rbd.Device) error {
+	if len(b.URI.Secret) == 0 {
+		return nil
+	}
+
+	if err := r.mon.Run(qmp.Command{Name: "object-del", Arguments: &qemu_types.StrID{ID: b.SecretID()}}, nil); err != nil {
+		return fmt.Errorf("object-del failed: %s", err)
+	}
+
+	return nil
+}
+
 func (r *InstanceQemu) DiskSetReadIops(diskname string, iops int) error {
 	if iops < 0 {
 		return fmt.Errorf("invalid iops value: cannot be less than 0")
@@ -784,7 +883,7 @@ func (r *InstanceQemu) DiskRemoveQemuBitmap(diskname string) error {
 		return &NotConnectedError{"instance_qemu", diskname}
 	}
 
-	if !d.HasBitmap {
+	if !d.HasBitmap && !d.BitmapInconsistent {
 		return nil
 	}
 
@@ -796,6 +895,14 @@ func (r *InstanceQemu) DiskRemoveQemuBitmap(diskname string) error {
 	return r.mon.Run(qmp.Command{Name: "block-dirty-bitmap-remove", Arguments: &opts}, nil)
 }
 
+func (r *InstanceQemu) DiskSetSnapshots(_ string, _ []*DiskSnapshot) error {
+	return ErrNotImplemented
+}
+
+func (r *InstanceQemu) DiskSetPath(_, _, _ string) error {
+	return ErrNotImplemented
+}
+
 func (r *InstanceQemu) NetIfaceAppend(_ NetIfaceProperties) error {
 	return ErrNotImplemented
 }
@@ -864,13 +971,24 @@ func (r *InstanceQemu) initVSockDevice() error {
 		return err
 	}
 
-	// An addr/slot on the PCI bus
-	var pciAddr string
+	if r.MachineTypeGet().Chipset == QEMU_CHIPSET_Q35 {
+		// The PCIe root port
+		var parentBus string
+
+		busQomQuery := qemu_types.QomQuery{Path: "vsock_device", Property: "parent_bus"}
+
+		if err := r.mon.Run(qmp.Command{Name: "qom-get", Arguments: &busQomQuery}, &parentBus); err == nil {
+			vsock.QemuAddr = filepath.Base(parentBus)
+		}
+	} else {
+		// An addr/slot on the PCI bus
+		var pciAddr string
 
-	addrQomQuery := qemu_types.QomQuery{Path: "vsock_device", Property: "legacy-addr"}
+		addrQomQuery := qemu_types.QomQuery{Path: "vsock_device", Property: "legacy-addr"}
 
-	if err := r.mon.Run(qmp.Command{Name: "qom-get", Arguments: &addrQomQuery}, &pciAddr); err == nil {
-		vsock.QemuAddr = fmt.Sprintf("0x%s", strings.Split(pciAddr, ".")[0])
+		if err := r.mon.Run(qmp.Command{Name: "qom-get", Arguments: &addrQomQuery}, &pciAddr); err == nil {
+			vsock.QemuAddr = fmt.Sprintf("0x%s", strings.Split(pciAddr, ".")[0])
+		}
 	}
 
 	r.VSockDevice = &vsock
@@ -13,6 +13,8 @@ import (
 
 	qemu_types "github.com/0xef53/kvmrun/internal/qemu/types"
 	"github.com/0xef53/kvmrun/kvmrun/backend/block"
+	"github.com/0xef53/kvmrun/kvmrun/backend/file"
+	"github.com/0xef53/kvmrun/kvmrun/backend/rbd"
 
 	qmp "github.com/0xef53/go-qmp/v2"
 	"golang.org/x/sync/errgroup"
@@ -41,6 +43,9 @@ func (r *InstanceQemu_i440fx) init() error {
 		return err
 	}
 
+	// SCSI buses are required to init both cdroms and disks
+	r.initSCSIBuses()
+
 	gr.Go(func() error { return r.initCdromPool() })
 	gr.Go(func() error { return r.initDiskPool() })
 	gr.Go(func() error { return r.initNetIfacePool() })
@@ -49,6 +54,165 @@ func (r *InstanceQemu_i440fx) init() error {
 	return gr.Wait()
 }
 
+// pciAllocator chooses the PCI bus for a new hot-pluggable device.
+// The i440fx and q35 machines share the code of the device pools
+// and differ only in the placement of the devices.
+type pciAllocator interface {
+	// nextBus returns the bus for a new device.
+	// An empty value means the first free slot of the root bus
+	nextBus() (string, error)
+}
+
+// nextBus returns an empty value, since all devices
+// of the i440fx machine are placed on the root bus.
+func (r *InstanceQemu_i440fx) nextBus() (string, error) {
+	return "", nil
+}
+
+// pciDevice is a PCI device with the name of the bus it is placed on.
+type pciDevice struct {
+	qemu_types.PCIDeviceInfo
+
+	// Empty for the devices placed on the root bus
+	Bus string
+}
+
+// pciDevices returns a flat list of devices placed on the root bus
+// and behind the bridges (e.g. the PCIe root ports of q35).
+func (r *InstanceQemu_i440fx) pciDevices() []*pciDevice {
+	devices := make([]*pciDevice, 0, 16)
+
+	for _, bus := range r.pciDevs {
+		for _, dev := range bus.Devices {
+			if dev.PCIBridge != nil {
+				for _, child := range dev.PCIBridge.Devices {
+					devices = append(devices, &pciDevice{PCIDeviceInfo: child, Bus: dev.QdevID})
+				}
+
+				continue
+			}
+
+			devices = append(devices, &pciDevice{PCIDeviceInfo: dev})
+		}
+	}
+
+	return devices
+}
+
+// placement returns the address of the device in the QemuAddr format:
+// the PCIe root port name or the slot on the root bus.
+func (d *pciDevice) placement() string {
+	if _, ok := ParsePCIeRootPortName(d.Bus); ok {
+		return d.Bus
+	}
+
+	return fmt.Sprintf("0x%x", d.Slot)
+}
+
+// deviceAddr returns the address of the device with the given ID
+// in the QemuAddr format (see pciDevice.placement).
+func (r *InstanceQemu_i440fx) deviceAddr(qdevID string) (string, error) {
+	var parentBus string
+
+	busQomQuery := qemu_types.QomQuery{Path: qdevID, Property: "parent_bus"}
+
+	if err := r.mon.Run(qmp.Command{Name: "qom-get", Arguments: &busQomQuery}, &parentBus); err != nil {
+		return "", err
+	}
+
+	// in:  /machine/peripheral/pcie.3/pcie.3
+	// out: pcie.3
+	if name := filepath.Base(parentBus); len(name) > 0 {
+		if _, ok := ParsePCIeRootPortName(name); ok {
+			return name, nil
+		}
+	}
+
+	// An addr/slot on the root bus
+	var pciAddr string
+
+	qomQuery := qemu_types.QomQuery{Path: qdevID, Property: "legacy-addr"}
+
+	if err := r.mon.Run(qmp.Command{Name: "qom-get", Arguments: &qomQuery}, &pciAddr); err == nil {
+		return fmt.Sprintf("0x%s", strings.Split(pciAddr, ".")[0]), nil
+	}
+
+	return "", nil
+}
+
+// scsiAddr returns the address of the SCSI device with the given ID
+// in the format: bus_name:bus_addr/lun.
+func (r *InstanceQemu_i440fx) scsiAddr(qdevID string) (string, error) {
+	var parentBus string
+
+	busQomQuery := qemu_types.QomQuery{Path: qdevID, Property: "parent_bus"}
+
+	if err := r.mon.Run(qmp.Command{Name: "qom-get", Arguments: &busQomQuery}, &parentBus); err != nil {
+		return "", err
+	}
+	// in:  /machine/peripheral/scsi0/virtio-backend/scsi0.0
+	// out: scsi0
+	parentBusName := strings.Split(filepath.Base(parentBus), ".")[0]
+
+	var lun int
+
+	lunQomQuery := qemu_types.QomQuery{Path: qdevID, Property: "lun"}
+
+	if err := r.mon.Run(qmp.Command{Name: "qom-get", Arguments: &lunQomQuery}, &lun); err != nil {
+		return "", err
+	}
+
+	if bus, ok := r.scsiBuses[parentBusName]; ok {
+		return fmt.Sprintf("%s:%s/%d", parentBusName, bus.Addr, lun), nil
+	}
+
+	return fmt.Sprintf("%s/%d", parentBusName, lun), nil
+}
+
+func (r *InstanceQemu_i440fx) initSCSIBuses() {
+	r.scsiBuses = make(map[string]*SCSIBusInfo)
+
+	for _, dev := range r.pciDevices() {
+		// desc:      SCSI controller
+		// class:     256
+		// id.device: 4100
+		if !(dev.ClassInfo.Class == 256 && dev.ID.Device == 4100) {
+			continue
+		}
+
+		r.scsiBuses[dev.QdevID] = &SCSIBusInfo{"virtio-scsi-pci", dev.placement()}
+	}
+}
+
+// addSCSIBus adds the virtio-scsi controller with the given name
+// if it does not exist yet.
+func (r *InstanceQemu_i440fx) addSCSIBus(busName string, alloc pciAllocator) error {
+	if _, ok := r.scsiBuses[busName]; ok {
+		return nil
+	}
+
+	bus, err := alloc.nextBus()
+	if err != nil {
+		return err
+	}
+
+	busOpts := qemu_types.SCSIHostBusDeviceOptions{
+		Driver: "virtio-scsi-pci",
+		ID:     busName,
+		Bus:    bus,
+	}
+
+	if err := r.mon.Run(qmp.Command{Name: "device_add", Arguments: &busOpts}, nil); err != nil {
+		return fmt.Errorf("device_add failed: %s", err)
+	}
+
+	if len(bus) > 0 {
+		r.scsiBuses[busName] = &SCSIBusInfo{"virtio-scsi-pci", bus}
+	}
+
+	return nil
+}
+
 func (r *InstanceQemu_i440fx) initCdromPool() error {
 	for _, dev := range r.blkDevs {
 		if !strings.HasPrefix(dev.QdevPath, "cdrom_") {
@@ -124,26 +288,11 @@ func (r *InstanceQemu_i440fx) initCdromPool() error {
 			}
 		case CdromDriverType_SCSI_CD:
 			// SCSI bus name/addr and lun of disk
-			var parentBus string
-
-			busQomQuery := qemu_types.QomQuery{Path: cdrom.QdevID(), Property: "parent_bus"}
-
-			if err := r.mon.Run(qmp.Command{Name: "qom-get", Arguments: &busQomQuery}, &parentBus); err != nil {
-				return err
-			}
-			// in:  /machine/peripheral/scsi0/virtio-backend/scsi0.0
-			// out: scsi0
-			parentBusName := strings.Split(filepath.Base(parentBus), ".")[0]
-
-			var lun int
-
-			lunQomQuery := qemu_types.QomQuery{Path: cdrom.QdevID(), Property: "lun"}
-
-			if err := r.mon.Run(qmp.Command{Name: "qom-get", Arguments: &lunQomQuery}, &lun); err != nil {
+			if addr, err := r.scsiAddr(cdrom.QdevID()); err == nil {
+				cdrom.QemuAddr = addr
+			} else {
 				return err
 			}
-
-			cdrom.QemuAddr = fmt.Sprintf("%s:%s/%d", parentBusName, r.scsiBuses[parentBusName].Addr, lun)
 		}
 
 		cdrom.Readonly = dev.Inserted.ReadOnly
@@ -155,6 +304,10 @@ func (r *InstanceQemu_i440fx) initCdromPool() error {
 }
 
 func (r *InstanceQemu_i440fx) CdromAppend(opts CdromProperties) error {
+	return r.cdromAppend(opts, r)
+}
+
+func (r *InstanceQemu_i440fx) cdromAppend(opts CdromProperties, alloc pciAllocator) error {
 	if err := opts.Validate(true); err != nil {
 		return err
 	}
@@ -191,15 +344,8 @@ func (r *InstanceQemu_i440fx) CdromAppend(opts CdromProperties) error {
 	case CdromDriverType_SCSI_CD:
 		busName, _, _ := ParseSCSIAddr(cd.QemuAddr)
 
-		if _, ok := r.scsiBuses[busName]; !ok {
-			busOpts := qemu_types.SCSIHostBusDeviceOptions{
-				Driver: "virtio-scsi-pci",
-				ID:     busName,
-			}
-
-			if err := r.mon.Run(qmp.Command{Name: "device_add", Arguments: &busOpts}, nil); err != nil {
-				return fmt.Errorf("device_add failed: %s", err)
-			}
+		if err := r.addSCSIBus(busName, alloc); err != nil {
+			return err
 		}
 
 		deviceOpts.Bus = fmt.Sprintf("%s.0", busName)
@@ -292,19 +438,6 @@ func (r *InstanceQemu_i440fx) CdromRemove(devname string) error {
 }
 
 func (r *InstanceQemu_i440fx) initDiskPool() error {
-	r.scsiBuses = make(map[string]*SCSIBusInfo)
-
-	for _, dev := range r.pciDevs[0].Devices {
-		// desc:      SCSI controller
-		// class:     256
-		// id.device: 4100
-		if !(dev.ClassInfo.Class == 256 && dev.ID.Device == 4100) {
-			continue
-		}
-
-		r.scsiBuses[dev.QdevID] = &SCSIBusInfo{"virtio-scsi-pci", fmt.Sprintf("0x%x", dev.Slot)}
-	}
-
 	for _, dev := range r.blkDevs {
 		// skip reserved names and empty devices
 		if dev.Device == "modiso" || dev.Device == "cidata" || dev.Device == "fwloader" || dev.Device == "fwflash" {
@@ -333,6 +466,10 @@ func (r *InstanceQemu_i440fx) initDiskPool() error {
 					b.File.Target,
 					b.File.Lun,
 				)
+			case "rbd":
+				devicePath = r.rbdDevicePath(dev.Device, &b)
+			case "nbd":
+				devicePath = nbdDevicePath(&b)
 			default:
 				return fmt.Errorf("unknown backing device driver: %s", b.File.Driver)
 			}
@@ -340,11 +477,15 @@ func (r *InstanceQemu_i440fx) initDiskPool() error {
 			devicePath = dev.Inserted.File
 		}
 
+		// The disk itself is the image at the bottom of the backing chain.
+		// The images above it are the overlays of the disk snapshots.
+		baseImage, overlays := backingChain(&dev.Inserted.Image)
+
 		if dev.Inserted.BackingFileDepth > 0 {
-			if len(dev.Inserted.BackingFile) != 0 {
-				devicePath = dev.Inserted.BackingFile
+			if len(baseImage.Filename) != 0 {
+				devicePath = baseImage.Filename
 			} else {
-				devicePath = dev.Inserted.Image.Filename
+				devicePath = dev.Inserted.BackingFile
 			}
 		}
 
@@ -355,11 +496,17 @@ func (r *InstanceQemu_i440fx) initDiskPool() error {
 
 		disk.IopsRd = dev.Inserted.IopsRd
 		disk.IopsWr = dev.Inserted.IopsWr
+		disk.Readonly = dev.Inserted.ReadOnly
+
+		disk.QemuVirtualSize = baseImage.VirtualSize
+
+		// The format QEMU has opened the image with
+		if _, ok := disk.Backend.(*file.Device); ok {
+			disk.DiskProperties.Format = baseImage.Format
+		}
 
 		if dev.Inserted.BackingFileDepth > 0 {
-			disk.QemuVirtualSize = dev.Inserted.Image.BackingImage.VirtualSize
-		} else {
-			disk.QemuVirtualSize = dev.Inserted.Image.VirtualSize
+			disk.Snapshots = snapshotsFromChain(disk.BaseName(), overlays)
 		}
 
 		qomQuery := qemu_types.QomQuery{Path: disk.QdevID(), Property: "type"}
@@ -381,41 +528,30 @@ func (r *InstanceQemu_i440fx) initDiskPool() error {
 
 		switch disk.Driver() {
 		case DiskDriverType_VIRTIO_BLK_PCI, DiskDriverType_IDE_HD:
-			// An addr/slot on the PCI bus
-			var pciAddr string
-
-			qomQuery := qemu_types.QomQuery{Path: disk.QdevID(), Property: "legacy-addr"}
-
-			if err := r.mon.Run(qmp.Command{Name: "qom-get", Arguments: &qomQuery}, &pciAddr); err == nil {
-				disk.QemuAddr = fmt.Sprintf("0x%s", strings.Split(pciAddr, ".")[0])
+			// A PCIe root port or an addr/slot on the root bus
+			if addr, err := r.deviceAddr(disk.QdevID()); err == nil {
+				disk.QemuAddr = addr
+			} else {
+				return err
 			}
 		case DiskDriverType_SCSI_HD:
 			// SCSI bus name/addr and lun of disk
-			var parentBus string
-
-			busQomQuery := qemu_types.QomQuery{Path: disk.QdevID(), Property: "parent_bus"}
-
-			if err := r.mon.Run(qmp.Command{Name: "qom-get", Arguments: &busQomQuery}, &parentBus); err != nil {
-				return err
-			}
-			// in:  /machine/peripheral/scsi0/virtio-backend/scsi0.0
-			// out: scsi0
-			parentBusName := strings.Split(filepath.Base(parentBus), ".")[0]
-
-			var lun int
-
-			lunQomQuery := qemu_types.QomQuery{Path: disk.QdevID(), Property: "lun"}
-
-			if err := r.mon.Run(qmp.Command{Name: "qom-get", Arguments: &lunQomQuery}, &lun); err != nil {
+			if addr, err := r.scsiAddr(disk.QdevID()); err == nil {
+				disk.QemuAddr = addr
+			} else {
 				return err
 			}
-
-			disk.QemuAddr = fmt.Sprintf("%s:%s/%d", parentBusName, r.scsiBuses[parentBusName].Addr, lun)
 		}
 
 		for _, m := range append(dev.DirtyBitmaps, dev.Inserted.DirtyBitmaps...) {
 			if m.Name == "backup" {
-				disk.HasBitmap = true
+				// The bitmap was not saved properly (e.g. QEMU crashed),
+				// so it cannot be used for an incremental backup
+				if m.Inconsistent {
+					disk.BitmapInconsistent = true
+				} else {
+					disk.HasBitmap = true
+				}
 			}
 		}
 
@@ -432,6 +568,10 @@ func (r *InstanceQemu_i440fx) initDiskPool() error {
 }
 
 func (r *InstanceQemu_i440fx) DiskAppend(opts DiskProperties) error {
+	return r.diskAppend(opts, r)
+}
+
+func (r *InstanceQemu_i440fx) diskAppend(opts DiskProperties, alloc pciAllocator) error {
 	if err := opts.Validate(true); err != nil {
 		return err
 	}
@@ -497,6 +637,24 @@ func (r *InstanceQemu_i440fx) DiskAppend(opts DiskProperties) error {
 		}
 	}
 
+	// Map the image file to a chroot using bind mount
+	if b, ok := d.Backend.(*file.Device); ok {
+		chrootFilePath := filepath.Join(CHROOTDIR, r.name, d.Path)
+
+		defer func() {
+			if !success {
+				file.Unbind(chrootFilePath)
+			}
+		}()
+
+		if err := b.BindTo(chrootFilePath, r.uid); err != nil {
+			if os.IsExist(err) {
+				return fmt.Errorf("image file is already in use: %s", d.Path)
+			}
+			return err
+		}
+	}
+
 	// Changes in QEMU
 
 	// Add QEMU device
@@ -507,29 +665,59 @@ func (r *InstanceQemu_i440fx) DiskAppend(opts DiskProperties) error {
 	}
 
 	switch d.Driver() {
+	case DiskDriverType_VIRTIO_BLK_PCI:
+		bus, err := alloc.nextBus()
+		if err != nil {
+			return err
+		}
+
+		if len(bus) > 0 {
+			devOpts.Bus = bus
+			d.QemuAddr = bus
+		}
 	case DiskDriverType_SCSI_HD:
 		busName, _, _ := ParseSCSIAddr(d.QemuAddr)
 
-		if _, ok := r.scsiBuses[busName]; !ok {
-			busOpts := qemu_types.SCSIHostBusDeviceOptions{
-				Driver: "virtio-scsi-pci",
-				ID:     busName,
-			}
-
-			if err := r.mon.Run(qmp.Command{Name: "device_add", Arguments: &busOpts}, nil); err != nil {
-				return fmt.Errorf("device_add failed: %s", err)
-			}
+		if err := r.addSCSIBus(busName, alloc); err != nil {
+			return err
 		}
 
 		devOpts.Bus = fmt.Sprintf("%s.0", busName)
 		devOpts.SCSI_ID = 1
 	}
 
+	// Encrypted NBD connection requires the TLS client credentials
+	if d.UseTLS() {
+		if err := r.tlsCredsAdd(TLSEndpointClient); err != nil {
+			return err
+		}
+	}
+
+	// Prepare Ceph configuration files and the key secret
+	if b, ok := d.Backend.(*rbd.Device); ok {
+		if err := r.rbdAttach(b); err != nil {
+			return err
+		}
+
+		defer func() {
+			if !success {
+				r.rbdDetach(b)
+			}
+		}()
+	}
+
+	driveOpts := d.driveOptions()
+
+	if d.Readonly {
+		driveOpts = append(driveOpts, "readonly=on")
+	}
+
 	// Use HMP for add new block backend
 	cmd := fmt.Sprintf(
-		"drive_add auto \"file=%s,id=%s,format=raw,if=none,aio=native,cache=none,detect-zeroes=on,iops_rd=%d,iops_wr=%d\"",
-		d.Path,
+		"drive_add auto \"%s,id=%s,format=%s,if=none,aio=native,cache=none,detect-zeroes=on,iops_rd=%d,iops_wr=%d\"",
+		strings.Join(driveOpts, ","),
 		d.BaseName(),
+		d.Format(),
 		d.IopsRd,
 		d.IopsWr,
 	)
@@ -569,6 +757,13 @@ func (r *InstanceQemu_i440fx) DiskRemove(diskname string) error {
 		}
 	}
 
+	// Remove the bind mount of the image file from a chroot
+	if _, ok := d.Backend.(*file.Device); ok {
+		if err := file.Unbind(filepath.Join(CHROOTDIR, r.name, d.Path)); err != nil {
+			return err
+		}
+	}
+
 	// Changes in QEMU
 
 	// Remove QEMU device
@@ -611,17 +806,23 @@ func (r *InstanceQemu_i440fx) DiskRemove(diskname string) error {
 		}
 	}
 
+	if b, ok := d.Backend.(*rbd.Device); ok {
+		if err := r.rbdDetach(b); err != nil {
+			return err
+		}
+	}
+
 	return r.Disks.Remove(d.Backend.BaseName())
 }
 
 func (r *InstanceQemu_i440fx) initNetIfacePool() error {
-	for _, dev := range r.pciDevs[0].Devices {
+	for _, dev := range r.pciDevices() {
 		// {'class': 512, 'desc': 'Ethernet controller'}
 		if dev.ClassInfo.Class != 512 {
 			continue
 		}
 
-		netif := NetIface{QemuAddr: fmt.Sprintf("0x%x", dev.Slot)}
+		netif := NetIface{QemuAddr: dev.placement()}
 
 		typeQomQuery := qemu_types.QomQuery{Path: dev.QdevID, Property: "type"}
 
@@ -694,6 +895,10 @@ func (r *InstanceQemu_i440fx) initNetIfacePool() error {
 }
 
 func (r *InstanceQemu_i440fx) NetIfaceAppend(opts NetIfaceProperties) error {
+	return r.netIfaceAppend(opts, r)
+}
+
+func (r *InstanceQemu_i440fx) netIfaceAppend(opts NetIfaceProperties, alloc pciAllocator) error {
 	if err := opts.Validate(true); err != nil {
 		return err
 	}
@@ -713,6 +918,15 @@ func (r *InstanceQemu_i440fx) NetIfaceAppend(opts NetIfaceProperties) error {
 		return &AlreadyConnectedError{"instance_qemu", n.Ifname}
 	}
 
+	bus, err := alloc.nextBus()
+	if err != nil {
+		return err
+	}
+
+	if len(bus) > 0 {
+		n.QemuAddr = bus
+	}
+
 	// Changes in QEMU
 
 	backendOpts := qemu_types.NetdevTapOptions{
@@ -729,6 +943,7 @@ func (r *InstanceQemu_i440fx) NetIfaceAppend(opts NetIfaceProperties) error {
 		Netdev: n.Ifname,
 		ID:     n.QdevID(),
 		Mac:    n.HwAddr,
+		Bus:    bus,
 	}
 
 	// Enable multi-queue on virtio-net-pci interface
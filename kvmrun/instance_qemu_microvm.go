@@ -0,0 +1,275 @@
+package kvmrun
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	qemu_types "github.com/0xef53/kvmrun/internal/qemu/types"
+	"github.com/0xef53/kvmrun/kvmrun/backend/file"
+
+	qmp "github.com/0xef53/go-qmp/v2"
+	"golang.org/x/sync/errgroup"
+)
+
+// InstanceQemu_microvm represents a configuration of a running QEMU instance
+// with the microvm machine type.
+//
+// There is no PCI bus on this machine type, so only virtio-mmio devices
+// are available and none of them can be hot-plugged.
+type InstanceQemu_microvm struct {
+	*InstanceQemu
+
+	blkDevs []qemu_types.BlockInfo `json:"-"`
+}
+
+func (r *InstanceQemu_microvm) init() error {
+	var gr errgroup.Group
+
+	r.blkDevs = make([]qemu_types.BlockInfo, 0, 4)
+
+	if err := r.mon.Run(qmp.Command{Name: "query-block", Arguments: nil}, &r.blkDevs); err != nil {
+		return err
+	}
+
+	gr.Go(func() error { return r.initDiskPool() })
+	gr.Go(func() error { return r.initNetIfacePool() })
+
+	return gr.Wait()
+}
+
+func (r *InstanceQemu_microvm) initDiskPool() error {
+	for _, dev := range r.blkDevs {
+		// skip reserved names and empty devices
+		if dev.Device == "modiso" {
+			continue
+		}
+		if dev.Inserted.File == "" {
+			continue
+		}
+
+		var devicePath string
+
+		if strings.HasPrefix(dev.Inserted.File, "json:") {
+			b := qemu_types.InsertedFileOptions{}
+
+			if err := json.Unmarshal([]byte(dev.Inserted.File[5:]), &b); err != nil {
+				return err
+			}
+
+			switch b.File.Driver {
+			case "iscsi":
+				devicePath = fmt.Sprintf(
+					"iscsi://%s%%%s@%s/%s/%s",
+					b.File.User,
+					b.File.Password,
+					b.File.Portal,
+					b.File.Target,
+					b.File.Lun,
+				)
+			case "rbd":
+				devicePath = r.rbdDevicePath(dev.Device, &b)
+			case "nbd":
+				devicePath = nbdDevicePath(&b)
+			default:
+				return fmt.Errorf("unknown backing device driver: %s", b.File.Driver)
+			}
+		} else {
+			devicePath = dev.Inserted.File
+		}
+
+		// The disk itself is the image at the bottom of the backing chain.
+		// The images above it are the overlays of the disk snapshots.
+		baseImage, overlays := backingChain(&dev.Inserted.Image)
+
+		if dev.Inserted.BackingFileDepth > 0 {
+			if len(baseImage.Filename) != 0 {
+				devicePath = baseImage.Filename
+			} else {
+				devicePath = dev.Inserted.BackingFile
+			}
+		}
+
+		disk, err := NewDisk(devicePath)
+		if err != nil {
+			return err
+		}
+
+		disk.IopsRd = dev.Inserted.IopsRd
+		disk.IopsWr = dev.Inserted.IopsWr
+		disk.Readonly = dev.Inserted.ReadOnly
+
+		disk.QemuVirtualSize = baseImage.VirtualSize
+
+		// The format QEMU has opened the image with
+		if _, ok := disk.Backend.(*file.Device); ok {
+			disk.DiskProperties.Format = baseImage.Format
+		}
+
+		if dev.Inserted.BackingFileDepth > 0 {
+			disk.Snapshots = snapshotsFromChain(disk.BaseName(), overlays)
+		}
+
+		qomQuery := qemu_types.QomQuery{Path: disk.QdevID(), Property: "type"}
+
+		if err := r.mon.Run(qmp.Command{Name: "qom-get", Arguments: &qomQuery}, &disk.DiskProperties.Driver); err != nil {
+			return err
+		}
+
+		disk.driver = DiskDriverTypeValue(disk.DiskProperties.Driver)
+
+		if disk.Driver() == DriverType_UNKNOWN {
+			// skip device with unknown driver type
+			continue
+		}
+
+		for _, m := range append(dev.DirtyBitmaps, dev.Inserted.DirtyBitmaps...) {
+			if m.Name == "backup" {
+				// The bitmap was not saved properly (e.g. QEMU crashed),
+				// so it cannot be used for an incremental backup
+				if m.Inconsistent {
+					disk.BitmapInconsistent = true
+				} else {
+					disk.HasBitmap = true
+				}
+			}
+		}
+
+		if be, err := NewDiskBackend(disk.Path); err == nil {
+			disk.Backend = be
+		} else {
+			return err
+		}
+
+		r.Disks.Append(disk)
+	}
+
+	return nil
+}
+
+func (r *InstanceQemu_microvm) initNetIfacePool() error {
+	// The set of network interfaces cannot be changed at runtime,
+	// so the startup configuration is used as a list of devices
+	for _, iface := range r.startupConf.NetIfaceGetList() {
+		netif := NetIface{
+			NetIfaceProperties: NetIfaceProperties{
+				Ifname: iface.Ifname,
+			},
+		}
+
+		typeQomQuery := qemu_types.QomQuery{Path: netif.QdevID(), Property: "type"}
+
+		if err := r.mon.Run(qmp.Command{Name: "qom-get", Arguments: &typeQomQuery}, &netif.NetIfaceProperties.Driver); err != nil {
+			if _, ok := err.(*qmp.DeviceNotFound); ok {
+				continue
+			}
+			return err
+		}
+
+		netif.driver = NetDriverTypeValue(netif.NetIfaceProperties.Driver)
+
+		if netif.Driver() == DriverType_UNKNOWN {
+			// skip device with unknown driver type
+			continue
+		}
+
+		macQomQuery := qemu_types.QomQuery{Path: netif.QdevID(), Property: "mac"}
+
+		if err := r.mon.Run(qmp.Command{Name: "qom-get", Arguments: &macQomQuery}, &netif.HwAddr); err != nil {
+			return err
+		}
+
+		netif.Queues = iface.Queues
+
+		// Ifup, Ifdown
+		scripts := struct {
+			Ifup   string `json:"ifup"`
+			Ifdown string `json:"ifdown"`
+		}{}
+
+		if b, err := os.ReadFile(filepath.Join(CHROOTDIR, r.name, "run/net", netif.Ifname)); err == nil {
+			if err := json.Unmarshal(b, &scripts); err != nil {
+				return err
+			}
+
+			netif.Ifup = scripts.Ifup
+			netif.Ifdown = scripts.Ifdown
+		} else {
+			return err
+		}
+
+		r.NetIfaces.Append(&netif)
+	}
+
+	return nil
+}
+
+func (r *InstanceQemu_microvm) CPUSetActual(_ int) error {
+	return fmt.Errorf("cpu hotplug: %w by microvm", ErrNotSupported)
+}
+
+func (r *InstanceQemu_microvm) InputDeviceAppend(_ InputDeviceProperties) error {
+	return fmt.Errorf("input devices: %w by microvm", ErrNotSupported)
+}
+
+func (r *InstanceQemu_microvm) InputDeviceRemove(_ string) error {
+	return fmt.Errorf("input devices: %w by microvm", ErrNotSupported)
+}
+
+func (r *InstanceQemu_microvm) CdromAppend(_ CdromProperties) error {
+	return fmt.Errorf("cdrom devices: %w by microvm", ErrNotSupported)
+}
+
+func (r *InstanceQemu_microvm) CdromRemove(_ string) error {
+	return fmt.Errorf("cdrom devices: %w by microvm", ErrNotSupported)
+}
+
+func (r *InstanceQemu_microvm) CdromChangeMedia(_, _ string) error {
+	return fmt.Errorf("cdrom devices: %w by microvm", ErrNotSupported)
+}
+
+func (r *InstanceQemu_microvm) CdromRemoveMedia(_ string) error {
+	return fmt.Errorf("cdrom devices: %w by microvm", ErrNotSupported)
+}
+
+func (r *InstanceQemu_microvm) DiskAppend(_ DiskProperties) error {
+	return fmt.Errorf("disk hotplug: %w by microvm", ErrNotSupported)
+}
+
+func (r *InstanceQemu_microvm) DiskRemove(_ string) error {
+	return fmt.Errorf("disk hotplug: %w by microvm", ErrNotSupported)
+}
+
+func (r *InstanceQemu_microvm) NetIfaceAppend(_ NetIfaceProperties) error {
+	return fmt.Errorf("net interface hotplug: %w by microvm", ErrNotSupported)
+}
+
+func (r *InstanceQemu_microvm) NetIfaceRemove(_ string) error {
+	return fmt.Errorf("net interface hotplug: %w by microvm", ErrNotSupported)
+}
+
+func (r *InstanceQemu_microvm) VSockDeviceAppend(_ ChannelVSockProperties) error {
+	return fmt.Errorf("vsock device hotplug: %w by microvm", ErrNotSupported)
+}
+
+func (r *InstanceQemu_microvm) VSockDeviceRemove() error {
+	return fmt.Errorf("vsock device hotplug: %w by microvm", ErrNotSupported)
+}
+
+func (r *InstanceQemu_microvm) CloudInitSetMedia(_ string) error {
+	return fmt.Errorf("cloud-init drive: %w by microvm", ErrNotSupported)
+}
+
+func (r *InstanceQemu_microvm) HostDeviceAppend(_ HostDeviceProperties) error {
+	return fmt.Errorf("host-pci devices: %w by microvm", ErrNotSupported)
+}
+
+func (r *InstanceQemu_microvm) HostDeviceRemove(_ string) error {
+	return fmt.Errorf("host-pci devices: %w by microvm", ErrNotSupported)
+}
+
+func (r *InstanceQemu_microvm) VNCSetPassword(_ string) error {
+	return fmt.Errorf("vnc: %w by microvm", ErrNotSupported)
+}
@@ -0,0 +1,318 @@
+package kvmrun
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/0xef53/kvmrun/internal/pci"
+	qemu_types "github.com/0xef53/kvmrun/internal/qemu/types"
+
+	qmp "github.com/0xef53/go-qmp/v2"
+)
+
+// InstanceQemu_q35 represents a configuration of a running QEMU instance
+// with the q35 chipset.
+//
+// The block devices, cdroms and cloud-init drive are handled
+// in the same way as for i440fx. The difference is in the PCI topology:
+// all hot-pluggable devices are placed on the PCIe root ports.
+type InstanceQemu_q35 struct {
+	*InstanceQemu_i440fx
+}
+
+// rootPorts returns the actual state of the PCIe root ports.
+func (r *InstanceQemu_q35) rootPorts() (*pcieRootPortPool, error) {
+	buses := make([]qemu_types.PCIInfo, 0, 1)
+
+	if err := r.mon.Run(qmp.Command{Name: "query-pci", Arguments: nil}, &buses); err != nil {
+		return nil, err
+	}
+
+	return newPCIeRootPortPoolFromQemu(buses), nil
+}
+
+// nextBus returns a free PCIe root port for a new device.
+func (r *InstanceQemu_q35) nextBus() (string, error) {
+	ports, err := r.rootPorts()
+	if err != nil {
+		return "", err
+	}
+
+	return ports.Next()
+}
+
+func (r *InstanceQemu_q35) CdromAppend(opts CdromProperties) error {
+	return r.cdromAppend(opts, r)
+}
+
+func (r *InstanceQemu_q35) DiskAppend(opts DiskProperties) error {
+	return r.diskAppend(opts, r)
+}
+
+func (r *InstanceQemu_q35) NetIfaceAppend(opts NetIfaceProperties) error {
+	return r.netIfaceAppend(opts, r)
+}
+
+func (r *InstanceQemu_q35) VSockDeviceAppend(opts ChannelVSockProperties) error {
+	if r.VSockDevice != nil {
+		return &AlreadyConnectedError{"instance_qemu", "vsock device"}
+	}
+
+	if err := opts.Validate(true); err != nil {
+		return err
+	}
+
+	ports, err := r.rootPorts()
+	if err != nil {
+		return err
+	}
+
+	port, err := ports.Next()
+	if err != nil {
+		return err
+	}
+
+	vsock := ChannelVSock{
+		ChannelVSockProperties: opts,
+		QemuAddr:               port,
+	}
+
+	devOpts := qemu_types.VSockDeviceOptions{
+		Driver:   "vhost-vsock-pci",
+		ID:       "vsock_device",
+		Bus:      port,
+		GuestCID: vsock.ContextID,
+	}
+
+	if err := r.mon.Run(qmp.Command{Name: "device_add", Arguments: &devOpts}, nil); err != nil {
+		return err
+	}
+
+	r.VSockDevice = &vsock
+
+	return nil
+}
+
+func (r *InstanceQemu_q35) HostDeviceAppend(opts HostDeviceProperties) error {
+	if err := opts.Validate(true); err != nil {
+		return err
+	}
+
+	if r.HostDevices.Exists(opts.PCIAddr) {
+		return &AlreadyConnectedError{"instance_qemu", opts.PCIAddr}
+	}
+
+	dev, err := NewHostDevice(opts.PCIAddr)
+	if err != nil {
+		return err
+	}
+
+	dev.PrimaryGPU = opts.PrimaryGPU
+	dev.Multifunction = opts.Multifunction
+
+	if dev.PrimaryGPU {
+		return fmt.Errorf("primary GPU cannot be hot-plugged: %s", dev.PCIAddr)
+	}
+
+	be, err := pci.LookupDevice(dev.PCIAddr)
+	if err != nil {
+		return err
+	}
+
+	if dev.Multifunction && !be.HasMultifunctionFeature() {
+		return fmt.Errorf("multifunction is not supported: %s", be.String())
+	}
+
+	ports, err := r.rootPorts()
+	if err != nil {
+		return err
+	}
+
+	port, err := ports.Next()
+	if err != nil {
+		return err
+	}
+
+	num, _ := ParsePCIeRootPortName(port)
+
+	if err := r.prepareHostDevice(be); err != nil {
+		return err
+	}
+
+	// Changes in QEMU
+
+	devices := []*qemu_types.HostPCIDeviceOptions{
+		{
+			Driver: "vfio-pci",
+			ID:     fmt.Sprintf("hostpci%d", num),
+			Host:   be.String(),
+			Bus:    port,
+		},
+	}
+
+	if dev.Multifunction {
+		devices[0].ID = fmt.Sprintf("hostpci%d.0", num)
+		devices[0].Addr = "0x0.0x0"
+		devices[0].Multifunction = true
+
+		for _, sub := range be.Subdevices() {
+			devices = append(devices, &qemu_types.HostPCIDeviceOptions{
+				Driver: "vfio-pci",
+				ID:     fmt.Sprintf("hostpci%d.%d", num, sub.AddrFunction()),
+				Host:   sub.String(),
+				Bus:    port,
+				Addr:   fmt.Sprintf("0x0.0x%x", sub.AddrFunction()),
+			})
+		}
+
+		// The function 0 must be added last, otherwise the guest
+		// will not see the other functions of the device.
+		devices = append(devices[1:], devices[0])
+	}
+
+	for _, devOpts := range devices {
+		if err := r.mon.Run(qmp.Command{Name: "device_add", Arguments: devOpts}, nil); err != nil {
+			return fmt.Errorf("device_add failed: %s", err)
+		}
+	}
+
+	// The startup configuration is used to restore the list
+	// of host devices of the running instance
+	if c, ok := r.startupConf.(*StartupConf); ok {
+		if err := c.InstanceConf.HostDeviceAppend(opts); err != nil && !IsAlreadyConnectedError(err) {
+			return err
+		}
+
+		if err := c.InstanceConf.SaveStartupConfig(); err != nil {
+			return err
+		}
+	}
+
+	return r.HostDevices.Append(dev)
+}
+
+// prepareHostDevice detaches the device and all its functions from the host
+// (as the launcher does at startup) and makes their IOMMU groups
+// available to QEMU, which is already running inside the chroot.
+func (r *InstanceQemu_q35) prepareHostDevice(be *pci.Device) error {
+	if err := pci.LoadVFIOModule(); err != nil {
+		return err
+	}
+
+	if be.Enabled() && be.CurrentDriver() == "vfio-pci" {
+		return fmt.Errorf("unable to work with open PCI device: %s", be.String())
+	}
+
+	oldmask := syscall.Umask(0000)
+	defer syscall.Umask(oldmask)
+
+	// The VFIO container device and the group devices
+	devices := []string{"/dev/vfio/vfio"}
+
+	for _, d := range append([]*pci.Device{be}, be.Subdevices()...) {
+		if err := d.AssignDriver("vfio-pci"); err != nil {
+			return fmt.Errorf("failed to detach PCI device %s: %w", d.String(), err)
+		}
+
+		group, err := d.IOMMUGroup()
+		if err != nil {
+			return err
+		}
+
+		devices = append(devices, filepath.Join("/dev/vfio", group))
+	}
+
+	for _, devpath := range devices {
+		chrootDevPath := filepath.Join(CHROOTDIR, r.name, devpath)
+
+		stat := syscall.Stat_t{}
+
+		if err := syscall.Stat(devpath, &stat); err != nil {
+			return err
+		}
+
+		os.MkdirAll(filepath.Dir(chrootDevPath), 0755)
+
+		if err := syscall.Mknod(chrootDevPath, syscall.S_IFCHR|uint32(os.FileMode(01600)), int(stat.Rdev)); err != nil && !os.IsExist(err) {
+			return err
+		}
+
+		if err := os.Chown(chrootDevPath, r.uid, 0); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *InstanceQemu_q35) HostDeviceRemove(hexaddr string) error {
+	dev := r.HostDevices.Get(hexaddr)
+
+	if dev == nil {
+		return &NotConnectedError{"instance_qemu", hexaddr}
+	}
+
+	// Find the root port the device is placed on
+	var qdevID string
+
+	for _, d := range r.pciDevices() {
+		if !strings.HasPrefix(d.QdevID, "hostpci") {
+			continue
+		}
+
+		var host string
+
+		qomQuery := qemu_types.QomQuery{Path: d.QdevID, Property: "host"}
+
+		if err := r.mon.Run(qmp.Command{Name: "qom-get", Arguments: &qomQuery}, &host); err != nil {
+			return err
+		}
+
+		if addr, err := pci.AddressFromHex(host); err == nil && addr.String() == dev.BackendAddr.String() {
+			qdevID = d.QdevID
+
+			break
+		}
+	}
+
+	if len(qdevID) == 0 {
+		return fmt.Errorf("unable to find a QEMU device for host-pci addr: %s", dev.PCIAddr)
+	}
+
+	// Changes in QEMU
+
+	// Other functions of a multifunction device are removed along with the function 0
+	ts := time.Now()
+
+	if err := r.mon.Run(qmp.Command{Name: "device_del", Arguments: &qemu_types.StrID{ID: qdevID}}, nil); err != nil {
+		return fmt.Errorf("device_del error: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	if _, err := r.mon.WaitDeviceDeletedEvent(ctx, qdevID, uint64(ts.Unix())); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return fmt.Errorf("device_del timeout error: failed to complete within 60 seconds")
+		}
+		return err
+	}
+
+	if c, ok := r.startupConf.(*StartupConf); ok {
+		if err := c.InstanceConf.HostDeviceRemove(hexaddr); err != nil && !IsNotConnectedError(err) {
+			return err
+		}
+
+		if err := c.InstanceConf.SaveStartupConfig(); err != nil {
+			return err
+		}
+	}
+
+	return r.HostDevices.Remove(hexaddr)
+}
@@ -5,6 +5,10 @@ const (
 	FIRST_WS_PORT       = 10700
 	FIRST_NBD_PORT      = 60000
 
+	// The number of PCIe root ports created for the q35 machine.
+	// Each hot-pluggable PCIe device occupies its own root port.
+	QEMU_Q35_ROOT_PORTS = 24
+
 	CONFDIR = "/etc/kvmrun"
 
 	QEMU_BINARY = "/usr/lib/kvmrun/qemu.wrapper"
@@ -17,6 +21,13 @@ const (
 	MODULESDIR = "/var/lib/kvmrun/modules"
 	LOGDIR     = "/var/log/kvmrun"
 
+	// Overlay images of the disk snapshots
+	SNAPSHOTSDIR = "/var/lib/kvmrun/snapshots"
+
+	// Marker of the host drain mode. In this mode the host refuses
+	// to start new machines and to accept incoming migrations
+	HOSTDRAINFILE = "/var/lib/kvmrun/drained"
+
 	DEFAULT_QEMU_ROOTDIR = "/"
 )
 
@@ -28,6 +28,7 @@ const (
 	NetDriverType_VIRTIO_NET_PCI NetDriverType = iota + 1
 	NetDriverType_RTL8139
 	NetDriverType_E1000
+	NetDriverType_VIRTIO_NET_DEVICE
 )
 
 func (t NetDriverType) String() string {
@@ -38,6 +39,8 @@ func (t NetDriverType) String() string {
 		return "rtl8139"
 	case NetDriverType_E1000:
 		return "e1000"
+	case NetDriverType_VIRTIO_NET_DEVICE:
+		return "virtio-net-device"
 	}
 
 	return "UNKNOWN"
@@ -60,6 +63,8 @@ func NetDriverTypeValue(s string) NetDriverType {
 		return NetDriverType_RTL8139
 	case "e1000":
 		return NetDriverType_E1000
+	case "virtio-net-device":
+		return NetDriverType_VIRTIO_NET_DEVICE
 	}
 
 	return DriverType_UNKNOWN
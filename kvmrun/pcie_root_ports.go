@@ -0,0 +1,111 @@
+package kvmrun
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	qemu_types "github.com/0xef53/kvmrun/internal/qemu/types"
+)
+
+// PCIeRootPortName returns the ID of the PCIe root port with the given number.
+// This is also the name of the bus provided by this root port.
+func PCIeRootPortName(num int) string {
+	return fmt.Sprintf("pcie.%d", num)
+}
+
+// ParsePCIeRootPortName returns the number of the PCIe root port
+// by its name. The second value is false if s is not a valid root port name.
+func ParsePCIeRootPortName(s string) (int, bool) {
+	if !strings.HasPrefix(s, "pcie.") {
+		return 0, false
+	}
+
+	num, err := strconv.Atoi(s[5:])
+	if err != nil || num < 1 || num > QEMU_Q35_ROOT_PORTS {
+		return 0, false
+	}
+
+	return num, true
+}
+
+// pcieRootPortPool tracks the occupied PCIe root ports of the q35 machine.
+//
+// A reserved root port is not given out by Next, but can be taken
+// by Get once: it is the port requested by the device address.
+// A busy root port is not given out at all.
+type pcieRootPortPool struct {
+	reserved map[int]struct{}
+	busy     map[int]struct{}
+}
+
+func newPCIeRootPortPool() *pcieRootPortPool {
+	return &pcieRootPortPool{
+		reserved: make(map[int]struct{}),
+		busy:     make(map[int]struct{}),
+	}
+}
+
+// newPCIeRootPortPoolFromQemu creates a pool based on the result
+// of the "query-pci" QMP command. A root port is considered busy
+// if there is at least one device behind it.
+func newPCIeRootPortPoolFromQemu(buses []qemu_types.PCIInfo) *pcieRootPortPool {
+	p := newPCIeRootPortPool()
+
+	for _, bus := range buses {
+		for _, dev := range bus.Devices {
+			if dev.PCIBridge == nil || len(dev.PCIBridge.Devices) == 0 {
+				continue
+			}
+
+			if num, ok := ParsePCIeRootPortName(dev.QdevID); ok {
+				p.busy[num] = struct{}{}
+			}
+		}
+	}
+
+	return p
+}
+
+// Reserve marks the root port as reserved for the device
+// with this address. It returns false if addr is not a valid root port name.
+func (p *pcieRootPortPool) Reserve(addr string) bool {
+	if num, ok := ParsePCIeRootPortName(addr); ok {
+		p.reserved[num] = struct{}{}
+
+		return true
+	}
+
+	return false
+}
+
+// Next returns the name of the first free root port and marks it as busy.
+func (p *pcieRootPortPool) Next() (string, error) {
+	for num := 1; num <= QEMU_Q35_ROOT_PORTS; num++ {
+		if _, ok := p.reserved[num]; ok {
+			continue
+		}
+
+		if _, ok := p.busy[num]; !ok {
+			p.busy[num] = struct{}{}
+
+			return PCIeRootPortName(num), nil
+		}
+	}
+
+	return "", fmt.Errorf("no free PCIe root ports left (total = %d)", QEMU_Q35_ROOT_PORTS)
+}
+
+// Get returns addr if it is a valid root port name that is not busy,
+// even if it is reserved. Otherwise, it returns the first free root port.
+func (p *pcieRootPortPool) Get(addr string) (string, error) {
+	if num, ok := ParsePCIeRootPortName(addr); ok {
+		if _, busy := p.busy[num]; !busy {
+			p.busy[num] = struct{}{}
+
+			return addr, nil
+		}
+	}
+
+	return p.Next()
+}
@@ -0,0 +1,93 @@
+package kvmrun
+
+import (
+	"testing"
+)
+
+func TestParsePCIeRootPortName(t *testing.T) {
+	testCases := map[string]int{
+		"pcie.1":  1,
+		"pcie.24": 24,
+		"pcie.0":  0,
+		"pcie.25": 0,
+		"pcie.x":  0,
+		"pci.1":   0,
+		"":        0,
+	}
+
+	for s, want := range testCases {
+		got, ok := ParsePCIeRootPortName(s)
+
+		if ok != (want > 0) || got != want {
+			t.Fatalf("got unexpected result (s = %q): want %d, got %d (ok = %t)", s, want, got, ok)
+		}
+	}
+}
+
+func TestPCIeRootPortPool(t *testing.T) {
+	p := newPCIeRootPortPool()
+
+	steps := []struct {
+		addr string
+		want string
+	}{
+		{"pcie.3", "pcie.3"},
+		{"", "pcie.1"},
+		// Already reserved by the first device
+		{"pcie.3", "pcie.2"},
+		{"invalid", "pcie.4"},
+		{"pcie.5", "pcie.5"},
+		{"", "pcie.6"},
+	}
+
+	for _, step := range steps {
+		got, err := p.Get(step.addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got != step.want {
+			t.Fatalf("got unexpected root port (addr = %q): want %s, got %s", step.addr, step.want, got)
+		}
+	}
+
+	for num := 7; num <= QEMU_Q35_ROOT_PORTS; num++ {
+		if _, err := p.Next(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if addr, err := p.Next(); err == nil {
+		t.Fatalf("expected an error when all root ports are busy, got %s", addr)
+	}
+
+	if addr, err := p.Get("pcie.1"); err == nil {
+		t.Fatalf("expected an error when all root ports are busy, got %s", addr)
+	}
+}
+
+func TestPCIeRootPortPoolReserve(t *testing.T) {
+	p := newPCIeRootPortPool()
+
+	if !p.Reserve("pcie.1") {
+		t.Fatalf("expected pcie.1 to be reserved")
+	}
+
+	if p.Reserve("pcie.100") {
+		t.Fatalf("expected pcie.100 to be rejected")
+	}
+
+	if got, _ := p.Next(); got != "pcie.2" {
+		t.Fatalf("got unexpected root port: want pcie.2, got %s", got)
+	}
+
+	// The reserved port is given to the device that requested it,
+	// but only once
+	if got, _ := p.Get("pcie.1"); got != "pcie.1" {
+		t.Fatalf("got unexpected root port: want pcie.1, got %s", got)
+	}
+
+	if got, _ := p.Get("pcie.1"); got != "pcie.3" {
+		t.Fatalf("got unexpected root port: want pcie.3, got %s", got)
+	}
+}
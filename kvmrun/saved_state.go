@@ -0,0 +1,217 @@
+package kvmrun
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// SavedState describes the machine state that was saved to a file
+// by the suspend operation. The state file itself is stored
+// in the machine config directory next to the description.
+type SavedState struct {
+	Checksum    string    `json:"checksum"`
+	Size        uint64    `json:"size"`
+	MachineType string    `json:"machine_type"`
+	Fingerprint string    `json:"fingerprint"`
+	Paused      bool      `json:"paused"`
+	Created     time.Time `json:"created"`
+
+	// Restoring is set when QEMU is started to load the state.
+	// The state is removed by kvmrund as soon as it is loaded,
+	// so this flag means that the previous attempt has failed.
+	Restoring bool `json:"restoring,omitempty"`
+}
+
+func SavedStateFile(vmname string) string {
+	return filepath.Join(CONFDIR, vmname, "saved_state")
+}
+
+func savedStateInfoFile(vmname string) string {
+	return filepath.Join(CONFDIR, vmname, "saved_state.json")
+}
+
+// GetSavedState returns the description of the saved machine state.
+// If there is no saved state, os.ErrNotExist is returned.
+func GetSavedState(vmname string) (*SavedState, error) {
+	b, err := os.ReadFile(savedStateInfoFile(vmname))
+	if err != nil {
+		return nil, err
+	}
+
+	st := SavedState{}
+
+	if err := json.Unmarshal(b, &st); err != nil {
+		return nil, fmt.Errorf("invalid saved state description: %w", err)
+	}
+
+	return &st, nil
+}
+
+func (st *SavedState) Save(vmname string) error {
+	b, err := json.MarshalIndent(st, "", "    ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(savedStateInfoFile(vmname), append(b, '\n'), 0644)
+}
+
+// RemoveSavedState removes the saved state file and its description.
+func RemoveSavedState(vmname string) error {
+	for _, fname := range []string{savedStateInfoFile(vmname), SavedStateFile(vmname)} {
+		if err := os.Remove(fname); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// VerifyChecksum compares the checksum of the state file
+// with the one that was calculated while saving.
+func (st *SavedState) VerifyChecksum(vmname string) error {
+	fd, err := os.Open(SavedStateFile(vmname))
+	if err != nil {
+		return err
+	}
+	defer fd.Close()
+
+	h := sha256.New()
+
+	n, err := io.Copy(h, fd)
+	if err != nil {
+		return err
+	}
+
+	if uint64(n) != st.Size {
+		return fmt.Errorf("saved state file size mismatch: %d != %d", n, st.Size)
+	}
+
+	if sum := hex.EncodeToString(h.Sum(nil)); sum != st.Checksum {
+		return fmt.Errorf("saved state checksum mismatch: %s != %s", sum, st.Checksum)
+	}
+
+	return nil
+}
+
+// CheckCompatibility checks whether the saved state can be restored
+// using the given machine configuration. The configuration must describe
+// the same set of devices, and the machine type must be the same
+// or be an alias of the saved one.
+func (st *SavedState) CheckCompatibility(vmi Instance) error {
+	saved, err := ParseMachineType(st.MachineType)
+	if err != nil {
+		return err
+	}
+
+	mtype := vmi.MachineTypeGet()
+
+	switch {
+	case mtype.String() == saved.String():
+	case mtype.Chipset == saved.Chipset && len(strings.Split(mtype.String(), "-")) != 3:
+		// Not versioned type, e.g. "pc" or "q35"
+	default:
+		return fmt.Errorf("machine type has been changed: %s != %s", mtype, saved)
+	}
+
+	if fp := StateFingerprint(vmi); fp != st.Fingerprint {
+		return fmt.Errorf("configuration has been changed since the state was saved")
+	}
+
+	return nil
+}
+
+// PinDeviceAddrs copies the addresses of the devices of the running
+// instance vmr to the configuration vmc, if they are not set there.
+// So the next start of the machine reproduces the same PCI layout,
+// which is required to load the saved state.
+func PinDeviceAddrs(vmc, vmr Instance) error {
+	c, ok := vmc.(*InstanceConf)
+	if !ok {
+		return fmt.Errorf("unexpected type of machine configuration: %T", vmc)
+	}
+
+	for _, cd := range vmr.CdromGetList() {
+		if v := c.Cdroms.Get(cd.Name); v != nil && len(v.QemuAddr) == 0 {
+			v.QemuAddr = cd.QemuAddr
+		}
+	}
+
+	for _, d := range vmr.DiskGetList() {
+		if v := c.Disks.Get(d.Path); v != nil && len(v.QemuAddr) == 0 {
+			v.QemuAddr = d.QemuAddr
+		}
+	}
+
+	for _, n := range vmr.NetIfaceGetList() {
+		if v := c.NetIfaces.Get(n.Ifname); v != nil && len(v.QemuAddr) == 0 {
+			v.QemuAddr = n.QemuAddr
+		}
+	}
+
+	if vsock := vmr.VSockDeviceGet(); vsock != nil && c.VSockDevice != nil && len(c.VSockDevice.QemuAddr) == 0 {
+		c.VSockDevice.QemuAddr = vsock.QemuAddr
+	}
+
+	return nil
+}
+
+// StateFingerprint returns a hash of the machine properties
+// that determine the set of emulated devices and their placement.
+// The saved state can only be loaded into a machine with the same
+// set of devices on the same addresses.
+func StateFingerprint(vmi Instance) string {
+	lines := make([]string, 0, 32)
+
+	add := func(format string, a ...interface{}) {
+		lines = append(lines, fmt.Sprintf(format, a...))
+	}
+
+	add("chipset=%d", vmi.MachineTypeGet().Chipset)
+
+	if fw := vmi.FirmwareGet(); fw != nil {
+		add("firmware=%s,flash=%t", fw.Image, len(fw.Flash) > 0)
+	}
+
+	add("memory=%d", vmi.MemoryGetTotal())
+	add("cpu=%d/%d,sockets=%d,model=%s", vmi.CPUGetActual(), vmi.CPUGetTotal(), vmi.CPUGetSockets(), vmi.CPUGetModel())
+
+	for _, d := range vmi.InputDeviceGetList() {
+		add("input=%s", d.Type)
+	}
+
+	for _, cd := range vmi.CdromGetList() {
+		add("cdrom=%s,driver=%s,addr=%s", cd.QdevID(), cd.Driver(), cd.QemuAddr)
+	}
+
+	for _, d := range vmi.DiskGetList() {
+		add("disk=%s,driver=%s,addr=%s", d.QdevID(), d.Driver(), d.QemuAddr)
+	}
+
+	for _, n := range vmi.NetIfaceGetList() {
+		add("net=%s,driver=%s,hwaddr=%s,queues=%d,addr=%s", n.Ifname, n.Driver(), strings.ToLower(n.HwAddr), n.Queues, n.QemuAddr)
+	}
+
+	if vsock := vmi.VSockDeviceGet(); vsock != nil {
+		add("vsock=%d,addr=%s", vsock.ContextID, vsock.QemuAddr)
+	}
+
+	if cidrive := vmi.CloudInitGetDrive(); cidrive != nil {
+		add("cloudinit=%s", cidrive.Driver())
+	}
+
+	for _, dev := range vmi.HostDeviceGetList() {
+		add("hostpci=%s", dev.PCIAddr)
+	}
+
+	h := sha256.Sum256([]byte(strings.Join(lines, "\n")))
+
+	return hex.EncodeToString(h[:])
+}
@@ -0,0 +1,103 @@
+package kvmrun
+
+import (
+	"testing"
+)
+
+func newTestInstanceConf(t *testing.T) *InstanceConf {
+	vmc := newInstanceConf("alice")
+
+	if err := vmc.DiskAppend(DiskProperties{Path: "/var/lib/kvmrun/alice_sda.img", Driver: "virtio-blk-pci"}); err != nil {
+		t.Fatalf("got unexpected error: %s", err)
+	}
+
+	if err := vmc.NetIfaceAppend(NetIfaceProperties{Ifname: "alice_eth0", HwAddr: "02:00:00:00:00:0a", Driver: "virtio-net-pci"}); err != nil {
+		t.Fatalf("got unexpected error: %s", err)
+	}
+
+	return vmc
+}
+
+func TestStateFingerprint(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(*InstanceConf)
+		changed bool
+	}{
+		{
+			name:    "unchanged",
+			modify:  func(vmc *InstanceConf) {},
+			changed: false,
+		},
+		{
+			name:    "memory",
+			modify:  func(vmc *InstanceConf) { vmc.MemorySetTotal(256) },
+			changed: true,
+		},
+		{
+			name:    "disk addr",
+			modify:  func(vmc *InstanceConf) { vmc.Disks.Get("alice_sda.img").QemuAddr = "0x7" },
+			changed: true,
+		},
+		{
+			name:    "net addr",
+			modify:  func(vmc *InstanceConf) { vmc.NetIfaces.Get("alice_eth0").QemuAddr = "pcie.2" },
+			changed: true,
+		},
+		{
+			name:    "net hwaddr case",
+			modify:  func(vmc *InstanceConf) { vmc.NetIfaces.Get("alice_eth0").HwAddr = "02:00:00:00:00:0A" },
+			changed: false,
+		},
+		{
+			name: "new disk",
+			modify: func(vmc *InstanceConf) {
+				vmc.DiskAppend(DiskProperties{Path: "/var/lib/kvmrun/alice_sdb.img", Driver: "virtio-blk-pci"})
+			},
+			changed: true,
+		},
+	}
+
+	orig := StateFingerprint(newTestInstanceConf(t))
+
+	for _, tc := range tests {
+		vmc := newTestInstanceConf(t)
+
+		tc.modify(vmc)
+
+		if changed := StateFingerprint(vmc) != orig; changed != tc.changed {
+			t.Fatalf("%s: got unexpected result: want changed=%t, got changed=%t", tc.name, tc.changed, changed)
+		}
+	}
+}
+
+func TestPinDeviceAddrs(t *testing.T) {
+	vmc := newTestInstanceConf(t)
+	vmr := newTestInstanceConf(t)
+
+	vmr.Disks.Get("alice_sda.img").QemuAddr = "pcie.1"
+	vmr.NetIfaces.Get("alice_eth0").QemuAddr = "pcie.2"
+
+	if StateFingerprint(vmc) == StateFingerprint(vmr) {
+		t.Fatalf("got unexpected result: fingerprints must differ before pinning")
+	}
+
+	if err := PinDeviceAddrs(vmc, vmr); err != nil {
+		t.Fatalf("got unexpected error: %s", err)
+	}
+
+	if want, got := StateFingerprint(vmr), StateFingerprint(vmc); want != got {
+		t.Fatalf("got unexpected fingerprint: want %s, got %s", want, got)
+	}
+
+	// An address that is already set in the configuration is not overwritten
+	vmr.Disks.Get("alice_sda.img").QemuAddr = "pcie.5"
+
+	if err := PinDeviceAddrs(vmc, vmr); err != nil {
+		t.Fatalf("got unexpected error: %s", err)
+	}
+
+	if want, got := "pcie.1", vmc.Disks.Get("alice_sda.img").QemuAddr; want != got {
+		t.Fatalf("got unexpected disk addr: want %s, got %s", want, got)
+	}
+}
@@ -0,0 +1,296 @@
+package kvmrun
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/0xef53/kvmrun/internal/appconf"
+	qemu_types "github.com/0xef53/kvmrun/internal/qemu/types"
+
+	qmp "github.com/0xef53/go-qmp/v2"
+)
+
+// The certificates signed by the kvmrun CA (see gencert) are also used
+// by QEMU to encrypt NBD and migration streams between servers.
+const (
+	TLSEndpointClient = "client"
+	TLSEndpointServer = "server"
+)
+
+// TLSCredsID returns the ID of the QEMU tls-creds-x509 object
+// for the given endpoint.
+func TLSCredsID(endpoint string) string {
+	return "tls_creds_" + endpoint
+}
+
+// TLSCredsDir returns the directory with the TLS credentials
+// of the given endpoint inside the machine chroot.
+func TLSCredsDir(endpoint string) string {
+	return filepath.Join("/tls", endpoint)
+}
+
+// TLSCredsObjectOptions returns a set of arguments for the QMP object-add command
+// that defines the tls-creds-x509 object for the given endpoint.
+// Since the object is added at runtime, the directory path is relative to the chroot.
+func TLSCredsObjectOptions(endpoint string) *qemu_types.TLSCredsX509Options {
+	return &qemu_types.TLSCredsX509Options{
+		QomType:    "tls-creds-x509",
+		ID:         TLSCredsID(endpoint),
+		Endpoint:   endpoint,
+		Dir:        TLSCredsDir(endpoint),
+		VerifyPeer: true,
+	}
+}
+
+// PrepareTLSCreds issues the certificates of the virtual machine
+// and writes them to the machine chroot using the file names
+// that QEMU expects: ca-cert.pem, <endpoint>-cert.pem and <endpoint>-key.pem.
+//
+// The certificates are signed by the kvmrun CA from certDir, but
+// have a separate organizational unit, so kvmrund does not accept them
+// (see appconf.MachineCertUnit). The keys of kvmrund itself never leave certDir.
+func PrepareTLSCreds(vmname string, uid int, certDir string) error {
+	ca, err := loadCertAuthority(certDir)
+	if err != nil {
+		return fmt.Errorf("failed to prepare TLS credentials: %w", err)
+	}
+
+	for _, endpoint := range []string{TLSEndpointClient, TLSEndpointServer} {
+		dir := filepath.Join(CHROOTDIR, vmname, TLSCredsDir(endpoint))
+
+		if err := ca.issueMachineCreds(dir, endpoint, vmname, uid); err != nil {
+			return fmt.Errorf("failed to prepare TLS credentials: %w", err)
+		}
+	}
+
+	return nil
+}
+
+type certAuthority struct {
+	cert    *x509.Certificate
+	certPEM []byte
+	key     crypto.Signer
+
+	// Host names and addresses of the kvmrund server certificate
+	dnsNames    []string
+	ipAddresses []net.IP
+}
+
+func loadCertAuthority(certDir string) (*certAuthority, error) {
+	pair, err := tls.LoadX509KeyPair(filepath.Join(certDir, "CA.crt"), filepath.Join(certDir, "CA.key"))
+	if err != nil {
+		return nil, err
+	}
+
+	key, ok := pair.PrivateKey.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type of the CA key")
+	}
+
+	ca := certAuthority{key: key}
+
+	if v, err := x509.ParseCertificate(pair.Certificate[0]); err == nil {
+		ca.cert = v
+	} else {
+		return nil, err
+	}
+
+	ca.certPEM = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: pair.Certificate[0]})
+
+	// The machine server certificate is verified by the clients
+	// using the same names as the kvmrund one
+	b, err := os.ReadFile(filepath.Join(certDir, "server.crt"))
+	if err != nil {
+		return nil, err
+	}
+
+	if block, _ := pem.Decode(b); block != nil {
+		srv, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+
+		ca.dnsNames = srv.DNSNames
+		ca.ipAddresses = srv.IPAddresses
+	} else {
+		return nil, fmt.Errorf("no certificate found: %s", filepath.Join(certDir, "server.crt"))
+	}
+
+	return &ca, nil
+}
+
+// issueMachineCreds generates a new key and certificate of the virtual machine
+// for the given endpoint and writes them to dir along with the CA certificate.
+func (ca *certAuthority) issueMachineCreds(dir, endpoint, vmname string, uid int) error {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return err
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	template := x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject: pkix.Name{
+			Organization:       ca.cert.Subject.Organization,
+			OrganizationalUnit: []string{appconf.MachineCertUnit},
+			CommonName:         vmname,
+		},
+		NotBefore:             now,
+		NotAfter:              ca.cert.NotAfter,
+		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+	}
+
+	if endpoint == TLSEndpointServer {
+		template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+		template.DNSNames = ca.dnsNames
+		template.IPAddresses = ca.ipAddresses
+	} else {
+		template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+	}
+
+	derBytes, err := x509.CreateCertificate(rand.Reader, &template, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		return err
+	}
+
+	keyBytes, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+
+	if err := os.Chown(dir, uid, 0); err != nil {
+		return err
+	}
+
+	files := map[string][]byte{
+		"ca-cert.pem":          ca.certPEM,
+		endpoint + "-cert.pem": pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: derBytes}),
+		endpoint + "-key.pem":  pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyBytes}),
+	}
+
+	for fname, b := range files {
+		fname = filepath.Join(dir, fname)
+
+		if err := os.WriteFile(fname, b, 0600); err != nil {
+			return err
+		}
+
+		if err := os.Chown(fname, uid, 0); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WriteTLSCreds copies the kvmrun certificates of the given endpoint
+// from certDir to dir using the file names that QEMU expects.
+// It must only be used for the processes run by kvmrund itself (e.g. qemu-img),
+// the virtual machines get their own certificates (see PrepareTLSCreds).
+func WriteTLSCreds(dir, endpoint string, uid int, certDir string) error {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+
+	if err := os.Chown(dir, uid, 0); err != nil {
+		return err
+	}
+
+	files := map[string]string{
+		"CA.crt":          "ca-cert.pem",
+		endpoint + ".crt": endpoint + "-cert.pem",
+		endpoint + ".key": endpoint + "-key.pem",
+	}
+
+	for src, dst := range files {
+		b, err := os.ReadFile(filepath.Join(certDir, src))
+		if err != nil {
+			return fmt.Errorf("failed to prepare TLS credentials: %w", err)
+		}
+
+		dst = filepath.Join(dir, dst)
+
+		if err := os.WriteFile(dst, b, 0600); err != nil {
+			return err
+		}
+
+		if err := os.Chown(dst, uid, 0); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// tlsCredsArgs returns the command line arguments to define
+// the tls-creds-x509 object for the given endpoint.
+// Objects are created before QEMU enters the chroot,
+// so the full directory path is used.
+func (b qemuCommandLine) tlsCredsArgs(endpoint string) []string {
+	opts := fmt.Sprintf(
+		"tls-creds-x509,id=%s,endpoint=%s,dir=%s,verify-peer=on",
+		TLSCredsID(endpoint),
+		endpoint,
+		filepath.Join(CHROOTDIR, b.vmconf.Name(), TLSCredsDir(endpoint)),
+	)
+
+	return []string{"-object", opts}
+}
+
+// diskTLSArgs returns the client TLS credentials arguments
+// if at least one of the disks requires them.
+func (b qemuCommandLine) diskTLSArgs() []string {
+	for _, disk := range b.vmconf.DiskGetList() {
+		if disk.UseTLS() {
+			return b.tlsCredsArgs(TLSEndpointClient)
+		}
+	}
+
+	return nil
+}
+
+// tlsCredsAdd creates the tls-creds-x509 object for the given endpoint
+// if it does not exist yet. The credentials files should already be
+// in the machine chroot (see PrepareTLSCreds).
+func (r *InstanceQemu) tlsCredsAdd(endpoint string) error {
+	var qomType string
+
+	qomQuery := qemu_types.QomQuery{Path: "/objects/" + TLSCredsID(endpoint), Property: "type"}
+
+	if err := r.mon.Run(qmp.Command{Name: "qom-get", Arguments: &qomQuery}, &qomType); err == nil {
+		return nil
+	} else {
+		if _, ok := err.(*qmp.DeviceNotFound); !ok {
+			return err
+		}
+	}
+
+	if err := r.mon.Run(qmp.Command{Name: "object-add", Arguments: TLSCredsObjectOptions(endpoint)}, nil); err != nil {
+		return fmt.Errorf("object-add failed: %s", err)
+	}
+
+	return nil
+}
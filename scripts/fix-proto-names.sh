@@ -23,7 +23,8 @@ for FNAME in $@ ; do
         -e 's/Ipnets/IPNets/g' \
         -e 's/IpfabricAttrs/IPFabricAttrs/g' \
         -e 's/ProcessId/ProcessID/g' \
-        -e 's/TaskId/TaskID/g'
+        -e 's/TaskId/TaskID/g' \
+        -e 's/JobId/JobID/g'
 
 done
 
@@ -49,11 +49,14 @@ func BlockConfOperations(vmname string) map[string]task.OperationMode {
 	return map[string]task.OperationMode{vmname: ModeBlockConf}
 }
 
-func BlockBackupOperations(vmname, diskname string) map[string]task.OperationMode {
-	return map[string]task.OperationMode{
-		vmname:                  ModeBlockConf,
-		vmname + ":" + diskname: ModeBlockAll,
+func BlockBackupOperations(vmname string, disknames ...string) map[string]task.OperationMode {
+	targets := map[string]task.OperationMode{vmname: ModeBlockConf}
+
+	for _, n := range disknames {
+		targets[vmname+":"+n] = ModeBlockAll
 	}
+
+	return targets
 }
 
 func WithUniqueLabel(label string) *task.TaskClassifierDefinition {
@@ -81,6 +84,10 @@ var longRunningTasks = map[string]error{
 	"MachineMigrationTask":         fmt.Errorf("machine is locked because the migration process is currently in progress"),
 	"MachineIncomingMigrationTask": fmt.Errorf("machine is locked because the incoming-migration process is currently in progress"),
 	"DiskBackupTask":               fmt.Errorf("resource is locked because the backup process is currently in progress"),
+	"MachineBackupTask":            fmt.Errorf("resource is locked because the backup process is currently in progress"),
+	"BackupExportTask":             fmt.Errorf("resource is locked because the backup process is currently in progress"),
+	"DiskRestoreTask":              fmt.Errorf("resource is locked because the restore process is currently in progress"),
+	"DiskMoveTask":                 fmt.Errorf("resource is locked because the disk moving process is currently in progress"),
 }
 
 func (s *Server) taskStart(fn func() (string, error)) (string, error) {
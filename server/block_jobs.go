@@ -0,0 +1,195 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	qemu_types "github.com/0xef53/kvmrun/internal/qemu/types"
+	"github.com/0xef53/kvmrun/kvmrun"
+
+	qmp "github.com/0xef53/go-qmp/v2"
+	"github.com/0xef53/go-task"
+)
+
+// taskBlockJobs is a set of QEMU block jobs started by a background task.
+type taskBlockJobs struct {
+	vmname string
+	jobs   map[string]struct{}
+
+	// Is used to find the set by task ID
+	stat func() *task.TaskStat
+}
+
+type blockJobRegistry struct {
+	mu sync.Mutex
+
+	// Task label -> block jobs
+	tasks map[string]*taskBlockJobs
+}
+
+func newBlockJobRegistry() *blockJobRegistry {
+	return &blockJobRegistry{tasks: make(map[string]*taskBlockJobs)}
+}
+
+// TaskBlockJobAdd binds the QEMU block job to the task with the specified label.
+// Such jobs can be paused, resumed or throttled by the task key
+// while the task is running.
+func (s *Server) TaskBlockJobAdd(label string, t task.Task, vmname, jobID string) {
+	s.blockJobs.mu.Lock()
+	defer s.blockJobs.mu.Unlock()
+
+	set, ok := s.blockJobs.tasks[label]
+	if !ok {
+		set = &taskBlockJobs{
+			vmname: vmname,
+			jobs:   make(map[string]struct{}),
+			stat:   t.Stat,
+		}
+
+		s.blockJobs.tasks[label] = set
+	}
+
+	set.jobs[jobID] = struct{}{}
+}
+
+// TaskBlockJobDone unbinds the finished block job from the task with the specified label.
+func (s *Server) TaskBlockJobDone(label, jobID string) {
+	s.blockJobs.mu.Lock()
+	defer s.blockJobs.mu.Unlock()
+
+	if set, ok := s.blockJobs.tasks[label]; ok {
+		delete(set.jobs, jobID)
+	}
+}
+
+// TaskBlockJobsRemove unbinds all block jobs from the task with the specified label.
+// Should be called when the task is finished.
+func (s *Server) TaskBlockJobsRemove(label string) {
+	s.blockJobs.mu.Lock()
+	defer s.blockJobs.mu.Unlock()
+
+	delete(s.blockJobs.tasks, label)
+}
+
+// taskBlockJobs returns the machine name and the block jobs of the task.
+// The key is a task label or a task ID.
+func (s *Server) taskBlockJobs(key string) (string, []string, error) {
+	s.blockJobs.mu.Lock()
+	defer s.blockJobs.mu.Unlock()
+
+	set, ok := s.blockJobs.tasks[key]
+	if !ok {
+		for _, v := range s.blockJobs.tasks {
+			if st := v.stat(); st != nil && st.ID == key {
+				set = v
+
+				break
+			}
+		}
+	}
+
+	if set == nil || len(set.jobs) == 0 {
+		return "", nil, fmt.Errorf("%w: no running block jobs for the task: %s", kvmrun.ErrNotFound, key)
+	}
+
+	jobs := make([]string, 0, len(set.jobs))
+
+	for jobID := range set.jobs {
+		jobs = append(jobs, jobID)
+	}
+
+	sort.Strings(jobs)
+
+	return set.vmname, jobs, nil
+}
+
+// TaskBlockJobOwner returns the label and the ID of the running task
+// that has started the block job on the machine.
+func (s *Server) TaskBlockJobOwner(vmname, jobID string) (string, string, bool) {
+	s.blockJobs.mu.Lock()
+	defer s.blockJobs.mu.Unlock()
+
+	for label, set := range s.blockJobs.tasks {
+		if set.vmname != vmname {
+			continue
+		}
+
+		if _, ok := set.jobs[jobID]; ok {
+			var taskID string
+
+			if st := set.stat(); st != nil {
+				taskID = st.ID
+			}
+
+			return label, taskID, true
+		}
+	}
+
+	return "", "", false
+}
+
+// TaskPause pauses all block jobs of the running task.
+func (s *Server) TaskPause(key string) error {
+	return s.taskBlockJobsRun(key, "block-job-pause", nil)
+}
+
+// TaskResume resumes all paused block jobs of the running task.
+func (s *Server) TaskResume(key string) error {
+	return s.taskBlockJobsRun(key, "block-job-resume", nil)
+}
+
+// TaskSetSpeed changes the speed limit (in bytes per second) of all block jobs
+// of the running task. Zero means unlimited.
+func (s *Server) TaskSetSpeed(key string, speed uint64) error {
+	return s.taskBlockJobsRun(key, "block-job-set-speed", &speed)
+}
+
+// taskBlockJobsRun runs the command for each block job of the task.
+// The jobs that have already finished are skipped, the errors
+// of the other jobs do not stop the processing and are returned together.
+func (s *Server) taskBlockJobsRun(key, command string, speed *uint64) error {
+	vmname, jobs, err := s.taskBlockJobs(key)
+	if err != nil {
+		return err
+	}
+
+	running := make([]*qemu_types.BlockJobInfo, 0, len(jobs))
+
+	if err := s.Mon.Run(vmname, qmp.Command{Name: "query-block-jobs", Arguments: nil}, &running); err != nil {
+		return fmt.Errorf("query-block-jobs failed: %w", err)
+	}
+
+	isRunning := func(jobID string) bool {
+		for _, j := range running {
+			if j.Device == jobID {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	var errs []error
+
+	for _, jobID := range jobs {
+		if !isRunning(jobID) {
+			continue
+		}
+
+		args := struct {
+			Device string  `json:"device"`
+			Speed  *uint64 `json:"speed,omitempty"`
+		}{
+			Device: jobID,
+			Speed:  speed,
+		}
+
+		if err := s.Mon.Run(vmname, qmp.Command{Name: command, Arguments: &args}, nil); err != nil {
+			errs = append(errs, fmt.Errorf("%s failed (%s): %w", command, jobID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
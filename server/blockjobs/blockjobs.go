@@ -0,0 +1,241 @@
+package blockjobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	qemu_types "github.com/0xef53/kvmrun/internal/qemu/types"
+	"github.com/0xef53/kvmrun/kvmrun"
+
+	qmp "github.com/0xef53/go-qmp/v2"
+	"github.com/0xef53/go-task"
+)
+
+// ErrOwnedByTask is returned when the job cannot be controlled directly,
+// because it is managed by a running kvmrun task.
+var ErrOwnedByTask = errors.New("job is owned by a running task")
+
+// Job describes a QEMU background job of the machine.
+type Job struct {
+	ID     string
+	Type   string
+	Status string
+	Error  string
+
+	// Amount of work done and the total amount of work.
+	// For block jobs these are the offset and the length in bytes
+	Offset uint64
+	Len    uint64
+
+	// Speed limit in bytes per second. Zero means unlimited
+	Speed uint64
+
+	Busy   bool
+	Paused bool
+	Ready  bool
+
+	// The kvmrun task that has started the job (if it is running)
+	TaskKey string
+	TaskID  string
+}
+
+// Owner task labels by the job ID prefixes used in kvmrun tasks
+var jobOwners = map[string][]string{
+	"copy_":   {"disk-backup/{disk}", "machine-backup"},
+	"migr_":   {"migration"},
+	"fleece_": {"backup-export"},
+	"move_":   {"disk-move/{disk}"},
+}
+
+func (s *Server) checkMachine(vmname string) error {
+	vm, err := s.MachineGet(vmname, true)
+	if err != nil {
+		return err
+	}
+
+	vmstate, err := s.MachineGetStatus(vm)
+	if err != nil {
+		return err
+	}
+
+	switch vmstate {
+	case kvmrun.StateRunning, kvmrun.StatePaused:
+	default:
+		return fmt.Errorf("%w: %s", kvmrun.ErrNotRunning, vmname)
+	}
+
+	return nil
+}
+
+// List returns all background jobs of the machine, including the jobs
+// that have been left behind by a crashed or restarted kvmrund.
+func (s *Server) List(ctx context.Context, vmname string) ([]*Job, error) {
+	if err := s.checkMachine(vmname); err != nil {
+		return nil, err
+	}
+
+	return s.list(vmname)
+}
+
+func (s *Server) list(vmname string) ([]*Job, error) {
+	jobs := make([]*qemu_types.JobInfo, 0)
+
+	if err := s.Mon.Run(vmname, qmp.Command{Name: "query-jobs", Arguments: nil}, &jobs); err != nil {
+		return nil, fmt.Errorf("query-jobs failed: %w", err)
+	}
+
+	blockJobs := make([]*qemu_types.BlockJobInfo, 0, len(jobs))
+
+	if err := s.Mon.Run(vmname, qmp.Command{Name: "query-block-jobs", Arguments: nil}, &blockJobs); err != nil {
+		return nil, fmt.Errorf("query-block-jobs failed: %w", err)
+	}
+
+	byDevice := make(map[string]*qemu_types.BlockJobInfo, len(blockJobs))
+
+	for _, j := range blockJobs {
+		byDevice[j.Device] = j
+	}
+
+	list := make([]*Job, 0, len(jobs))
+
+	for _, j := range jobs {
+		job := Job{
+			ID:     j.ID,
+			Type:   j.Type,
+			Status: j.Status,
+			Error:  j.Error,
+			Offset: j.CurrentProgress,
+			Len:    j.TotalProgress,
+		}
+
+		if b, ok := byDevice[j.ID]; ok {
+			job.Offset = b.Offset
+			job.Len = b.Len
+			job.Speed = b.Speed
+			job.Busy = b.Busy
+			job.Paused = b.Paused
+			job.Ready = b.Ready
+		}
+
+		job.TaskKey, job.TaskID = s.jobOwner(vmname, j.ID)
+
+		list = append(list, &job)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].ID < list[j].ID
+	})
+
+	return list, nil
+}
+
+// jobOwner returns the label and the ID of the running task
+// that has started the job. Empty strings are returned
+// if there is no such task.
+func (s *Server) jobOwner(vmname, jobID string) (string, string) {
+	if label, tid, ok := s.TaskBlockJobOwner(vmname, jobID); ok {
+		return label, tid
+	}
+
+	// Not all tasks register their jobs, so try to find
+	// the owner by the job naming convention
+	for prefix, labels := range jobOwners {
+		if !strings.HasPrefix(jobID, prefix) {
+			continue
+		}
+
+		diskname := strings.TrimPrefix(jobID, prefix)
+
+		for _, v := range labels {
+			label := vmname + "/" + strings.ReplaceAll(v, "{disk}", diskname)
+
+			for _, st := range s.Tasks.StatByLabel(label) {
+				switch st.State {
+				case task.StateCompleted, task.StateFailed:
+				default:
+					return label, st.ID
+				}
+			}
+		}
+	}
+
+	return "", ""
+}
+
+// Get returns the background job of the machine by its ID.
+func (s *Server) Get(ctx context.Context, vmname, jobID string) (*Job, error) {
+	if err := s.checkMachine(vmname); err != nil {
+		return nil, err
+	}
+
+	return s.get(vmname, jobID)
+}
+
+func (s *Server) get(vmname, jobID string) (*Job, error) {
+	jobs, err := s.list(vmname)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, j := range jobs {
+		if j.ID == jobID {
+			return j, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: job %s of %s", kvmrun.ErrNotFound, jobID, vmname)
+}
+
+func (s *Server) run(vmname, jobID, command string, orphanedOnly bool) error {
+	if err := s.checkMachine(vmname); err != nil {
+		return err
+	}
+
+	job, err := s.get(vmname, jobID)
+	if err != nil {
+		return err
+	}
+
+	// The owner task waits for the job to finish in a certain way
+	// and must complete or cancel the job itself
+	if orphanedOnly && len(job.TaskID) > 0 {
+		return fmt.Errorf("%w: %s (use the tasks service to cancel the task %s)", ErrOwnedByTask, job.TaskKey, job.TaskID)
+	}
+
+	if err := s.Mon.Run(vmname, qmp.Command{Name: command, Arguments: &qemu_types.JobOptions{ID: jobID}}, nil); err != nil {
+		return fmt.Errorf("%s failed: %w", command, err)
+	}
+
+	return nil
+}
+
+// Cancel aborts the job. A mirror job in the ready state
+// is aborted as well, without switching the device to the target.
+// Only the jobs that are not owned by a running task can be aborted.
+func (s *Server) Cancel(ctx context.Context, vmname, jobID string) error {
+	return s.run(vmname, jobID, "job-cancel", true)
+}
+
+func (s *Server) Pause(ctx context.Context, vmname, jobID string) error {
+	return s.run(vmname, jobID, "job-pause", false)
+}
+
+func (s *Server) Resume(ctx context.Context, vmname, jobID string) error {
+	return s.run(vmname, jobID, "job-resume", false)
+}
+
+// Complete finishes the job that is in the ready state
+// (e.g. switches the device to the target of a mirror job).
+// Only the jobs that are not owned by a running task can be completed.
+func (s *Server) Complete(ctx context.Context, vmname, jobID string) error {
+	return s.run(vmname, jobID, "job-complete", true)
+}
+
+// Dismiss removes the concluded job from the list of jobs.
+// Only the jobs that are not owned by a running task can be dismissed.
+func (s *Server) Dismiss(ctx context.Context, vmname, jobID string) error {
+	return s.run(vmname, jobID, "job-dismiss", true)
+}
@@ -0,0 +1,7 @@
+package blockjobs
+
+import "github.com/0xef53/kvmrun/server"
+
+type Server struct {
+	*server.Server
+}
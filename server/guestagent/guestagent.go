@@ -0,0 +1,256 @@
+package guestagent
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/0xef53/kvmrun/internal/guestagent"
+	"github.com/0xef53/kvmrun/kvmrun"
+)
+
+const (
+	DefaultTimeout     = 10 * time.Second
+	DefaultExecTimeout = 60 * time.Second
+
+	// The maximum time that a client can hold the agent connection
+	MaxTimeout = 10 * time.Minute
+)
+
+// run checks the machine state and calls fn with a connection
+// to the guest agent. The timeout applies to the whole call
+// including waiting for other clients of the same agent,
+// and is limited by MaxTimeout.
+func (s *Server) run(ctx context.Context, vmname string, timeout time.Duration, fn func(context.Context, *guestagent.Client) error) error {
+	vm, err := s.MachineGet(vmname, true)
+	if err != nil {
+		return err
+	}
+
+	vmstate, err := s.MachineGetStatus(vm)
+	if err != nil {
+		return err
+	}
+
+	switch vmstate {
+	case kvmrun.StateRunning:
+	case kvmrun.StatePaused:
+		return fmt.Errorf("machine is paused: %s", vmname)
+	default:
+		return fmt.Errorf("%w: %s", kvmrun.ErrNotRunning, vmname)
+	}
+
+	switch {
+	case timeout <= 0:
+		timeout = DefaultTimeout
+	case timeout > MaxTimeout:
+		timeout = MaxTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	return s.Agent.Run(ctx, vmname, func(c *guestagent.Client) error {
+		return fn(ctx, c)
+	})
+}
+
+func (s *Server) Ping(ctx context.Context, vmname string, timeout time.Duration) error {
+	return s.run(ctx, vmname, timeout, func(ctx context.Context, c *guestagent.Client) error {
+		return c.Ping(ctx)
+	})
+}
+
+func (s *Server) GetInfo(ctx context.Context, vmname string, timeout time.Duration) (*guestagent.Info, error) {
+	var info *guestagent.Info
+
+	err := s.run(ctx, vmname, timeout, func(ctx context.Context, c *guestagent.Client) (err error) {
+		info, err = c.Info(ctx)
+
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+func (s *Server) GetOSInfo(ctx context.Context, vmname string, timeout time.Duration) (*guestagent.OSInfo, error) {
+	var info *guestagent.OSInfo
+
+	err := s.run(ctx, vmname, timeout, func(ctx context.Context, c *guestagent.Client) (err error) {
+		info, err = c.GetOSInfo(ctx)
+
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+func (s *Server) GetNetworkInterfaces(ctx context.Context, vmname string, timeout time.Duration) ([]*guestagent.NetworkInterface, error) {
+	var ifaces []*guestagent.NetworkInterface
+
+	err := s.run(ctx, vmname, timeout, func(ctx context.Context, c *guestagent.Client) (err error) {
+		ifaces, err = c.NetworkGetInterfaces(ctx)
+
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return ifaces, nil
+}
+
+func (s *Server) GetFSInfo(ctx context.Context, vmname string, timeout time.Duration) ([]*guestagent.Filesystem, error) {
+	var fslist []*guestagent.Filesystem
+
+	err := s.run(ctx, vmname, timeout, func(ctx context.Context, c *guestagent.Client) (err error) {
+		fslist, err = c.GetFSInfo(ctx)
+
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fslist, nil
+}
+
+func (s *Server) SetUserPassword(ctx context.Context, vmname, username, password string, crypted bool, timeout time.Duration) error {
+	username = strings.TrimSpace(username)
+
+	if len(username) == 0 {
+		return fmt.Errorf("empty username")
+	}
+
+	if len(password) == 0 {
+		return fmt.Errorf("empty password")
+	}
+
+	return s.run(ctx, vmname, timeout, func(ctx context.Context, c *guestagent.Client) error {
+		return c.SetUserPassword(ctx, username, []byte(password), crypted)
+	})
+}
+
+type ExecOptions struct {
+	Path  string   `json:"path"`
+	Args  []string `json:"args"`
+	Env   []string `json:"env"`
+	Input []byte   `json:"input"`
+
+	// Do not wait for the process to exit,
+	// just return its PID
+	NoWait bool `json:"no_wait"`
+}
+
+func (o *ExecOptions) Validate() error {
+	o.Path = strings.TrimSpace(o.Path)
+
+	if len(o.Path) == 0 {
+		return fmt.Errorf("empty path")
+	}
+
+	return nil
+}
+
+type ExecResult struct {
+	PID int `json:"pid"`
+
+	*guestagent.ExecStatus
+}
+
+// Exec runs a process in the guest. Unless opts.NoWait is set, it waits
+// for the process to exit and returns the exit code and captured output.
+// The socket is not held between status requests,
+// so other calls can be made while the process is running.
+func (s *Server) Exec(ctx context.Context, vmname string, opts *ExecOptions, timeout time.Duration) (*ExecResult, error) {
+	if opts == nil {
+		return nil, fmt.Errorf("empty exec opts")
+	}
+
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case timeout <= 0:
+		timeout = DefaultExecTimeout
+	case timeout > MaxTimeout:
+		timeout = MaxTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	execOpts := guestagent.ExecOptions{
+		Path:          opts.Path,
+		Args:          opts.Args,
+		Env:           opts.Env,
+		InputData:     opts.Input,
+		CaptureOutput: true,
+	}
+
+	var pid int
+
+	err := s.run(ctx, vmname, timeout, func(ctx context.Context, c *guestagent.Client) (err error) {
+		pid, err = c.Exec(ctx, &execOpts)
+
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.NoWait {
+		return &ExecResult{PID: pid, ExecStatus: new(guestagent.ExecStatus)}, nil
+	}
+
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("process is still running (pid = %d): %w", pid, ctx.Err())
+		case <-ticker.C:
+		}
+
+		res, err := s.ExecStatus(ctx, vmname, pid, timeout)
+		if err != nil {
+			return nil, err
+		}
+
+		if res.Exited {
+			return res, nil
+		}
+	}
+}
+
+// ExecStatus returns the status of a process started by Exec.
+// The captured output can only be retrieved once after the process exits.
+func (s *Server) ExecStatus(ctx context.Context, vmname string, pid int, timeout time.Duration) (*ExecResult, error) {
+	var st *guestagent.ExecStatus
+
+	err := s.run(ctx, vmname, timeout, func(ctx context.Context, c *guestagent.Client) (err error) {
+		st, err = c.ExecStatus(ctx, pid)
+
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExecResult{PID: pid, ExecStatus: st}, nil
+}
@@ -0,0 +1,30 @@
+package server
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/0xef53/kvmrun/kvmrun"
+)
+
+func (s *Server) HostSetDrained(enabled bool) error {
+	if enabled {
+		if err := os.MkdirAll(filepath.Dir(kvmrun.HOSTDRAINFILE), 0755); err != nil {
+			return err
+		}
+
+		return os.WriteFile(kvmrun.HOSTDRAINFILE, []byte(""), 0644)
+	}
+
+	if err := os.Remove(kvmrun.HOSTDRAINFILE); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (s *Server) HostIsDrained() bool {
+	_, err := os.Stat(kvmrun.HOSTDRAINFILE)
+
+	return err == nil
+}
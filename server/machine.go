@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	qemu_types "github.com/0xef53/kvmrun/internal/qemu/types"
 	"github.com/0xef53/kvmrun/internal/utils"
 	"github.com/0xef53/kvmrun/kvmrun"
 
@@ -191,3 +192,30 @@ func (s *Server) MachineRemoveDownFile(vmname string) error {
 
 	return os.Remove(machineDownFile(vmname))
 }
+
+// MachineTLSCredsAdd issues the machine certificates (see kvmrun.PrepareTLSCreds)
+// and creates the QEMU tls-creds-x509 object for the given endpoint
+// if it does not exist yet. Returns the ID of the object.
+func (s *Server) MachineTLSCredsAdd(vmname string, uid int, endpoint string) (string, error) {
+	var qomType string
+
+	qomQuery := qemu_types.QomQuery{Path: "/objects/" + kvmrun.TLSCredsID(endpoint), Property: "type"}
+
+	if err := s.Mon.Run(vmname, qmp.Command{Name: "qom-get", Arguments: &qomQuery}, &qomType); err == nil {
+		return kvmrun.TLSCredsID(endpoint), nil
+	} else {
+		if _, ok := err.(*qmp.DeviceNotFound); !ok {
+			return "", err
+		}
+	}
+
+	if err := kvmrun.PrepareTLSCreds(vmname, uid, s.AppConf.Kvmrun.CertDir); err != nil {
+		return "", err
+	}
+
+	if err := s.Mon.Run(vmname, qmp.Command{Name: "object-add", Arguments: kvmrun.TLSCredsObjectOptions(endpoint)}, nil); err != nil {
+		return "", fmt.Errorf("failed to create TLS credentials: %w", err)
+	}
+
+	return kvmrun.TLSCredsID(endpoint), nil
+}
@@ -15,10 +15,15 @@ import (
 
 	qemu_types "github.com/0xef53/kvmrun/internal/qemu/types"
 	"github.com/0xef53/kvmrun/kvmrun"
+	"github.com/0xef53/kvmrun/kvmrun/backend/block"
+	"github.com/0xef53/kvmrun/kvmrun/backend/file"
+	"github.com/0xef53/kvmrun/kvmrun/backend/nbd"
 	"github.com/0xef53/kvmrun/server"
 
 	qmp "github.com/0xef53/go-qmp/v2"
 	"github.com/0xef53/go-task"
+
+	log "github.com/sirupsen/logrus"
 )
 
 type DiskBackupOptions struct {
@@ -26,6 +31,10 @@ type DiskBackupOptions struct {
 	Target      string `json:"target"`
 	Incremental bool   `json:"incremental"`
 	ClearBitmap bool   `json:"clear_bitmap"`
+	FSFreeze    bool   `json:"fs_freeze"`
+
+	// Speed limit in bytes per second. Zero means unlimited
+	Speed uint64 `json:"speed"`
 }
 
 func (o *DiskBackupOptions) Validate(_ bool) error {
@@ -72,7 +81,11 @@ func (s *Server) StartDiskBackupProcess(ctx context.Context, vmname string, opts
 }
 
 type DiskBackupStatDetails struct {
-	Disk *DataTransferStat
+	DiskName string
+	Disk     *DataTransferStat
+
+	// Guest filesystems were frozen at the moment the backup job started
+	FSConsistent bool
 }
 
 type DiskBackupTask struct {
@@ -92,6 +105,10 @@ type DiskBackupTask struct {
 	srcDisk *kvmrun.Disk
 	dstDisk *kvmrun.Disk
 
+	// A new image file should be created as a target
+	createTarget bool
+	targetFormat string
+
 	details  *DiskBackupStatDetails
 	statfile string
 
@@ -139,61 +156,30 @@ func (t *DiskBackupTask) BeforeStart(_ interface{}) error {
 		return fmt.Errorf("incompatible machine state: %d", vmstate)
 	}
 
-	var srcDisk *kvmrun.Disk
-
-	if d := t.vm.R.DiskGet(t.opts.DiskName); d != nil {
-		srcDisk = d
-	} else {
-		return &kvmrun.NotConnectedError{Source: "instance_qemu", Object: t.opts.DiskName}
-	}
-
-	if d := t.vm.R.DiskGet(t.opts.Target); d != nil {
-		return fmt.Errorf("target device is connected to the current machine: %s", t.opts.Target)
-	}
-
-	var srcSize uint64
-
-	if srcDisk.IsLocal() {
-		s, err := srcDisk.Backend.Size()
-		if err != nil {
-			return err
-		}
-
-		srcSize = s
-	} else {
-		srcSize = srcDisk.QemuVirtualSize
-	}
-
-	dstDisk, err := kvmrun.NewDisk(t.opts.Target)
+	pair, err := newBackupDisk(t.vm, t.opts.DiskName, t.opts.Target)
 	if err != nil {
 		return err
 	}
 
-	if ok, err := dstDisk.IsAvailable(); !ok {
-		return err
-	}
+	t.srcSize = pair.size
+	t.srcDisk = pair.src
+	t.dstDisk = pair.dst
+	t.createTarget = pair.createTarget
+	t.targetFormat = pair.format
 
-	if dstDisk.IsLocal() {
-		dstSize, err := dstDisk.Backend.Size()
-		if err != nil {
-			return err
-		}
-
-		if dstSize < srcSize {
-			return fmt.Errorf("target size is smaller than source (%d < %d)", dstSize, srcSize)
+	if t.opts.Incremental && !t.opts.ClearBitmap {
+		if err := checkIncrementalBackup(t.vmname, t.srcDisk); err != nil {
+			return fmt.Errorf("%w (use the clear-bitmap option to start a new chain with a full backup)", err)
 		}
 	}
 
-	t.srcSize = srcSize
-	t.srcDisk = srcDisk
-	t.dstDisk = dstDisk
-
 	// Init stat fields
 	t.details = &DiskBackupStatDetails{
-		Disk: new(DataTransferStat),
+		DiskName: t.srcDisk.BaseName(),
+		Disk:     new(DataTransferStat),
 	}
 
-	hashname := fmt.Sprintf("%x", md5.Sum([]byte(t.vmname+"/disk-backup/"+srcDisk.Backend.BaseName())))
+	hashname := fmt.Sprintf("%x", md5.Sum([]byte(t.vmname+"/disk-backup/"+t.srcDisk.Backend.BaseName())))
 
 	t.statfile = filepath.Join(kvmrun.CHROOTDIR, t.vmname, ".tasks", hashname)
 
@@ -285,27 +271,53 @@ func (t *DiskBackupTask) OnFailure(taskErr error) {
 func (t *DiskBackupTask) copy() error {
 	t.Logger.Debug("copy(): main process started")
 
+	if t.createTarget {
+		if err := t.createTargetFile(); err != nil {
+			return err
+		}
+	}
+
 	if t.dstDisk.IsLocal() {
-		defer os.Remove(filepath.Join(kvmrun.CHROOTDIR, t.vmname, t.dstDisk.Path))
+		defer t.unmapDeviceFromChroot()
 
-		if err := t.mapDeviceToChroot(t.dstDisk.Path); err != nil {
+		if err := t.mapDeviceToChroot(); err != nil {
 			return err
 		}
 	}
 
 	t.Logger.Debugf("copy(): run QMP command: drive-backup: src=%s, dst=%s", t.srcDisk.BaseName(), t.dstDisk.Path)
 
+	target, format, err := t.Server.backupTargetArgs(t.vm, t.dstDisk)
+	if err != nil {
+		return err
+	}
+
 	ts := time.Now()
 
 	backupArgs := qemu_types.DriveBackupOptions{
 		JobID:  fmt.Sprintf("copy_%s", t.srcDisk.BaseName()),
 		Device: t.srcDisk.BaseName(),
-		Target: t.dstDisk.Path,
+		Target: target,
+		Format: format,
 		Sync:   "full",
 		Mode:   "existing",
+		Speed:  t.opts.Speed,
+	}
+
+	var thawGuestFS func()
+	var fsFrozen bool
+
+	if t.opts.FSFreeze {
+		thawGuestFS, fsFrozen = t.Server.freezeGuestFSForBackup(t.vmname, t.Logger)
+
+		defer thawGuestFS()
 	}
 
 	if t.opts.Incremental {
+		if err := t.Server.removeInconsistentBitmap(t.vmname, t.srcDisk, t.Logger); err != nil {
+			return err
+		}
+
 		bitmapArgs := qemu_types.BlockDirtyBitmapOptions{
 			Node: t.srcDisk.BaseName(),
 			Name: "backup",
@@ -340,7 +352,7 @@ func (t *DiskBackupTask) copy() error {
 			t.Logger.Debugf("copy(): run QMP transaction: block-dirty-bitmap-add + drive-backup (src=%s, dst=%s", t.srcDisk.BaseName(), t.dstDisk.Path)
 
 			commands := []qmp.Command{
-				{Name: "block-dirty-bitmap-add", Arguments: bitmapArgs},
+				{Name: "block-dirty-bitmap-add", Arguments: backupBitmapAddArgs(t.srcDisk)},
 				{Name: "drive-backup", Arguments: &backupArgs},
 			}
 
@@ -356,6 +368,16 @@ func (t *DiskBackupTask) copy() error {
 		}
 	}
 
+	// The point in time of the backup is fixed when the job is created,
+	// so the guest filesystems can be thawed right now
+	if t.opts.FSFreeze {
+		thawGuestFS()
+
+		t.mu.Lock()
+		t.details.FSConsistent = fsFrozen
+		t.mu.Unlock()
+	}
+
 	// We start collecting statistics after the JOB_STATUS_CHANGE event
 	// "JOB_STATUS_CHANGE", "data": {"status": "running", "id": "copy_st_93cb9996"}
 
@@ -393,8 +415,20 @@ func (t *DiskBackupTask) copy() error {
 		return err
 	}
 
+	// Since that moment the job can be paused, resumed or throttled
+	// using the task key
+	label := t.vmname + "/disk-backup/" + t.srcDisk.Backend.BaseName()
+
+	t.Server.TaskBlockJobAdd(label, t, t.vmname, jobID)
+
+	defer t.Server.TaskBlockJobsRemove(label)
+
 	ts = time.Now()
 
+	var prevOffset uint64
+
+	prevTime := ts
+
 	ticker := time.NewTicker(time.Second)
 	defer ticker.Stop()
 
@@ -440,8 +474,26 @@ func (t *DiskBackupTask) copy() error {
 			break
 		}
 
+		speed := blockJobSpeed(prevOffset, job.Offset, time.Since(prevTime))
+
+		prevOffset, prevTime = job.Offset, time.Now()
+
 		// (total, remain, sent and speed)
-		t.updateStat(t.srcSize, job.Len-job.Offset, t.srcSize-(job.Len-job.Offset), 0)
+		t.updateStat(t.srcSize, job.Len-job.Offset, t.srcSize-(job.Len-job.Offset), speed)
+	}
+
+	if t.opts.Incremental {
+		pair := backupDisk{
+			src:          t.srcDisk,
+			dst:          t.dstDisk,
+			size:         t.srcSize,
+			createTarget: t.createTarget,
+		}
+
+		if err := updateBackupState(t.vmname, &pair, backupArgs.Sync, t.Logger); err != nil {
+			// non-fatal error. Just printing
+			t.Logger.Errorf("Failed to update backup state: %s", err)
+		}
 	}
 
 	t.Logger.Debug("copy(): completed")
@@ -449,8 +501,162 @@ func (t *DiskBackupTask) copy() error {
 	return nil
 }
 
-func (t *DiskBackupTask) mapDeviceToChroot(diskPath string) error {
-	chrootDir := filepath.Join(kvmrun.CHROOTDIR, t.vmname)
+// freezeGuestFSForBackup tries to freeze the guest filesystems.
+// A failed freeze is not fatal: the backup will be crash-consistent only.
+// The returned thaw function can be called multiple times.
+func (s *Server) freezeGuestFSForBackup(vmname string, l *log.Entry) (func(), bool) {
+	thaw, err := s.guestFSFreeze(vmname, l)
+	if err != nil {
+		l.Warnf("Backup will not be filesystem-consistent: %s", err)
+	}
+
+	var once sync.Once
+
+	return func() {
+		once.Do(func() {
+			if err := thaw(); err != nil {
+				l.Errorf("Failed to thaw guest filesystems: %s", err)
+			}
+		})
+	}, err == nil
+}
+
+// backupDisk is a pair of the source and target devices of a backup process
+type backupDisk struct {
+	src  *kvmrun.Disk
+	dst  *kvmrun.Disk
+	size uint64
+
+	// A new image file should be created as a target
+	createTarget bool
+
+	// Format of the new image file: raw or qcow2
+	format string
+}
+
+func newBackupDisk(vm *kvmrun.Machine, diskname, target string) (*backupDisk, error) {
+	var srcDisk *kvmrun.Disk
+
+	if d := vm.R.DiskGet(diskname); d != nil {
+		srcDisk = d
+	} else {
+		return nil, &kvmrun.NotConnectedError{Source: "instance_qemu", Object: diskname}
+	}
+
+	if d := vm.R.DiskGet(target); d != nil {
+		return nil, fmt.Errorf("target device is connected to the current machine: %s", target)
+	}
+
+	var srcSize uint64
+
+	if srcDisk.IsLocal() {
+		s, err := srcDisk.Size()
+		if err != nil {
+			return nil, err
+		}
+
+		srcSize = s
+	} else {
+		srcSize = srcDisk.QemuVirtualSize
+	}
+
+	dstDisk, err := kvmrun.NewDisk(target)
+	if err != nil {
+		return nil, err
+	}
+
+	switch dstDisk.Backend.(type) {
+	case *file.Device, *block.Device, *nbd.Device:
+	default:
+		return nil, fmt.Errorf("%w: backup target of this type: %s", kvmrun.ErrNotSupported, target)
+	}
+
+	// Backup images contain the guest data, so their format
+	// is determined by the file extension and is never detected
+	if _, ok := dstDisk.Backend.(*file.Device); ok {
+		dstDisk.DiskProperties.Format = imageFormatByName(dstDisk.Path)
+	}
+
+	var createTarget bool
+
+	switch ok, err := dstDisk.IsAvailable(); {
+	case ok:
+	case os.IsNotExist(err):
+		// A non-existent image file will be created as a raw
+		// or qcow2 image depending on the file extension
+		if _, ok := dstDisk.Backend.(*file.Device); ok {
+			createTarget = true
+		} else {
+			return nil, err
+		}
+	default:
+		return nil, err
+	}
+
+	if dstDisk.IsLocal() && !createTarget {
+		dstSize, err := dstDisk.Size()
+		if err != nil {
+			return nil, err
+		}
+
+		if dstSize < srcSize {
+			return nil, fmt.Errorf("target size is smaller than source (%d < %d)", dstSize, srcSize)
+		}
+	}
+
+	pair := backupDisk{
+		src:          srcDisk,
+		dst:          dstDisk,
+		size:         srcSize,
+		createTarget: createTarget,
+	}
+
+	if createTarget {
+		pair.format = dstDisk.Format()
+	}
+
+	return &pair, nil
+}
+
+func (t *DiskBackupTask) createTargetFile() error {
+	t.Logger.Infof("Creating a new %s image file: %s (size = %d)", t.targetFormat, t.dstDisk.Path, t.srcSize)
+
+	return createBackupImage(t.dstDisk.Path, t.targetFormat, t.srcSize)
+}
+
+func (t *DiskBackupTask) mapDeviceToChroot() error {
+	return mapDiskToChroot(t.vmname, t.dstDisk, t.vm.C.UID())
+}
+
+func (t *DiskBackupTask) unmapDeviceFromChroot() error {
+	return unmapDiskFromChroot(t.vmname, t.dstDisk)
+}
+
+func createRawImage(fname string, size uint64) error {
+	fd, err := os.OpenFile(fname, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	defer fd.Close()
+
+	if err := fd.Truncate(int64(size)); err != nil {
+		os.Remove(fname)
+
+		return err
+	}
+
+	return nil
+}
+
+func mapDiskToChroot(vmname string, disk *kvmrun.Disk, uid int) error {
+	chrootDir := filepath.Join(kvmrun.CHROOTDIR, vmname)
+
+	diskPath := disk.Path
+
+	// Image files are mapped using bind mount
+	if b, ok := disk.Backend.(*file.Device); ok {
+		return b.BindTo(filepath.Join(chrootDir, diskPath), uid)
+	}
 
 	if err := os.MkdirAll(filepath.Join(chrootDir, filepath.Dir(diskPath)), 0755); err != nil {
 		return err
@@ -466,5 +672,15 @@ func (t *DiskBackupTask) mapDeviceToChroot(diskPath string) error {
 		return fmt.Errorf("mknod %s: %s", diskPath, err)
 	}
 
-	return os.Chown(filepath.Join(chrootDir, diskPath), t.vm.C.UID(), 0)
+	return os.Chown(filepath.Join(chrootDir, diskPath), uid, 0)
+}
+
+func unmapDiskFromChroot(vmname string, disk *kvmrun.Disk) error {
+	chrootPath := filepath.Join(kvmrun.CHROOTDIR, vmname, disk.Path)
+
+	if _, ok := disk.Backend.(*file.Device); ok {
+		return file.Unbind(chrootPath)
+	}
+
+	return os.Remove(chrootPath)
 }
@@ -0,0 +1,755 @@
+package machine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/0xef53/kvmrun/internal/qemu"
+	qemu_types "github.com/0xef53/kvmrun/internal/qemu/types"
+	"github.com/0xef53/kvmrun/kvmrun"
+	"github.com/0xef53/kvmrun/server"
+
+	qmp "github.com/0xef53/go-qmp/v2"
+	"github.com/0xef53/go-task"
+)
+
+const (
+	DefaultBackupExportTimeout = 6 * time.Hour
+
+	// The name of the disabled dirty bitmap that is exported via NBD.
+	// It contains the changes since the previous backup.
+	backupExportBitmap = "backup-export"
+)
+
+type BackupExportOptions struct {
+	Disks       []string      `json:"disks"`
+	ListenAddr  string        `json:"listen_addr"`
+	TLS         bool          `json:"tls"`
+	Incremental bool          `json:"incremental"`
+	FSFreeze    bool          `json:"fs_freeze"`
+	Timeout     time.Duration `json:"timeout"`
+}
+
+func (o *BackupExportOptions) Validate(_ bool) error {
+	if len(o.Disks) == 0 {
+		return fmt.Errorf("empty list of disks")
+	}
+
+	disks := make(map[string]struct{})
+
+	for idx := range o.Disks {
+		o.Disks[idx] = strings.TrimSpace(o.Disks[idx])
+
+		if len(o.Disks[idx]) == 0 {
+			return fmt.Errorf("empty diskname")
+		}
+
+		if _, ok := disks[o.Disks[idx]]; ok {
+			return fmt.Errorf("duplicate disk: %s", o.Disks[idx])
+		}
+
+		disks[o.Disks[idx]] = struct{}{}
+	}
+
+	o.ListenAddr = strings.TrimSpace(o.ListenAddr)
+
+	if len(o.ListenAddr) == 0 {
+		o.ListenAddr = "127.0.0.1"
+	}
+
+	if net.ParseIP(o.ListenAddr) == nil {
+		return fmt.Errorf("cannot parse listen addr: %s", o.ListenAddr)
+	}
+
+	if o.Timeout <= 0 {
+		o.Timeout = DefaultBackupExportTimeout
+	}
+
+	return nil
+}
+
+type BackupExport struct {
+	DiskName string `json:"disk_name"`
+	URI      string `json:"uri"`
+
+	// The name of the exported dirty bitmap. Clients can get it
+	// using the "qemu:dirty-bitmap:<name>" NBD metadata context.
+	// Empty if there is no previous backup, i.e. the full image
+	// should be read.
+	Bitmap string `json:"bitmap"`
+}
+
+type BackupExportRequisites struct {
+	TaskID  string          `json:"task_id"`
+	Exports []*BackupExport `json:"exports"`
+
+	// Guest filesystems were frozen at the moment the exports were created
+	FSConsistent bool `json:"fs_consistent"`
+}
+
+// Running backup export tasks by machine name.
+// Used to pass the Finish signal to the task.
+var backupExports = struct {
+	sync.Mutex
+	m map[string]*BackupExportTask
+}{m: make(map[string]*BackupExportTask)}
+
+// StartBackupExport creates a point-in-time view of the disks using
+// image fleecing and exports it with the QEMU built-in NBD server.
+// The exports remain available until FinishBackupExport is called
+// or the timeout expires.
+func (s *Server) StartBackupExport(ctx context.Context, vmname string, opts *BackupExportOptions) (*BackupExportRequisites, error) {
+	if opts == nil {
+		return nil, fmt.Errorf("empty backup export opts")
+	}
+
+	for idx, diskname := range opts.Disks {
+		if d, err := kvmrun.NewDisk(diskname); err == nil {
+			opts.Disks[idx] = d.Backend.BaseName()
+		}
+	}
+
+	t := NewBackupExportTask(vmname, opts)
+
+	t.Server = s
+
+	taskOpts := []task.TaskOption{
+		server.WithUniqueLabel(vmname + "/backup-export"),
+		server.WithGroupLabel(vmname),
+		server.WithGroupLabel(vmname + "/long-running"),
+	}
+
+	requisites := BackupExportRequisites{}
+
+	tid, err := s.TaskStart(ctx, t, &requisites, taskOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("cannot start backup export: %w", err)
+	}
+
+	requisites.TaskID = tid
+
+	return &requisites, nil
+}
+
+// FinishBackupExport stops the NBD exports and removes all temporary objects.
+// If success is false, the exported changes are merged back into
+// the dirty bitmap, so they will be included in the next backup.
+func (s *Server) FinishBackupExport(ctx context.Context, vmname string, success bool) error {
+	backupExports.Lock()
+	t, ok := backupExports.m[vmname]
+	backupExports.Unlock()
+
+	if !ok {
+		return fmt.Errorf("%w: backup export for %s", kvmrun.ErrNotFound, vmname)
+	}
+
+	t.finish(success)
+
+	select {
+	case <-t.done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	return nil
+}
+
+type BackupExportTask struct {
+	*task.GenericTask
+	*Server
+
+	targets map[string]task.OperationMode
+
+	// Arguments
+	vmname string
+	opts   *BackupExportOptions
+
+	// Do not set manually next fields !
+	vm *kvmrun.Machine
+
+	disks []*backupExportDisk
+
+	nbdStarted bool
+
+	finished   chan bool
+	finishOnce sync.Once
+	done       chan struct{}
+}
+
+type backupExportDisk struct {
+	disk *kvmrun.Disk
+	node string
+	size uint64
+
+	// Dirty bitmap operation performed within the transaction:
+	// "block-dirty-bitmap-merge" (the export bitmap was created),
+	// "block-dirty-bitmap-add" (the backup bitmap was created) or empty
+	bitmapAction string
+
+	created    bool
+	nodeAdded  bool
+	jobStarted bool
+}
+
+func (d *backupExportDisk) jobID() string {
+	return "fleece_" + d.disk.BaseName()
+}
+
+func (d *backupExportDisk) fleeceNode() string {
+	return "fleece_" + d.disk.BaseName()
+}
+
+// fleeceImage returns the path of the temporary overlay
+// relative to the machine chroot directory
+func (d *backupExportDisk) fleeceImage() string {
+	return filepath.Join("/.fleece", d.disk.BaseName()+".qcow2")
+}
+
+func NewBackupExportTask(vmname string, opts *BackupExportOptions) *BackupExportTask {
+	return &BackupExportTask{
+		GenericTask: new(task.GenericTask),
+
+		targets:  server.BlockBackupOperations(vmname, opts.Disks...),
+		vmname:   vmname,
+		opts:     opts,
+		finished: make(chan bool, 1),
+		done:     make(chan struct{}),
+	}
+}
+
+func (t *BackupExportTask) Targets() map[string]task.OperationMode { return t.targets }
+
+func (t *BackupExportTask) BeforeStart(resp interface{}) (err error) {
+	requisites, ok := resp.(*BackupExportRequisites)
+	if !ok {
+		return fmt.Errorf("unexpected error: invalid type of resp interface")
+	}
+
+	if t.opts == nil {
+		return fmt.Errorf("empty backup export opts")
+	} else {
+		if err := t.opts.Validate(true); err != nil {
+			return err
+		}
+	}
+
+	if vm, err := t.Server.MachineGet(t.vmname, true); err == nil {
+		t.vm = vm
+	} else {
+		return err
+	}
+
+	vmstate, err := t.MachineGetStatus(t.vm)
+	if err != nil {
+		return err
+	}
+
+	switch vmstate {
+	case kvmrun.StatePaused, kvmrun.StateRunning:
+		if t.vm.R == nil {
+			return fmt.Errorf("unexpected error: QEMU instance not found")
+		}
+	default:
+		return fmt.Errorf("incompatible machine state: %d", vmstate)
+	}
+
+	if err := t.initDisks(); err != nil {
+		return err
+	}
+
+	// Cleanup
+	defer func() {
+		if err != nil {
+			t.cleanup(false)
+		}
+	}()
+
+	if err := t.addFleeceNodes(); err != nil {
+		return err
+	}
+
+	fsConsistent, err := t.startFleecing()
+	if err != nil {
+		return err
+	}
+
+	exports, err := t.startNBDServer()
+	if err != nil {
+		return err
+	}
+
+	requisites.Exports = exports
+	requisites.FSConsistent = fsConsistent
+
+	backupExports.Lock()
+	backupExports.m[t.vmname] = t
+	backupExports.Unlock()
+
+	return nil
+}
+
+func (t *BackupExportTask) Main() error {
+	defer func() {
+		backupExports.Lock()
+		delete(backupExports.m, t.vmname)
+		backupExports.Unlock()
+
+		close(t.done)
+	}()
+
+	timer := time.NewTimer(t.opts.Timeout)
+	defer timer.Stop()
+
+	var success bool
+	var err error
+
+	select {
+	case success = <-t.finished:
+		if !success {
+			err = fmt.Errorf("backup was finished as unsuccessful")
+		}
+	case <-timer.C:
+		err = fmt.Errorf("timeout exceeded (%s)", t.opts.Timeout)
+	case <-t.Ctx().Done():
+		err = t.Ctx().Err()
+	}
+
+	t.cleanup(success)
+
+	return err
+}
+
+func (t *BackupExportTask) finish(success bool) {
+	t.finishOnce.Do(func() {
+		t.finished <- success
+	})
+}
+
+func (t *BackupExportTask) initDisks() error {
+	blocks := make([]*qemu_types.BlockInfo, 0, len(t.opts.Disks))
+
+	if err := t.Mon.Run(t.vmname, qmp.Command{Name: "query-block", Arguments: nil}, &blocks); err != nil {
+		return err
+	}
+
+	nodes := make(map[string]string)
+
+	for _, b := range blocks {
+		nodes[b.Device] = b.Inserted.NodeName
+	}
+
+	disks := make([]*backupExportDisk, 0, len(t.opts.Disks))
+
+	for _, diskname := range t.opts.Disks {
+		d := t.vm.R.DiskGet(diskname)
+		if d == nil {
+			return &kvmrun.NotConnectedError{Source: "instance_qemu", Object: diskname}
+		}
+
+		if t.opts.Incremental {
+			if err := checkIncrementalBackup(t.vmname, d); err != nil {
+				return fmt.Errorf("%s: %w (remove the dirty bitmap to start a new chain with a full backup)", diskname, err)
+			}
+		}
+
+		size := d.QemuVirtualSize
+
+		if size == 0 {
+			s, err := d.Size()
+			if err != nil {
+				return err
+			}
+
+			size = s
+		}
+
+		node, ok := nodes[d.BaseName()]
+		if !ok || len(node) == 0 {
+			node = d.BaseName()
+		}
+
+		disks = append(disks, &backupExportDisk{disk: d, node: node, size: size})
+	}
+
+	t.disks = disks
+
+	return nil
+}
+
+// addFleeceNodes creates temporary qcow2 overlays on top of the source disks.
+// The old data of the blocks overwritten by the guest are copied there
+// by the backup job, so the overlays always show the point-in-time view.
+func (t *BackupExportTask) addFleeceNodes() error {
+	fleeceDir := filepath.Join(kvmrun.CHROOTDIR, t.vmname, ".fleece")
+
+	if err := os.MkdirAll(fleeceDir, 0755); err != nil {
+		return err
+	}
+
+	for _, d := range t.disks {
+		fname := filepath.Join(kvmrun.CHROOTDIR, t.vmname, d.fleeceImage())
+
+		t.Logger.Debugf("addFleeceNodes(): creating a temporary overlay: %s (size = %d)", fname, d.size)
+
+		if err := qemu.CreateImage(fname, "qcow2", d.size); err != nil {
+			return err
+		}
+
+		d.created = true
+
+		if err := os.Chown(fname, t.vm.C.UID(), 0); err != nil {
+			return err
+		}
+
+		opts := struct {
+			Driver   string            `json:"driver"`
+			NodeName string            `json:"node-name"`
+			File     map[string]string `json:"file"`
+			Backing  string            `json:"backing"`
+		}{
+			Driver:   "qcow2",
+			NodeName: d.fleeceNode(),
+			File: map[string]string{
+				"driver":   "file",
+				"filename": d.fleeceImage(),
+			},
+			Backing: d.node,
+		}
+
+		if err := t.Mon.Run(t.vmname, qmp.Command{Name: "blockdev-add", Arguments: &opts}, nil); err != nil {
+			return fmt.Errorf("failed to add fleece node (%s): %w", d.disk.BaseName(), err)
+		}
+
+		d.nodeAdded = true
+	}
+
+	return nil
+}
+
+// startFleecing runs the bitmap operations and starts the backup jobs
+// (sync=none) for all disks in a single transaction. So all exports
+// have the same point in time.
+func (t *BackupExportTask) startFleecing() (bool, error) {
+	// The "block-dirty-bitmap-merge" action is not allowed
+	// by qmp.RunTransaction, so the raw command is used here
+	actions := make([]qmp.TransactionAction, 0, len(t.disks)*4)
+
+	for _, d := range t.disks {
+		if t.opts.Incremental {
+			if d.disk.HasBitmap {
+				t.Logger.Infof("%s: mode: incremental backup", d.disk.BaseName())
+
+				d.bitmapAction = "block-dirty-bitmap-merge"
+
+				actions = append(actions,
+					qmp.TransactionAction{
+						Type: "block-dirty-bitmap-add",
+						Data: &qemu_types.BlockDirtyBitmapAddOptions{
+							Node:     d.disk.BaseName(),
+							Name:     backupExportBitmap,
+							Disabled: true,
+						},
+					},
+					qmp.TransactionAction{
+						Type: "block-dirty-bitmap-merge",
+						Data: &qemu_types.BlockDirtyBitmapMergeOptions{
+							Node:    d.disk.BaseName(),
+							Target:  backupExportBitmap,
+							Bitmaps: []string{"backup"},
+						},
+					},
+					qmp.TransactionAction{
+						Type: "block-dirty-bitmap-clear",
+						Data: &qemu_types.BlockDirtyBitmapOptions{
+							Node: d.disk.BaseName(),
+							Name: "backup",
+						},
+					},
+				)
+			} else {
+				t.Logger.Infof("%s: mode: full backup", d.disk.BaseName())
+
+				if err := t.Server.removeInconsistentBitmap(t.vmname, d.disk, t.Logger); err != nil {
+					return false, err
+				}
+
+				d.bitmapAction = "block-dirty-bitmap-add"
+
+				actions = append(actions, qmp.TransactionAction{
+					Type: "block-dirty-bitmap-add",
+					Data: backupBitmapAddArgs(d.disk),
+				})
+			}
+		} else {
+			t.Logger.Infof("%s: mode: full disk export", d.disk.BaseName())
+		}
+
+		actions = append(actions, qmp.TransactionAction{
+			Type: "blockdev-backup",
+			Data: &qemu_types.BlockdevBackupOptions{
+				JobID:  d.jobID(),
+				Device: d.node,
+				Target: d.fleeceNode(),
+				Sync:   "none",
+			},
+		})
+	}
+
+	var fsFrozen bool
+
+	if t.opts.FSFreeze {
+		var thawGuestFS func()
+
+		thawGuestFS, fsFrozen = t.Server.freezeGuestFSForBackup(t.vmname, t.Logger)
+
+		defer thawGuestFS()
+	}
+
+	args := struct {
+		Actions []qmp.TransactionAction `json:"actions"`
+	}{
+		Actions: actions,
+	}
+
+	if err := t.Mon.Run(t.vmname, qmp.Command{Name: "transaction", Arguments: &args}, nil); err != nil {
+		// Nothing was changed
+		for _, d := range t.disks {
+			d.bitmapAction = ""
+		}
+
+		return false, err
+	}
+
+	for _, d := range t.disks {
+		d.jobStarted = true
+	}
+
+	return fsFrozen, nil
+}
+
+func (t *BackupExportTask) startNBDServer() ([]*BackupExport, error) {
+	port := kvmrun.FIRST_NBD_PORT + t.vm.C.UID()
+
+	opts := struct {
+		Addr     qemu_types.InetSocketAddressLegacy `json:"addr"`
+		TLSCreds string                             `json:"tls-creds,omitempty"`
+	}{
+		Addr: qemu_types.InetSocketAddressLegacy{
+			Type: "inet",
+			Data: qemu_types.InetSocketAddressBase{
+				Host: t.opts.ListenAddr,
+				Port: strconv.Itoa(port),
+			},
+		},
+	}
+
+	scheme := "nbd"
+
+	if t.opts.TLS {
+		tlsCredsID, err := t.MachineTLSCredsAdd(t.vmname, t.vm.C.UID(), kvmrun.TLSEndpointServer)
+		if err != nil {
+			return nil, err
+		}
+
+		opts.TLSCreds = tlsCredsID
+
+		scheme = "nbds"
+	}
+
+	if err := t.Mon.Run(t.vmname, qmp.Command{Name: "nbd-server-start", Arguments: &opts}, nil); err != nil {
+		return nil, err
+	}
+
+	t.nbdStarted = true
+
+	host := t.opts.ListenAddr
+
+	if ip := net.ParseIP(host); ip != nil && ip.IsUnspecified() {
+		if h, err := os.Hostname(); err == nil {
+			host = h
+		}
+	}
+
+	exports := make([]*BackupExport, 0, len(t.disks))
+
+	for _, d := range t.disks {
+		opts := struct {
+			Device   string `json:"device"`
+			Name     string `json:"name"`
+			Writable bool   `json:"writable"`
+			Bitmap   string `json:"bitmap,omitempty"`
+		}{
+			Device: d.fleeceNode(),
+			Name:   d.disk.BaseName(),
+		}
+
+		if d.bitmapAction == "block-dirty-bitmap-merge" {
+			opts.Bitmap = backupExportBitmap
+		}
+
+		if err := t.Mon.Run(t.vmname, qmp.Command{Name: "nbd-server-add", Arguments: &opts}, nil); err != nil {
+			return nil, fmt.Errorf("failed to export (%s): %w", d.disk.BaseName(), err)
+		}
+
+		exports = append(exports, &BackupExport{
+			DiskName: d.disk.BaseName(),
+			URI:      fmt.Sprintf("%s://%s/%s", scheme, net.JoinHostPort(host, strconv.Itoa(port)), d.disk.BaseName()),
+			Bitmap:   opts.Bitmap,
+		})
+	}
+
+	return exports, nil
+}
+
+// cleanup removes all objects created by the task. All errors are non-fatal.
+func (t *BackupExportTask) cleanup(success bool) {
+	if t.nbdStarted {
+		if err := t.Mon.Run(t.vmname, qmp.Command{Name: "nbd-server-stop", Arguments: nil}, nil); err != nil {
+			t.Logger.Errorf("cleanup: nbd-server-stop failed: %s", err)
+		}
+
+		t.nbdStarted = false
+	}
+
+	for _, d := range t.disks {
+		if d.jobStarted {
+			if err := t.cancelJob(d); err != nil {
+				t.Logger.Errorf("cleanup: %s: %s", d.disk.BaseName(), err)
+			}
+
+			d.jobStarted = false
+		}
+
+		if d.nodeAdded {
+			if err := t.Mon.Run(t.vmname, qmp.Command{Name: "blockdev-del", Arguments: &struct {
+				NodeName string `json:"node-name"`
+			}{d.fleeceNode()}}, nil); err != nil {
+				t.Logger.Errorf("cleanup: %s: blockdev-del failed: %s", d.disk.BaseName(), err)
+			}
+
+			d.nodeAdded = false
+		}
+
+		if d.created {
+			if err := os.Remove(filepath.Join(kvmrun.CHROOTDIR, t.vmname, d.fleeceImage())); err != nil && !os.IsNotExist(err) {
+				t.Logger.Errorf("cleanup: %s: %s", d.disk.BaseName(), err)
+			}
+
+			d.created = false
+		}
+
+		if err := t.finalizeBitmaps(d, success); err != nil {
+			t.Logger.Errorf("cleanup: %s: %s", d.disk.BaseName(), err)
+		}
+
+		d.bitmapAction = ""
+	}
+}
+
+func (t *BackupExportTask) cancelJob(d *backupExportDisk) error {
+	opts := struct {
+		Device string `json:"device"`
+		Force  bool   `json:"force,omitempty"`
+	}{
+		Device: d.jobID(),
+		Force:  true,
+	}
+
+	if err := t.Mon.Run(t.vmname, qmp.Command{Name: "block-job-cancel", Arguments: &opts}, nil); err != nil {
+		return fmt.Errorf("block-job-cancel failed: %w", err)
+	}
+
+	// The fleece node can only be removed after the job has gone
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	ticker := time.NewTicker(200 * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		jobs := make([]*qemu_types.BlockJobInfo, 0, len(t.disks))
+
+		if err := t.Mon.Run(t.vmname, qmp.Command{Name: "query-block-jobs", Arguments: nil}, &jobs); err != nil {
+			return err
+		}
+
+		var found bool
+
+		for _, j := range jobs {
+			if j.Device == d.jobID() {
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.New("timed out waiting for the job to be cancelled")
+		case <-ticker.C:
+		}
+	}
+}
+
+// finalizeBitmaps removes the export bitmap. If the backup failed,
+// the changes are returned to the main bitmap in order to be
+// included in the next backup.
+func (t *BackupExportTask) finalizeBitmaps(d *backupExportDisk, success bool) error {
+	switch d.bitmapAction {
+	case "block-dirty-bitmap-merge":
+		if !success {
+			mergeArgs := qemu_types.BlockDirtyBitmapMergeOptions{
+				Node:    d.disk.BaseName(),
+				Target:  "backup",
+				Bitmaps: []string{backupExportBitmap},
+			}
+
+			if err := t.Mon.Run(t.vmname, qmp.Command{Name: "block-dirty-bitmap-merge", Arguments: &mergeArgs}, nil); err != nil {
+				return fmt.Errorf("block-dirty-bitmap-merge failed: %w", err)
+			}
+		}
+
+		bitmapArgs := qemu_types.BlockDirtyBitmapOptions{
+			Node: d.disk.BaseName(),
+			Name: backupExportBitmap,
+		}
+
+		if err := t.Mon.Run(t.vmname, qmp.Command{Name: "block-dirty-bitmap-remove", Arguments: &bitmapArgs}, nil); err != nil {
+			return fmt.Errorf("block-dirty-bitmap-remove failed: %w", err)
+		}
+	case "block-dirty-bitmap-add":
+		if !success {
+			// Without a complete full backup the bitmap is useless
+			bitmapArgs := qemu_types.BlockDirtyBitmapOptions{
+				Node: d.disk.BaseName(),
+				Name: "backup",
+			}
+
+			if err := t.Mon.Run(t.vmname, qmp.Command{Name: "block-dirty-bitmap-remove", Arguments: &bitmapArgs}, nil); err != nil {
+				return fmt.Errorf("block-dirty-bitmap-remove failed: %w", err)
+			}
+		}
+	}
+
+	// The images are created by an external tool,
+	// so only the bitmap state is known here
+	if success && len(d.bitmapAction) > 0 {
+		if err := newBackupState(d.disk).Save(t.vmname, d.disk.BaseName()); err != nil {
+			return fmt.Errorf("failed to save backup state: %w", err)
+		}
+	}
+
+	return nil
+}
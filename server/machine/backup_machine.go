@@ -0,0 +1,646 @@
+package machine
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	qemu_types "github.com/0xef53/kvmrun/internal/qemu/types"
+	"github.com/0xef53/kvmrun/kvmrun"
+	"github.com/0xef53/kvmrun/server"
+
+	qmp "github.com/0xef53/go-qmp/v2"
+	"github.com/0xef53/go-task"
+)
+
+type MachineBackupTarget struct {
+	DiskName string `json:"disk_name"`
+	Target   string `json:"target"`
+}
+
+type MachineBackupOptions struct {
+	Targets     []*MachineBackupTarget `json:"targets"`
+	Incremental bool                   `json:"incremental"`
+	ClearBitmap bool                   `json:"clear_bitmap"`
+	FSFreeze    bool                   `json:"fs_freeze"`
+
+	// Speed limit of each backup job in bytes per second.
+	// Zero means unlimited
+	Speed uint64 `json:"speed"`
+}
+
+func (o *MachineBackupOptions) Validate(_ bool) error {
+	if len(o.Targets) == 0 {
+		return fmt.Errorf("empty list of disks")
+	}
+
+	disks := make(map[string]struct{})
+	targets := make(map[string]struct{})
+
+	for _, t := range o.Targets {
+		if t == nil {
+			return fmt.Errorf("empty backup target")
+		}
+
+		t.DiskName = strings.TrimSpace(t.DiskName)
+
+		if len(t.DiskName) == 0 {
+			return fmt.Errorf("empty diskname")
+		}
+
+		t.Target = strings.TrimSpace(t.Target)
+
+		if len(t.Target) == 0 {
+			return fmt.Errorf("empty target string: %s", t.DiskName)
+		}
+
+		if _, ok := disks[t.DiskName]; ok {
+			return fmt.Errorf("duplicate disk: %s", t.DiskName)
+		}
+
+		if _, ok := targets[t.Target]; ok {
+			return fmt.Errorf("duplicate target: %s", t.Target)
+		}
+
+		disks[t.DiskName] = struct{}{}
+		targets[t.Target] = struct{}{}
+	}
+
+	return nil
+}
+
+func (s *Server) StartMachineBackupProcess(ctx context.Context, vmname string, opts *MachineBackupOptions) (string, error) {
+	if opts == nil {
+		return "", fmt.Errorf("empty machine backup opts")
+	}
+
+	for _, t := range opts.Targets {
+		if t == nil {
+			continue
+		}
+
+		if d, err := kvmrun.NewDisk(t.DiskName); err == nil {
+			t.DiskName = d.Backend.BaseName()
+		}
+	}
+
+	t := NewMachineBackupTask(vmname, opts)
+
+	t.Server = s
+
+	taskOpts := []task.TaskOption{
+		server.WithUniqueLabel(vmname + "/machine-backup"),
+		server.WithGroupLabel(vmname),
+		server.WithGroupLabel(vmname + "/long-running"),
+	}
+
+	tid, err := s.TaskStart(ctx, t, nil, taskOpts...)
+	if err != nil {
+		return "", fmt.Errorf("cannot start machine backup: %w", err)
+	}
+
+	return tid, nil
+}
+
+type MachineBackupStatDetails struct {
+	Disks map[string]*DataTransferStat
+
+	// Guest filesystems were frozen at the moment the backup jobs started
+	FSConsistent bool
+}
+
+type MachineBackupTask struct {
+	*task.GenericTask
+	*Server
+
+	targets map[string]task.OperationMode
+
+	// Arguments
+	vmname string
+	opts   *MachineBackupOptions
+
+	// Do not set manually next fields !
+	vm *kvmrun.Machine
+
+	disks []*machineBackupDisk
+
+	details  *MachineBackupStatDetails
+	statfile string
+
+	mu sync.Mutex
+}
+
+type machineBackupDisk struct {
+	*backupDisk
+
+	// Dirty bitmap operation performed within the transaction:
+	// "block-dirty-bitmap-add", "block-dirty-bitmap-clear" or empty
+	bitmapAction string
+
+	// The sync mode of the backup job
+	sync string
+
+	created   bool
+	mapped    bool
+	completed bool
+
+	// Job offset at the previous check, used to calculate the speed
+	prevOffset uint64
+}
+
+func (d *machineBackupDisk) jobID() string {
+	return "copy_" + d.src.BaseName()
+}
+
+func NewMachineBackupTask(vmname string, opts *MachineBackupOptions) *MachineBackupTask {
+	disknames := make([]string, 0, len(opts.Targets))
+
+	for _, t := range opts.Targets {
+		if t != nil {
+			disknames = append(disknames, t.DiskName)
+		}
+	}
+
+	return &MachineBackupTask{
+		GenericTask: new(task.GenericTask),
+
+		targets: server.BlockBackupOperations(vmname, disknames...),
+		vmname:  vmname,
+		opts:    opts,
+	}
+}
+
+func (t *MachineBackupTask) Targets() map[string]task.OperationMode { return t.targets }
+
+func (t *MachineBackupTask) BeforeStart(_ interface{}) error {
+	if t.opts == nil {
+		return fmt.Errorf("empty machine backup opts")
+	} else {
+		if err := t.opts.Validate(true); err != nil {
+			return err
+		}
+	}
+
+	if vm, err := t.Server.MachineGet(t.vmname, true); err == nil {
+		t.vm = vm
+	} else {
+		return err
+	}
+
+	vmstate, err := t.MachineGetStatus(t.vm)
+	if err != nil {
+		return err
+	}
+
+	switch vmstate {
+	case kvmrun.StatePaused, kvmrun.StateRunning:
+		if t.vm.R == nil {
+			return fmt.Errorf("unexpected error: QEMU instance not found")
+		}
+	default:
+		return fmt.Errorf("incompatible machine state: %d", vmstate)
+	}
+
+	disks := make([]*machineBackupDisk, 0, len(t.opts.Targets))
+
+	for _, tgt := range t.opts.Targets {
+		pair, err := newBackupDisk(t.vm, tgt.DiskName, tgt.Target)
+		if err != nil {
+			return fmt.Errorf("%s: %w", tgt.DiskName, err)
+		}
+
+		// The same device cannot be used as a target twice
+		for _, d := range disks {
+			if d.dst.Path == pair.dst.Path {
+				return fmt.Errorf("duplicate target: %s", pair.dst.Path)
+			}
+		}
+
+		if t.opts.Incremental && !t.opts.ClearBitmap {
+			if err := checkIncrementalBackup(t.vmname, pair.src); err != nil {
+				return fmt.Errorf("%s: %w (use the clear-bitmap option to start a new chain with a full backup)", tgt.DiskName, err)
+			}
+		}
+
+		disks = append(disks, &machineBackupDisk{backupDisk: pair})
+	}
+
+	t.disks = disks
+
+	// Init stat fields
+	t.details = &MachineBackupStatDetails{
+		Disks: make(map[string]*DataTransferStat),
+	}
+
+	for _, d := range t.disks {
+		t.details.Disks[d.src.BaseName()] = &DataTransferStat{Total: d.size, Remaining: d.size}
+	}
+
+	hashname := fmt.Sprintf("%x", md5.Sum([]byte(t.vmname+"/machine-backup")))
+
+	t.statfile = filepath.Join(kvmrun.CHROOTDIR, t.vmname, ".tasks", hashname)
+
+	if err := os.Remove(t.statfile); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (t *MachineBackupTask) Stat() *task.TaskStat {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	st := t.GenericTask.Stat()
+
+	if t.details != nil {
+		details := MachineBackupStatDetails{
+			Disks:        make(map[string]*DataTransferStat, len(t.details.Disks)),
+			FSConsistent: t.details.FSConsistent,
+		}
+
+		for n, v := range t.details.Disks {
+			x := *v
+			details.Disks[n] = &x
+		}
+
+		st.Details = &details
+	}
+
+	return st
+}
+
+func (t *MachineBackupTask) updateStat(diskname string, total, remain, sent uint64, speed int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if total == 0 {
+		return
+	}
+
+	t.details.Disks[diskname] = &DataTransferStat{
+		Total:       total,
+		Remaining:   remain,
+		Transferred: sent,
+		Progress:    int(sent * 100 / total),
+		Speed:       speed,
+	}
+
+	// The overall progress is calculated
+	// based on the total amount of data
+	var sumTotal, sumSent uint64
+
+	for _, v := range t.details.Disks {
+		sumTotal += v.Total
+		sumSent += v.Transferred
+	}
+
+	if sumTotal > 0 {
+		t.SetProgress(int(sumSent * 100 / sumTotal))
+	}
+}
+
+func (t *MachineBackupTask) writeStatFile(taskErr error) error {
+	st := t.Stat()
+
+	if taskErr == nil {
+		st.State = task.StateCompleted
+	} else {
+		st.State = task.StateFailed
+		st.StateDesc = taskErr.Error()
+	}
+
+	statFileData := server.TaskStatFile{
+		Label: t.vmname + "/machine-backup",
+		Stat:  st,
+	}
+
+	b, err := json.MarshalIndent(statFileData, "", "    ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(t.statfile, b, 0644)
+}
+
+func (t *MachineBackupTask) Main() (err error) {
+	defer func() {
+		if _err := t.writeStatFile(err); _err != nil {
+			t.Logger.Errorf("Failed to save task state to a special file: %s", _err)
+		}
+	}()
+
+	return t.copy()
+}
+
+func (t *MachineBackupTask) OnFailure(taskErr error) {
+	for _, d := range t.disks {
+		if !d.completed {
+			opts := struct {
+				Device string `json:"device"`
+				Force  bool   `json:"force,omitempty"`
+			}{
+				Device: d.jobID(),
+				Force:  true,
+			}
+
+			if err := t.Mon.Run(t.vmname, qmp.Command{Name: "block-job-cancel", Arguments: &opts}, nil); err != nil {
+				// non-fatal error. Just printing
+				t.Logger.Debugf("OnFailureHook: forced block-job-cancel failed: %s: %s", d.src.BaseName(), err)
+			}
+		}
+
+		// A just added or cleared bitmap does not match any backup copy.
+		// So it should be removed to force a full backup next time.
+		if len(d.bitmapAction) > 0 {
+			bitmapArgs := qemu_types.BlockDirtyBitmapOptions{
+				Node: d.src.BaseName(),
+				Name: "backup",
+			}
+
+			if err := t.Mon.Run(t.vmname, qmp.Command{Name: "block-dirty-bitmap-remove", Arguments: &bitmapArgs}, nil); err != nil {
+				// non-fatal error. Just printing
+				t.Logger.Errorf("OnFailureHook: block-dirty-bitmap-remove failed: %s: %s", d.src.BaseName(), err)
+			}
+		}
+
+		if d.created {
+			if err := os.Remove(d.dst.Path); err != nil {
+				// non-fatal error. Just printing
+				t.Logger.Errorf("OnFailureHook: cannot remove target file: %s", err)
+			}
+		}
+	}
+}
+
+func (t *MachineBackupTask) copy() error {
+	t.Logger.Debug("copy(): main process started")
+
+	for _, d := range t.disks {
+		if d.createTarget {
+			t.Logger.Infof("Creating a new %s image file: %s (size = %d)", d.format, d.dst.Path, d.size)
+
+			if err := createBackupImage(d.dst.Path, d.format, d.size); err != nil {
+				return err
+			}
+
+			d.created = true
+		}
+	}
+
+	defer func() {
+		for _, d := range t.disks {
+			if d.mapped {
+				if err := unmapDiskFromChroot(t.vmname, d.dst); err != nil {
+					t.Logger.Errorf("Failed to unmap a target device: %s", err)
+				}
+			}
+		}
+	}()
+
+	for _, d := range t.disks {
+		if d.dst.IsLocal() {
+			if err := mapDiskToChroot(t.vmname, d.dst, t.vm.C.UID()); err != nil {
+				return err
+			}
+
+			d.mapped = true
+		}
+	}
+
+	commands := make([]qmp.Command, 0, 2*len(t.disks))
+
+	for _, d := range t.disks {
+		target, format, err := t.Server.backupTargetArgs(t.vm, d.dst)
+		if err != nil {
+			return err
+		}
+
+		backupArgs := qemu_types.DriveBackupOptions{
+			JobID:  d.jobID(),
+			Device: d.src.BaseName(),
+			Target: target,
+			Format: format,
+			Sync:   "full",
+			Mode:   "existing",
+			Speed:  t.opts.Speed,
+		}
+
+		var bitmapArgs interface{}
+
+		switch {
+		case !t.opts.Incremental:
+			t.Logger.Infof("Mode: full disk copying (%s)", d.src.BaseName())
+		case d.src.HasBitmap && t.opts.ClearBitmap:
+			t.Logger.Infof("Mode: full backup with bitmap reset (%s)", d.src.BaseName())
+
+			d.bitmapAction = "block-dirty-bitmap-clear"
+
+			bitmapArgs = &qemu_types.BlockDirtyBitmapOptions{
+				Node: d.src.BaseName(),
+				Name: "backup",
+			}
+		case d.src.HasBitmap:
+			t.Logger.Infof("Mode: incremental backup (%s)", d.src.BaseName())
+
+			backupArgs.Sync = "incremental"
+			backupArgs.Bitmap = "backup"
+		default:
+			t.Logger.Infof("Mode: full backup (%s)", d.src.BaseName())
+
+			if err := t.Server.removeInconsistentBitmap(t.vmname, d.src, t.Logger); err != nil {
+				return err
+			}
+
+			d.bitmapAction = "block-dirty-bitmap-add"
+
+			bitmapArgs = backupBitmapAddArgs(d.src)
+		}
+
+		if len(d.bitmapAction) > 0 {
+			commands = append(commands, qmp.Command{Name: d.bitmapAction, Arguments: bitmapArgs})
+		}
+
+		d.sync = backupArgs.Sync
+
+		commands = append(commands, qmp.Command{Name: "drive-backup", Arguments: &backupArgs})
+
+		t.Logger.Debugf("copy(): src=%s, dst=%s, sync=%s", d.src.BaseName(), d.dst.Path, backupArgs.Sync)
+	}
+
+	var thawGuestFS func()
+	var fsFrozen bool
+
+	if t.opts.FSFreeze {
+		thawGuestFS, fsFrozen = t.Server.freezeGuestFSForBackup(t.vmname, t.Logger)
+
+		defer thawGuestFS()
+	}
+
+	ts := time.Now()
+
+	// All backup jobs start at the same point in time
+	// and complete or fail together
+	t.Logger.Debugf("copy(): run QMP transaction with %d commands", len(commands))
+
+	if err := t.Server.Mon.RunGroupedTransaction(t.vmname, commands, nil); err != nil {
+		// Transaction is atomic, so nothing has been changed
+		for _, d := range t.disks {
+			d.bitmapAction = ""
+			d.completed = true
+		}
+
+		return err
+	}
+
+	// The point in time of the backup is fixed when the jobs are created,
+	// so the guest filesystems can be thawed right now
+	if t.opts.FSFreeze {
+		thawGuestFS()
+
+		t.mu.Lock()
+		t.details.FSConsistent = fsFrozen
+		t.mu.Unlock()
+	}
+
+	// Stat will be available after the job status changes to running
+	for _, d := range t.disks {
+		timeoutCtx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+
+		_, err := t.Server.Mon.WaitJobStatusChangeEvent(t.vmname, timeoutCtx, d.jobID(), "running", uint64(ts.Unix()))
+
+		cancel()
+
+		if err != nil {
+			return fmt.Errorf("%s: %w", d.src.BaseName(), err)
+		}
+	}
+
+	// Since that moment the jobs can be paused, resumed or throttled
+	// using the task key
+	for _, d := range t.disks {
+		t.Server.TaskBlockJobAdd(t.vmname+"/machine-backup", t, t.vmname, d.jobID())
+	}
+
+	defer t.Server.TaskBlockJobsRemove(t.vmname + "/machine-backup")
+
+	errJobNotFound := errors.New("job not found")
+
+	getJobs := func() (map[string]*qemu_types.BlockJobInfo, error) {
+		jobs := make([]*qemu_types.BlockJobInfo, 0, len(t.disks))
+
+		if err := t.Server.Mon.Run(t.vmname, qmp.Command{Name: "query-block-jobs", Arguments: nil}, &jobs); err != nil {
+			return nil, err
+		}
+
+		m := make(map[string]*qemu_types.BlockJobInfo, len(jobs))
+
+		for _, j := range jobs {
+			m[j.Device] = j
+		}
+
+		return m, nil
+	}
+
+	checkEvents := func(jobID string) error {
+		if _, found, err := t.Server.Mon.FindBlockJobErrorEvent(t.vmname, jobID, uint64(ts.Unix())); err == nil {
+			if found {
+				return fmt.Errorf("errors detected during disk copying")
+			}
+		} else {
+			return fmt.Errorf("FindBlockJobErrorEvent failed: %s", err)
+		}
+
+		if _, found, err := t.Server.Mon.FindBlockJobCompletedEvent(t.vmname, jobID, uint64(ts.Unix())); err == nil {
+			if !found {
+				return errJobNotFound
+			}
+		} else {
+			return fmt.Errorf("FindBlockJobCompletedEvent failed: %s", err)
+		}
+
+		return nil
+	}
+
+	prevTime := time.Now()
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-t.Ctx().Done():
+			return t.Ctx().Err()
+		case <-ticker.C:
+		}
+
+		jobs, err := getJobs()
+		if err != nil {
+			return err
+		}
+
+		elapsed := time.Since(prevTime)
+
+		prevTime = time.Now()
+
+		remaining := 0
+
+		for _, d := range t.disks {
+			if d.completed {
+				continue
+			}
+
+			if job, ok := jobs[d.jobID()]; ok {
+				speed := blockJobSpeed(d.prevOffset, job.Offset, elapsed)
+
+				d.prevOffset = job.Offset
+
+				// (diskname, total, remain, sent and speed)
+				t.updateStat(d.src.BaseName(), d.size, job.Len-job.Offset, d.size-(job.Len-job.Offset), speed)
+
+				remaining++
+
+				continue
+			}
+
+			switch err := checkEvents(d.jobID()); {
+			case err == nil:
+			case errors.Is(err, errJobNotFound):
+				return fmt.Errorf("%s: no completed event found (the job may have been cancelled)", d.src.BaseName())
+			default:
+				return fmt.Errorf("%s: %w", d.src.BaseName(), err)
+			}
+
+			d.completed = true
+
+			t.Server.TaskBlockJobDone(t.vmname+"/machine-backup", d.jobID())
+
+			// (diskname, total, remain, sent and speed)
+			t.updateStat(d.src.BaseName(), d.size, 0, d.size, 0)
+		}
+
+		if remaining == 0 {
+			break
+		}
+	}
+
+	if t.opts.Incremental {
+		for _, d := range t.disks {
+			if err := updateBackupState(t.vmname, d.backupDisk, d.sync, t.Logger); err != nil {
+				// non-fatal error. Just printing
+				t.Logger.Errorf("Failed to update backup state (%s): %s", d.src.BaseName(), err)
+			}
+		}
+	}
+
+	t.Logger.Debug("copy(): completed")
+
+	return nil
+}
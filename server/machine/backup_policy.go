@@ -0,0 +1,116 @@
+package machine
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/0xef53/kvmrun/kvmrun"
+	"github.com/0xef53/kvmrun/server"
+
+	log "github.com/sirupsen/logrus"
+)
+
+func (s *Server) BackupPolicySet(ctx context.Context, vmname string, policy *kvmrun.BackupPolicy) error {
+	if policy == nil {
+		return fmt.Errorf("empty backup policy")
+	} else {
+		if err := policy.Validate(true); err != nil {
+			return err
+		}
+	}
+
+	err := s.TaskRunFunc(ctx, server.BlockConfOperations(vmname), true, nil, func(l *log.Entry) error {
+		vm, err := s.MachineGet(vmname, false)
+		if err != nil {
+			return err
+		}
+
+		for idx, diskname := range policy.Disks {
+			d := vm.C.DiskGet(diskname)
+			if d == nil {
+				return &kvmrun.NotConnectedError{Source: "instance_conf", Object: diskname}
+			}
+
+			policy.Disks[idx] = d.BaseName()
+		}
+
+		return policy.Save(vmname)
+	})
+
+	if err != nil {
+		return fmt.Errorf("cannot set backup policy: %w", err)
+	}
+
+	return nil
+}
+
+type BackupPolicyInfo struct {
+	Policy  *kvmrun.BackupPolicy
+	NextRun time.Time
+}
+
+func (s *Server) BackupPolicyGet(ctx context.Context, vmname string) (*BackupPolicyInfo, error) {
+	if _, err := s.MachineGet(vmname, false); err != nil {
+		return nil, err
+	}
+
+	policy, err := kvmrun.GetBackupPolicy(vmname)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("%w: backup policy for %s", kvmrun.ErrNotFound, vmname)
+		}
+
+		return nil, err
+	}
+
+	info := BackupPolicyInfo{
+		Policy: policy,
+	}
+
+	if !policy.Disabled {
+		info.NextRun = policy.NextRun(time.Now())
+	}
+
+	return &info, nil
+}
+
+func (s *Server) BackupPolicyRemove(ctx context.Context, vmname string) error {
+	err := s.TaskRunFunc(ctx, server.BlockConfOperations(vmname), true, nil, func(l *log.Entry) error {
+		if _, err := s.MachineGet(vmname, false); err != nil {
+			return err
+		}
+
+		return kvmrun.RemoveBackupPolicy(vmname)
+	})
+
+	if err != nil {
+		return fmt.Errorf("cannot remove backup policy: %w", err)
+	}
+
+	return nil
+}
+
+// BackupCatalogList returns the backups created by the scheduler.
+// If diskname is set, only the backups of this disk are returned.
+func (s *Server) BackupCatalogList(ctx context.Context, vmname, diskname string) ([]*kvmrun.BackupCatalogEntry, error) {
+	if _, err := s.MachineGet(vmname, false); err != nil {
+		return nil, err
+	}
+
+	catalog, err := kvmrun.GetBackupCatalog(vmname)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(diskname) > 0 {
+		if d, err := kvmrun.NewDisk(diskname); err == nil {
+			diskname = d.BaseName()
+		}
+
+		return catalog.Disk(diskname), nil
+	}
+
+	return catalog.Entries, nil
+}
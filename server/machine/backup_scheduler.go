@@ -0,0 +1,315 @@
+package machine
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/0xef53/kvmrun/kvmrun"
+
+	"github.com/0xef53/go-task"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// RunBackupScheduler performs the machine backups according to their policies.
+// The schedules are checked at the beginning of every minute until ctx is done.
+// Backups missed while kvmrund was not running are not performed.
+func (s *Server) RunBackupScheduler(ctx context.Context) {
+	var mu sync.Mutex
+
+	// Machines with a scheduled backup in progress
+	running := make(map[string]struct{})
+
+	log.Info("Backup scheduler started")
+
+	for {
+		now := time.Now()
+		next := now.Truncate(time.Minute).Add(time.Minute)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(next.Sub(now)):
+		}
+
+		names, err := s.MachineGetNames()
+		if err != nil {
+			log.Errorf("Backup scheduler: %s", err)
+
+			continue
+		}
+
+		for _, vmname := range names {
+			l := log.WithField("vmname", vmname)
+
+			policy, err := kvmrun.GetBackupPolicy(vmname)
+			if err != nil {
+				if !os.IsNotExist(err) {
+					l.Errorf("Backup scheduler: %s", err)
+				}
+
+				continue
+			}
+
+			if policy.Disabled || !policy.Due(next) {
+				continue
+			}
+
+			mu.Lock()
+
+			if _, ok := running[vmname]; ok {
+				mu.Unlock()
+
+				l.Warn("Scheduled backup skipped: the previous one is still in progress")
+
+				continue
+			}
+
+			running[vmname] = struct{}{}
+
+			mu.Unlock()
+
+			go func() {
+				defer func() {
+					mu.Lock()
+					delete(running, vmname)
+					mu.Unlock()
+				}()
+
+				if err := s.runScheduledBackup(ctx, vmname, policy, next, l); err != nil {
+					l.Errorf("Scheduled backup failed: %s", err)
+				}
+			}()
+		}
+	}
+}
+
+func (s *Server) runScheduledBackup(ctx context.Context, vmname string, policy *kvmrun.BackupPolicy, ts time.Time, l *log.Entry) error {
+	vm, err := s.MachineGet(vmname, true)
+	if err != nil {
+		return err
+	}
+
+	vmstate, err := s.MachineGetStatus(vm)
+	if err != nil {
+		return err
+	}
+
+	switch vmstate {
+	case kvmrun.StatePaused, kvmrun.StateRunning:
+		if vm.R == nil {
+			return fmt.Errorf("unexpected error: QEMU instance not found")
+		}
+	default:
+		return fmt.Errorf("machine is not running")
+	}
+
+	catalog, err := kvmrun.GetBackupCatalog(vmname)
+	if err != nil {
+		return err
+	}
+
+	disknames := policy.Disks
+
+	if len(disknames) == 0 {
+		for _, d := range vm.R.DiskGetList() {
+			disknames = append(disknames, d.BaseName())
+		}
+	}
+
+	kind := scheduledBackupType(vm, policy, catalog, disknames, l)
+
+	opts := MachineBackupOptions{
+		Targets:     make([]*MachineBackupTarget, 0, len(disknames)),
+		Incremental: true,
+		ClearBitmap: kind == kvmrun.BackupTypeFull,
+		FSFreeze:    policy.FSFreeze,
+	}
+
+	for _, diskname := range disknames {
+		target := policy.Target(vmname, diskname, kind, ts)
+
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return err
+		}
+
+		opts.Targets = append(opts.Targets, &MachineBackupTarget{DiskName: diskname, Target: target})
+	}
+
+	tid, err := s.StartMachineBackupProcess(ctx, vmname, &opts)
+	if err != nil {
+		return err
+	}
+
+	l.Infof("Scheduled %s backup started (task ID = %s)", kind, tid)
+
+	if err := s.waitMachineBackup(ctx, vmname, tid); err != nil {
+		return err
+	}
+
+	for _, t := range opts.Targets {
+		e := kvmrun.BackupCatalogEntry{
+			DiskName: t.DiskName,
+			Path:     t.Target,
+			Type:     kind,
+			TaskID:   tid,
+			Created:  ts,
+		}
+
+		if fi, err := os.Stat(t.Target); err == nil {
+			e.Size = uint64(fi.Size())
+		}
+
+		catalog.Entries = append(catalog.Entries, &e)
+	}
+
+	pruneBackups(catalog, policy, time.Now(), l)
+
+	if err := catalog.Save(vmname); err != nil {
+		return fmt.Errorf("failed to save backup catalog: %w", err)
+	}
+
+	l.Infof("Scheduled %s backup completed", kind)
+
+	return nil
+}
+
+// waitMachineBackup waits until the machine backup task with the specified ID completes.
+func (s *Server) waitMachineBackup(ctx context.Context, vmname, tid string) error {
+	ticker := time.NewTicker(5 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		stats, err := s.TaskGetStats(tid, vmname+"/machine-backup")
+		if err != nil {
+			return err
+		}
+
+		var found bool
+
+		for _, st := range stats {
+			if st.ID != tid {
+				continue
+			}
+
+			switch st.State {
+			case task.StateCompleted:
+				return nil
+			case task.StateFailed:
+				return fmt.Errorf("%s", st.StateDesc)
+			}
+
+			found = true
+		}
+
+		if !found {
+			return fmt.Errorf("backup task state is unknown: %s", tid)
+		}
+	}
+}
+
+// scheduledBackupType decides whether the next scheduled backup should be full.
+// A full backup is performed for all disks at once if the chain of any disk
+// is missing, broken, changed outside the scheduler or has reached
+// the length defined by the policy.
+func scheduledBackupType(vm *kvmrun.Machine, policy *kvmrun.BackupPolicy, catalog *kvmrun.BackupCatalog, disknames []string, l *log.Entry) string {
+	if policy.FullEvery < 2 {
+		return kvmrun.BackupTypeFull
+	}
+
+	for _, diskname := range disknames {
+		chains := catalog.Chains(diskname)
+
+		if len(chains) == 0 {
+			return kvmrun.BackupTypeFull
+		}
+
+		last := chains[len(chains)-1]
+
+		if last[0].Type != kvmrun.BackupTypeFull || len(last) >= policy.FullEvery {
+			return kvmrun.BackupTypeFull
+		}
+
+		d := vm.R.DiskGet(diskname)
+		if d == nil {
+			return kvmrun.BackupTypeFull
+		}
+
+		if err := checkIncrementalBackup(vm.Name, d); err != nil {
+			l.Warnf("%s: %s: a full backup will be performed", diskname, err)
+
+			return kvmrun.BackupTypeFull
+		}
+
+		st, err := kvmrun.GetBackupState(vm.Name, d.BaseName())
+		if err != nil || len(st.Images) == 0 || st.Images[len(st.Images)-1] != last[len(last)-1].Path {
+			l.Warnf("%s: backup chain was changed outside the scheduler: a full backup will be performed", diskname)
+
+			return kvmrun.BackupTypeFull
+		}
+	}
+
+	return kvmrun.BackupTypeIncremental
+}
+
+// pruneBackups removes the backup chains that do not fit the retention rules
+// of the policy. The newest chain of each disk is always kept. A chain
+// is removed entirely, since incremental backups are useless without
+// the preceding ones.
+func pruneBackups(catalog *kvmrun.BackupCatalog, policy *kvmrun.BackupPolicy, now time.Time, l *log.Entry) {
+	disknames := make(map[string]struct{})
+
+	for _, e := range catalog.Entries {
+		disknames[e.DiskName] = struct{}{}
+	}
+
+	maxAge := time.Duration(policy.KeepDays) * 24 * time.Hour
+
+	for diskname := range disknames {
+		chains := catalog.Chains(diskname)
+
+		for idx, chain := range chains[:len(chains)-1] {
+			var expired bool
+
+			if policy.KeepChains > 0 && len(chains)-idx > policy.KeepChains {
+				expired = true
+			}
+
+			if policy.KeepDays > 0 && now.Sub(chain[len(chain)-1].Created) > maxAge {
+				expired = true
+			}
+
+			if !expired {
+				continue
+			}
+
+			// The newest images depend on the older ones
+			sort.SliceStable(chain, func(i, j int) bool {
+				return chain[i].Created.After(chain[j].Created)
+			})
+
+			for _, e := range chain {
+				if err := os.Remove(e.Path); err != nil && !os.IsNotExist(err) {
+					l.Errorf("Failed to remove backup: %s", err)
+
+					break
+				}
+
+				catalog.Remove(e.Path)
+
+				l.Infof("Backup removed by the retention policy: %s", e.Path)
+			}
+		}
+	}
+}
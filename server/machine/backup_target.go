@@ -0,0 +1,213 @@
+package machine
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/0xef53/kvmrun/internal/qemu"
+	qemu_types "github.com/0xef53/kvmrun/internal/qemu/types"
+	"github.com/0xef53/kvmrun/kvmrun"
+	"github.com/0xef53/kvmrun/kvmrun/backend/file"
+	"github.com/0xef53/kvmrun/kvmrun/backend/nbd"
+
+	qmp "github.com/0xef53/go-qmp/v2"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// imageFormatByName returns the format of a backup or target image file
+// based on its extension. Raw is used by default.
+func imageFormatByName(fname string) string {
+	switch strings.ToLower(filepath.Ext(fname)) {
+	case ".qcow2", ".qcow":
+		return file.FormatQcow2
+	}
+
+	return file.FormatRaw
+}
+
+func createBackupImage(fname, format string, size uint64) error {
+	if format == file.FormatQcow2 {
+		return qemu.CreateImage(fname, format, size)
+	}
+
+	return createRawImage(fname, size)
+}
+
+// backupTargetArgs returns the target and format arguments
+// of the drive-backup command for the specified target disk.
+func (s *Server) backupTargetArgs(vm *kvmrun.Machine, disk *kvmrun.Disk) (string, string, error) {
+	b, ok := disk.Backend.(*nbd.Device)
+	if !ok {
+		return disk.Path, disk.Format(), nil
+	}
+
+	target := qemu_types.NBDBlockdevOptions{
+		Driver: "nbd",
+		Server: qemu_types.InetSocketAddress{
+			Type: "inet",
+			Host: b.URI.Host,
+			Port: strconv.Itoa(b.URI.Port),
+		},
+		Export: b.URI.ExportName,
+	}
+
+	if b.TLS() {
+		tlsCredsID, err := s.MachineTLSCredsAdd(vm.Name, vm.C.UID(), kvmrun.TLSEndpointClient)
+		if err != nil {
+			return "", "", err
+		}
+
+		target.TLSCreds = tlsCredsID
+
+		if vm.R.QemuVersion().Int() >= 70000 { // >= 7.x.x
+			target.TLSHostname = b.URI.Host
+		}
+	}
+
+	// The json: pseudo-protocol is the only way
+	// to pass the TLS credentials to the target
+	jsonTarget, err := json.Marshal(&target)
+	if err != nil {
+		return "", "", err
+	}
+
+	return "json:" + string(jsonTarget), "nbd", nil
+}
+
+// persistentBitmapSupported reports whether the dirty bitmap
+// of the disk can be stored in the image itself. Only qcow2 images
+// can keep bitmaps across restarts.
+func persistentBitmapSupported(d *kvmrun.Disk) bool {
+	return d.Format() == file.FormatQcow2
+}
+
+func backupBitmapAddArgs(d *kvmrun.Disk) *qemu_types.BlockDirtyBitmapAddOptions {
+	return &qemu_types.BlockDirtyBitmapAddOptions{
+		Node:       d.BaseName(),
+		Name:       "backup",
+		Persistent: persistentBitmapSupported(d),
+	}
+}
+
+func newBackupState(d *kvmrun.Disk) *kvmrun.BackupState {
+	return &kvmrun.BackupState{
+		Disk:       d.Path,
+		Persistent: persistentBitmapSupported(d),
+	}
+}
+
+// checkIncrementalBackup returns an error if the disk has already been
+// backed up, but its dirty bitmap has been lost or cannot be trusted anymore.
+// Without this check such a backup would silently become a full one.
+func checkIncrementalBackup(vmname string, d *kvmrun.Disk) error {
+	st, err := kvmrun.GetBackupState(vmname, d.BaseName())
+	if err != nil {
+		if os.IsNotExist(err) {
+			// The first backup of the disk
+			return nil
+		}
+
+		return err
+	}
+
+	return st.CheckIncremental(d, true)
+}
+
+// removeInconsistentBitmap removes the bitmap that QEMU failed to save properly.
+// Such a bitmap cannot be used or cleared, but prevents creating a new one.
+func (s *Server) removeInconsistentBitmap(vmname string, d *kvmrun.Disk, l *log.Entry) error {
+	if !d.BitmapInconsistent {
+		return nil
+	}
+
+	l.Warnf("Removing the inconsistent dirty bitmap of %s", d.BaseName())
+
+	bitmapArgs := qemu_types.BlockDirtyBitmapOptions{
+		Node: d.BaseName(),
+		Name: "backup",
+	}
+
+	if err := s.Mon.Run(vmname, qmp.Command{Name: "block-dirty-bitmap-remove", Arguments: &bitmapArgs}, nil); err != nil {
+		return fmt.Errorf("block-dirty-bitmap-remove failed: %w", err)
+	}
+
+	d.BitmapInconsistent = false
+
+	return nil
+}
+
+// updateBackupState records the target of a successful backup, where sync
+// is the drive-backup sync mode. A new qcow2 image with an incremental backup
+// is linked to the previous image in the chain, so the whole chain
+// can be read as a single disk. Other targets, and the images that could
+// not be linked, start a new chain of images, but do not break the dirty bitmap.
+func updateBackupState(vmname string, d *backupDisk, sync string, l *log.Entry) error {
+	_, isFile := d.dst.Backend.(*file.Device)
+
+	var st *kvmrun.BackupState
+
+	if sync == "incremental" {
+		switch v, err := kvmrun.GetBackupState(vmname, d.src.BaseName()); {
+		case err == nil:
+			st = v
+		case os.IsNotExist(err):
+			st = newBackupState(d.src)
+		default:
+			return err
+		}
+
+		switch {
+		case !isFile:
+			st.Images = nil
+		case len(st.Images) == 0:
+			l.Warnf("Backing chain of %s is unknown, the image contains only changed blocks: %s", d.src.BaseName(), d.dst.Path)
+
+			st.Images = []string{d.dst.Path}
+		default:
+			prev := st.Images[len(st.Images)-1]
+
+			// The target is linked after the job has completed,
+			// because QEMU would try to open the backing chain
+			// that is not available inside the chroot
+			var linked bool
+
+			if d.createTarget && d.dst.Format() == file.FormatQcow2 {
+				if err := qemu.RebaseOverlay(d.dst.Path, prev, imageFormatByName(prev)); err == nil {
+					l.Infof("Backing file of %s: %s", d.dst.Path, prev)
+
+					linked = true
+				} else {
+					l.Errorf("Failed to link %s to the previous image: %s", d.dst.Path, err)
+				}
+			}
+
+			if linked {
+				st.Images = append(st.Images, d.dst.Path)
+			} else {
+				// The image without a backing file cannot be read
+				// as a part of the chain, so a new chain is started
+				l.Warnf("Backing chain of %s is broken, the image contains only changed blocks: %s", d.src.BaseName(), d.dst.Path)
+
+				st.Images = []string{d.dst.Path}
+			}
+		}
+	} else {
+		// A full backup starts a new chain
+		st = newBackupState(d.src)
+
+		if isFile {
+			st.Images = []string{d.dst.Path}
+		}
+	}
+
+	if err := st.Save(vmname, d.src.BaseName()); err != nil {
+		return fmt.Errorf("failed to save backup state: %w", err)
+	}
+
+	return nil
+}
@@ -1,5 +1,7 @@
 package machine
 
+import "time"
+
 type DataTransferStat struct {
 	Total       uint64
 	Remaining   uint64
@@ -7,3 +9,13 @@ type DataTransferStat struct {
 	Progress    int
 	Speed       int
 }
+
+// blockJobSpeed returns the real throughput of a block job in mbit/s
+// based on the change of the job offset since the previous check.
+func blockJobSpeed(prevOffset, offset uint64, elapsed time.Duration) int {
+	if offset <= prevOffset || elapsed <= 0 {
+		return 0
+	}
+
+	return int(float64((offset-prevOffset)*8) / elapsed.Seconds() / (1 << 20))
+}
@@ -0,0 +1,662 @@
+package machine
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/0xef53/kvmrun/internal/lvm"
+	qemu_types "github.com/0xef53/kvmrun/internal/qemu/types"
+	"github.com/0xef53/kvmrun/kvmrun"
+	"github.com/0xef53/kvmrun/kvmrun/backend/block"
+	"github.com/0xef53/kvmrun/kvmrun/backend/file"
+	"github.com/0xef53/kvmrun/server"
+
+	qmp "github.com/0xef53/go-qmp/v2"
+	"github.com/0xef53/go-task"
+)
+
+type DiskMoveOptions struct {
+	DiskName string `json:"disk_name"`
+	Target   string `json:"target"`
+
+	// Create a new logical volume (/dev/VG/LV) or image file as a target
+	CreateTarget bool `json:"create_target"`
+
+	// Remove the source logical volume or image file on success
+	RemoveSource bool `json:"remove_source"`
+
+	// Speed limit in bytes per second. Zero means unlimited
+	Speed uint64 `json:"speed"`
+}
+
+func (o *DiskMoveOptions) Validate(_ bool) error {
+	o.DiskName = strings.TrimSpace(o.DiskName)
+
+	if len(o.DiskName) == 0 {
+		return fmt.Errorf("empty diskname")
+	}
+
+	o.Target = strings.TrimSpace(o.Target)
+
+	if len(o.Target) == 0 {
+		return fmt.Errorf("empty target string")
+	}
+
+	return nil
+}
+
+// StartDiskMoveProcess moves the disk of the running machine to another
+// local device or image file. The data is copied using the drive-mirror job,
+// then QEMU switches the disk to the target. The base name of the target
+// must be the same as the disk name, because it is used as the QEMU device ID.
+func (s *Server) StartDiskMoveProcess(ctx context.Context, vmname string, opts *DiskMoveOptions) (string, error) {
+	if opts == nil {
+		return "", fmt.Errorf("empty disk move opts")
+	}
+
+	if d, err := kvmrun.NewDisk(opts.DiskName); err == nil {
+		opts.DiskName = d.Backend.BaseName()
+	}
+
+	t := NewDiskMoveTask(vmname, opts)
+
+	t.Server = s
+
+	taskOpts := []task.TaskOption{
+		server.WithUniqueLabel(vmname + "/disk-move/" + opts.DiskName),
+		server.WithGroupLabel(vmname),
+		server.WithGroupLabel(vmname + "/long-running"),
+	}
+
+	tid, err := s.TaskStart(ctx, t, nil, taskOpts...)
+	if err != nil {
+		return "", fmt.Errorf("cannot start disk move: %w", err)
+	}
+
+	return tid, nil
+}
+
+type DiskMoveStatDetails struct {
+	DiskName string
+	Source   string
+	Target   string
+	Disk     *DataTransferStat
+}
+
+type DiskMoveTask struct {
+	*task.GenericTask
+	*Server
+
+	targets map[string]task.OperationMode
+
+	// Arguments
+	vmname string
+	opts   *DiskMoveOptions
+
+	// Do not set manually next fields !
+	vm *kvmrun.Machine
+
+	srcSize uint64
+	srcDisk *kvmrun.Disk
+	dstDisk *kvmrun.Disk
+
+	created bool
+	mapped  bool
+
+	// QEMU has switched the disk to the target
+	pivoted bool
+
+	details  *DiskMoveStatDetails
+	statfile string
+
+	mu sync.Mutex
+}
+
+func NewDiskMoveTask(vmname string, opts *DiskMoveOptions) *DiskMoveTask {
+	return &DiskMoveTask{
+		GenericTask: new(task.GenericTask),
+
+		targets: server.BlockBackupOperations(vmname, opts.DiskName),
+		vmname:  vmname,
+		opts:    opts,
+	}
+}
+
+func (t *DiskMoveTask) Targets() map[string]task.OperationMode { return t.targets }
+
+func (t *DiskMoveTask) label() string {
+	return t.vmname + "/disk-move/" + t.srcDisk.BaseName()
+}
+
+func (t *DiskMoveTask) jobID() string {
+	return "move_" + t.srcDisk.BaseName()
+}
+
+func (t *DiskMoveTask) BeforeStart(_ interface{}) error {
+	if t.opts == nil {
+		return fmt.Errorf("empty disk move opts")
+	} else {
+		if err := t.opts.Validate(true); err != nil {
+			return err
+		}
+	}
+
+	if vm, err := t.Server.MachineGet(t.vmname, true); err == nil {
+		t.vm = vm
+	} else {
+		return err
+	}
+
+	vmstate, err := t.MachineGetStatus(t.vm)
+	if err != nil {
+		return err
+	}
+
+	switch vmstate {
+	case kvmrun.StatePaused, kvmrun.StateRunning:
+		if t.vm.R == nil {
+			return fmt.Errorf("unexpected error: QEMU instance not found")
+		}
+	default:
+		return fmt.Errorf("%w: %s", kvmrun.ErrNotRunning, t.vmname)
+	}
+
+	if d := t.vm.R.DiskGet(t.opts.DiskName); d != nil {
+		t.srcDisk = d
+	} else {
+		return &kvmrun.NotConnectedError{Source: "instance_qemu", Object: t.opts.DiskName}
+	}
+
+	if !t.srcDisk.IsLocal() {
+		return fmt.Errorf("%w: moving of non-local disks: %s", kvmrun.ErrNotSupported, t.srcDisk.Path)
+	}
+
+	// The active layer is an overlay
+	if len(t.srcDisk.Snapshots) > 0 {
+		return fmt.Errorf("disk has snapshots that must be deleted first: %s", t.srcDisk.Path)
+	}
+
+	dstDisk, err := kvmrun.NewDisk(t.opts.Target)
+	if err != nil {
+		return err
+	}
+
+	switch dstDisk.Backend.(type) {
+	case *block.Device, *file.Device:
+	default:
+		return fmt.Errorf("%w: target of this type: %s", kvmrun.ErrNotSupported, t.opts.Target)
+	}
+
+	if dstDisk.Path == t.srcDisk.Path {
+		return fmt.Errorf("target is the same as the source: %s", dstDisk.Path)
+	}
+
+	if dstDisk.BaseName() != t.srcDisk.BaseName() {
+		return fmt.Errorf("target must have the same base name as the disk (%s): %s", t.srcDisk.BaseName(), dstDisk.Path)
+	}
+
+	if t.srcDisk.QemuVirtualSize > 0 {
+		t.srcSize = t.srcDisk.QemuVirtualSize
+	} else {
+		s, err := t.srcDisk.Size()
+		if err != nil {
+			return err
+		}
+
+		t.srcSize = s
+	}
+
+	switch ok, err := dstDisk.IsAvailable(); {
+	case ok:
+		if t.opts.CreateTarget {
+			return fmt.Errorf("%w: %s", kvmrun.ErrAlreadyExists, dstDisk.Path)
+		}
+
+		// An existing image file is not yet available to the guest,
+		// so its format can be detected. It must be known before
+		// the size comparison to get the virtual size of qcow2 image
+		if b, ok := dstDisk.Backend.(*file.Device); ok {
+			format, err := file.DetectFormat(b.Path)
+			if err != nil {
+				return err
+			}
+
+			dstDisk.DiskProperties.Format = format
+		}
+
+		dstSize, err := dstDisk.Size()
+		if err != nil {
+			return err
+		}
+
+		if dstSize < t.srcSize {
+			return fmt.Errorf("target size is smaller than source (%d < %d)", dstSize, t.srcSize)
+		}
+	case os.IsNotExist(err):
+		if !t.opts.CreateTarget {
+			return fmt.Errorf("target does not exist (use the create-target option): %s", dstDisk.Path)
+		}
+
+		switch b := dstDisk.Backend.(type) {
+		case *block.Device:
+			if _, _, err := parseLogicalVolumePath(dstDisk.Path); err != nil {
+				return err
+			}
+		case *file.Device:
+			// A new image file is created depending on the file extension
+			dstDisk.DiskProperties.Format = imageFormatByName(b.Path)
+		}
+	default:
+		return err
+	}
+
+	t.dstDisk = dstDisk
+
+	// Init stat fields
+	t.details = &DiskMoveStatDetails{
+		DiskName: t.srcDisk.BaseName(),
+		Source:   t.srcDisk.Path,
+		Target:   t.dstDisk.Path,
+		Disk:     &DataTransferStat{Total: t.srcSize, Remaining: t.srcSize},
+	}
+
+	hashname := fmt.Sprintf("%x", md5.Sum([]byte(t.label())))
+
+	t.statfile = filepath.Join(kvmrun.CHROOTDIR, t.vmname, ".tasks", hashname)
+
+	if err := os.Remove(t.statfile); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
+
+// parseLogicalVolumePath splits the path in the /dev/VG/LV format
+// into the volume group and logical volume names.
+func parseLogicalVolumePath(p string) (string, string, error) {
+	ff := strings.Split(strings.TrimPrefix(filepath.Clean(p), "/"), "/")
+
+	if len(ff) != 3 || ff[0] != "dev" {
+		return "", "", fmt.Errorf("no idea how to create a block device (expected /dev/VG/LV): %s", p)
+	}
+
+	return ff[1], ff[2], nil
+}
+
+func (t *DiskMoveTask) Stat() *task.TaskStat {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	st := t.GenericTask.Stat()
+
+	st.Details = t.details
+
+	return st
+}
+
+func (t *DiskMoveTask) updateStat(total, remain, sent uint64, speed int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if total == 0 {
+		return
+	}
+
+	t.details.Disk = &DataTransferStat{
+		Total:       total,
+		Remaining:   remain,
+		Transferred: sent,
+		Progress:    int(sent * 100 / total),
+		Speed:       speed,
+	}
+
+	t.SetProgress(int(t.details.Disk.Progress))
+}
+
+func (t *DiskMoveTask) writeStatFile(taskErr error) error {
+	st := t.Stat()
+
+	if taskErr == nil {
+		st.State = task.StateCompleted
+	} else {
+		st.State = task.StateFailed
+		st.StateDesc = taskErr.Error()
+	}
+
+	statFileData := server.TaskStatFile{
+		Label: t.label(),
+		Stat:  st,
+	}
+
+	b, err := json.MarshalIndent(statFileData, "", "    ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(t.statfile, b, 0644)
+}
+
+func (t *DiskMoveTask) Main() (err error) {
+	defer func() {
+		if _err := t.writeStatFile(err); _err != nil {
+			t.Logger.Errorf("Failed to save task state to a special file: %s", _err)
+		}
+	}()
+
+	if err := t.mirror(); err != nil {
+		return err
+	}
+
+	return t.finalize()
+}
+
+func (t *DiskMoveTask) OnFailure(taskErr error) {
+	// The machine already uses the target,
+	// so nothing can be rolled back
+	if t.pivoted {
+		return
+	}
+
+	opts := struct {
+		Device string `json:"device"`
+		Force  bool   `json:"force,omitempty"`
+	}{
+		Device: t.jobID(),
+		Force:  true,
+	}
+
+	if err := t.Mon.Run(t.vmname, qmp.Command{Name: "block-job-cancel", Arguments: &opts}, nil); err != nil {
+		// non-fatal error. Just printing
+		t.Logger.Debugf("OnFailureHook: forced block-job-cancel failed: %s", err)
+	}
+
+	// The target cannot be removed while QEMU keeps it open
+	for i := 0; i < 30; i++ {
+		if job, err := t.getJob(); err != nil || job == nil {
+			break
+		}
+
+		time.Sleep(time.Second)
+	}
+
+	if t.mapped {
+		if err := unmapDiskFromChroot(t.vmname, t.dstDisk); err != nil {
+			t.Logger.Errorf("OnFailureHook: failed to unmap the target device: %s", err)
+		}
+	}
+
+	if t.created {
+		if err := removeDiskDevice(t.dstDisk); err != nil {
+			// non-fatal error. Just printing
+			t.Logger.Errorf("OnFailureHook: cannot remove target: %s", err)
+		}
+	}
+}
+
+func (t *DiskMoveTask) getJob() (*qemu_types.BlockJobInfo, error) {
+	jobs := make([]*qemu_types.BlockJobInfo, 0, 1)
+
+	if err := t.Server.Mon.Run(t.vmname, qmp.Command{Name: "query-block-jobs", Arguments: nil}, &jobs); err != nil {
+		return nil, err
+	}
+
+	for _, j := range jobs {
+		if j.Device == t.jobID() {
+			return j, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (t *DiskMoveTask) createTarget() error {
+	switch t.dstDisk.Backend.(type) {
+	case *block.Device:
+		vgname, lvname, err := parseLogicalVolumePath(t.dstDisk.Path)
+		if err != nil {
+			return err
+		}
+
+		t.Logger.Infof("Creating a new logical volume: %s (size = %d)", t.dstDisk.Path, t.srcSize)
+
+		if err := lvm.CreateVolume(vgname, lvname, t.srcSize); err != nil {
+			return err
+		}
+	case *file.Device:
+		format := t.dstDisk.Format()
+
+		t.Logger.Infof("Creating a new %s image file: %s (size = %d)", format, t.dstDisk.Path, t.srcSize)
+
+		if err := os.MkdirAll(filepath.Dir(t.dstDisk.Path), 0755); err != nil {
+			return err
+		}
+
+		if err := createBackupImage(t.dstDisk.Path, format, t.srcSize); err != nil {
+			return err
+		}
+	}
+
+	t.created = true
+
+	return nil
+}
+
+// mirror copies the disk data to the target and switches
+// the disk to the target when both of them are synchronized.
+func (t *DiskMoveTask) mirror() error {
+	t.Logger.Debug("mirror(): main process started")
+
+	if t.opts.CreateTarget {
+		if err := t.createTarget(); err != nil {
+			return err
+		}
+	}
+
+	if err := mapDiskToChroot(t.vmname, t.dstDisk, t.vm.C.UID()); err != nil {
+		return err
+	}
+
+	t.mapped = true
+
+	mirrorArgs := qemu_types.DriveMirrorOptions{
+		JobID:  t.jobID(),
+		Device: t.srcDisk.BaseName(),
+		Target: t.dstDisk.Path,
+		Format: t.dstDisk.Format(),
+		Sync:   "full",
+		Mode:   "existing",
+		Speed:  t.opts.Speed,
+	}
+
+	if t.vm.R.QemuVersion().Int() >= 60000 { // >= 6.x.x
+		// Guest writes go to both devices, so the mirror
+		// is guaranteed to converge
+		mirrorArgs.CopyMode = "write-blocking"
+	}
+
+	t.Logger.Infof("Run QMP command: drive-mirror; src=%s, dst=%s, format=%s", t.srcDisk.Path, mirrorArgs.Target, mirrorArgs.Format)
+
+	ts := time.Now()
+
+	if err := t.Server.Mon.Run(t.vmname, qmp.Command{Name: "drive-mirror", Arguments: &mirrorArgs}, nil); err != nil {
+		return fmt.Errorf("failed to start mirroring: %w", err)
+	}
+
+	// Since that moment the job can be paused, resumed or throttled
+	// using the task key
+	t.Server.TaskBlockJobAdd(t.label(), t, t.vmname, t.jobID())
+
+	defer t.Server.TaskBlockJobsRemove(t.label())
+
+	// Stat will be available after the job status changes to running
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	if _, err := t.Server.Mon.WaitJobStatusChangeEvent(t.vmname, timeoutCtx, t.jobID(), "running", uint64(ts.Unix())); err != nil {
+		return err
+	}
+
+	var prevOffset uint64
+
+	prevTime := time.Now()
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-t.Ctx().Done():
+			return t.Ctx().Err()
+		case <-ticker.C:
+		}
+
+		job, err := t.getJob()
+		if err != nil {
+			return fmt.Errorf("failed to get mirroring status: %w", err)
+		}
+
+		if job == nil {
+			if _, found, err := t.Server.Mon.FindBlockJobErrorEvent(t.vmname, t.jobID(), uint64(ts.Unix())); err == nil && found {
+				return fmt.Errorf("errors detected during disk mirroring")
+			}
+
+			return fmt.Errorf("mirroring job disappeared (it may have been cancelled)")
+		}
+
+		speed := blockJobSpeed(prevOffset, job.Offset, time.Since(prevTime))
+
+		prevOffset, prevTime = job.Offset, time.Now()
+
+		// (total, remain, sent and speed)
+		t.updateStat(t.srcSize, job.Len-job.Offset, t.srcSize-(job.Len-job.Offset), speed)
+
+		if job.Ready {
+			break
+		}
+	}
+
+	t.Logger.Info("Disks are synchronized. Run QMP command: block-job-complete")
+
+	ts = time.Now()
+
+	jobArgs := struct {
+		Device string `json:"device"`
+	}{
+		Device: t.jobID(),
+	}
+
+	if err := t.Server.Mon.Run(t.vmname, qmp.Command{Name: "block-job-complete", Arguments: &jobArgs}, nil); err != nil {
+		return fmt.Errorf("failed to complete mirroring: %w", err)
+	}
+
+	for {
+		select {
+		case <-t.Ctx().Done():
+			return t.Ctx().Err()
+		case <-ticker.C:
+		}
+
+		job, err := t.getJob()
+		if err != nil {
+			return fmt.Errorf("failed to get mirroring status: %w", err)
+		}
+
+		if job != nil {
+			continue
+		}
+
+		if _, found, err := t.Server.Mon.FindBlockJobErrorEvent(t.vmname, t.jobID(), uint64(ts.Unix())); err == nil {
+			if found {
+				return fmt.Errorf("errors detected during disk mirroring")
+			}
+		} else {
+			return fmt.Errorf("FindBlockJobErrorEvent failed: %w", err)
+		}
+
+		if _, found, err := t.Server.Mon.FindBlockJobCompletedEvent(t.vmname, t.jobID(), uint64(ts.Unix())); err == nil {
+			if !found {
+				return fmt.Errorf("no completed event found (the job may have been cancelled)")
+			}
+		} else {
+			return fmt.Errorf("FindBlockJobCompletedEvent failed: %w", err)
+		}
+
+		break
+	}
+
+	t.pivoted = true
+
+	// (total, remain, sent and speed)
+	t.updateStat(t.srcSize, 0, t.srcSize, 0)
+
+	t.Logger.Infof("Disk has been switched to the target: %s", t.dstDisk.Path)
+
+	t.Logger.Debug("mirror(): completed")
+
+	return nil
+}
+
+// finalize updates the machine configuration
+// and removes the source if requested.
+func (t *DiskMoveTask) finalize() error {
+	// The dirty bitmap stayed on the source device
+	if err := kvmrun.InvalidateBackupState(t.vmname, t.srcDisk.BaseName(), "disk has been moved to "+t.dstDisk.Path); err != nil {
+		t.Logger.Errorf("Failed to invalidate backup state: %s", err)
+	}
+
+	if err := unmapDiskFromChroot(t.vmname, t.srcDisk); err != nil {
+		// non-fatal error. Just printing
+		t.Logger.Errorf("Failed to unmap the source device: %s", err)
+	}
+
+	if t.vm.C.DiskGet(t.srcDisk.BaseName()) != nil {
+		if err := t.vm.C.DiskSetPath(t.srcDisk.BaseName(), t.dstDisk.Path, t.dstDisk.DiskProperties.Format); err != nil {
+			return fmt.Errorf("disk has been moved, but the configuration was not updated: %w", err)
+		}
+
+		if err := t.vm.C.Save(); err != nil {
+			return fmt.Errorf("disk has been moved, but the configuration was not saved: %w", err)
+		}
+
+		t.Logger.Info("Machine configuration has been updated")
+	}
+
+	if t.opts.RemoveSource {
+		if err := removeDiskDevice(t.srcDisk); err != nil {
+			t.Logger.Errorf("Failed to remove the source: %s", err)
+		} else {
+			t.Logger.Infof("Source has been removed: %s", t.srcDisk.Path)
+		}
+	}
+
+	return nil
+}
+
+// removeDiskDevice removes the logical volume or the image file.
+// Other devices cannot be removed.
+func removeDiskDevice(d *kvmrun.Disk) error {
+	switch d.Backend.(type) {
+	case *block.Device:
+		isLV, err := lvm.IsLogicalVolume(d.Path)
+		if err != nil {
+			return err
+		}
+
+		if !isLV {
+			return fmt.Errorf("not a logical volume: %s", d.Path)
+		}
+
+		return lvm.RemoveVolume(d.Path)
+	case *file.Device:
+		return os.Remove(d.Path)
+	}
+
+	return fmt.Errorf("%w: removing of this device type: %s", kvmrun.ErrNotSupported, d.Path)
+}
@@ -0,0 +1,56 @@
+package machine
+
+import (
+	"testing"
+)
+
+func TestParseLogicalVolumePath(t *testing.T) {
+	tests := []struct {
+		path string
+		vg   string
+		lv   string
+		fail bool
+	}{
+		{path: "/dev/vg0/alice_sda", vg: "vg0", lv: "alice_sda"},
+		{path: "/dev/vg0//alice_sda/", vg: "vg0", lv: "alice_sda"},
+		{path: "/dev/vg0/alice/sda", fail: true},
+		{path: "/dev/sda", fail: true},
+		{path: "/var/lib/alice_sda", fail: true},
+	}
+
+	for _, tc := range tests {
+		vg, lv, err := parseLogicalVolumePath(tc.path)
+
+		if tc.fail {
+			if err == nil {
+				t.Fatalf("%s: expected error, got %s/%s", tc.path, vg, lv)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("%s: got unexpected error: %s", tc.path, err)
+		}
+
+		if vg != tc.vg || lv != tc.lv {
+			t.Fatalf("%s: got unexpected result: want %s/%s, got %s/%s", tc.path, tc.vg, tc.lv, vg, lv)
+		}
+	}
+}
+
+func TestImageFormatByName(t *testing.T) {
+	tests := map[string]string{
+		"/var/lib/alice_sda.qcow2": "qcow2",
+		"/var/lib/alice_sda.QCOW2": "qcow2",
+		"/var/lib/alice_sda.qcow":  "qcow2",
+		"/var/lib/alice_sda.img":   "raw",
+		"/var/lib/alice_sda":       "raw",
+	}
+
+	for fname, want := range tests {
+		if got := imageFormatByName(fname); got != want {
+			t.Fatalf("%s: got unexpected format: want %s, got %s", fname, want, got)
+		}
+	}
+}
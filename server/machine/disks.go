@@ -3,11 +3,18 @@ package machine
 import (
 	"context"
 	"fmt"
+	"os"
 	"strings"
 
+	"github.com/0xef53/kvmrun/internal/lvm"
+	"github.com/0xef53/kvmrun/internal/qemu"
+	qemu_types "github.com/0xef53/kvmrun/internal/qemu/types"
 	"github.com/0xef53/kvmrun/kvmrun"
+	"github.com/0xef53/kvmrun/kvmrun/backend/block"
+	"github.com/0xef53/kvmrun/kvmrun/backend/file"
 	"github.com/0xef53/kvmrun/server"
 
+	qmp "github.com/0xef53/go-qmp/v2"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -26,12 +33,36 @@ func (s *Server) DiskAttach(ctx context.Context, vmname string, opts *kvmrun.Dis
 			return err
 		}
 
+		if len(opts.Format) == 0 {
+			known := vm.C.DiskGet(opts.Path)
+
+			if known == nil && vm.R != nil {
+				known = vm.R.DiskGet(opts.Path)
+			}
+
+			// The guest may have already written to the known disk,
+			// so its format must not be detected again
+			if known != nil {
+				if _, ok := known.Backend.(*file.Device); ok {
+					opts.Format = known.BaseFormat()
+				}
+			}
+
+			if err := opts.InitFormat(); err != nil {
+				return err
+			}
+		}
+
 		if live && vm.R != nil {
 			// -1 -- означает, что параметр не нужно брать в расчет
 			if position >= 0 {
 				return fmt.Errorf("unable to insert at the '%d' position while the machine is running", position)
 			}
 
+			if d := vm.C.DiskGet(opts.Path); d != nil && len(d.Snapshots) > 0 && vm.R.DiskGet(opts.Path) == nil {
+				return fmt.Errorf("disk with snapshots cannot be attached while the machine is running: %s", opts.Path)
+			}
+
 			if err := vm.R.DiskAppend(*opts); err != nil {
 				if kvmrun.IsAlreadyConnectedError(err) {
 					// In this case just re-set the io limits
@@ -91,6 +122,12 @@ func (s *Server) DiskDetach(ctx context.Context, vmname, diskname string, live b
 			return err
 		}
 
+		// Snapshot overlays contain the actual disk state
+		// and cannot be used without the configuration
+		if d := vm.C.DiskGet(diskname); d != nil && len(d.Snapshots) > 0 {
+			return fmt.Errorf("disk has snapshots that must be deleted first: %s", d.Path)
+		}
+
 		if live && vm.R != nil {
 			if err := vm.R.DiskRemove(diskname); err != nil && !kvmrun.IsNotConnectedError(err) {
 				return err
@@ -191,7 +228,14 @@ func (s *Server) DiskRemoveQemuBitmap(ctx context.Context, vmname, diskname stri
 		}
 
 		if vm.R != nil {
-			return vm.R.DiskRemoveQemuBitmap(diskname)
+			if err := vm.R.DiskRemoveQemuBitmap(diskname); err != nil {
+				return err
+			}
+
+			// The next incremental backup will start a new chain
+			if d := vm.R.DiskGet(diskname); d != nil {
+				return kvmrun.RemoveBackupState(vmname, d.BaseName())
+			}
 		}
 
 		return nil
@@ -230,3 +274,135 @@ func (s *Server) DiskResizeQemuBlockdev(ctx context.Context, vmname, diskname st
 
 	return nil
 }
+
+// DiskGrow extends the logical volume or the image file of the disk
+// to the specified size in bytes and notifies the guest if the machine
+// is running. Shrinking is not supported. Returns the resulting size,
+// which may be greater than requested due to LVM extent rounding.
+func (s *Server) DiskGrow(ctx context.Context, vmname, diskname string, size uint64) (uint64, error) {
+	diskname = strings.TrimSpace(diskname)
+
+	if len(diskname) == 0 {
+		return 0, fmt.Errorf("empty disk name")
+	}
+
+	if size == 0 {
+		return 0, fmt.Errorf("new size is not set")
+	}
+
+	if d, err := kvmrun.NewDisk(diskname); err == nil {
+		diskname = d.BaseName()
+	}
+
+	var newSize uint64
+
+	err := s.TaskRunFunc(ctx, server.BlockBackupOperations(vmname, diskname), true, nil, func(l *log.Entry) error {
+		vm, err := s.MachineGet(vmname, true)
+		if err != nil {
+			return err
+		}
+
+		var d *kvmrun.Disk
+
+		if vm.R != nil {
+			d = vm.R.DiskGet(diskname)
+		} else {
+			d = vm.C.DiskGet(diskname)
+		}
+
+		if d == nil {
+			return &kvmrun.NotConnectedError{Source: "instance_conf", Object: diskname}
+		}
+
+		// The active layer is an overlay
+		if len(d.Snapshots) > 0 {
+			return fmt.Errorf("disk has snapshots that must be deleted first: %s", d.Path)
+		}
+
+		// The saved RAM state refers to the current disk size
+		if vm.R == nil {
+			if _, err := os.Stat(kvmrun.SavedStateFile(vmname)); err == nil {
+				return fmt.Errorf("machine has a saved state that must be discarded first")
+			}
+		}
+
+		curSize, err := d.Size()
+		if err != nil {
+			return err
+		}
+
+		if vm.R != nil && d.QemuVirtualSize > 0 {
+			curSize = d.QemuVirtualSize
+		}
+
+		switch {
+		case size < curSize:
+			return fmt.Errorf("shrinking is not supported (%d < %d)", size, curSize)
+		case size == curSize:
+			newSize = curSize
+
+			return nil
+		}
+
+		switch d.Backend.(type) {
+		case *block.Device:
+			isLV, err := lvm.IsLogicalVolume(d.Path)
+			if err != nil {
+				return err
+			}
+
+			if !isLV {
+				return fmt.Errorf("%w: growing of non-LVM block devices: %s", kvmrun.ErrNotSupported, d.Path)
+			}
+
+			l.Infof("Extending the logical volume: %s (size = %d)", d.Path, size)
+
+			if err := lvm.ExtendVolume(d.Path, size); err != nil {
+				return err
+			}
+
+			// LVM rounds the size up to the extent size
+			newSize, err = d.Size()
+			if err != nil {
+				return err
+			}
+		case *file.Device:
+			// QEMU grows the image file itself
+			if vm.R == nil {
+				l.Infof("Growing the image file: %s (size = %d)", d.Path, size)
+
+				if err := qemu.ResizeImage(d.Path, d.Format(), size); err != nil {
+					return err
+				}
+			}
+
+			newSize = size
+		default:
+			return fmt.Errorf("%w: growing of this disk type: %s", kvmrun.ErrNotSupported, d.Path)
+		}
+
+		if vm.R != nil {
+			opts := qemu_types.BlockResizeQuery{
+				Device: d.BaseName(),
+				Size:   int(newSize),
+			}
+
+			if err := s.Mon.Run(vmname, qmp.Command{Name: "block_resize", Arguments: &opts}, nil); err != nil {
+				return fmt.Errorf("block_resize failed: %w", err)
+			}
+		}
+
+		// Previous backups of the disk have a different size
+		if err := kvmrun.InvalidateBackupState(vmname, d.BaseName(), "disk has been resized"); err != nil {
+			l.Errorf("Failed to invalidate backup state: %s", err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return 0, fmt.Errorf("cannot grow disk: %w", err)
+	}
+
+	return newSize, nil
+}
@@ -0,0 +1,420 @@
+package machine
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/0xef53/kvmrun/kvmrun"
+	"github.com/0xef53/kvmrun/server"
+
+	"github.com/0xef53/go-task"
+)
+
+const maxEvacuationConcurrency = 16
+
+type EvacuationOptions struct {
+	// DstServers is an ordered list of destination servers.
+	// If a machine cannot be migrated to the first one,
+	// the next one is tried, and so on.
+	DstServers     []string         `json:"dst_servers"`
+	Concurrency    int              `json:"concurrency"`
+	WithLocalDisks bool             `json:"with_local_disks"`
+	Migration      MigrationOptions `json:"migration"`
+}
+
+func (o *EvacuationOptions) Validate(strict bool) error {
+	servers := make([]string, 0, len(o.DstServers))
+
+	for _, v := range o.DstServers {
+		if v = strings.TrimSpace(v); len(v) > 0 {
+			servers = append(servers, v)
+		}
+	}
+
+	if len(servers) == 0 {
+		return fmt.Errorf("empty list of destination servers")
+	}
+
+	o.DstServers = servers
+
+	switch {
+	case o.Concurrency == 0:
+		o.Concurrency = 1
+	case o.Concurrency < 0 || o.Concurrency > maxEvacuationConcurrency:
+		return fmt.Errorf("invalid concurrency value: must be in range from 1 to %d", maxEvacuationConcurrency)
+	}
+
+	// These ones are specific to each machine
+	if len(o.Migration.Disks) > 0 || len(o.Migration.Overrides.Name) > 0 || len(o.Migration.Overrides.Disks) > 0 || len(o.Migration.Overrides.NetIfaces) > 0 {
+		return fmt.Errorf("disks and overrides cannot be specified for the host evacuation")
+	}
+
+	if err := o.Migration.Validate(strict); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *Server) StartEvacuationProcess(ctx context.Context, opts *EvacuationOptions) (string, error) {
+	if opts == nil {
+		return "", fmt.Errorf("empty evacuation opts")
+	}
+
+	t := NewHostEvacuationTask(opts)
+
+	t.Server = s
+
+	taskOpts := []task.TaskOption{
+		server.WithUniqueLabel("evacuation"),
+	}
+
+	tid, err := s.TaskStart(ctx, t, nil, taskOpts...)
+	if err != nil {
+		return "", fmt.Errorf("cannot start evacuation: %w", err)
+	}
+
+	return tid, nil
+}
+
+type EvacuationMachineStat struct {
+	DstServer string
+	State     string
+	Progress  int
+	Err       string
+}
+
+type HostEvacuationStatDetails struct {
+	Machines map[string]*EvacuationMachineStat
+}
+
+type HostEvacuationTask struct {
+	*task.GenericTask
+	*Server
+
+	targets map[string]task.OperationMode
+
+	// Arguments
+	opts *EvacuationOptions
+
+	// Do not set manually next fields !
+	vmnames []string
+
+	// Running child migration tasks by machine names
+	children map[string]*MachineMigrationTask
+
+	details *HostEvacuationStatDetails
+
+	mu sync.Mutex
+}
+
+func NewHostEvacuationTask(opts *EvacuationOptions) *HostEvacuationTask {
+	return &HostEvacuationTask{
+		GenericTask: new(task.GenericTask),
+		targets:     server.NoBlockOperations("evacuation"),
+		opts:        opts,
+	}
+}
+
+func (t *HostEvacuationTask) Targets() map[string]task.OperationMode { return t.targets }
+
+func (t *HostEvacuationTask) BeforeStart(_ interface{}) error {
+	if t.opts == nil {
+		return fmt.Errorf("empty evacuation opts")
+	} else {
+		if err := t.opts.Validate(true); err != nil {
+			return err
+		}
+	}
+
+	vmlist, err := t.GetList()
+	if err != nil {
+		return err
+	}
+
+	t.details = &HostEvacuationStatDetails{
+		Machines: make(map[string]*EvacuationMachineStat),
+	}
+
+	t.children = make(map[string]*MachineMigrationTask)
+
+	for _, vm := range vmlist {
+		switch vm.State {
+		case kvmrun.StateRunning, kvmrun.StatePaused:
+		default:
+			continue
+		}
+
+		t.vmnames = append(t.vmnames, vm.M.Name)
+
+		t.details.Machines[vm.M.Name] = &EvacuationMachineStat{State: "pending"}
+	}
+
+	sort.Strings(t.vmnames)
+
+	// New machines must not appear on this host
+	// while the evacuation is in progress and after it
+	if err := t.HostSetDrained(true); err != nil {
+		return fmt.Errorf("failed to switch the host to drain mode: %w", err)
+	}
+
+	return nil
+}
+
+func (t *HostEvacuationTask) Stat() *task.TaskStat {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	st := t.GenericTask.Stat()
+
+	if t.details != nil {
+		// A copy, because the details are changed by parallel workers
+		details := HostEvacuationStatDetails{
+			Machines: make(map[string]*EvacuationMachineStat, len(t.details.Machines)),
+		}
+
+		for vmname, v := range t.details.Machines {
+			x := *v
+
+			details.Machines[vmname] = &x
+		}
+
+		st.Details = &details
+	}
+
+	return st
+}
+
+func (t *HostEvacuationTask) updateStat(vmname string, fn func(*EvacuationMachineStat)) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	fn(t.details.Machines[vmname])
+
+	if len(t.details.Machines) == 0 {
+		return
+	}
+
+	var percent int
+
+	for _, st := range t.details.Machines {
+		percent += st.Progress
+	}
+
+	t.SetProgress(percent / len(t.details.Machines))
+}
+
+func (t *HostEvacuationTask) Main() error {
+	if len(t.vmnames) == 0 {
+		t.Logger.Info("No running machines found")
+
+		return nil
+	}
+
+	jobs := make(chan string)
+
+	var failed []string
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	for i := 0; i < t.opts.Concurrency && i < len(t.vmnames); i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for vmname := range jobs {
+				if err := t.evacuateMachine(vmname); err != nil {
+					t.Logger.Errorf("Failed to evacuate %s: %s", vmname, err)
+
+					mu.Lock()
+					failed = append(failed, vmname)
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+
+LOOP:
+	for _, vmname := range t.vmnames {
+		select {
+		case <-t.Ctx().Done():
+			break LOOP
+		case jobs <- vmname:
+		}
+	}
+
+	close(jobs)
+
+	wg.Wait()
+
+	if err := t.Ctx().Err(); err != nil {
+		return err
+	}
+
+	if len(failed) > 0 {
+		sort.Strings(failed)
+
+		return fmt.Errorf("failed to evacuate %d machine(s): %s", len(failed), strings.Join(failed, ", "))
+	}
+
+	return nil
+}
+
+func (t *HostEvacuationTask) OnFailure(taskErr error) {
+	// Cancel the child migration processes that are still running.
+	// Other migrations of the same machines are not touched
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	// Tasks are cancelled by their handles, not by the labels,
+	// because the labels may already belong to other attempts
+	for _, child := range t.children {
+		child.Cancel()
+	}
+
+	t.Logger.Warn("OnFailureHook: the host remains in drain mode")
+}
+
+// evacuateMachine migrates the machine to the first suitable server
+// from the list of destination servers.
+func (t *HostEvacuationTask) evacuateMachine(vmname string) error {
+	var lastErr error
+
+	for _, dstServer := range t.opts.DstServers {
+		if err := t.Ctx().Err(); err != nil {
+			return err
+		}
+
+		t.updateStat(vmname, func(st *EvacuationMachineStat) {
+			st.DstServer = dstServer
+			st.State = "running"
+			st.Progress = 0
+			st.Err = ""
+		})
+
+		retryable, err := t.migrateMachine(vmname, dstServer)
+		if err == nil {
+			t.updateStat(vmname, func(st *EvacuationMachineStat) {
+				st.State = "completed"
+				st.Progress = 100
+			})
+
+			t.Logger.Infof("Machine %s has been migrated to %s", vmname, dstServer)
+
+			return nil
+		}
+
+		lastErr = err
+
+		t.updateStat(vmname, func(st *EvacuationMachineStat) {
+			st.State = "failed"
+			st.Err = err.Error()
+		})
+
+		if !retryable {
+			break
+		}
+
+		t.Logger.Warnf("Failed to migrate %s to %s: %s", vmname, dstServer, err)
+	}
+
+	return lastErr
+}
+
+// migrateMachine runs a child migration task and waits for its completion.
+// The first return value reports whether another destination server can be tried.
+func (t *HostEvacuationTask) migrateMachine(vmname, dstServer string) (bool, error) {
+	opts := t.opts.Migration
+
+	opts.Disks = nil
+
+	if t.opts.WithLocalDisks {
+		vm, err := t.MachineGet(vmname, true)
+		if err != nil {
+			return false, err
+		}
+
+		if vm.R == nil {
+			return false, fmt.Errorf("machine is not running: %s", vmname)
+		}
+
+		for _, d := range vm.R.DiskGetList() {
+			if d.IsLocal() {
+				opts.Disks = append(opts.Disks, d.Path)
+			}
+		}
+	}
+
+	child := NewMachineMigrationTask(vmname, dstServer, &opts)
+
+	child.Server = t.Server
+
+	taskOpts := []task.TaskOption{
+		server.WithUniqueLabel(vmname + "/migration"),
+		server.WithGroupLabel(vmname),
+		server.WithGroupLabel(vmname + "/long-running"),
+		server.WithGroupLabel("evacuation/children"),
+	}
+
+	// A fresh context, so that the child task gets its own metadata
+	// and is not considered as a part of the evacuation task
+	tid, err := t.TaskStart(context.Background(), child, nil, taskOpts...)
+	if err != nil {
+		// Nothing has been started yet
+		return true, fmt.Errorf("cannot start migration: %w", err)
+	}
+
+	t.mu.Lock()
+	t.children[vmname] = child
+	t.mu.Unlock()
+
+	defer func() {
+		t.mu.Lock()
+		delete(t.children, vmname)
+		t.mu.Unlock()
+	}()
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	var cancelled bool
+
+	for {
+		<-ticker.C
+
+		// The child task is cancelled once, then we wait for its completion
+		if t.Ctx().Err() != nil && !cancelled {
+			child.Cancel()
+
+			cancelled = true
+		}
+
+		st := t.Tasks.Stat(tid)
+		if st == nil {
+			return false, fmt.Errorf("unexpected error: migration task not found: %s", tid)
+		}
+
+		t.updateStat(vmname, func(s *EvacuationMachineStat) {
+			s.Progress = st.Progress
+		})
+
+		switch st.State {
+		case task.StateCompleted:
+			return false, nil
+		case task.StateFailed:
+			err := st.Err
+			if err == nil {
+				err = fmt.Errorf("%s", st.StateDesc)
+			}
+
+			// After switching to post-copy mode the machine
+			// cannot be migrated anywhere else
+			return !child.postCopyStarted() && !cancelled, fmt.Errorf("migration failed: %w", err)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package machine
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/0xef53/kvmrun/internal/guestagent"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	guestFSFreezeTimeout = 60 * time.Second
+	guestFSThawTimeout   = 30 * time.Second
+)
+
+// guestFSFreeze freezes the guest filesystems using the guest agent
+// and returns a function to thaw them. The thaw function must always be called,
+// even if the freeze failed, because some filesystems might have been frozen.
+func (s *Server) guestFSFreeze(vmname string, l *log.Entry) (func() error, error) {
+	thaw := func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), guestFSThawTimeout)
+		defer cancel()
+
+		// A new connection is used here, because the previous one
+		// could be broken by a freeze timeout. The agent processes
+		// commands sequentially, so the thaw will be executed
+		// after the freeze anyway. The thaw must not wait for
+		// other clients of the agent, so it interrupts them.
+		err := s.Agent.RunUrgent(ctx, vmname, func(c *guestagent.Client) error {
+			n, err := c.FSThaw(ctx)
+			if err != nil {
+				return err
+			}
+
+			l.Infof("Guest filesystems are thawed (count = %d)", n)
+
+			return nil
+		})
+
+		if err != nil {
+			return fmt.Errorf("guest-fsfreeze-thaw failed: %w", err)
+		}
+
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), guestFSFreezeTimeout)
+	defer cancel()
+
+	var connected bool
+
+	err := s.Agent.Run(ctx, vmname, func(c *guestagent.Client) error {
+		connected = true
+
+		n, err := c.FSFreeze(ctx)
+		if err != nil {
+			return err
+		}
+
+		l.Infof("Guest filesystems are frozen (count = %d)", n)
+
+		return nil
+	})
+
+	if err != nil {
+		if !connected {
+			// Nothing is frozen
+			return func() error { return nil }, fmt.Errorf("guest agent is not available: %w", err)
+		}
+
+		return thaw, fmt.Errorf("guest-fsfreeze-freeze failed: %w", err)
+	}
+
+	return thaw, nil
+}
@@ -10,7 +10,7 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
-func (s *Server) HostDeviceAttach(ctx context.Context, vmname string, opts *kvmrun.HostDeviceProperties, strict bool) error {
+func (s *Server) HostDeviceAttach(ctx context.Context, vmname string, opts *kvmrun.HostDeviceProperties, strict, live bool) error {
 	if opts == nil {
 		return fmt.Errorf("empty host-PCI-device opts")
 	} else {
@@ -19,17 +19,53 @@ func (s *Server) HostDeviceAttach(ctx context.Context, vmname string, opts *kvmr
 		}
 	}
 
-	err := s.TaskRunFunc(ctx, server.BlockConfOperations(vmname), true, nil, func(l *log.Entry) error {
-		vm, err := s.MachineGet(vmname, false)
+	// A config-only change does not interfere with operations
+	// on the running machine
+	targets := server.BlockConfOperations(vmname)
+
+	if live {
+		targets = server.BlockAnyOperations(vmname)
+	}
+
+	err := s.TaskRunFunc(ctx, targets, true, nil, func(l *log.Entry) error {
+		vm, err := s.MachineGet(vmname, live)
 		if err != nil {
 			return err
 		}
 
-		if err := vm.C.HostDeviceAppend(*opts); err != nil && !kvmrun.IsAlreadyConnectedError(err) {
+		// The configuration is changed first and rolled back
+		// if the device cannot be attached to the running machine
+		var appended bool
+
+		switch err := vm.C.HostDeviceAppend(*opts); {
+		case err == nil:
+			appended = true
+		case kvmrun.IsAlreadyConnectedError(err):
+		default:
+			return err
+		}
+
+		if err := vm.C.Save(); err != nil {
 			return err
 		}
 
-		return vm.C.Save()
+		if live && vm.R != nil {
+			if err := vm.R.HostDeviceAppend(*opts); err != nil && !kvmrun.IsAlreadyConnectedError(err) {
+				if appended {
+					if err := vm.C.HostDeviceRemove(opts.PCIAddr); err == nil {
+						if err := vm.C.Save(); err != nil {
+							l.Errorf("Failed to roll back the configuration: %s", err)
+						}
+					} else {
+						l.Errorf("Failed to roll back the configuration: %s", err)
+					}
+				}
+
+				return err
+			}
+		}
+
+		return nil
 	})
 
 	if err != nil {
@@ -39,18 +75,50 @@ func (s *Server) HostDeviceAttach(ctx context.Context, vmname string, opts *kvmr
 	return nil
 }
 
-func (s *Server) HostDeviceDetach(ctx context.Context, vmname, hexaddr string) error {
-	err := s.TaskRunFunc(ctx, server.BlockConfOperations(vmname), true, nil, func(l *log.Entry) error {
-		vm, err := s.MachineGet(vmname, false)
+func (s *Server) HostDeviceDetach(ctx context.Context, vmname, hexaddr string, live bool) error {
+	// A config-only change does not interfere with operations
+	// on the running machine
+	targets := server.BlockConfOperations(vmname)
+
+	if live {
+		targets = server.BlockAnyOperations(vmname)
+	}
+
+	err := s.TaskRunFunc(ctx, targets, true, nil, func(l *log.Entry) error {
+		vm, err := s.MachineGet(vmname, live)
 		if err != nil {
 			return err
 		}
 
+		// The configuration is changed first and rolled back
+		// if the device cannot be detached from the running machine
+		removed := vm.C.HostDeviceGet(hexaddr)
+
 		if err := vm.C.HostDeviceRemove(hexaddr); err != nil && !kvmrun.IsNotConnectedError(err) {
 			return err
 		}
 
-		return vm.C.Save()
+		if err := vm.C.Save(); err != nil {
+			return err
+		}
+
+		if live && vm.R != nil {
+			if err := vm.R.HostDeviceRemove(hexaddr); err != nil && !kvmrun.IsNotConnectedError(err) {
+				if removed != nil {
+					if err := vm.C.HostDeviceAppend(removed.HostDeviceProperties); err == nil {
+						if err := vm.C.Save(); err != nil {
+							l.Errorf("Failed to roll back the configuration: %s", err)
+						}
+					} else {
+						l.Errorf("Failed to roll back the configuration: %s", err)
+					}
+				}
+
+				return err
+			}
+		}
+
+		return nil
 	})
 
 	if err != nil {
@@ -8,6 +8,9 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -35,6 +38,8 @@ type MigrationOptions struct {
 	Overrides   MigrationOverrides `json:"overrides"`
 	CreateDisks bool               `json:"create_disks"`
 	RemoveAfter bool               `json:"remove_after"`
+	TLS         bool               `json:"tls"`
+	Tuning      MigrationTuning    `json:"tuning"`
 }
 
 func (o *MigrationOptions) Validate(strict bool) error {
@@ -48,6 +53,10 @@ func (o *MigrationOptions) Validate(strict bool) error {
 		return err
 	}
 
+	if err := o.Tuning.Validate(strict); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -83,6 +92,123 @@ func (o *MigrationOverrides) Validate(_ bool) error {
 	return nil
 }
 
+// MigrationTuning contains the parameters and capabilities
+// of the machine state migration. Zero values mean the defaults.
+type MigrationTuning struct {
+	// bytes per second
+	MaxBandwidth uint64 `json:"max_bandwidth"`
+	// milliseconds
+	DowntimeLimit      uint64 `json:"downtime_limit"`
+	MultifdChannels    uint32 `json:"multifd_channels"`
+	MultifdCompression string `json:"multifd_compression"`
+	DisableXbzrle      bool   `json:"disable_xbzrle"`
+	// bytes
+	XbzrleCacheSize     uint64 `json:"xbzrle_cache_size"`
+	DisableAutoConverge bool   `json:"disable_auto_converge"`
+	// percents
+	CPUThrottleInitial   uint32 `json:"cpu_throttle_initial"`
+	CPUThrottleIncrement uint32 `json:"cpu_throttle_increment"`
+	// PostCopy enables switching to the post-copy mode
+	// after the specified number of passes or seconds
+	// (whichever comes first). If neither is specified,
+	// the switch occurs after the first pass.
+	PostCopy             bool   `json:"postcopy"`
+	PostCopyAfterPasses  uint32 `json:"postcopy_after_passes"`
+	PostCopyAfterSeconds uint32 `json:"postcopy_after_seconds"`
+}
+
+const (
+	defaultMigrationMaxBandwidth    = 8 << 30   // 8 GiB/s
+	defaultMigrationXbzrleCacheSize = 512 << 20 // 512 MiB
+)
+
+func (o *MigrationTuning) Validate(_ bool) error {
+	o.MultifdCompression = strings.TrimSpace(o.MultifdCompression)
+
+	if o.MultifdChannels > 255 {
+		return fmt.Errorf("invalid number of multifd channels: must be in range from 1 to 255")
+	}
+
+	if len(o.MultifdCompression) > 0 && o.MultifdChannels == 0 {
+		return fmt.Errorf("compression requires multifd channels")
+	}
+
+	if o.XbzrleCacheSize > 0 {
+		if o.DisableXbzrle {
+			return fmt.Errorf("xbzrle cache size is specified, but xbzrle is disabled")
+		}
+
+		// XBZRLE is not compatible with multifd
+		if o.MultifdChannels > 0 {
+			return fmt.Errorf("xbzrle cannot be used together with multifd")
+		}
+	}
+
+	if o.CPUThrottleInitial > 99 {
+		return fmt.Errorf("invalid cpu-throttle-initial value: must be in range from 1 to 99")
+	}
+
+	if o.CPUThrottleIncrement > 99 {
+		return fmt.Errorf("invalid cpu-throttle-increment value: must be in range from 1 to 99")
+	}
+
+	if o.DisableAutoConverge && (o.CPUThrottleInitial > 0 || o.CPUThrottleIncrement > 0) {
+		return fmt.Errorf("cpu throttling parameters are specified, but auto-converge is disabled")
+	}
+
+	if o.PostCopy {
+		if o.MultifdChannels > 0 {
+			return fmt.Errorf("post-copy mode cannot be used together with multifd")
+		}
+	} else {
+		if o.PostCopyAfterPasses > 0 || o.PostCopyAfterSeconds > 0 {
+			return fmt.Errorf("post-copy switching parameters are specified, but post-copy mode is disabled")
+		}
+	}
+
+	return nil
+}
+
+// readyForPostCopy reports whether it's time to switch to the post-copy mode.
+// The dirty-sync-count is increased each time QEMU starts a new pass over RAM.
+func (o *MigrationTuning) readyForPostCopy(dirtySyncCount uint64, elapsed time.Duration) bool {
+	if !o.PostCopy {
+		return false
+	}
+
+	if o.PostCopyAfterSeconds > 0 && elapsed >= time.Duration(o.PostCopyAfterSeconds)*time.Second {
+		return true
+	}
+
+	passes := uint64(o.PostCopyAfterPasses)
+
+	if passes == 0 && o.PostCopyAfterSeconds == 0 {
+		passes = 1
+	}
+
+	return passes > 0 && dirtySyncCount > passes
+}
+
+// useXbzrle reports whether the XBZRLE capability should be enabled.
+// XBZRLE is enabled by default unless multifd is used.
+func (o *MigrationTuning) useXbzrle() bool {
+	return !o.DisableXbzrle && o.MultifdChannels == 0
+}
+
+// capabilities returns the desired states of the migration capabilities.
+func (o *MigrationTuning) capabilities() map[string]bool {
+	return map[string]bool{
+		"xbzrle":        o.useXbzrle(),
+		"auto-converge": !o.DisableAutoConverge,
+		"multifd":       o.MultifdChannels > 0,
+		"dirty-bitmaps": true,
+		"postcopy-ram":  o.PostCopy,
+		// These ones are deprecated and were removed in QEMU 9.0
+		"compress": false,
+		"block":    false,
+	}
+}
+
 func (s *Server) StartMigrationProcess(ctx context.Context, vmname, dstServer string, opts *MigrationOptions) (string, error) {
 	if opts == nil {
 		return "", fmt.Errorf("empty migration opts")
@@ -107,9 +233,11 @@ func (s *Server) StartMigrationProcess(ctx context.Context, vmname, dstServer st
 }
 
 type MachineMigrationStatDetails struct {
-	DstServer string
-	VMState   *DataTransferStat
-	Disks     map[string]*DataTransferStat
+	DstServer        string
+	VMState          *DataTransferStat
+	Disks            map[string]*DataTransferStat
+	PostCopyActive   bool
+	PostCopyRequests uint64
 }
 
 type MachineMigrationTask struct {
@@ -129,6 +257,8 @@ type MachineMigrationTask struct {
 	movingDisks   []*kvmrun.Disk
 	dstServerAddr net.IP
 	turnOffAfter  bool
+	tlsCredsID    string
+	postcopy      bool
 	requisites    *pb_types.IncomingMigrationRequisites
 
 	details *MachineMigrationStatDetails
@@ -150,6 +280,44 @@ func NewMachineMigrationTask(vmname, dstServer string, opts *MigrationOptions) *
 func (t *MachineMigrationTask) Targets() map[string]task.OperationMode { return t.targets }
 
 func (t *MachineMigrationTask) BeforeStart(_ interface{}) error {
+	if err := t.prepare(); err != nil {
+		return err
+	}
+
+	// The same credentials are used to encrypt both the state stream
+	// and the NBD connections that transfer the disk contents
+	if t.opts.TLS {
+		if id, err := t.MachineTLSCredsAdd(t.vmname, t.vm.C.UID(), kvmrun.TLSEndpointClient); err == nil {
+			t.tlsCredsID = id
+		} else {
+			return err
+		}
+	}
+
+	// Incoming migration process on DST server
+	if r, err := t.requestIncomingMigration(); err == nil {
+		t.requisites = r
+	} else {
+		return fmt.Errorf("failed to request incoming migration: %w", err)
+	}
+
+	// Init stat fields
+	t.details = &MachineMigrationStatDetails{
+		DstServer: t.dstServer,
+		VMState:   new(DataTransferStat),
+		Disks:     make(map[string]*DataTransferStat),
+	}
+	for _, d := range t.movingDisks {
+		t.details.Disks[d.Path] = &DataTransferStat{Total: d.QemuVirtualSize}
+	}
+
+	return nil
+}
+
+// prepare validates the options and the machine state
+// and fills the auxiliary fields of the task.
+// It doesn't change anything either on this or on the destination server.
+func (t *MachineMigrationTask) prepare() error {
 	if t.opts == nil {
 		return fmt.Errorf("empty migration opts")
 	} else {
@@ -183,6 +351,10 @@ func (t *MachineMigrationTask) BeforeStart(_ interface{}) error {
 		return fmt.Errorf("incompatible machine state: %d", vmstate)
 	}
 
+	if err := t.checkMigrationTuning(); err != nil {
+		return err
+	}
+
 	if ips, err := net.LookupIP(t.dstServer); err == nil {
 		t.dstServerAddr = ips[0]
 	} else {
@@ -198,9 +370,35 @@ func (t *MachineMigrationTask) BeforeStart(_ interface{}) error {
 			return &kvmrun.NotConnectedError{Source: "instance_qemu", Object: dname}
 		}
 
+		// Disks on the shared storage (such as iSCSI or RBD)
+		// are available on the destination server as is
+		if !d.IsLocal() {
+			return fmt.Errorf("disk is located on shared storage and cannot be moved: %s", d.Path)
+		}
+
 		t.movingDisks = append(t.movingDisks, d)
 	}
 
+	// Snapshot overlays are stored on the local file system.
+	// The mirroring job copies the entire snapshot chain
+	// into the destination disk, so the snapshots are not preserved.
+	for _, d := range t.vm.R.DiskGetList() {
+		if len(d.Snapshots) == 0 {
+			continue
+		}
+
+		if !slices.ContainsFunc(t.movingDisks, func(x *kvmrun.Disk) bool { return x.Path == d.Path }) {
+			return fmt.Errorf("disk has local snapshots and must be migrated with storage: %s", d.Path)
+		}
+	}
+
+	// The disk mirroring jobs are completed only after the machine state
+	// has been migrated. In the post-copy mode the machine starts running
+	// on the destination server before that moment.
+	if t.opts.Tuning.PostCopy && (len(t.movingDisks) > 0 || t.vm.C.FirmwareGetFlash() != nil) {
+		return fmt.Errorf("post-copy mode cannot be used together with storage migration")
+	}
+
 	// The keys of t.opts.Overrides.Disks may be presented as short names
 	// and must be converted to fully qualified notation.
 	// The values must be specified as fully qualified names as well.
@@ -218,23 +416,6 @@ func (t *MachineMigrationTask) BeforeStart(_ interface{}) error {
 		t.opts.Overrides.Disks = valid
 	}
 
-	// Incoming migration process on DST server
-	if r, err := t.requestIncomingMigration(); err == nil {
-		t.requisites = r
-	} else {
-		return fmt.Errorf("failed to request incoming migration: %w", err)
-	}
-
-	// Init stat fields
-	t.details = &MachineMigrationStatDetails{
-		DstServer: t.dstServer,
-		VMState:   new(DataTransferStat),
-		Disks:     make(map[string]*DataTransferStat),
-	}
-	for _, d := range t.movingDisks {
-		t.details.Disks[d.Path] = &DataTransferStat{Total: d.QemuVirtualSize}
-	}
-
 	return nil
 }
 
@@ -425,6 +606,17 @@ func (t *MachineMigrationTask) OnSuccess() error {
 }
 
 func (t *MachineMigrationTask) OnFailure(taskErr error) {
+	if t.postCopyStarted() {
+		// The point of no return has been passed. The source machine
+		// is paused and its RAM is out of date, so it must not be resumed.
+		// The destination machine contains the only up-to-date state
+		// of the guest, so it must not be terminated as well.
+		t.Logger.Errorf("OnFailureHook: post-copy migration failed after the point of no return: %s", taskErr)
+		t.Logger.Errorf("OnFailureHook: the source machine is left paused, the destination machine is left as is on %s; a manual recovery is required (see migrate-recover)", t.dstServer)
+
+		return
+	}
+
 	for _, d := range t.movingDisks {
 		opts := struct {
 			Device string `json:"device"`
@@ -476,59 +668,15 @@ func (t *MachineMigrationTask) migrateMachineState(ctx context.Context, allDisks
 
 	t.Logger.Debug("migrateVMState(): disks are synchronized")
 
-	var err error
-
-	// Set capabilities
-	err = func() error {
-		t.Logger.Debug("migrateVMState(): run QMP command: migrate-set-capabilities")
-
-		/*
-			TODO: need to improve
-				* first get a list,
-				* then change only specified parameters
-				* and then apply it uning migrate-set-capabilities
-		*/
-
-		args := struct {
-			Capabilities []qemu_types.MigrationCapabilityStatus `json:"capabilities"`
-		}{
-			Capabilities: []qemu_types.MigrationCapabilityStatus{
-				{Capability: "xbzrle", State: true},
-				{Capability: "auto-converge", State: true},
-				{Capability: "dirty-bitmaps", State: true},
-			},
-		}
-
-		if t.vm.R.QemuVersion().Int() < 90000 { // < 9.x.x
-			args.Capabilities = append(args.Capabilities, qemu_types.MigrationCapabilityStatus{Capability: "compress", State: false})
-			args.Capabilities = append(args.Capabilities, qemu_types.MigrationCapabilityStatus{Capability: "block", State: false})
-		}
+	t.Logger.Debug("migrateVMState(): run QMP command: migrate-set-capabilities")
 
-		return t.Mon.Run(t.vmname, qmp.Command{Name: "migrate-set-capabilities", Arguments: &args}, nil)
-	}()
-	if err != nil {
+	if err := t.setMigrationCapabilities(); err != nil {
 		return err
 	}
 
-	// Parameters
-	err = func() error {
-		t.Logger.Debug("migrateVMState(): run QMP command: migrate-set-parameters")
-
-		/*
-			TODO: need to improve
-				* first get a list,
-				* then change only specified parameters
-				* and then apply it uning migrate-set-parameters
-		*/
+	t.Logger.Debug("migrateVMState(): run QMP command: migrate-set-parameters")
 
-		args := qemu_types.MigrateSetParameters{
-			MaxBandwidth:    8589934592,
-			XbzrleCacheSize: 536870912,
-		}
-
-		return t.Mon.Run(t.vmname, qmp.Command{Name: "migrate-set-parameters", Arguments: &args}, nil)
-	}()
-	if err != nil {
+	if err := t.setMigrationParameters(); err != nil {
 		return err
 	}
 
@@ -545,17 +693,28 @@ func (t *MachineMigrationTask) migrateMachineState(ctx context.Context, allDisks
 		return err
 	}
 
+	startTime := time.Now()
+
 LOOP:
 	for {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		default:
+		// After switching to the post-copy mode the destination machine
+		// contains the only up-to-date state of the guest,
+		// so the migration cannot be interrupted anymore.
+		if !t.postCopyStarted() {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			default:
+			}
 		}
 
 		mi := &qemu_types.MigrationInfo{}
 
 		if err := t.Server.Mon.Run(t.vmname, qmp.Command{Name: "query-migrate", Arguments: nil}, mi); err != nil {
+			if t.postCopyStarted() {
+				return &postCopyError{Err: err}
+			}
+
 			return err
 		}
 
@@ -565,10 +724,27 @@ LOOP:
 		}
 
 		switch mi.Status {
-		case "active", "postcopy-active":
+		case "active":
+			if !t.postCopyStarted() && t.opts.Tuning.readyForPostCopy(mi.Ram.DirtySyncCount, time.Since(startTime)) {
+				t.Logger.Info("Switch to the post-copy mode")
+
+				if err := t.Server.Mon.Run(t.vmname, qmp.Command{Name: "migrate-start-postcopy", Arguments: nil}, nil); err != nil {
+					return err
+				}
+
+				t.setPostCopyStarted()
+			}
+		case "postcopy-active":
+			t.updatePostCopyStat(mi.Ram.PostcopyRequests)
 		case "completed":
 			break LOOP
+		case "postcopy-paused":
+			return &postCopyError{Err: fmt.Errorf("QEMU migration paused: the connection to the destination server was lost")}
 		case "failed":
+			if t.postCopyStarted() {
+				return &postCopyError{Err: fmt.Errorf("QEMU migration failed: %s", mi.ErrDesc)}
+			}
+
 			return fmt.Errorf("QEMU migration failed: %s", mi.ErrDesc)
 		case "cancelled":
 			return fmt.Errorf("QEMU migration cancelled by QMP command")
@@ -583,6 +759,58 @@ LOOP:
 }
 
 func (t *MachineMigrationTask) requestIncomingMigration() (*pb_types.IncomingMigrationRequisites, error) {
+	manifest, diskSizes, extraFiles, err := t.incomingManifest()
+	if err != nil {
+		return nil, err
+	}
+
+	req := pb_system.StartIncomingMigrationRequest{
+		Name:         t.vmname,
+		Manifest:     manifest,
+		ListenAddr:   t.dstServerAddr.String(),
+		Disks:        diskSizes,
+		CreateDisks:  t.opts.CreateDisks,
+		ExtraFiles:   extraFiles,
+		TurnOffAfter: t.turnOffAfter,
+		Tls:          t.opts.TLS,
+	}
+
+	// Only multifd and post-copy settings must match on both sides
+	if t.opts.Tuning.MultifdChannels > 0 || t.opts.Tuning.PostCopy {
+		req.Tuning = &pb_types.MigrationTuning{
+			MultifdChannels:    t.opts.Tuning.MultifdChannels,
+			MultifdCompression: t.opts.Tuning.MultifdCompression,
+			Postcopy:           t.opts.Tuning.PostCopy,
+		}
+	}
+
+	if len(t.opts.Overrides.Name) > 0 {
+		req.Name = t.opts.Overrides.Name
+	}
+
+	var requisites *pb_types.IncomingMigrationRequisites
+
+	err = t.Server.KvmrunGRPC(t.dstServer, func(client *grpc_interfaces.Kvmrun) error {
+		resp, err := client.System().StartIncomingMigration(t.Ctx(), &req)
+		if err != nil {
+			return err
+		}
+
+		requisites = resp.Requisites
+
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return requisites, nil
+}
+
+// incomingManifest returns the machine manifest with the overrides applied,
+// the sizes of the disks to be moved and the extra configuration files.
+func (t *MachineMigrationTask) incomingManifest() ([]byte, map[string]uint64, map[string][]byte, error) {
 	diskSizes := make(map[string]uint64)
 
 	// The keys in Overrides.Disks are already converted to full name
@@ -617,6 +845,18 @@ func (t *MachineMigrationTask) requestIncomingMigration() (*pb_types.IncomingMig
 			hasOverrides = true
 		}
 
+		// The snapshot chains of the moving disks are flattened
+		// on the destination server
+		for _, d := range t.movingDisks {
+			for _, x := range append(tmp.C.Disks.Values(d.Path), tmp.R.Disks.Values(d.Path)...) {
+				if len(x.Snapshots) > 0 {
+					x.Snapshots = nil
+
+					hasOverrides = true
+				}
+			}
+		}
+
 		if len(t.opts.Overrides.Disks) > 0 {
 			for _, d := range tmp.C.Disks.Values() {
 				if ovrd, ok := t.opts.Overrides.Disks[d.Path]; ok {
@@ -656,58 +896,29 @@ func (t *MachineMigrationTask) requestIncomingMigration() (*pb_types.IncomingMig
 		return json.Marshal(t.vm)
 	}()
 	if err != nil {
-		return nil, err
+		return nil, nil, nil, err
 	}
 
 	// Some extra files that may contain additional
 	// configuration such as network settings, EFI boot, cloud-init etc.
 	extraFiles, err := t.extraFiles()
 	if err != nil {
-		return nil, err
-	}
-
-	req := pb_system.StartIncomingMigrationRequest{
-		Name:         t.vmname,
-		Manifest:     manifest,
-		ListenAddr:   t.dstServerAddr.String(),
-		Disks:        diskSizes,
-		CreateDisks:  t.opts.CreateDisks,
-		ExtraFiles:   extraFiles,
-		TurnOffAfter: t.turnOffAfter,
-	}
-
-	if len(t.opts.Overrides.Name) > 0 {
-		req.Name = t.opts.Overrides.Name
-	}
-
-	var requisites *pb_types.IncomingMigrationRequisites
-
-	err = t.Server.KvmrunGRPC(t.dstServer, func(client *grpc_interfaces.Kvmrun) error {
-		resp, err := client.System().StartIncomingMigration(t.Ctx(), &req)
-		if err != nil {
-			return err
-		}
-
-		requisites = resp.Requisites
-
-		return err
-	})
-
-	if err != nil {
-		return nil, err
+		return nil, nil, nil, err
 	}
 
-	return requisites, nil
+	return manifest, diskSizes, extraFiles, nil
 }
 
 // extraFiles returns a map including the contents of some extra files
 // placed in the virtual machine directory.
 // These files may contain additional configuration such as network settings
-// (config_network).
+// (config_network), backup policy and catalog, and the backup states.
 func (t *MachineMigrationTask) extraFiles() (map[string][]byte, error) {
-	r := regexp.MustCompile(`^(extra|comment|cloudinit_drive|ci_drive|config_[[:alnum:]]*|[\.\_[:alnum:]]*_config)$`)
+	return readExtraFiles(filepath.Join(kvmrun.CONFDIR, t.vmname))
+}
 
-	vmdir := filepath.Join(kvmrun.CONFDIR, t.vmname)
+func readExtraFiles(vmdir string) (map[string][]byte, error) {
+	r := regexp.MustCompile(`^(extra|comment|cloudinit_drive|ci_drive|config_[[:alnum:]]*|[\.\_[:alnum:]]*_config|backup_policy\.json|backup_catalog\.json)$`)
 
 	files, err := os.ReadDir(vmdir)
 	if err != nil {
@@ -729,6 +940,41 @@ func (t *MachineMigrationTask) extraFiles() (map[string][]byte, error) {
 		extraFiles[f.Name()] = c
 	}
 
+	// The backup states are transferred as broken: non-persistent bitmaps
+	// do not survive the migration, and the images of the backup chains
+	// remain on this server. So the next backup will be a full one.
+	states, err := os.ReadDir(filepath.Join(vmdir, "backups"))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	for _, f := range states {
+		if !f.Type().IsRegular() || filepath.Ext(f.Name()) != ".json" {
+			continue
+		}
+
+		c, err := os.ReadFile(filepath.Join(vmdir, "backups", f.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		st := kvmrun.BackupState{}
+
+		if err := json.Unmarshal(c, &st); err != nil {
+			return nil, fmt.Errorf("invalid backup state: %s: %w", f.Name(), err)
+		}
+
+		if len(st.Broken) == 0 {
+			st.Broken = "machine has been migrated"
+		}
+
+		if b, err := json.MarshalIndent(&st, "", "    "); err == nil {
+			extraFiles[filepath.Join("backups", f.Name())] = append(b, '\n')
+		} else {
+			return nil, err
+		}
+	}
+
 	return extraFiles, nil
 }
 
@@ -771,6 +1017,8 @@ func (p *machineMigrationTask_StorageMirroringProcessor) run() (err error) {
 
 	p.logger.Infof("Start disks mirroring process (%s)", strings.Join(diskNames, ", "))
 
+	defer p.t.Server.TaskBlockJobsRemove(p.t.vmname + "/migration")
+
 	defer func() {
 		if err == nil {
 			p.logger.Infof("Disks mirroring process completed (%s)", strings.Join(diskNames, ", "))
@@ -799,12 +1047,18 @@ func (p *machineMigrationTask_StorageMirroringProcessor) run() (err error) {
 
 		ts := time.Now()
 
-		args := p.newMirrorOpts(d.BaseName(), dstName)
+		args, err := p.newMirrorOpts(d.BaseName(), dstName)
+		if err != nil {
+			return err
+		}
 
 		if err := p.t.Server.Mon.Run(p.t.vmname, qmp.Command{Name: "drive-mirror", Arguments: args}, nil); err != nil {
 			return fmt.Errorf("failed to start mirroring (%s): %w", d.BaseName(), err)
 		}
 
+		// The mirroring can be paused, resumed or throttled using the task key
+		p.t.Server.TaskBlockJobAdd(p.t.vmname+"/migration", p.t, p.t.vmname, args.JobID)
+
 		group1.Go(func() error { return p.waitForReady(ctx1, ts, d) })
 	}
 
@@ -1001,11 +1255,11 @@ func (p *machineMigrationTask_StorageMirroringProcessor) waitForCompleted(ctx co
 	return nil
 }
 
-func (p *machineMigrationTask_StorageMirroringProcessor) newMirrorOpts(srcName, dstName string) *qemu_types.DriveMirrorOptions {
+func (p *machineMigrationTask_StorageMirroringProcessor) newMirrorOpts(srcName, dstName string) (*qemu_types.DriveMirrorOptions, error) {
 	opts := qemu_types.DriveMirrorOptions{
 		JobID:  fmt.Sprintf("migr_%s", srcName),
 		Device: srcName,
-		Target: fmt.Sprintf("nbd:%s:%d:exportname=%s", p.t.dstServerAddr.String(), int(p.t.requisites.NBDPort), dstName),
+		Target: fmt.Sprintf("nbd:%s:%d:exportname=%s", p.t.dstServerAddr.String(), p.t.requisites.NBDPort, dstName),
 		Format: "nbd",
 		Sync:   "full",
 		Mode:   "existing",
@@ -1015,5 +1269,213 @@ func (p *machineMigrationTask_StorageMirroringProcessor) newMirrorOpts(srcName,
 		opts.CopyMode = "write-blocking"
 	}
 
-	return &opts
+	if len(p.t.tlsCredsID) == 0 {
+		return &opts, nil
+	}
+
+	target := qemu_types.NBDBlockdevOptions{
+		Driver: "nbd",
+		Server: qemu_types.InetSocketAddress{
+			Type: "inet",
+			Host: p.t.dstServerAddr.String(),
+			Port: strconv.Itoa(int(p.t.requisites.NBDPort)),
+		},
+		Export:   dstName,
+		TLSCreds: p.t.tlsCredsID,
+	}
+
+	if p.t.vm.R.QemuVersion().Int() >= 70000 { // >= 7.x.x
+		// The server certificate is issued for the host name
+		// rather than for the resolved address
+		target.TLSHostname = p.t.dstServer
+	}
+
+	// The json: pseudo-protocol is the only way
+	// to pass the TLS credentials to the target
+	b, err := json.Marshal(&target)
+	if err != nil {
+		return nil, err
+	}
+
+	opts.Target = "json:" + string(b)
+
+	return &opts, nil
+}
+
+// postCopyError is returned when the migration fails after switching
+// to the post-copy mode.
+type postCopyError struct {
+	Err error
+}
+
+func (e *postCopyError) Error() string {
+	return "post-copy migration failed, manual recovery is required: " + e.Err.Error()
+}
+
+func (e *postCopyError) Unwrap() error {
+	return e.Err
+}
+
+func (t *MachineMigrationTask) postCopyStarted() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.postcopy
+}
+
+func (t *MachineMigrationTask) setPostCopyStarted() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.postcopy = true
+}
+
+func (t *MachineMigrationTask) updatePostCopyStat(requests uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.details.PostCopyActive = true
+	t.details.PostCopyRequests = requests
+}
+
+// queryMigrationCapabilities returns the migration capabilities
+// reported by the QEMU instance.
+func (t *MachineMigrationTask) queryMigrationCapabilities() (map[string]bool, error) {
+	caps := make([]qemu_types.MigrationCapabilityStatus, 0, 32)
+
+	if err := t.Mon.Run(t.vmname, qmp.Command{Name: "query-migrate-capabilities", Arguments: nil}, &caps); err != nil {
+		return nil, err
+	}
+
+	m := make(map[string]bool, len(caps))
+
+	for _, c := range caps {
+		m[c.Capability] = c.State
+	}
+
+	return m, nil
+}
+
+// checkMigrationTuning validates the requested tuning
+// against the capabilities of the source QEMU instance.
+func (t *MachineMigrationTask) checkMigrationTuning() error {
+	caps, err := t.queryMigrationCapabilities()
+	if err != nil {
+		return err
+	}
+
+	for name, state := range t.opts.Tuning.capabilities() {
+		if _, ok := caps[name]; !ok && state {
+			return fmt.Errorf("migration capability is not supported by QEMU: %s", name)
+		}
+	}
+
+	if len(t.opts.Tuning.MultifdCompression) > 0 {
+		var params qemu_types.MigrationParameters
+
+		if err := t.Mon.Run(t.vmname, qmp.Command{Name: "query-migrate-parameters", Arguments: nil}, &params); err != nil {
+			return err
+		}
+
+		if len(params.MultifdCompression) == 0 {
+			return fmt.Errorf("multifd compression is not supported by QEMU")
+		}
+	}
+
+	return nil
+}
+
+// setMigrationCapabilities changes only those capabilities
+// whose current state differs from the desired one.
+func (t *MachineMigrationTask) setMigrationCapabilities() error {
+	current, err := t.queryMigrationCapabilities()
+	if err != nil {
+		return err
+	}
+
+	changed := make([]qemu_types.MigrationCapabilityStatus, 0, 8)
+
+	for name, state := range t.opts.Tuning.capabilities() {
+		if v, ok := current[name]; ok && v != state {
+			changed = append(changed, qemu_types.MigrationCapabilityStatus{Capability: name, State: state})
+		} else if !ok && state {
+			return fmt.Errorf("migration capability is not supported by QEMU: %s", name)
+		}
+	}
+
+	if len(changed) == 0 {
+		return nil
+	}
+
+	sort.Slice(changed, func(i, j int) bool {
+		return changed[i].Capability < changed[j].Capability
+	})
+
+	args := struct {
+		Capabilities []qemu_types.MigrationCapabilityStatus `json:"capabilities"`
+	}{
+		Capabilities: changed,
+	}
+
+	return t.Mon.Run(t.vmname, qmp.Command{Name: "migrate-set-capabilities", Arguments: &args}, nil)
+}
+
+// setMigrationParameters reads the current migration parameters,
+// changes the requested ones and writes them back.
+func (t *MachineMigrationTask) setMigrationParameters() error {
+	var params qemu_types.MigrationParameters
+
+	if err := t.Mon.Run(t.vmname, qmp.Command{Name: "query-migrate-parameters", Arguments: nil}, &params); err != nil {
+		return err
+	}
+
+	tuning := &t.opts.Tuning
+
+	if tuning.MaxBandwidth > 0 {
+		params.MaxBandwidth = tuning.MaxBandwidth
+	} else {
+		params.MaxBandwidth = defaultMigrationMaxBandwidth
+	}
+
+	if tuning.DowntimeLimit > 0 {
+		params.DowntimeLimit = tuning.DowntimeLimit
+	}
+
+	if tuning.useXbzrle() {
+		if tuning.XbzrleCacheSize > 0 {
+			params.XbzrleCacheSize = tuning.XbzrleCacheSize
+		} else {
+			params.XbzrleCacheSize = defaultMigrationXbzrleCacheSize
+		}
+	}
+
+	if tuning.MultifdChannels > 0 {
+		params.MultifdChannels = tuning.MultifdChannels
+
+		if len(tuning.MultifdCompression) > 0 {
+			params.MultifdCompression = tuning.MultifdCompression
+		}
+	}
+
+	if !tuning.DisableAutoConverge {
+		if tuning.CPUThrottleInitial > 0 {
+			params.CPUThrottleInitial = tuning.CPUThrottleInitial
+		}
+
+		if tuning.CPUThrottleIncrement > 0 {
+			params.CPUThrottleIncrement = tuning.CPUThrottleIncrement
+		}
+	}
+
+	// The empty value of TLSCreds disables the encryption
+	// that may have been left since the previous migration
+	if t.opts.TLS {
+		params.TLSCreds = t.tlsCredsID
+		params.TLSHostname = t.dstServer
+	} else {
+		params.TLSCreds = ""
+		params.TLSHostname = ""
+	}
+
+	return t.Mon.Run(t.vmname, qmp.Command{Name: "migrate-set-parameters", Arguments: &params}, nil)
 }
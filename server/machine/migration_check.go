@@ -0,0 +1,97 @@
+package machine
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/0xef53/kvmrun/internal/version"
+
+	pb_system "github.com/0xef53/kvmrun/api/services/system/v2"
+
+	grpc_interfaces "github.com/0xef53/kvmrun/internal/grpc/interfaces"
+)
+
+type MigrationCheckIssue struct {
+	Subject string `json:"subject"`
+	Desc    string `json:"desc"`
+}
+
+type MigrationCheckResult struct {
+	Blockers []*MigrationCheckIssue `json:"blockers"`
+	Warnings []*MigrationCheckIssue `json:"warnings"`
+}
+
+// CheckMigration performs a pre-flight check of the migration
+// without starting anything on either server.
+// All problems found are returned as a list of blockers and warnings.
+func (s *Server) CheckMigration(ctx context.Context, vmname, dstServer string, opts *MigrationOptions) (*MigrationCheckResult, error) {
+	if opts == nil {
+		return nil, fmt.Errorf("empty migration opts")
+	}
+
+	res := MigrationCheckResult{
+		Blockers: make([]*MigrationCheckIssue, 0),
+		Warnings: make([]*MigrationCheckIssue, 0),
+	}
+
+	t := NewMachineMigrationTask(vmname, dstServer, opts)
+
+	t.Server = s
+
+	// The same checks as before the migration start
+	if err := t.prepare(); err != nil {
+		res.Blockers = append(res.Blockers, &MigrationCheckIssue{Subject: "source", Desc: err.Error()})
+
+		return &res, nil
+	}
+
+	manifest, diskSizes, extraFiles, err := t.incomingManifest()
+	if err != nil {
+		return nil, err
+	}
+
+	req := pb_system.CheckIncomingMigrationRequest{
+		Name:        vmname,
+		Manifest:    manifest,
+		Disks:       diskSizes,
+		ExtraFiles:  extraFiles,
+		CreateDisks: opts.CreateDisks,
+	}
+
+	if len(opts.Overrides.Name) > 0 {
+		req.Name = opts.Overrides.Name
+	}
+
+	var resp *pb_system.CheckIncomingMigrationResponse
+
+	err = s.KvmrunGRPC(dstServer, func(client *grpc_interfaces.Kvmrun) (err error) {
+		resp, err = client.System().CheckIncomingMigration(ctx, &req)
+
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to request the destination server: %w", err)
+	}
+
+	for _, issue := range resp.Blockers {
+		res.Blockers = append(res.Blockers, &MigrationCheckIssue{Subject: issue.Subject, Desc: issue.Desc})
+	}
+
+	for _, issue := range resp.Warnings {
+		res.Warnings = append(res.Warnings, &MigrationCheckIssue{Subject: issue.Subject, Desc: issue.Desc})
+	}
+
+	// Migration to an older QEMU version is not guaranteed to work
+	if len(resp.QemuVersion) > 0 {
+		if dstVer, err := version.Parse(resp.QemuVersion); err == nil {
+			if srcVer := t.vm.R.QemuVersion(); dstVer.Int() < srcVer.Int() {
+				res.Warnings = append(res.Warnings, &MigrationCheckIssue{
+					Subject: "qemu",
+					Desc:    fmt.Sprintf("destination QEMU version is older than the source one: %s < %s", dstVer, srcVer),
+				})
+			}
+		}
+	}
+
+	return &res, nil
+}
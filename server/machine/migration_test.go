@@ -0,0 +1,93 @@
+package machine
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/0xef53/kvmrun/kvmrun"
+)
+
+func TestReadExtraFiles(t *testing.T) {
+	vmdir := t.TempDir()
+
+	files := map[string]string{
+		"config":              "{}",
+		"extra":               "extra",
+		"comment":             "comment",
+		"config_network":      "network",
+		"ci_drive":            "/path/to/ci.img",
+		"backup_policy.json":  `{"schedule": "0 3 * * *"}`,
+		"backup_catalog.json": `{"backups": []}`,
+		"backups/vda.json":    `{"disk": "/dev/vg0/vm_disk", "images": ["/backups/vda.full.qcow2"]}`,
+		"backups/vdb.json":    `{"disk": "/dev/vg0/vm_disk_b", "broken": "disk has been resized"}`,
+		"backups/vdc.tmp":     "garbage",
+		"unknown.json":        "{}",
+		"config.lock":         "",
+	}
+
+	for fname, content := range files {
+		fname = filepath.Join(vmdir, fname)
+
+		if err := os.MkdirAll(filepath.Dir(fname), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(fname, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	extraFiles, err := readExtraFiles(vmdir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := make([]string, 0, len(extraFiles))
+
+	for fname := range extraFiles {
+		got = append(got, fname)
+	}
+
+	sort.Strings(got)
+
+	want := []string{
+		"backup_catalog.json",
+		"backup_policy.json",
+		"backups/vda.json",
+		"backups/vdb.json",
+		"ci_drive",
+		"comment",
+		"config_network",
+		"extra",
+	}
+
+	if strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Fatalf("got unexpected list of files:\nwant %q\ngot  %q", want, got)
+	}
+
+	if s := string(extraFiles["backup_policy.json"]); s != files["backup_policy.json"] {
+		t.Fatalf("got unexpected content of backup_policy.json: %s", s)
+	}
+
+	// The next backup on the destination server must be a full one
+	testCases := map[string]string{
+		"backups/vda.json": "machine has been migrated",
+		"backups/vdb.json": "disk has been resized",
+	}
+
+	for fname, want := range testCases {
+		st := kvmrun.BackupState{}
+
+		if err := json.Unmarshal(extraFiles[fname], &st); err != nil {
+			t.Fatal(err)
+		}
+
+		if st.Broken != want {
+			t.Fatalf("got unexpected state of %s: want broken = %q, got %q", fname, want, st.Broken)
+		}
+	}
+}
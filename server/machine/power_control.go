@@ -2,22 +2,35 @@ package machine
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/0xef53/kvmrun/kvmrun"
 	"github.com/0xef53/kvmrun/server"
 
+	qmp "github.com/0xef53/go-qmp/v2"
 	log "github.com/sirupsen/logrus"
 )
 
-func (s *Server) Start(ctx context.Context, vmname string, waitTime time.Duration) error {
+func (s *Server) Start(ctx context.Context, vmname string, waitTime time.Duration, discardSavedState bool) error {
 	if _, err := kvmrun.GetInstanceConf(vmname); err != nil {
 		return err
 	}
 
+	if s.HostIsDrained() {
+		return fmt.Errorf("%w: new machines cannot be started", kvmrun.ErrHostDrained)
+	}
+
 	return s.TaskRunFunc(ctx, server.BlockAnyOperations(vmname), true, nil, func(l *log.Entry) error {
 		s.MachineRemoveDownFile(vmname)
 
+		// The machine will boot from scratch
+		if discardSavedState {
+			if err := kvmrun.RemoveSavedState(vmname); err != nil {
+				return err
+			}
+		}
+
 		return s.SystemdStartService(vmname, waitTime)
 	})
 }
@@ -80,3 +93,62 @@ func (s *Server) Reset(ctx context.Context, vmname string) error {
 		return s.SystemdStartService(vmname, 0)
 	})
 }
+
+func (s *Server) Pause(ctx context.Context, vmname string) error {
+	return s.TaskRunFunc(ctx, server.BlockAnyOperations(vmname), true, nil, func(l *log.Entry) error {
+		switch vmstate, err := s.machineGetRunningStatus(vmname); {
+		case err != nil:
+			return err
+		case vmstate == kvmrun.StatePaused:
+			// Already paused
+			return nil
+		case vmstate != kvmrun.StateRunning:
+			return fmt.Errorf("incompatible machine state: %d", vmstate)
+		}
+
+		if err := s.Mon.Run(vmname, qmp.Command{Name: "stop", Arguments: nil}, nil); err != nil {
+			return err
+		}
+
+		l.Info("Machine has been paused")
+
+		return nil
+	})
+}
+
+func (s *Server) Resume(ctx context.Context, vmname string) error {
+	return s.TaskRunFunc(ctx, server.BlockAnyOperations(vmname), true, nil, func(l *log.Entry) error {
+		switch vmstate, err := s.machineGetRunningStatus(vmname); {
+		case err != nil:
+			return err
+		case vmstate == kvmrun.StateRunning:
+			// Already running
+			return nil
+		case vmstate != kvmrun.StatePaused:
+			return fmt.Errorf("incompatible machine state: %d", vmstate)
+		}
+
+		if err := s.Mon.Run(vmname, qmp.Command{Name: "cont", Arguments: nil}, nil); err != nil {
+			return err
+		}
+
+		l.Info("Machine has been resumed")
+
+		return nil
+	})
+}
+
+// machineGetRunningStatus returns the status of the machine
+// that has a running QEMU process.
+func (s *Server) machineGetRunningStatus(vmname string) (kvmrun.InstanceState, error) {
+	vm, err := s.MachineGet(vmname, true)
+	if err != nil {
+		return kvmrun.StateNoState, err
+	}
+
+	if vm.R == nil {
+		return kvmrun.StateNoState, fmt.Errorf("%w: %s", kvmrun.ErrNotRunning, vmname)
+	}
+
+	return s.MachineGetStatus(vm)
+}
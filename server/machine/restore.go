@@ -0,0 +1,528 @@
+package machine
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+
+	"github.com/0xef53/kvmrun/internal/qemu"
+	"github.com/0xef53/kvmrun/kvmrun"
+	"github.com/0xef53/kvmrun/kvmrun/backend/block"
+	"github.com/0xef53/kvmrun/kvmrun/backend/file"
+	"github.com/0xef53/kvmrun/kvmrun/backend/nbd"
+	"github.com/0xef53/kvmrun/server"
+
+	"github.com/0xef53/go-task"
+
+	log "github.com/sirupsen/logrus"
+)
+
+type DiskRestoreOptions struct {
+	DiskName string `json:"disk_name"`
+	Source   string `json:"source"`
+}
+
+func (o *DiskRestoreOptions) Validate(_ bool) error {
+	o.DiskName = strings.TrimSpace(o.DiskName)
+
+	if len(o.DiskName) == 0 {
+		return fmt.Errorf("empty diskname")
+	}
+
+	o.Source = strings.TrimSpace(o.Source)
+
+	if len(o.Source) == 0 {
+		return fmt.Errorf("empty source string")
+	}
+
+	return nil
+}
+
+type DiskRestoreResult struct {
+	// ID of the task that copies the backup to the disk
+	// of the powered off machine
+	TaskID string
+
+	// Name of the disk with the backup data
+	// that was attached to the running machine
+	AttachedDisk string
+}
+
+// StartDiskRestoreProcess copies the backup image to the disk of the powered off machine.
+// If the machine is running, the backup image is attached to it as a read-only disk instead,
+// so the required data can be copied inside the guest. Such a disk is not saved
+// to the machine configuration.
+func (s *Server) StartDiskRestoreProcess(ctx context.Context, vmname string, opts *DiskRestoreOptions) (*DiskRestoreResult, error) {
+	if opts == nil {
+		return nil, fmt.Errorf("empty disk restore opts")
+	} else {
+		if err := opts.Validate(true); err != nil {
+			return nil, err
+		}
+	}
+
+	if d, err := kvmrun.NewDisk(opts.DiskName); err == nil {
+		opts.DiskName = d.Backend.BaseName()
+	}
+
+	vm, err := s.MachineGet(vmname, true)
+	if err != nil {
+		return nil, err
+	}
+
+	vmstate, err := s.MachineGetStatus(vm)
+	if err != nil {
+		return nil, err
+	}
+
+	switch vmstate {
+	case kvmrun.StateRunning, kvmrun.StatePaused:
+		diskname, err := s.attachRestoreSource(ctx, vmname, opts)
+		if err != nil {
+			return nil, fmt.Errorf("cannot attach backup: %w", err)
+		}
+
+		return &DiskRestoreResult{AttachedDisk: diskname}, nil
+	}
+
+	t := NewDiskRestoreTask(vmname, opts)
+
+	t.Server = s
+
+	taskOpts := []task.TaskOption{
+		server.WithUniqueLabel(vmname + "/disk-restore/" + opts.DiskName),
+		server.WithGroupLabel(vmname),
+		server.WithGroupLabel(vmname + "/long-running"),
+	}
+
+	tid, err := s.TaskStart(ctx, t, nil, taskOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("cannot start disk restore: %w", err)
+	}
+
+	return &DiskRestoreResult{TaskID: tid}, nil
+}
+
+func (s *Server) attachRestoreSource(ctx context.Context, vmname string, opts *DiskRestoreOptions) (string, error) {
+	var diskname string
+
+	err := s.TaskRunFunc(ctx, server.BlockAnyOperations(vmname), true, nil, func(l *log.Entry) error {
+		vm, err := s.MachineGet(vmname, true)
+		if err != nil {
+			return err
+		}
+
+		if vm.R == nil {
+			return fmt.Errorf("%w: %s", kvmrun.ErrNotRunning, vmname)
+		}
+
+		if vm.R.DiskGet(opts.DiskName) == nil {
+			return &kvmrun.NotConnectedError{Source: "instance_qemu", Object: opts.DiskName}
+		}
+
+		src, err := newRestoreSource(opts.Source)
+		if err != nil {
+			return err
+		}
+
+		if d := vm.R.DiskGet(src.Path); d != nil && d.Path == src.Path {
+			return fmt.Errorf("backup is already attached: %s", src.Path)
+		}
+
+		// The backing files are not available inside the chroot
+		if b, ok := src.Backend.(*file.Device); ok && src.BaseFormat() == file.FormatQcow2 {
+			info, err := qemu.GetImageInfo(&qemu.Image{Path: b.Path, Format: file.FormatQcow2})
+			if err != nil {
+				return err
+			}
+
+			if info.BackingImage != nil {
+				return fmt.Errorf("image with a backing chain can only be restored to a powered off machine: %s", src.Path)
+			}
+		}
+
+		props := kvmrun.DiskProperties{
+			Path:     src.Path,
+			Format:   src.BaseFormat(),
+			Driver:   kvmrun.DefaultDiskDriver().String(),
+			Readonly: true,
+		}
+
+		if err := vm.R.DiskAppend(props); err != nil {
+			return err
+		}
+
+		l.Infof("Backup is attached as a read-only disk: %s", src.Path)
+
+		diskname = src.BaseName()
+
+		return nil
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return diskname, nil
+}
+
+type DiskRestoreStatDetails struct {
+	DiskName string
+	Source   string
+	Disk     *DataTransferStat
+}
+
+type DiskRestoreTask struct {
+	*task.GenericTask
+	*Server
+
+	targets map[string]task.OperationMode
+
+	// Arguments
+	vmname string
+	opts   *DiskRestoreOptions
+
+	// Do not set manually next fields !
+	srcSize  uint64
+	srcDisk  *kvmrun.Disk
+	srcImage *qemu.Image
+	dstDisk  *kvmrun.Disk
+
+	// Temporary directory with the TLS client credentials
+	tlsDir string
+
+	details  *DiskRestoreStatDetails
+	statfile string
+
+	mu sync.Mutex
+}
+
+func NewDiskRestoreTask(vmname string, opts *DiskRestoreOptions) *DiskRestoreTask {
+	return &DiskRestoreTask{
+		GenericTask: new(task.GenericTask),
+
+		targets: server.BlockAnyOperations(vmname, opts.DiskName),
+		vmname:  vmname,
+		opts:    opts,
+	}
+}
+
+func (t *DiskRestoreTask) Targets() map[string]task.OperationMode { return t.targets }
+
+func (t *DiskRestoreTask) BeforeStart(_ interface{}) (err error) {
+	if t.opts == nil {
+		return fmt.Errorf("empty disk restore opts")
+	} else {
+		if err := t.opts.Validate(true); err != nil {
+			return err
+		}
+	}
+
+	vm, err := t.Server.MachineGet(t.vmname, true)
+	if err != nil {
+		return err
+	}
+
+	vmstate, err := t.MachineGetStatus(vm)
+	if err != nil {
+		return err
+	}
+
+	switch vmstate {
+	case kvmrun.StateInactive, kvmrun.StateCrashed:
+	default:
+		return fmt.Errorf("machine must be powered off")
+	}
+
+	// The saved RAM state refers to the current disk state
+	if _, err := kvmrun.GetSavedState(t.vmname); err == nil {
+		return fmt.Errorf("machine has a saved state that must be discarded first")
+	}
+
+	if d := vm.C.DiskGet(t.opts.DiskName); d != nil {
+		t.dstDisk = d
+	} else {
+		return &kvmrun.NotConnectedError{Source: "instance_conf", Object: t.opts.DiskName}
+	}
+
+	if len(t.dstDisk.Snapshots) > 0 {
+		return fmt.Errorf("disk has snapshots that must be deleted first: %s", t.dstDisk.Path)
+	}
+
+	switch t.dstDisk.Backend.(type) {
+	case *file.Device, *block.Device:
+	default:
+		return fmt.Errorf("%w: restore to a disk of this type: %s", kvmrun.ErrNotSupported, t.dstDisk.Path)
+	}
+
+	if src, err := newRestoreSource(t.opts.Source); err == nil {
+		t.srcDisk = src
+	} else {
+		return err
+	}
+
+	if t.srcDisk.Path == t.dstDisk.Path {
+		return fmt.Errorf("source and target are the same disk: %s", t.srcDisk.Path)
+	}
+
+	if err := t.checkTargetNotInUse(); err != nil {
+		return err
+	}
+
+	// Cleanup
+	defer func() {
+		if err != nil && len(t.tlsDir) > 0 {
+			os.RemoveAll(t.tlsDir)
+		}
+	}()
+
+	if img, err := t.sourceImage(); err == nil {
+		t.srcImage = img
+	} else {
+		return err
+	}
+
+	info, err := qemu.GetImageInfo(t.srcImage)
+	if err != nil {
+		return err
+	}
+
+	t.srcSize = info.VirtualSize
+
+	dstSize, err := t.dstDisk.Size()
+	if err != nil {
+		return err
+	}
+
+	if dstSize < t.srcSize {
+		return fmt.Errorf("target size is smaller than source (%d < %d)", dstSize, t.srcSize)
+	}
+
+	// Init stat fields
+	t.details = &DiskRestoreStatDetails{
+		DiskName: t.dstDisk.BaseName(),
+		Source:   t.srcDisk.Path,
+		Disk:     &DataTransferStat{Total: t.srcSize, Remaining: t.srcSize},
+	}
+
+	hashname := fmt.Sprintf("%x", md5.Sum([]byte(t.vmname+"/disk-restore/"+t.dstDisk.BaseName())))
+
+	t.statfile = filepath.Join(kvmrun.CHROOTDIR, t.vmname, ".tasks", hashname)
+
+	if err := os.Remove(t.statfile); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (t *DiskRestoreTask) Stat() *task.TaskStat {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	st := t.GenericTask.Stat()
+
+	st.Details = t.details
+
+	return st
+}
+
+func (t *DiskRestoreTask) updateStat(progress float64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	sent := uint64(float64(t.srcSize) * progress / 100)
+
+	if sent > t.srcSize {
+		sent = t.srcSize
+	}
+
+	t.details.Disk = &DataTransferStat{
+		Total:       t.srcSize,
+		Remaining:   t.srcSize - sent,
+		Transferred: sent,
+		Progress:    int(progress),
+	}
+
+	t.SetProgress(int(progress))
+}
+
+func (t *DiskRestoreTask) writeStatFile(taskErr error) error {
+	st := t.Stat()
+
+	if taskErr == nil {
+		st.State = task.StateCompleted
+	} else {
+		st.State = task.StateFailed
+		st.StateDesc = taskErr.Error()
+	}
+
+	statFileData := server.TaskStatFile{
+		Label: t.vmname + "/disk-restore/" + t.dstDisk.BaseName(),
+		Stat:  st,
+	}
+
+	b, err := json.MarshalIndent(statFileData, "", "    ")
+	if err != nil {
+		return err
+	}
+
+	// The chroot directory does not exist while the machine is powered off
+	if err := os.MkdirAll(filepath.Dir(t.statfile), 0755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(t.statfile, b, 0644)
+}
+
+func (t *DiskRestoreTask) Main() (err error) {
+	defer func() {
+		if len(t.tlsDir) > 0 {
+			os.RemoveAll(t.tlsDir)
+		}
+
+		if _err := t.writeStatFile(err); _err != nil {
+			t.Logger.Errorf("Failed to save task state to a special file: %s", _err)
+		}
+	}()
+
+	t.Logger.Infof("Restoring %s from %s", t.dstDisk.Path, t.srcDisk.Path)
+
+	// The dirty bitmap does not track the changes made by qemu-img,
+	// so even a partially restored disk breaks the backup chain
+	if err := kvmrun.InvalidateBackupState(t.vmname, t.dstDisk.BaseName(), "disk was restored from "+t.srcDisk.Path); err != nil {
+		return fmt.Errorf("failed to update backup state: %w", err)
+	}
+
+	if err := qemu.Convert(t.Ctx(), t.srcImage, t.dstDisk.Path, t.dstDisk.Format(), t.updateStat); err != nil {
+		return err
+	}
+
+	t.updateStat(100)
+
+	t.Logger.Debug("Main(): completed")
+
+	return nil
+}
+
+// checkTargetNotInUse makes sure that the target disk
+// is not used by other machines or by the host system.
+func (t *DiskRestoreTask) checkTargetNotInUse() error {
+	if _, ok := t.dstDisk.Backend.(*block.Device); ok {
+		// Exclusive opening fails if the device is mounted
+		// or is a part of another device (e.g. LVM, RAID)
+		fd, err := os.OpenFile(t.dstDisk.Path, os.O_RDONLY|syscall.O_EXCL, 0)
+		if err != nil {
+			if errors.Is(err, syscall.EBUSY) {
+				return fmt.Errorf("disk is in use: %s", t.dstDisk.Path)
+			}
+
+			return err
+		}
+		fd.Close()
+	}
+
+	names, err := t.MachineGetNames()
+	if err != nil {
+		return err
+	}
+
+	for _, n := range names {
+		if n == t.vmname {
+			continue
+		}
+
+		vm, err := t.MachineGet(n, true)
+		if err != nil || vm.R == nil {
+			continue
+		}
+
+		if d := vm.R.DiskGet(t.dstDisk.Path); d != nil && d.Path == t.dstDisk.Path {
+			return fmt.Errorf("disk is in use by another machine: %s", n)
+		}
+	}
+
+	return nil
+}
+
+// sourceImage returns the description of the backup image for qemu-img.
+// The qcow2 backing chain of the incremental backups is followed by qemu-img.
+func (t *DiskRestoreTask) sourceImage() (*qemu.Image, error) {
+	switch b := t.srcDisk.Backend.(type) {
+	case *file.Device:
+		return &qemu.Image{Path: b.Path, Format: t.srcDisk.BaseFormat()}, nil
+	case *block.Device:
+		return &qemu.Image{Path: b.Path, Format: file.FormatRaw}, nil
+	case *nbd.Device:
+		if !b.TLS() {
+			return &qemu.Image{Path: b.Path, Format: file.FormatRaw}, nil
+		}
+
+		dir, err := os.MkdirTemp("", "kvmrun-restore-")
+		if err != nil {
+			return nil, err
+		}
+
+		t.tlsDir = dir
+
+		if err := kvmrun.WriteTLSCreds(dir, kvmrun.TLSEndpointClient, 0, t.AppConf.Kvmrun.CertDir); err != nil {
+			return nil, err
+		}
+
+		opts := []string{
+			"driver=raw",
+			"file.driver=nbd",
+			"file.server.type=inet",
+			"file.server.host=" + b.URI.Host,
+			fmt.Sprintf("file.server.port=%d", b.URI.Port),
+			"file.export=" + b.URI.ExportName,
+			"file.tls-creds=tls0",
+		}
+
+		img := qemu.Image{
+			Path:      strings.Join(opts, ","),
+			ImageOpts: true,
+			Objects:   []string{"tls-creds-x509,id=tls0,endpoint=client,dir=" + dir},
+		}
+
+		return &img, nil
+	}
+
+	return nil, fmt.Errorf("unexpected source type: %s", t.srcDisk.Path)
+}
+
+func newRestoreSource(source string) (*kvmrun.Disk, error) {
+	src, err := kvmrun.NewDisk(source)
+	if err != nil {
+		return nil, err
+	}
+
+	switch src.Backend.(type) {
+	case *file.Device, *block.Device, *nbd.Device:
+	default:
+		return nil, fmt.Errorf("%w: restore from a source of this type: %s", kvmrun.ErrNotSupported, source)
+	}
+
+	// Backup images contain the guest data, so their format
+	// is determined by the file extension and is never detected
+	if _, ok := src.Backend.(*file.Device); ok {
+		src.DiskProperties.Format = imageFormatByName(src.Path)
+	}
+
+	if ok, err := src.IsAvailable(); !ok {
+		if err == nil {
+			err = fmt.Errorf("source is not available: %s", source)
+		}
+
+		return nil, err
+	}
+
+	return src, nil
+}
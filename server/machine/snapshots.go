@@ -0,0 +1,640 @@
+package machine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/0xef53/kvmrun/internal/qemu"
+	qemu_types "github.com/0xef53/kvmrun/internal/qemu/types"
+	"github.com/0xef53/kvmrun/kvmrun"
+	"github.com/0xef53/kvmrun/kvmrun/backend/file"
+	"github.com/0xef53/kvmrun/server"
+
+	qmp "github.com/0xef53/go-qmp/v2"
+	log "github.com/sirupsen/logrus"
+)
+
+type DiskSnapshotOptions struct {
+	Name  string   `json:"name"`
+	Disks []string `json:"disks"`
+}
+
+func (o *DiskSnapshotOptions) Validate(_ bool) error {
+	o.Name = strings.TrimSpace(o.Name)
+
+	if err := kvmrun.ValidateSnapshotName(o.Name); err != nil {
+		return err
+	}
+
+	for idx, dname := range o.Disks {
+		o.Disks[idx] = strings.TrimSpace(dname)
+
+		if len(o.Disks[idx]) == 0 {
+			return fmt.Errorf("empty disk name")
+		}
+	}
+
+	return nil
+}
+
+// DiskSnapshotInfo describes a snapshot that may cover several disks
+// of the machine. Such snapshots are created at the same moment.
+type DiskSnapshotInfo struct {
+	Name    string
+	Created time.Time
+	Disks   []string
+}
+
+// DiskSnapshotCreate creates an external snapshot of the specified disks
+// or of all local disks. A snapshot of a running machine is created
+// using a single QMP transaction, so the disk states are crash-consistent.
+func (s *Server) DiskSnapshotCreate(ctx context.Context, vmname string, opts *DiskSnapshotOptions) error {
+	if opts == nil {
+		return fmt.Errorf("empty snapshot opts")
+	} else {
+		if err := opts.Validate(true); err != nil {
+			return err
+		}
+	}
+
+	err := s.TaskRunFunc(ctx, server.BlockAnyOperations(vmname), true, nil, func(l *log.Entry) error {
+		vm, live, err := s.snapshotMachineGet(vmname)
+		if err != nil {
+			return err
+		}
+
+		disks, err := snapshotDisksGet(vm, opts.Disks, live)
+		if err != nil {
+			return err
+		}
+
+		if len(disks) == 0 {
+			return fmt.Errorf("no disks found that support snapshots")
+		}
+
+		for _, d := range disks {
+			if d.SnapshotIndex(opts.Name) >= 0 {
+				return fmt.Errorf("snapshot already exists: %s: %s", d.BaseName(), opts.Name)
+			}
+		}
+
+		if err := os.MkdirAll(filepath.Join(kvmrun.SNAPSHOTSDIR, vmname), 0755); err != nil {
+			return err
+		}
+
+		var success bool
+
+		created := make([]string, 0, len(disks))
+
+		defer func() {
+			if !success {
+				for _, p := range created {
+					if live {
+						file.Unbind(filepath.Join(kvmrun.CHROOTDIR, vmname, p))
+					}
+
+					os.Remove(p)
+				}
+			}
+		}()
+
+		for _, d := range disks {
+			overlay := kvmrun.SnapshotOverlayPath(vmname, d.BaseName(), opts.Name)
+
+			if _, err := os.Stat(overlay); err == nil {
+				return fmt.Errorf("overlay image already exists: %s", overlay)
+			}
+
+			size, err := snapshotDiskSize(vm, d, live)
+			if err != nil {
+				return err
+			}
+
+			if err := qemu.CreateOverlay(overlay, d.ActivePath(), d.Format(), size); err != nil {
+				return err
+			}
+
+			created = append(created, overlay)
+
+			if live {
+				b, err := file.New(overlay)
+				if err != nil {
+					return err
+				}
+
+				if err := b.BindTo(filepath.Join(kvmrun.CHROOTDIR, vmname, overlay), vm.R.UID()); err != nil {
+					return err
+				}
+			}
+		}
+
+		if live {
+			commands := make([]qmp.Command, 0, len(disks))
+
+			for idx, d := range disks {
+				args := qemu_types.BlockdevSnapshotSyncOptions{
+					Device:       d.BaseName(),
+					SnapshotFile: created[idx],
+					Format:       file.FormatQcow2,
+					Mode:         "existing",
+				}
+
+				commands = append(commands, qmp.Command{Name: "blockdev-snapshot-sync", Arguments: &args})
+			}
+
+			if err := s.Mon.RunTransaction(vmname, commands, nil); err != nil {
+				return err
+			}
+		}
+
+		ts := time.Now()
+
+		for idx, d := range disks {
+			snapshots := append(d.Snapshots, &kvmrun.DiskSnapshot{
+				Name:    opts.Name,
+				Overlay: created[idx],
+				Created: ts,
+			})
+
+			if err := vm.C.DiskSetSnapshots(d.Path, snapshots); err != nil {
+				return err
+			}
+		}
+
+		if err := vm.C.Save(); err != nil {
+			return err
+		}
+
+		success = true
+
+		l.Infof("Snapshot %s has been created (%d disk(s))", opts.Name, len(disks))
+
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("cannot create snapshot: %w", err)
+	}
+
+	return nil
+}
+
+// DiskSnapshotList returns a list of snapshots from the machine configuration
+// sorted by the creation time.
+func (s *Server) DiskSnapshotList(ctx context.Context, vmname string) ([]*DiskSnapshotInfo, error) {
+	vm, err := s.MachineGet(vmname, false)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]*DiskSnapshotInfo, 0, 4)
+
+	index := make(map[string]*DiskSnapshotInfo)
+
+	for _, d := range vm.C.DiskGetList() {
+		for _, snap := range d.Snapshots {
+			info, ok := index[snap.Name]
+			if !ok {
+				info = &DiskSnapshotInfo{
+					Name:    snap.Name,
+					Created: snap.Created,
+				}
+
+				index[snap.Name] = info
+
+				snapshots = append(snapshots, info)
+			}
+
+			if snap.Created.Before(info.Created) {
+				info.Created = snap.Created
+			}
+
+			info.Disks = append(info.Disks, d.Path)
+		}
+	}
+
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		return snapshots[i].Created.Before(snapshots[j].Created)
+	})
+
+	return snapshots, nil
+}
+
+// DiskSnapshotDelete removes the snapshot from the specified disks
+// or from all disks that have it. The overlay of the snapshot is merged
+// into the image below it, so the current disk state is not changed.
+func (s *Server) DiskSnapshotDelete(ctx context.Context, vmname string, opts *DiskSnapshotOptions) error {
+	if opts == nil {
+		return fmt.Errorf("empty snapshot opts")
+	} else {
+		if err := opts.Validate(true); err != nil {
+			return err
+		}
+	}
+
+	err := s.TaskRunFunc(ctx, server.BlockAnyOperations(vmname), true, nil, func(l *log.Entry) error {
+		vm, live, err := s.snapshotMachineGet(vmname)
+		if err != nil {
+			return err
+		}
+
+		disks, err := snapshotDisksWith(vm, opts.Name, opts.Disks, live)
+		if err != nil {
+			return err
+		}
+
+		for _, d := range disks {
+			idx := d.SnapshotIndex(opts.Name)
+
+			overlay := d.Snapshots[idx].Overlay
+
+			if live {
+				if err := s.commitSnapshotLive(ctx, vmname, d, idx); err != nil {
+					return fmt.Errorf("%s: %w", d.BaseName(), err)
+				}
+			} else {
+				if err := commitSnapshot(d, idx); err != nil {
+					return fmt.Errorf("%s: %w", d.BaseName(), err)
+				}
+			}
+
+			// The overlay is no longer part of the chain,
+			// so the configuration must be updated immediately
+			snapshots := append(d.Snapshots[:idx:idx], d.Snapshots[idx+1:]...)
+
+			if err := vm.C.DiskSetSnapshots(d.Path, snapshots); err != nil {
+				return err
+			}
+
+			if err := vm.C.Save(); err != nil {
+				return err
+			}
+
+			if live {
+				if err := file.Unbind(filepath.Join(kvmrun.CHROOTDIR, vmname, overlay)); err != nil {
+					l.Warnf("Failed to unbind the overlay image: %s", err)
+				}
+			}
+
+			if err := os.Remove(overlay); err != nil {
+				l.Warnf("Failed to remove the overlay image: %s", err)
+			}
+
+			l.Infof("Snapshot %s has been removed from %s", opts.Name, d.BaseName())
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("cannot delete snapshot: %w", err)
+	}
+
+	return nil
+}
+
+// DiskSnapshotRevert discards all changes made to the disks since
+// the snapshot was created. All newer snapshots are removed as well.
+// The machine must be powered off.
+func (s *Server) DiskSnapshotRevert(ctx context.Context, vmname, snapname string) error {
+	snapname = strings.TrimSpace(snapname)
+
+	if err := kvmrun.ValidateSnapshotName(snapname); err != nil {
+		return err
+	}
+
+	err := s.TaskRunFunc(ctx, server.BlockAnyOperations(vmname), true, nil, func(l *log.Entry) error {
+		vm, live, err := s.snapshotMachineGet(vmname)
+		if err != nil {
+			return err
+		}
+
+		if live {
+			return fmt.Errorf("machine must be powered off")
+		}
+
+		// The saved RAM state refers to the current disk state
+		if _, err := kvmrun.GetSavedState(vmname); err == nil {
+			return fmt.Errorf("machine has a saved state that must be discarded first")
+		}
+
+		disks, err := snapshotDisksWith(vm, snapname, nil, false)
+		if err != nil {
+			return err
+		}
+
+		for _, d := range disks {
+			idx := d.SnapshotIndex(snapname)
+
+			snap := d.Snapshots[idx]
+
+			backingPath, backingFormat := d.SnapshotBacking(idx)
+
+			size, err := d.Size()
+			if err != nil {
+				return err
+			}
+
+			// A new empty overlay replaces the old one
+			if err := qemu.CreateOverlay(snap.Overlay+".tmp", backingPath, backingFormat, size); err != nil {
+				return err
+			}
+
+			if err := os.Rename(snap.Overlay+".tmp", snap.Overlay); err != nil {
+				os.Remove(snap.Overlay + ".tmp")
+
+				return err
+			}
+
+			newer := d.Snapshots[idx+1:]
+
+			if err := vm.C.DiskSetSnapshots(d.Path, d.Snapshots[:idx+1]); err != nil {
+				return err
+			}
+
+			if err := vm.C.Save(); err != nil {
+				return err
+			}
+
+			for _, x := range newer {
+				if err := os.Remove(x.Overlay); err != nil {
+					l.Warnf("Failed to remove the overlay image: %s", err)
+				}
+
+				l.Infof("Snapshot %s has been removed from %s", x.Name, d.BaseName())
+			}
+
+			l.Infof("Disk %s has been reverted to snapshot %s", d.BaseName(), snapname)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("cannot revert snapshot: %w", err)
+	}
+
+	return nil
+}
+
+// snapshotMachineGet returns the machine and reports whether
+// its QEMU instance is running.
+func (s *Server) snapshotMachineGet(vmname string) (*kvmrun.Machine, bool, error) {
+	vm, err := s.MachineGet(vmname, true)
+	if err != nil {
+		return nil, false, err
+	}
+
+	vmstate, err := s.MachineGetStatus(vm)
+	if err != nil {
+		return nil, false, err
+	}
+
+	switch vmstate {
+	case kvmrun.StateRunning, kvmrun.StatePaused:
+		if vm.R == nil {
+			return nil, false, fmt.Errorf("unexpected error: QEMU instance not found")
+		}
+
+		return vm, true, nil
+	case kvmrun.StateInactive, kvmrun.StateCrashed:
+		return vm, false, nil
+	}
+
+	return nil, false, fmt.Errorf("incompatible machine state: %d", vmstate)
+}
+
+// snapshotDisksGet returns the specified disks from the machine configuration.
+// If no disks are specified, all disks that support snapshots are returned.
+func snapshotDisksGet(vm *kvmrun.Machine, disknames []string, live bool) ([]*kvmrun.Disk, error) {
+	var disks []*kvmrun.Disk
+
+	if len(disknames) == 0 {
+		for _, d := range vm.C.DiskGetList() {
+			if d.SupportsSnapshots() {
+				disks = append(disks, d)
+			}
+		}
+	} else {
+		for _, dname := range disknames {
+			d := vm.C.DiskGet(dname)
+			if d == nil {
+				return nil, &kvmrun.NotConnectedError{Source: "instance_conf", Object: dname}
+			}
+
+			if !d.SupportsSnapshots() {
+				return nil, fmt.Errorf("disk is located on shared storage and does not support snapshots: %s", d.Path)
+			}
+
+			disks = append(disks, d)
+		}
+	}
+
+	if live {
+		for _, d := range disks {
+			if err := checkRuntimeChain(vm, d); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return disks, nil
+}
+
+// snapshotDisksWith returns the disks that have the snapshot with the given name.
+// If some disks are specified, each of them must have this snapshot.
+func snapshotDisksWith(vm *kvmrun.Machine, snapname string, disknames []string, live bool) ([]*kvmrun.Disk, error) {
+	var disks []*kvmrun.Disk
+
+	if len(disknames) == 0 {
+		for _, d := range vm.C.DiskGetList() {
+			if d.SnapshotIndex(snapname) >= 0 {
+				disks = append(disks, d)
+			}
+		}
+
+		if len(disks) == 0 {
+			return nil, fmt.Errorf("%w: snapshot %s", kvmrun.ErrNotFound, snapname)
+		}
+	} else {
+		for _, dname := range disknames {
+			d := vm.C.DiskGet(dname)
+			if d == nil {
+				return nil, &kvmrun.NotConnectedError{Source: "instance_conf", Object: dname}
+			}
+
+			if d.SnapshotIndex(snapname) < 0 {
+				return nil, fmt.Errorf("%w: snapshot %s of disk %s", kvmrun.ErrNotFound, snapname, d.BaseName())
+			}
+
+			disks = append(disks, d)
+		}
+	}
+
+	if live {
+		for _, d := range disks {
+			if err := checkRuntimeChain(vm, d); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return disks, nil
+}
+
+// checkRuntimeChain checks that the running instance uses
+// the same snapshot chain as described in the configuration.
+func checkRuntimeChain(vm *kvmrun.Machine, d *kvmrun.Disk) error {
+	rd := vm.R.DiskGet(d.Path)
+	if rd == nil {
+		return &kvmrun.NotConnectedError{Source: "instance_qemu", Object: d.Path}
+	}
+
+	if rd.ActivePath() != d.ActivePath() {
+		return fmt.Errorf("snapshot chain of disk %s differs from the running instance", d.BaseName())
+	}
+
+	return nil
+}
+
+func snapshotDiskSize(vm *kvmrun.Machine, d *kvmrun.Disk, live bool) (uint64, error) {
+	if live {
+		if rd := vm.R.DiskGet(d.Path); rd != nil && rd.QemuVirtualSize > 0 {
+			return rd.QemuVirtualSize, nil
+		}
+	}
+
+	return d.Size()
+}
+
+// commitSnapshot merges the overlay of the snapshot into
+// the image below it while the machine is powered off.
+func commitSnapshot(d *kvmrun.Disk, idx int) error {
+	if err := qemu.CommitOverlay(d.Snapshots[idx].Overlay); err != nil {
+		return err
+	}
+
+	// The next overlay must refer to the image
+	// that now contains the merged data
+	if idx+1 < len(d.Snapshots) {
+		backingPath, backingFormat := d.SnapshotBacking(idx)
+
+		if err := qemu.RebaseOverlay(d.Snapshots[idx+1].Overlay, backingPath, backingFormat); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// commitSnapshotLive merges the overlay of the snapshot into
+// the image below it using the QEMU block-commit job.
+// If the overlay is the active layer, QEMU switches the disk
+// to the image below it when the job is completed.
+// The job is cancelled if the context is done before it is completed.
+func (s *Server) commitSnapshotLive(ctx context.Context, vmname string, d *kvmrun.Disk, idx int) error {
+	backingPath, _ := d.SnapshotBacking(idx)
+
+	jobID := "commit_" + d.BaseName()
+
+	opts := qemu_types.BlockCommitOptions{
+		JobID:  jobID,
+		Device: d.BaseName(),
+		Base:   backingPath,
+	}
+
+	active := idx == len(d.Snapshots)-1
+
+	if !active {
+		opts.Top = d.Snapshots[idx].Overlay
+	}
+
+	ts := time.Now()
+
+	if err := s.Mon.Run(vmname, qmp.Command{Name: "block-commit", Arguments: &opts}, nil); err != nil {
+		return err
+	}
+
+	getJob := func() (*qemu_types.BlockJobInfo, error) {
+		jobs := make([]*qemu_types.BlockJobInfo, 0, 1)
+
+		if err := s.Mon.Run(vmname, qmp.Command{Name: "query-block-jobs", Arguments: nil}, &jobs); err != nil {
+			return nil, err
+		}
+
+		for _, j := range jobs {
+			if j.Device == jobID {
+				return j, nil
+			}
+		}
+
+		return nil, nil
+	}
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	var completing bool
+
+	for {
+		select {
+		case <-ctx.Done():
+			cancelOpts := struct {
+				Device string `json:"device"`
+				Force  bool   `json:"force,omitempty"`
+			}{
+				Device: jobID,
+				Force:  true,
+			}
+
+			if err := s.Mon.Run(vmname, qmp.Command{Name: "block-job-cancel", Arguments: &cancelOpts}, nil); err != nil {
+				return fmt.Errorf("%w (block-job-cancel failed: %s)", ctx.Err(), err)
+			}
+
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		job, err := getJob()
+		if err != nil {
+			return err
+		}
+
+		if job == nil {
+			break
+		}
+
+		// The active commit is finished by the explicit command
+		if active && job.Ready && !completing {
+			if err := s.Mon.Run(vmname, qmp.Command{Name: "block-job-complete", Arguments: &qemu_types.DeviceName{Device: jobID}}, nil); err != nil {
+				return err
+			}
+
+			completing = true
+		}
+	}
+
+	if _, found, err := s.Mon.FindBlockJobErrorEvent(vmname, jobID, uint64(ts.Unix())); err == nil {
+		if found {
+			return fmt.Errorf("errors detected during block-commit")
+		}
+	} else {
+		return err
+	}
+
+	if _, found, err := s.Mon.FindBlockJobCompletedEvent(vmname, jobID, uint64(ts.Unix())); err == nil {
+		if !found {
+			return errors.New("block-commit job was not completed")
+		}
+	} else {
+		return err
+	}
+
+	return nil
+}
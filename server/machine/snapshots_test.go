@@ -0,0 +1,115 @@
+package machine
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/0xef53/kvmrun/kvmrun"
+)
+
+func newTestSnapshotMachine(t *testing.T) *kvmrun.Machine {
+	vmc := kvmrun.NewInstanceConf("alice")
+
+	for _, p := range []string{"/var/lib/kvmrun/alice_sda.img", "/var/lib/kvmrun/alice_sdb.img"} {
+		if err := vmc.DiskAppend(kvmrun.DiskProperties{Path: p, Driver: "virtio-blk-pci"}); err != nil {
+			t.Fatalf("got unexpected error: %s", err)
+		}
+	}
+
+	snapshots := map[string][]string{
+		"alice_sda.img": {"daily", "hourly"},
+		"alice_sdb.img": {"hourly"},
+	}
+
+	for diskname, names := range snapshots {
+		var list []*kvmrun.DiskSnapshot
+
+		for _, name := range names {
+			list = append(list, &kvmrun.DiskSnapshot{Name: name, Overlay: kvmrun.SnapshotOverlayPath("alice", diskname, name)})
+		}
+
+		if err := vmc.DiskSetSnapshots(diskname, list); err != nil {
+			t.Fatalf("got unexpected error: %s", err)
+		}
+	}
+
+	return &kvmrun.Machine{Name: "alice", C: vmc}
+}
+
+func TestSnapshotDisksWith(t *testing.T) {
+	vm := newTestSnapshotMachine(t)
+
+	tests := []struct {
+		snapname  string
+		disknames []string
+		want      []string
+		wantErr   error
+	}{
+		{snapname: "hourly", want: []string{"alice_sda.img", "alice_sdb.img"}},
+		{snapname: "daily", want: []string{"alice_sda.img"}},
+		{snapname: "daily", disknames: []string{"alice_sda.img"}, want: []string{"alice_sda.img"}},
+		{snapname: "daily", disknames: []string{"alice_sdb.img"}, wantErr: kvmrun.ErrNotFound},
+		{snapname: "weekly", wantErr: kvmrun.ErrNotFound},
+	}
+
+	for _, tc := range tests {
+		disks, err := snapshotDisksWith(vm, tc.snapname, tc.disknames, false)
+
+		if tc.wantErr != nil {
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("%s %v: got unexpected error: want %v, got %v", tc.snapname, tc.disknames, tc.wantErr, err)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("%s %v: got unexpected error: %s", tc.snapname, tc.disknames, err)
+		}
+
+		got := make([]string, 0, len(disks))
+
+		for _, d := range disks {
+			got = append(got, d.BaseName())
+		}
+
+		if len(got) != len(tc.want) {
+			t.Fatalf("%s %v: got unexpected disks: want %v, got %v", tc.snapname, tc.disknames, tc.want, got)
+		}
+
+		for i := range got {
+			if got[i] != tc.want[i] {
+				t.Fatalf("%s %v: got unexpected disks: want %v, got %v", tc.snapname, tc.disknames, tc.want, got)
+			}
+		}
+	}
+
+	var notConnected *kvmrun.NotConnectedError
+
+	if _, err := snapshotDisksWith(vm, "hourly", []string{"alice_sdc.img"}, false); !errors.As(err, &notConnected) {
+		t.Fatalf("got unexpected error: want *kvmrun.NotConnectedError, got %v", err)
+	}
+}
+
+func TestSnapshotBacking(t *testing.T) {
+	vm := newTestSnapshotMachine(t)
+
+	d := vm.C.DiskGet("alice_sda.img")
+
+	tests := []struct {
+		idx    int
+		path   string
+		format string
+	}{
+		{0, d.Path, d.BaseFormat()},
+		{1, kvmrun.SnapshotOverlayPath("alice", "alice_sda.img", "daily"), "qcow2"},
+	}
+
+	for _, tc := range tests {
+		path, format := d.SnapshotBacking(tc.idx)
+
+		if path != tc.path || format != tc.format {
+			t.Fatalf("got unexpected backing (idx = %d): want %s (%s), got %s (%s)", tc.idx, tc.path, tc.format, path, format)
+		}
+	}
+}
@@ -0,0 +1,389 @@
+package machine
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	qemu_types "github.com/0xef53/kvmrun/internal/qemu/types"
+	"github.com/0xef53/kvmrun/kvmrun"
+	"github.com/0xef53/kvmrun/server"
+
+	qmp "github.com/0xef53/go-qmp/v2"
+	"github.com/0xef53/go-task"
+)
+
+func (s *Server) StartSuspendProcess(ctx context.Context, vmname string) (string, error) {
+	t := NewMachineSuspendTask(vmname)
+
+	t.Server = s
+
+	taskOpts := []task.TaskOption{
+		server.WithUniqueLabel(vmname + "/suspend"),
+		server.WithGroupLabel(vmname),
+		server.WithGroupLabel(vmname + "/long-running"),
+	}
+
+	tid, err := s.TaskStart(ctx, t, nil, taskOpts...)
+	if err != nil {
+		return "", fmt.Errorf("cannot start suspend: %w", err)
+	}
+
+	return tid, nil
+}
+
+type MachineSuspendStatDetails struct {
+	VMState *DataTransferStat
+}
+
+type MachineSuspendTask struct {
+	*task.GenericTask
+	*Server
+
+	targets map[string]task.OperationMode
+
+	// Arguments
+	vmname string
+
+	// Do not set manually next fields !
+	vm       *kvmrun.Machine
+	vmstate  kvmrun.InstanceState
+	listener net.Listener
+
+	details *MachineSuspendStatDetails
+
+	mu sync.Mutex
+}
+
+func NewMachineSuspendTask(vmname string) *MachineSuspendTask {
+	return &MachineSuspendTask{
+		GenericTask: new(task.GenericTask),
+		targets:     server.BlockAnyOperations(vmname),
+		vmname:      vmname,
+	}
+}
+
+func (t *MachineSuspendTask) Targets() map[string]task.OperationMode { return t.targets }
+
+func (t *MachineSuspendTask) BeforeStart(_ interface{}) error {
+	if vm, err := t.Server.MachineGet(t.vmname, true); err == nil {
+		t.vm = vm
+	} else {
+		return err
+	}
+
+	vmstate, err := t.MachineGetStatus(t.vm)
+	if err != nil {
+		return err
+	}
+
+	switch vmstate {
+	case kvmrun.StateRunning, kvmrun.StatePaused:
+		if t.vm.R == nil {
+			return fmt.Errorf("unexpected error: QEMU instance not found")
+		}
+	default:
+		return fmt.Errorf("incompatible machine state: %d", vmstate)
+	}
+
+	t.vmstate = vmstate
+
+	if len(t.vm.R.HostDeviceGetList()) > 0 {
+		return fmt.Errorf("machines with host PCI devices cannot be suspended")
+	}
+
+	// The state will be restored using the machine configuration,
+	// so it must describe the same set of devices as the running instance,
+	// placed on the same addresses
+	if err := kvmrun.PinDeviceAddrs(t.vm.C, t.vm.R); err != nil {
+		return err
+	}
+
+	if kvmrun.StateFingerprint(t.vm.C) != kvmrun.StateFingerprint(t.vm.R) {
+		return fmt.Errorf("machine configuration has changes that are not applied to the running instance")
+	}
+
+	t.details = &MachineSuspendStatDetails{
+		VMState: new(DataTransferStat),
+	}
+
+	return nil
+}
+
+func (t *MachineSuspendTask) Stat() *task.TaskStat {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	st := t.GenericTask.Stat()
+
+	st.Details = t.details
+
+	return st
+}
+
+func (t *MachineSuspendTask) updateStat(total, remain uint64, speed int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if total > 0 {
+		t.details.VMState = &DataTransferStat{
+			Total:       total,
+			Remaining:   remain,
+			Transferred: total - remain,
+			Progress:    int((total - remain) * 100 / total),
+			Speed:       speed,
+		}
+
+		t.SetProgress(t.details.VMState.Progress)
+	}
+}
+
+// socketPath returns the path to the unix socket inside the machine chroot.
+// QEMU sends its state to this socket.
+func (t *MachineSuspendTask) socketPath() string {
+	return filepath.Join(kvmrun.CHROOTDIR, t.vmname, "run/saved_state.sock")
+}
+
+func (t *MachineSuspendTask) Main() error {
+	if err := kvmrun.RemoveSavedState(t.vmname); err != nil {
+		return err
+	}
+
+	if t.vmstate == kvmrun.StateRunning {
+		if err := t.Server.Mon.Run(t.vmname, qmp.Command{Name: "stop", Arguments: nil}, nil); err != nil {
+			return err
+		}
+
+		t.Logger.Info("Machine has been paused")
+	}
+
+	if err := t.prepareMigration(); err != nil {
+		return err
+	}
+
+	os.Remove(t.socketPath())
+
+	l, err := net.Listen("unix", t.socketPath())
+	if err != nil {
+		return err
+	}
+	defer os.Remove(t.socketPath())
+
+	t.listener = l
+
+	// QEMU runs as a non-privileged user
+	if err := os.Chown(t.socketPath(), t.vm.R.UID(), 0); err != nil {
+		return err
+	}
+
+	type result struct {
+		size     uint64
+		checksum string
+		err      error
+	}
+
+	received := make(chan *result, 1)
+
+	go func() {
+		size, checksum, err := t.receiveState()
+
+		received <- &result{size: size, checksum: checksum, err: err}
+	}()
+
+	t.Logger.Info("Saving the machine state to ", kvmrun.SavedStateFile(t.vmname))
+
+	if err := t.migrate(); err != nil {
+		// Interrupt the receiver if it is still waiting for connection
+		l.Close()
+
+		<-received
+
+		return err
+	}
+
+	res := <-received
+
+	if res.err != nil {
+		return res.err
+	}
+
+	// Save the pinned device addresses
+	if err := t.vm.C.Save(); err != nil {
+		return err
+	}
+
+	st := kvmrun.SavedState{
+		Checksum:    res.checksum,
+		Size:        res.size,
+		MachineType: t.vm.R.MachineTypeGet().String(),
+		Fingerprint: kvmrun.StateFingerprint(t.vm.C),
+		Paused:      t.vmstate == kvmrun.StatePaused,
+		Created:     time.Now(),
+	}
+
+	if err := st.Save(t.vmname); err != nil {
+		return err
+	}
+
+	t.Logger.Infof("Machine state has been saved: size = %d, sha256 = %s", st.Size, st.Checksum)
+
+	return nil
+}
+
+// prepareMigration disables all migration capabilities
+// that could be left after previous migrations, because the state
+// must be loadable by QEMU without any additional settings.
+func (t *MachineSuspendTask) prepareMigration() error {
+	caps := make([]qemu_types.MigrationCapabilityStatus, 0, 32)
+
+	if err := t.Mon.Run(t.vmname, qmp.Command{Name: "query-migrate-capabilities", Arguments: nil}, &caps); err != nil {
+		return err
+	}
+
+	changed := make([]qemu_types.MigrationCapabilityStatus, 0, len(caps))
+
+	for _, c := range caps {
+		if c.State {
+			changed = append(changed, qemu_types.MigrationCapabilityStatus{Capability: c.Capability, State: false})
+		}
+	}
+
+	if len(changed) > 0 {
+		args := struct {
+			Capabilities []qemu_types.MigrationCapabilityStatus `json:"capabilities"`
+		}{
+			Capabilities: changed,
+		}
+
+		if err := t.Mon.Run(t.vmname, qmp.Command{Name: "migrate-set-capabilities", Arguments: &args}, nil); err != nil {
+			return err
+		}
+	}
+
+	// The state is written to a local file, so there is no need for TLS
+	params := qemu_types.MigrationParameters{
+		MaxBandwidth: defaultMigrationMaxBandwidth,
+		TLSCreds:     "",
+	}
+
+	return t.Mon.Run(t.vmname, qmp.Command{Name: "migrate-set-parameters", Arguments: &params}, nil)
+}
+
+// receiveState accepts a connection from QEMU and writes
+// the incoming stream to the saved state file, calculating its checksum.
+func (t *MachineSuspendTask) receiveState() (uint64, string, error) {
+	conn, err := t.listener.Accept()
+	if err != nil {
+		return 0, "", err
+	}
+	defer conn.Close()
+
+	tmpFile := kvmrun.SavedStateFile(t.vmname) + ".tmp"
+
+	fd, err := os.OpenFile(tmpFile, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return 0, "", err
+	}
+	defer func() {
+		fd.Close()
+		os.Remove(tmpFile)
+	}()
+
+	h := sha256.New()
+
+	n, err := io.Copy(io.MultiWriter(fd, h), conn)
+	if err != nil {
+		return 0, "", err
+	}
+
+	if err := fd.Sync(); err != nil {
+		return 0, "", err
+	}
+
+	if err := os.Rename(tmpFile, kvmrun.SavedStateFile(t.vmname)); err != nil {
+		return 0, "", err
+	}
+
+	return uint64(n), hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func (t *MachineSuspendTask) migrate() error {
+	args := struct {
+		URI string `json:"uri"`
+	}{
+		// The path inside the machine chroot
+		URI: "unix:/run/saved_state.sock",
+	}
+
+	if err := t.Server.Mon.Run(t.vmname, qmp.Command{Name: "migrate", Arguments: &args}, nil); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		mi := &qemu_types.MigrationInfo{}
+
+		if err := t.Server.Mon.Run(t.vmname, qmp.Command{Name: "query-migrate", Arguments: nil}, mi); err != nil {
+			return err
+		}
+
+		t.updateStat(mi.Ram.Total, mi.Ram.Remaining, int(mi.Ram.Speed))
+
+		switch mi.Status {
+		case "completed":
+			return nil
+		case "failed":
+			return fmt.Errorf("QEMU migration failed: %s", mi.ErrDesc)
+		case "cancelled":
+			return fmt.Errorf("QEMU migration cancelled by QEMU monitor")
+		}
+
+		select {
+		case <-t.Ctx().Done():
+			if err := t.Server.Mon.Run(t.vmname, qmp.Command{Name: "migrate_cancel", Arguments: nil}, nil); err != nil {
+				t.Logger.Errorf("Failed to cancel QEMU migration: %s", err)
+			}
+
+			return t.Ctx().Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (t *MachineSuspendTask) OnSuccess() error {
+	t.MachineCreateDownFile(t.vmname)
+
+	// The guest must not continue running after its state was saved
+	if err := t.Server.Mon.Run(t.vmname, qmp.Command{Name: "quit", Arguments: nil}, nil); err != nil {
+		t.Logger.Warnf("Failed to terminate machine using command 'quit': %s", err)
+	}
+
+	if err := t.SystemdStopService(t.vmname, 30*time.Second); err != nil {
+		t.Logger.Errorf("Failed to stop the systemd unit: %s", err)
+	}
+
+	t.Logger.Info("Machine has been suspended")
+
+	return nil
+}
+
+func (t *MachineSuspendTask) OnFailure(taskErr error) {
+	if err := kvmrun.RemoveSavedState(t.vmname); err != nil {
+		t.Logger.Errorf("OnFailureHook: failed to remove the saved state: %s", err)
+	}
+
+	if t.vmstate == kvmrun.StateRunning {
+		if err := t.Server.Mon.Run(t.vmname, qmp.Command{Name: "cont", Arguments: nil}, nil); err != nil {
+			t.Logger.Errorf("OnFailureHook: failed to resume the machine: %s", err)
+		}
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/0xef53/kvmrun/internal/appconf"
+	"github.com/0xef53/kvmrun/internal/guestagent"
 	"github.com/0xef53/kvmrun/internal/monitor"
 	"github.com/0xef53/kvmrun/internal/systemd"
 	"github.com/0xef53/kvmrun/kvmrun"
@@ -26,7 +27,10 @@ type Server struct {
 	AppConf   *appconf.Config
 	SystemCtl *systemd.Manager
 	Mon       *monitor.Pool
+	Agent     *guestagent.Pool
 	Tasks     *task.Pool
+
+	blockJobs *blockJobRegistry
 }
 
 func NewServer(_ context.Context, appConf *appconf.Config) (*Server, error) {
@@ -53,7 +57,9 @@ func NewServer(_ context.Context, appConf *appconf.Config) (*Server, error) {
 		SessionID: uuid.New().String(),
 		AppConf:   appConf,
 		Mon:       monitor.NewPool(kvmrun.QMPMONDIR),
+		Agent:     guestagent.NewPool(kvmrun.QMPMONDIR),
 		Tasks:     task.NewPool(),
+		blockJobs: newBlockJobRegistry(),
 	}
 
 	// Systemd connection
@@ -129,6 +135,9 @@ func (s *Server) GetAppConfig(_ context.Context) *appconf.Config {
 			QemuRootDir: s.AppConf.Kvmrun.QemuRootDir,
 			CertDir:     s.AppConf.Kvmrun.CertDir,
 		},
+		Migration: appconf.MigrationConfig{
+			TLS: s.AppConf.Migration.TLS,
+		},
 	}
 }
 
@@ -0,0 +1,383 @@
+package system
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/0xef53/kvmrun/internal/dotenv"
+	"github.com/0xef53/kvmrun/internal/lvm"
+	"github.com/0xef53/kvmrun/internal/qemu"
+	"github.com/0xef53/kvmrun/kvmrun"
+	"github.com/0xef53/kvmrun/kvmrun/backend/block"
+	"github.com/0xef53/kvmrun/kvmrun/backend/file"
+	"github.com/0xef53/kvmrun/server/network"
+
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+type IncomingCheckOptions struct {
+	Manifest    []byte            `json:"manifest"`
+	Disks       map[string]uint64 `json:"disks"`
+	ExtraFiles  map[string][]byte `json:"extra_files"`
+	CreateDisks bool              `json:"create_disks"`
+}
+
+func (o *IncomingCheckOptions) Validate(strict bool) error {
+	if len(o.Manifest) == 0 {
+		return fmt.Errorf("empty machine manifest")
+	}
+
+	for diskname := range o.Disks {
+		if len(strings.TrimSpace(diskname)) == 0 {
+			return fmt.Errorf("empty diskname in 'disks' map")
+		}
+	}
+
+	return nil
+}
+
+// CheckIssue describes a problem that was found
+// during the pre-flight migration check.
+type CheckIssue struct {
+	Subject string `json:"subject"`
+	Desc    string `json:"desc"`
+}
+
+type IncomingCheckResult struct {
+	Blockers    []*CheckIssue `json:"blockers"`
+	Warnings    []*CheckIssue `json:"warnings"`
+	QemuVersion string        `json:"qemu_version"`
+}
+
+func (r *IncomingCheckResult) block(subject, format string, a ...interface{}) {
+	r.Blockers = append(r.Blockers, &CheckIssue{Subject: subject, Desc: fmt.Sprintf(format, a...)})
+}
+
+func (r *IncomingCheckResult) warn(subject, format string, a ...interface{}) {
+	r.Warnings = append(r.Warnings, &CheckIssue{Subject: subject, Desc: fmt.Sprintf(format, a...)})
+}
+
+// CheckIncomingMigration checks whether the machine described by the manifest
+// can be migrated to this server. Nothing is created or started.
+func (s *Server) CheckIncomingMigration(ctx context.Context, vmname string, opts *IncomingCheckOptions) (*IncomingCheckResult, error) {
+	if opts == nil {
+		return nil, fmt.Errorf("empty incoming-check opts")
+	} else {
+		if err := opts.Validate(true); err != nil {
+			return nil, err
+		}
+	}
+
+	res := IncomingCheckResult{
+		Blockers: make([]*CheckIssue, 0),
+		Warnings: make([]*CheckIssue, 0),
+	}
+
+	if s.HostIsDrained() {
+		res.block("host", "%s: incoming migrations are not accepted", kvmrun.ErrHostDrained)
+	}
+
+	// Name collisions
+	switch _, err := s.MachineGet(vmname, false); {
+	case err == nil:
+		res.block("name", "machine already exists: %s", vmname)
+	case errors.Is(err, kvmrun.ErrNotFound):
+		// OK
+	default:
+		return nil, err
+	}
+
+	var incvm incomingMachine
+
+	if err := json.Unmarshal(opts.Manifest, &incvm); err != nil {
+		return nil, fmt.Errorf("invalid manifest struct: %w", err)
+	}
+
+	if incvm.R == nil {
+		return nil, fmt.Errorf("invalid manifest struct: no runtime configuration")
+	}
+
+	s.checkIncomingQemu(&res, &incvm, opts)
+
+	s.checkIncomingMemory(&res, &incvm)
+
+	if err := s.checkIncomingNetwork(&res, opts); err != nil {
+		return nil, err
+	}
+
+	s.checkIncomingDisks(&res, opts)
+
+	return &res, nil
+}
+
+func (s *Server) checkIncomingQemu(res *IncomingCheckResult, incvm *incomingMachine, opts *IncomingCheckOptions) {
+	qemuRootDir := s.AppConf.Kvmrun.QemuRootDir
+
+	if b, ok := opts.ExtraFiles["config_envs"]; ok {
+		if vmenvs, err := dotenv.Parse(bytes.NewReader(b)); err == nil {
+			if dir, ok := vmenvs["QEMU_ROOTDIR"]; ok {
+				qemuRootDir = dir
+			}
+		} else {
+			res.warn("qemu", "failed to parse config_envs: %s", err)
+		}
+	}
+
+	if _, err := os.Stat(qemuRootDir); err != nil {
+		res.block("qemu", "QEMU root directory is not available: %s", err)
+
+		return
+	}
+
+	qver, err := qemu.GetVersion(qemuRootDir, kvmrun.QEMU_BINARY)
+	if err != nil {
+		res.block("qemu", "%s", err)
+
+		return
+	}
+
+	res.QemuVersion = qver.String()
+
+	if err := qemu.VerifyVersion(qver.String()); err != nil {
+		res.block("qemu", "%s", err)
+
+		return
+	}
+
+	// Machine type
+	if mtype := incvm.R.MachineTypeGet(); mtype != nil && len(mtype.String()) > 0 {
+		if types, err := qemu.GetMachineTypes(qemuRootDir, kvmrun.QEMU_BINARY); err == nil {
+			if !slices.Contains(types, mtype.String()) {
+				res.block("machine_type", "machine type is not supported by QEMU %s: %s", qver, mtype)
+			}
+		} else {
+			res.warn("machine_type", "failed to get a list of supported machine types: %s", err)
+		}
+	}
+
+	// CPU model
+	if model, _, _ := strings.Cut(incvm.R.CPUGetModel(), ","); len(model) > 0 {
+		if models, err := qemu.GetCPUModels(qemuRootDir, kvmrun.QEMU_BINARY); err == nil {
+			if !slices.Contains(models, model) {
+				res.block("cpu", "CPU model is not supported by QEMU %s: %s", qver, model)
+			}
+		} else {
+			res.warn("cpu", "failed to get a list of supported CPU models: %s", err)
+		}
+
+		if model == "host" {
+			res.warn("cpu", "CPU model 'host' is used, so the host CPUs must be identical")
+		}
+	}
+}
+
+func (s *Server) checkIncomingMemory(res *IncomingCheckResult, incvm *incomingMachine) {
+	available, err := memAvailable()
+	if err != nil {
+		res.warn("memory", "failed to determine the amount of available memory: %s", err)
+
+		return
+	}
+
+	// In MiB
+	actual := uint64(incvm.R.MemoryGetActual())
+	total := uint64(incvm.R.MemoryGetTotal())
+
+	switch {
+	case available < actual:
+		res.block("memory", "not enough memory: %d MiB available, %d MiB required", available, actual)
+	case available < total:
+		res.warn("memory", "not enough memory to grow up to the total size: %d MiB available, %d MiB total", available, total)
+	}
+}
+
+func (s *Server) checkIncomingNetwork(res *IncomingCheckResult, opts *IncomingCheckOptions) error {
+	b, ok := opts.ExtraFiles["config_network"]
+	if !ok {
+		return nil
+	}
+
+	schemes := make([]*network.SchemeProperties, 0, 5)
+
+	if err := json.Unmarshal(b, &schemes); err != nil {
+		res.block("network", "failed to parse network configuration: %s", err)
+
+		return nil
+	}
+
+	linkExists := func(linkname string) (bool, error) {
+		if _, err := netlink.LinkByName(linkname); err != nil {
+			if _, ok := err.(netlink.LinkNotFoundError); ok {
+				return false, nil
+			}
+
+			return false, fmt.Errorf("netlink: %w", err)
+		}
+
+		return true, nil
+	}
+
+	for _, scheme := range schemes {
+		var linkname, attrname string
+
+		switch scheme.SchemeType {
+		case network.Scheme_BRIDGE:
+			attrname = "bridge_interface"
+		case network.Scheme_VLAN:
+			attrname = "parent_interface"
+		case network.Scheme_VXLAN, network.Scheme_ROUTED:
+			attrname = "bind_interface"
+		default:
+			continue
+		}
+
+		if err := scheme.ValueAs(attrname, &linkname); err != nil {
+			res.block("network", "%s: invalid network scheme: %s", scheme.Ifname, err)
+
+			continue
+		}
+
+		switch ok, err := linkExists(linkname); {
+		case err != nil:
+			return err
+		case !ok:
+			res.block("network", "%s: %s interface not found: %s", scheme.Ifname, strings.ReplaceAll(attrname, "_", " "), linkname)
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) checkIncomingDisks(res *IncomingCheckResult, opts *IncomingCheckOptions) {
+	for diskPath, diskSize := range opts.Disks {
+		d, err := kvmrun.NewDisk(diskPath)
+		if err != nil {
+			res.block("disk", "%s: %s", diskPath, err)
+
+			continue
+		}
+
+		if !opts.CreateDisks {
+			if ok, err := d.IsAvailable(); !ok {
+				res.block("disk", "%s: disk is not available: %s", diskPath, err)
+
+				continue
+			}
+
+			if d.IsLocal() {
+				if size, err := d.Size(); err == nil {
+					if size < diskSize {
+						res.block("disk", "%s: insufficient space: %d bytes available, %d bytes required", diskPath, size, diskSize)
+					}
+				} else {
+					res.block("disk", "%s: %s", diskPath, err)
+				}
+			}
+
+			continue
+		}
+
+		switch ok, err := d.IsAvailable(); {
+		case err == nil:
+			if ok {
+				res.block("disk", "%s: disk already exists", diskPath)
+
+				continue
+			}
+		case os.IsNotExist(err):
+		default:
+			res.block("disk", "%s: %s", diskPath, err)
+
+			continue
+		}
+
+		switch d.Backend.(type) {
+		case *block.Device:
+			ff := strings.Split(diskPath[1:], "/")
+			if len(ff) != 3 {
+				res.block("disk", "%s: no idea how to create a block device", diskPath)
+
+				continue
+			}
+
+			if free, err := lvm.VolumeGroupFree(ff[1]); err == nil {
+				if free < diskSize {
+					res.block("disk", "%s: insufficient space in volume group %s: %d bytes available, %d bytes required", diskPath, ff[1], free, diskSize)
+				}
+			} else {
+				res.block("disk", "%s: %s", diskPath, err)
+			}
+		case *file.Device:
+			free, err := dirFree(filepath.Dir(diskPath))
+			if err != nil {
+				res.block("disk", "%s: %s", diskPath, err)
+
+				continue
+			}
+
+			if free < diskSize {
+				res.block("disk", "%s: insufficient space: %d bytes available, %d bytes required", diskPath, free, diskSize)
+			}
+		default:
+			res.block("disk", "%s: no idea how to create a disk of this type", diskPath)
+		}
+	}
+}
+
+// memAvailable returns the amount of available memory in MiB.
+func memAvailable() (uint64, error) {
+	b, err := os.ReadFile("/proc/meminfo")
+	if err != nil {
+		return 0, err
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+
+	for scanner.Scan() {
+		// MemAvailable:   12345678 kB
+		fields := strings.Fields(scanner.Text())
+
+		if len(fields) >= 2 && fields[0] == "MemAvailable:" {
+			kb, err := strconv.ParseUint(fields[1], 10, 64)
+			if err != nil {
+				return 0, err
+			}
+
+			return kb >> 10, nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+
+	return 0, fmt.Errorf("MemAvailable not found in /proc/meminfo")
+}
+
+// dirFree returns the free space in bytes on the file system
+// containing the directory or its nearest existing parent.
+func dirFree(dir string) (uint64, error) {
+	for {
+		var st unix.Statfs_t
+
+		err := unix.Statfs(dir, &st)
+		if err == nil {
+			return st.Bavail * uint64(st.Bsize), nil
+		}
+
+		if !os.IsNotExist(err) || dir == "/" {
+			return 0, err
+		}
+
+		dir = filepath.Dir(dir)
+	}
+}
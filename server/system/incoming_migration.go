@@ -10,6 +10,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/0xef53/kvmrun/internal/dotenv"
@@ -20,6 +21,8 @@ import (
 	qemu_types "github.com/0xef53/kvmrun/internal/qemu/types"
 	"github.com/0xef53/kvmrun/internal/version"
 	"github.com/0xef53/kvmrun/kvmrun"
+	"github.com/0xef53/kvmrun/kvmrun/backend/block"
+	"github.com/0xef53/kvmrun/kvmrun/backend/file"
 	"github.com/0xef53/kvmrun/server"
 
 	qmp "github.com/0xef53/go-qmp/v2"
@@ -33,6 +36,11 @@ type IncomingMigrationOptions struct {
 	ListenAddr   string            `json:"listen_addr"`
 	CreateDisks  bool              `json:"create_disks"`
 	TurnOffAfter bool              `json:"turn_off_after"`
+	TLS          bool              `json:"tls"`
+
+	MultifdChannels    uint32 `json:"multifd_channels"`
+	MultifdCompression string `json:"multifd_compression"`
+	PostCopy           bool   `json:"postcopy"`
 }
 
 func (o *IncomingMigrationOptions) Validate(strict bool) error {
@@ -72,6 +80,10 @@ func (s *Server) StartIncomingMigrationProcess(ctx context.Context, vmname strin
 		return nil, fmt.Errorf("empty incoming-migration opts")
 	}
 
+	if s.HostIsDrained() {
+		return nil, fmt.Errorf("%w: incoming migrations are not accepted", kvmrun.ErrHostDrained)
+	}
+
 	t := NewMachineIncomingMigrationTask(vmname, opts)
 
 	t.Server = s
@@ -106,6 +118,10 @@ type MachineIncomingMigrationTask struct {
 	requisites       *IncomingRequisites
 	hasFirmwareFlash bool
 	createdDisks     []string
+
+	// postcopy is set when the machine state migration
+	// has been switched to the post-copy mode
+	postcopy atomic.Bool
 }
 
 func NewMachineIncomingMigrationTask(vmname string, opts *IncomingMigrationOptions) *MachineIncomingMigrationTask {
@@ -173,7 +189,26 @@ func (t *MachineIncomingMigrationTask) BeforeStart(resp interface{}) (err error)
 		t.hasFirmwareFlash = true
 	}
 
-	if err := t.startNBDServer(kvmrun.FIRST_NBD_PORT + vmconf.UID()); err != nil {
+	// The same credentials are used to encrypt both the state stream
+	// and the NBD connections that transfer the disk contents.
+	// The empty value disables the encryption
+	var tlsCredsID string
+
+	if t.opts.TLS {
+		if id, err := t.MachineTLSCredsAdd(t.vmname, vmconf.UID(), kvmrun.TLSEndpointServer); err == nil {
+			tlsCredsID = id
+		} else {
+			return err
+		}
+
+		t.Logger.Info("Machine state and disks will be transferred using TLS")
+	}
+
+	if err := t.startNBDServer(kvmrun.FIRST_NBD_PORT+vmconf.UID(), tlsCredsID); err != nil {
+		return err
+	}
+
+	if err := t.startIncomingListener(kvmrun.FIRST_INCOMING_PORT+vmconf.UID(), tlsCredsID); err != nil {
 		return err
 	}
 
@@ -248,6 +283,12 @@ func (t *MachineIncomingMigrationTask) Main() error {
 			continue
 		}
 
+		if t.opts.PostCopy {
+			if err := t.checkPostCopy(); err != nil {
+				return err
+			}
+		}
+
 		t.Logger.Infof("Data transfer completed successfully: QEMU status = %s", st.Status)
 
 		break
@@ -284,7 +325,7 @@ func (t *MachineIncomingMigrationTask) Main() error {
 		if mi.Status == "completed" {
 			t.Logger.Info("Machine state migration completed")
 		} else {
-			return err
+			return fmt.Errorf("unexpected machine state migration status: %s", mi.Status)
 		}
 	} else {
 		t.Logger.Warnf("Failed to request migration status: %s", err)
@@ -323,6 +364,16 @@ func (t *MachineIncomingMigrationTask) OnSuccess() error {
 }
 
 func (t *MachineIncomingMigrationTask) OnFailure(taskErr error) {
+	if t.postcopy.Load() {
+		// The source machine is paused and its RAM is out of date,
+		// so this machine contains the only up-to-date state of the guest
+		// and must not be terminated.
+		t.Logger.Errorf("OnFailureHook: post-copy migration failed after the point of no return: %s", taskErr)
+		t.Logger.Errorf("OnFailureHook: the machine is left as is; a manual recovery is required (see migrate-recover)")
+
+		return
+	}
+
 	t.SystemdSendSIGTERM(t.vmname)
 
 	if err := t.SystemdStopService(t.vmname, 30*time.Second); err != nil {
@@ -342,7 +393,15 @@ func (t *MachineIncomingMigrationTask) OnFailure(taskErr error) {
 	t.Logger.Infof("OnFailureHook: removed: %s", t.vmname)
 
 	for _, diskPath := range t.createdDisks {
-		if err := lvm.RemoveVolume(diskPath); err != nil {
+		var err error
+
+		if strings.HasPrefix(diskPath, "/dev/") {
+			err = lvm.RemoveVolume(diskPath)
+		} else {
+			err = os.Remove(diskPath)
+		}
+
+		if err != nil {
 			t.Logger.Warnf("OnFailureHook: %s", err)
 		}
 		t.Logger.Infof("OnFailureHook: removed: %s", diskPath)
@@ -368,6 +427,16 @@ func (t *MachineIncomingMigrationTask) monitorIncomingConnection(taskCompleted c
 		}
 
 		if failcnt == 12 {
+			if t.postcopy.Load() {
+				// Cancelling the task at this stage will not help,
+				// the migration can only be recovered manually
+				t.Logger.Warn("ConnMonitor: no one established connection found in the last 60 seconds, but post-copy is active. Manual recovery may be required")
+
+				failcnt = 0
+
+				continue
+			}
+
 			break
 		}
 
@@ -422,7 +491,7 @@ func (t *MachineIncomingMigrationTask) validateDisks() error {
 		}
 
 		if d.IsLocal() {
-			size, err := d.Backend.Size()
+			size, err := d.Size()
 			if err != nil {
 				return err
 			}
@@ -453,7 +522,8 @@ func (t *MachineIncomingMigrationTask) createLocalDisks() error {
 			return err
 		}
 
-		if d.IsLocal() {
+		switch d.Backend.(type) {
+		case *block.Device:
 			ff := strings.Split(diskPath[1:], "/")
 			if len(ff) != 3 {
 				return fmt.Errorf("no idea how to create a block device: %s", diskPath)
@@ -464,15 +534,37 @@ func (t *MachineIncomingMigrationTask) createLocalDisks() error {
 			}
 
 			t.createdDisks = append(t.createdDisks, diskPath)
+		case *file.Device:
+			// The disk data is transferred via NBD,
+			// so the raw image is suitable regardless of the source format
+			if err := os.MkdirAll(filepath.Dir(diskPath), 0755); err != nil {
+				return err
+			}
+
+			fd, err := os.OpenFile(diskPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+			if err != nil {
+				return err
+			}
+
+			t.createdDisks = append(t.createdDisks, diskPath)
+
+			err = fd.Truncate(int64(diskSize))
+
+			fd.Close()
+
+			if err != nil {
+				return err
+			}
 		}
 	}
 
 	return nil
 }
 
-func (t *MachineIncomingMigrationTask) startNBDServer(port int) error {
+func (t *MachineIncomingMigrationTask) startNBDServer(port int, tlsCredsID string) error {
 	opts := struct {
-		Addr qemu_types.InetSocketAddressLegacy `json:"addr"`
+		Addr     qemu_types.InetSocketAddressLegacy `json:"addr"`
+		TLSCreds string                             `json:"tls-creds,omitempty"`
 	}{
 		Addr: qemu_types.InetSocketAddressLegacy{
 			Type: "inet",
@@ -481,6 +573,7 @@ func (t *MachineIncomingMigrationTask) startNBDServer(port int) error {
 				Port: strconv.Itoa(port),
 			},
 		},
+		TLSCreds: tlsCredsID,
 	}
 
 	if err := t.Mon.Run(t.vmname, qmp.Command{Name: "nbd-server-start", Arguments: &opts}, nil); err != nil {
@@ -520,6 +613,100 @@ func (t *MachineIncomingMigrationTask) startNBDServer(port int) error {
 	return nil
 }
 
+// startIncomingListener starts listening for the machine state stream.
+// QEMU is launched with "-incoming defer", so the migration parameters
+// should be set before the migrate-incoming command.
+func (t *MachineIncomingMigrationTask) startIncomingListener(port int, tlsCredsID string) error {
+	params := qemu_types.MigrationParameters{
+		TLSCreds: tlsCredsID,
+	}
+
+	// Multifd and post-copy must be enabled on both sides
+	caps := make([]qemu_types.MigrationCapabilityStatus, 0, 2)
+
+	if t.opts.MultifdChannels > 0 {
+		caps = append(caps, qemu_types.MigrationCapabilityStatus{Capability: "multifd", State: true})
+
+		params.MultifdChannels = t.opts.MultifdChannels
+		params.MultifdCompression = t.opts.MultifdCompression
+	}
+
+	if t.opts.PostCopy {
+		caps = append(caps, qemu_types.MigrationCapabilityStatus{Capability: "postcopy-ram", State: true})
+	}
+
+	if len(caps) > 0 {
+		capsArgs := struct {
+			Capabilities []qemu_types.MigrationCapabilityStatus `json:"capabilities"`
+		}{
+			Capabilities: caps,
+		}
+
+		if err := t.Mon.Run(t.vmname, qmp.Command{Name: "migrate-set-capabilities", Arguments: &capsArgs}, nil); err != nil {
+			return err
+		}
+	}
+
+	if err := t.Mon.Run(t.vmname, qmp.Command{Name: "migrate-set-parameters", Arguments: &params}, nil); err != nil {
+		return err
+	}
+
+	host := "0.0.0.0"
+
+	if vmenvs, err := dotenv.Read(filepath.Join(kvmrun.CONFDIR, t.vmname, "config_envs")); err == nil {
+		if v, ok := vmenvs["INCOMING_HOST"]; ok && len(v) > 0 {
+			host = v
+		}
+	} else {
+		if !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	args := struct {
+		URI string `json:"uri"`
+	}{
+		URI: fmt.Sprintf("tcp:%s:%d", host, port),
+	}
+
+	return t.Mon.Run(t.vmname, qmp.Command{Name: "migrate-incoming", Arguments: &args}, nil)
+}
+
+// checkPostCopy resumes the machine as soon as the migration
+// has been switched to the post-copy mode. Starting from this point
+// the missing memory pages are requested from the source on demand.
+func (t *MachineIncomingMigrationTask) checkPostCopy() error {
+	if t.postcopy.Load() {
+		return nil
+	}
+
+	mi := qemu_types.MigrationInfo{}
+
+	if err := t.Server.Mon.Run(t.vmname, qmp.Command{Name: "query-migrate", Arguments: nil}, &mi); err != nil {
+		return err
+	}
+
+	switch mi.Status {
+	case "postcopy-active", "postcopy-paused", "postcopy-recover":
+	default:
+		return nil
+	}
+
+	t.postcopy.Store(true)
+
+	t.Logger.Info("Machine state migration switched to the post-copy mode")
+
+	if t.opts.TurnOffAfter {
+		return nil
+	}
+
+	if err := t.Server.Mon.Run(t.vmname, qmp.Command{Name: "cont", Arguments: nil}, nil); err != nil {
+		return fmt.Errorf("failed to send CONT signal via QMP: %w", err)
+	}
+
+	return nil
+}
+
 func (t *MachineIncomingMigrationTask) stopNBDServer() error {
 	return t.Mon.Run(t.vmname, qmp.Command{Name: "nbd-server-stop", Arguments: nil}, nil)
 }
@@ -581,9 +768,19 @@ func (t *MachineIncomingMigrationTask) startIncomingMachine() (uint32, error) {
 		return 0, err
 	}
 
-	// Extra files
+	// Extra files (including the backup states in the subdirectory)
 	for fname, content := range t.opts.ExtraFiles {
-		if err := os.WriteFile(filepath.Join(vmdir, fname), content, 0644); err != nil {
+		if !filepath.IsLocal(fname) {
+			return 0, fmt.Errorf("invalid name of extra file: %s", fname)
+		}
+
+		fname = filepath.Join(vmdir, fname)
+
+		if err := os.MkdirAll(filepath.Dir(fname), 0755); err != nil {
+			return 0, err
+		}
+
+		if err := os.WriteFile(fname, content, 0644); err != nil {
 			return 0, err
 		}
 	}
@@ -599,8 +796,15 @@ func (t *MachineIncomingMigrationTask) startIncomingMachine() (uint32, error) {
 			if qemu.IsDefaultMachineType(qver.String(), incvm.R.MachineTypeGet().String()) {
 				t.Logger.Infof("Machine type on source and destination servers are the same")
 
-				// It's not a mistake, we are actually using "R" here
-				incvm.R.MachineTypeSet("")
+				// It's not a mistake, we are actually using "R" here.
+				// The alias of the same chipset should be used,
+				// otherwise q35 machine will be turned into i440fx.
+				switch incvm.R.MachineTypeGet().Chipset {
+				case kvmrun.QEMU_CHIPSET_Q35:
+					incvm.R.MachineTypeSet("q35")
+				default:
+					incvm.R.MachineTypeSet("")
+				}
 			}
 		} else {
 			return 0, err
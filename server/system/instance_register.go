@@ -135,9 +135,54 @@ func (t *InstanceRegistrationTask) Main() error {
 
 	group.Go(func() error { return t.initNetworkSecondStage(ctx) })
 
+	if st, err := kvmrun.GetSavedState(t.vmname); err == nil && st.Restoring {
+		group.Go(func() error { return t.cleanSavedState(ctx) })
+	}
+
 	return group.Wait()
 }
 
+// cleanSavedState waits until QEMU loads the machine state
+// saved by the suspend operation and removes the state file.
+func (t *InstanceRegistrationTask) cleanSavedState(ctx context.Context) error {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		mi := qemu_types.MigrationInfo{}
+
+		if err := t.Server.Mon.Run(t.vmname, qmp.Command{Name: "query-migrate", Arguments: nil}, &mi); err != nil {
+			if qmp.IsSocketNotAvailable(err) {
+				continue
+			}
+			return err
+		}
+
+		switch mi.Status {
+		case "completed":
+			t.Logger.Info("[saved-state] Machine state has been restored")
+		case "failed":
+			t.Logger.Error("[saved-state] Failed to restore machine state")
+		default:
+			continue
+		}
+
+		break
+	}
+
+	if err := kvmrun.RemoveSavedState(t.vmname); err != nil {
+		t.Logger.Errorf("[saved-state] Failed to remove saved state: %s", err)
+	}
+
+	return nil
+}
+
 // waitForQemuSystem waits until the "launcher" process turns
 // into the "qemu-system" process.
 func (t *InstanceRegistrationTask) waitForQemuSystem() error {
@@ -0,0 +1,79 @@
+package blockjobs
+
+import (
+	"context"
+
+	pb "github.com/0xef53/kvmrun/api/services/blockjobs/v2"
+	pb_types "github.com/0xef53/kvmrun/api/types/v2"
+
+	empty "github.com/golang/protobuf/ptypes/empty"
+)
+
+func (s *service) List(ctx context.Context, req *pb.ListRequest) (*pb.ListResponse, error) {
+	jobs, err := s.ServiceServer.BlockJobs.List(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	protos := make([]*pb_types.BlockJob, 0, len(jobs))
+
+	for _, j := range jobs {
+		protos = append(protos, blockJobToProto(j))
+	}
+
+	return &pb.ListResponse{Jobs: protos}, nil
+}
+
+func (s *service) Get(ctx context.Context, req *pb.GetRequest) (*pb.GetResponse, error) {
+	job, err := s.ServiceServer.BlockJobs.Get(ctx, req.Name, req.JobID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetResponse{Job: blockJobToProto(job)}, nil
+}
+
+func (s *service) Cancel(ctx context.Context, req *pb.CancelRequest) (*empty.Empty, error) {
+	err := s.ServiceServer.BlockJobs.Cancel(ctx, req.Name, req.JobID)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(empty.Empty), nil
+}
+
+func (s *service) Pause(ctx context.Context, req *pb.PauseRequest) (*empty.Empty, error) {
+	err := s.ServiceServer.BlockJobs.Pause(ctx, req.Name, req.JobID)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(empty.Empty), nil
+}
+
+func (s *service) Resume(ctx context.Context, req *pb.ResumeRequest) (*empty.Empty, error) {
+	err := s.ServiceServer.BlockJobs.Resume(ctx, req.Name, req.JobID)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(empty.Empty), nil
+}
+
+func (s *service) Complete(ctx context.Context, req *pb.CompleteRequest) (*empty.Empty, error) {
+	err := s.ServiceServer.BlockJobs.Complete(ctx, req.Name, req.JobID)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(empty.Empty), nil
+}
+
+func (s *service) Dismiss(ctx context.Context, req *pb.DismissRequest) (*empty.Empty, error) {
+	err := s.ServiceServer.BlockJobs.Dismiss(ctx, req.Name, req.JobID)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(empty.Empty), nil
+}
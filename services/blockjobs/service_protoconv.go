@@ -0,0 +1,24 @@
+package blockjobs
+
+import (
+	pb_types "github.com/0xef53/kvmrun/api/types/v2"
+
+	"github.com/0xef53/kvmrun/server/blockjobs"
+)
+
+func blockJobToProto(j *blockjobs.Job) *pb_types.BlockJob {
+	return &pb_types.BlockJob{
+		Id:      j.ID,
+		Type:    j.Type,
+		Status:  j.Status,
+		Error:   j.Error,
+		Offset:  j.Offset,
+		Length:  j.Len,
+		Speed:   j.Speed,
+		Busy:    j.Busy,
+		Paused:  j.Paused,
+		Ready:   j.Ready,
+		TaskKey: j.TaskKey,
+		TaskID:  j.TaskID,
+	}
+}
@@ -0,0 +1,84 @@
+package guestagent
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/0xef53/kvmrun/api/services/guestagent/v2"
+
+	empty "github.com/golang/protobuf/ptypes/empty"
+)
+
+func (s *service) Ping(ctx context.Context, req *pb.PingRequest) (*empty.Empty, error) {
+	err := s.ServiceServer.GuestAgent.Ping(ctx, req.Name, time.Duration(req.Timeout)*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(empty.Empty), nil
+}
+
+func (s *service) GetInfo(ctx context.Context, req *pb.GetInfoRequest) (*pb.GetInfoResponse, error) {
+	info, err := s.ServiceServer.GuestAgent.GetInfo(ctx, req.Name, time.Duration(req.Timeout)*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetInfoResponse{Info: agentInfoToProto(info)}, nil
+}
+
+func (s *service) GetOSInfo(ctx context.Context, req *pb.GetOSInfoRequest) (*pb.GetOSInfoResponse, error) {
+	info, err := s.ServiceServer.GuestAgent.GetOSInfo(ctx, req.Name, time.Duration(req.Timeout)*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetOSInfoResponse{Info: osInfoToProto(info)}, nil
+}
+
+func (s *service) GetNetworkInterfaces(ctx context.Context, req *pb.GetNetworkInterfacesRequest) (*pb.GetNetworkInterfacesResponse, error) {
+	ifaces, err := s.ServiceServer.GuestAgent.GetNetworkInterfaces(ctx, req.Name, time.Duration(req.Timeout)*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetNetworkInterfacesResponse{Interfaces: networkInterfacesToProto(ifaces)}, nil
+}
+
+func (s *service) GetFSInfo(ctx context.Context, req *pb.GetFSInfoRequest) (*pb.GetFSInfoResponse, error) {
+	fslist, err := s.ServiceServer.GuestAgent.GetFSInfo(ctx, req.Name, time.Duration(req.Timeout)*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetFSInfoResponse{Filesystems: filesystemsToProto(fslist)}, nil
+}
+
+func (s *service) SetUserPassword(ctx context.Context, req *pb.SetUserPasswordRequest) (*empty.Empty, error) {
+	err := s.ServiceServer.GuestAgent.SetUserPassword(ctx, req.Name, req.Username, req.Password, req.Crypted, time.Duration(req.Timeout)*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(empty.Empty), nil
+}
+
+func (s *service) Exec(ctx context.Context, req *pb.ExecRequest) (*pb.ExecResponse, error) {
+	opts := optsFromExecRequest(req)
+
+	res, err := s.ServiceServer.GuestAgent.Exec(ctx, req.Name, opts, time.Duration(req.Timeout)*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ExecResponse{Status: execResultToProto(res)}, nil
+}
+
+func (s *service) ExecStatus(ctx context.Context, req *pb.ExecStatusRequest) (*pb.ExecStatusResponse, error) {
+	res, err := s.ServiceServer.GuestAgent.ExecStatus(ctx, req.Name, int(req.PID), time.Duration(req.Timeout)*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ExecStatusResponse{Status: execResultToProto(res)}, nil
+}
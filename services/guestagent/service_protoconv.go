@@ -0,0 +1,120 @@
+package guestagent
+
+import (
+	ga "github.com/0xef53/kvmrun/internal/guestagent"
+	"github.com/0xef53/kvmrun/server/guestagent"
+
+	pb "github.com/0xef53/kvmrun/api/services/guestagent/v2"
+	pb_types "github.com/0xef53/kvmrun/api/types/v2"
+)
+
+func optsFromExecRequest(req *pb.ExecRequest) *guestagent.ExecOptions {
+	return &guestagent.ExecOptions{
+		Path:   req.Path,
+		Args:   req.Args,
+		Env:    req.Env,
+		Input:  req.Input,
+		NoWait: req.NoWait,
+	}
+}
+
+func agentInfoToProto(info *ga.Info) *pb_types.GuestAgentInfo {
+	proto := pb_types.GuestAgentInfo{
+		Version:           info.Version,
+		SupportedCommands: make([]*pb_types.GuestAgentInfo_Command, 0, len(info.SupportedCommands)),
+	}
+
+	for _, c := range info.SupportedCommands {
+		proto.SupportedCommands = append(proto.SupportedCommands, &pb_types.GuestAgentInfo_Command{
+			Name:            c.Name,
+			Enabled:         c.Enabled,
+			SuccessResponse: c.SuccessResponse,
+		})
+	}
+
+	return &proto
+}
+
+func osInfoToProto(info *ga.OSInfo) *pb_types.GuestOSInfo {
+	return &pb_types.GuestOSInfo{
+		KernelRelease: info.KernelRelease,
+		KernelVersion: info.KernelVersion,
+		Machine:       info.Machine,
+		Id:            info.ID,
+		Name:          info.Name,
+		PrettyName:    info.PrettyName,
+		Version:       info.Version,
+		VersionId:     info.VersionID,
+		Variant:       info.Variant,
+		VariantId:     info.VariantID,
+	}
+}
+
+func networkInterfacesToProto(ifaces []*ga.NetworkInterface) []*pb_types.GuestNetworkInterface {
+	protos := make([]*pb_types.GuestNetworkInterface, 0, len(ifaces))
+
+	for _, iface := range ifaces {
+		proto := pb_types.GuestNetworkInterface{
+			Name:            iface.Name,
+			HardwareAddress: iface.HardwareAddress,
+			IpAddresses:     make([]*pb_types.GuestNetworkInterface_IPAddress, 0, len(iface.IPAddresses)),
+		}
+
+		for _, addr := range iface.IPAddresses {
+			proto.IpAddresses = append(proto.IpAddresses, &pb_types.GuestNetworkInterface_IPAddress{
+				Type:    addr.Type,
+				Address: addr.Address,
+				Prefix:  int32(addr.Prefix),
+			})
+		}
+
+		protos = append(protos, &proto)
+	}
+
+	return protos
+}
+
+func filesystemsToProto(fslist []*ga.Filesystem) []*pb_types.GuestFilesystem {
+	protos := make([]*pb_types.GuestFilesystem, 0, len(fslist))
+
+	for _, fs := range fslist {
+		proto := pb_types.GuestFilesystem{
+			Name:       fs.Name,
+			Mountpoint: fs.Mountpoint,
+			Type:       fs.Type,
+			UsedBytes:  fs.UsedBytes,
+			TotalBytes: fs.TotalBytes,
+			Disks:      make([]*pb_types.GuestFilesystem_Disk, 0, len(fs.Disks)),
+		}
+
+		for _, d := range fs.Disks {
+			proto.Disks = append(proto.Disks, &pb_types.GuestFilesystem_Disk{
+				BusType: d.BusType,
+				Dev:     d.Dev,
+				Serial:  d.Serial,
+			})
+		}
+
+		protos = append(protos, &proto)
+	}
+
+	return protos
+}
+
+func execResultToProto(res *guestagent.ExecResult) *pb_types.GuestExecStatus {
+	proto := pb_types.GuestExecStatus{
+		PID: int64(res.PID),
+	}
+
+	if res.ExecStatus != nil {
+		proto.Exited = res.Exited
+		proto.ExitCode = int32(res.ExitCode)
+		proto.Signal = int32(res.Signal)
+		proto.Stdout = res.OutData
+		proto.Stderr = res.ErrData
+		proto.StdoutTruncated = res.OutTruncated
+		proto.StderrTruncated = res.ErrTruncated
+	}
+
+	return &proto
+}
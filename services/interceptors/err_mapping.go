@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 
+	"github.com/0xef53/kvmrun/internal/guestagent"
 	"github.com/0xef53/kvmrun/kvmrun"
+	"github.com/0xef53/kvmrun/server/blockjobs"
 
 	"google.golang.org/grpc"
 	grpc_codes "google.golang.org/grpc/codes"
@@ -30,8 +32,10 @@ func MapErrorsUnaryServerInterceptor() grpc.UnaryServerInterceptor {
 			code = grpc_codes.AlreadyExists
 		case errors.Is(err, kvmrun.ErrNotFound):
 			code = grpc_codes.NotFound
-		case errors.Is(err, kvmrun.ErrNotRunning):
+		case errors.Is(err, kvmrun.ErrNotRunning), errors.Is(err, kvmrun.ErrHostDrained), errors.Is(err, blockjobs.ErrOwnedByTask):
 			code = grpc_codes.FailedPrecondition
+		case errors.Is(err, guestagent.ErrNotResponding):
+			code = grpc_codes.Unavailable
 		default:
 			code = grpc_codes.Internal
 		}
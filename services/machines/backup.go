@@ -4,6 +4,8 @@ import (
 	"context"
 
 	pb "github.com/0xef53/kvmrun/api/services/machines/v2"
+
+	empty "github.com/golang/protobuf/ptypes/empty"
 )
 
 func (s *service) StartDiskBackupProcess(ctx context.Context, req *pb.StartDiskBackupRequest) (*pb.StartDiskBackupResponse, error) {
@@ -16,3 +18,88 @@ func (s *service) StartDiskBackupProcess(ctx context.Context, req *pb.StartDiskB
 
 	return &pb.StartDiskBackupResponse{TaskKey: tid}, nil
 }
+
+func (s *service) StartMachineBackupProcess(ctx context.Context, req *pb.StartMachineBackupRequest) (*pb.StartMachineBackupResponse, error) {
+	opts := optsFromStartMachineBackupRequest(req)
+
+	tid, err := s.ServiceServer.Machine.StartMachineBackupProcess(ctx, req.Name, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.StartMachineBackupResponse{TaskKey: tid}, nil
+}
+
+func (s *service) StartBackupExport(ctx context.Context, req *pb.StartBackupExportRequest) (*pb.StartBackupExportResponse, error) {
+	opts := optsFromStartBackupExportRequest(req)
+
+	requisites, err := s.ServiceServer.Machine.StartBackupExport(ctx, req.Name, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return backupExportRequisitesToResponse(requisites), nil
+}
+
+func (s *service) FinishBackupExport(ctx context.Context, req *pb.FinishBackupExportRequest) (*empty.Empty, error) {
+	if err := s.ServiceServer.Machine.FinishBackupExport(ctx, req.Name, req.Success); err != nil {
+		return nil, err
+	}
+
+	return new(empty.Empty), nil
+}
+
+func (s *service) StartDiskRestoreProcess(ctx context.Context, req *pb.StartDiskRestoreRequest) (*pb.StartDiskRestoreResponse, error) {
+	opts := optsFromStartDiskRestoreRequest(req)
+
+	res, err := s.ServiceServer.Machine.StartDiskRestoreProcess(ctx, req.Name, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.StartDiskRestoreResponse{TaskKey: res.TaskID, AttachedDisk: res.AttachedDisk}, nil
+}
+
+func (s *service) BackupPolicySet(ctx context.Context, req *pb.BackupPolicySetRequest) (*empty.Empty, error) {
+	err := s.ServiceServer.Machine.BackupPolicySet(ctx, req.Name, backupPolicyFromProto(req.Policy))
+	if err != nil {
+		return nil, err
+	}
+
+	return new(empty.Empty), nil
+}
+
+func (s *service) BackupPolicyGet(ctx context.Context, req *pb.BackupPolicyGetRequest) (*pb.BackupPolicyGetResponse, error) {
+	info, err := s.ServiceServer.Machine.BackupPolicyGet(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := pb.BackupPolicyGetResponse{
+		Policy: backupPolicyToProto(info.Policy),
+	}
+
+	if !info.NextRun.IsZero() {
+		resp.NextRun = uint64(info.NextRun.Unix())
+	}
+
+	return &resp, nil
+}
+
+func (s *service) BackupPolicyRemove(ctx context.Context, req *pb.BackupPolicyRemoveRequest) (*empty.Empty, error) {
+	err := s.ServiceServer.Machine.BackupPolicyRemove(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(empty.Empty), nil
+}
+
+func (s *service) BackupCatalogList(ctx context.Context, req *pb.BackupCatalogListRequest) (*pb.BackupCatalogListResponse, error) {
+	entries, err := s.ServiceServer.Machine.BackupCatalogList(ctx, req.Name, req.DiskName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.BackupCatalogListResponse{Entries: backupCatalogToProto(entries)}, nil
+}
@@ -63,3 +63,23 @@ func (s *service) DiskResizeQemuBlockdev(ctx context.Context, req *pb.DiskResize
 
 	return new(empty.Empty), nil
 }
+
+func (s *service) StartDiskMoveProcess(ctx context.Context, req *pb.StartDiskMoveRequest) (*pb.StartDiskMoveResponse, error) {
+	opts := optsFromStartDiskMoveRequest(req)
+
+	tid, err := s.ServiceServer.Machine.StartDiskMoveProcess(ctx, req.Name, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.StartDiskMoveResponse{TaskKey: tid}, nil
+}
+
+func (s *service) DiskGrow(ctx context.Context, req *pb.DiskGrowRequest) (*pb.DiskGrowResponse, error) {
+	size, err := s.ServiceServer.Machine.DiskGrow(ctx, req.Name, req.DiskName, req.Size)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DiskGrowResponse{Size: size}, nil
+}
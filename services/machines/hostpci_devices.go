@@ -11,7 +11,7 @@ import (
 func (s *service) HostDeviceAttach(ctx context.Context, req *pb.HostDeviceAttachRequest) (*empty.Empty, error) {
 	opts := optsFromHostDeviceAttachRequest(req)
 
-	err := s.ServiceServer.Machine.HostDeviceAttach(ctx, req.Name, opts, req.StrictMode)
+	err := s.ServiceServer.Machine.HostDeviceAttach(ctx, req.Name, opts, req.StrictMode, req.Live)
 	if err != nil {
 		return nil, err
 	}
@@ -20,7 +20,7 @@ func (s *service) HostDeviceAttach(ctx context.Context, req *pb.HostDeviceAttach
 }
 
 func (s *service) HostDeviceDetach(ctx context.Context, req *pb.HostDeviceDetachRequest) (*empty.Empty, error) {
-	err := s.ServiceServer.Machine.HostDeviceDetach(ctx, req.Name, req.PCIAddr)
+	err := s.ServiceServer.Machine.HostDeviceDetach(ctx, req.Name, req.PCIAddr, req.Live)
 	if err != nil {
 		return nil, err
 	}
@@ -7,7 +7,7 @@ import (
 )
 
 func (s *service) StartMigrationProcess(ctx context.Context, req *pb.StartMigrationRequest) (*pb.StartMigrationResponse, error) {
-	opts := optsFromStartMigrationRequest(req)
+	opts := optsFromStartMigrationRequest(req, s.ServiceServer.AppConf.Migration.TLS)
 
 	tid, err := s.ServiceServer.Machine.StartMigrationProcess(ctx, req.Name, req.DstServer, opts)
 	if err != nil {
@@ -16,3 +16,25 @@ func (s *service) StartMigrationProcess(ctx context.Context, req *pb.StartMigrat
 
 	return &pb.StartMigrationResponse{TaskKey: tid}, nil
 }
+
+func (s *service) MigrationCheck(ctx context.Context, req *pb.MigrationCheckRequest) (*pb.MigrationCheckResponse, error) {
+	opts := optsFromMigrationCheckRequest(req, s.ServiceServer.AppConf.Migration.TLS)
+
+	res, err := s.ServiceServer.Machine.CheckMigration(ctx, req.Name, req.DstServer, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return migrationCheckResultToProto(res), nil
+}
+
+func (s *service) StartEvacuationProcess(ctx context.Context, req *pb.StartEvacuationRequest) (*pb.StartEvacuationResponse, error) {
+	opts := optsFromStartEvacuationRequest(req, s.ServiceServer.AppConf.Migration.TLS)
+
+	tid, err := s.ServiceServer.Machine.StartEvacuationProcess(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.StartEvacuationResponse{TaskKey: tid}, nil
+}
@@ -12,7 +12,7 @@ import (
 func (s *service) Start(ctx context.Context, req *pb.StartRequest) (*empty.Empty, error) {
 	waitTime := time.Duration(req.WaitInterval) * time.Second
 
-	err := s.ServiceServer.Machine.Start(ctx, req.Name, waitTime)
+	err := s.ServiceServer.Machine.Start(ctx, req.Name, waitTime, req.DiscardSavedState)
 	if err != nil {
 		return nil, err
 	}
@@ -46,3 +46,30 @@ func (s *service) Reset(ctx context.Context, req *pb.ResetRequest) (*empty.Empty
 
 	return new(empty.Empty), nil
 }
+
+func (s *service) Pause(ctx context.Context, req *pb.PauseRequest) (*empty.Empty, error) {
+	err := s.ServiceServer.Machine.Pause(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(empty.Empty), nil
+}
+
+func (s *service) Resume(ctx context.Context, req *pb.ResumeRequest) (*empty.Empty, error) {
+	err := s.ServiceServer.Machine.Resume(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(empty.Empty), nil
+}
+
+func (s *service) StartSuspendProcess(ctx context.Context, req *pb.StartSuspendRequest) (*pb.StartSuspendResponse, error) {
+	tid, err := s.ServiceServer.Machine.StartSuspendProcess(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.StartSuspendResponse{TaskKey: tid}, nil
+}
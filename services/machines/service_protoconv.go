@@ -62,6 +62,10 @@ func machineToProto(vm *kvmrun.Machine, vmstate kvmrun.InstanceState, t time.Dur
 				IopsWr:    uint32(d.IopsWr),
 				Bootindex: uint32(d.Bootindex),
 				Addr:      d.QemuAddr,
+				Snapshots: snapshotNames(d.Snapshots),
+				Readonly:  d.Readonly,
+				Backup:    diskBackupStateToProto(vm, d),
+				Format:    d.DiskProperties.Format,
 			})
 		}
 
@@ -184,6 +188,7 @@ func propertiesFromMachineOpts(proto *pb_types.MachineOpts) *kvmrun.InstanceProp
 		opts.Disks.Append(&kvmrun.Disk{
 			DiskProperties: kvmrun.DiskProperties{
 				Path:   v.Path,
+				Format: v.Format,
 				Driver: v.Driver,
 				IopsRd: int(v.IopsRd),
 				IopsWr: int(v.IopsWr),
@@ -286,6 +291,7 @@ func optsFromInputDeviceAttachRequest(req *pb.InputDeviceAttachRequest) *kvmrun.
 func optsFromDiskAttachRequest(req *pb.DiskAttachRequest) *kvmrun.DiskProperties {
 	return &kvmrun.DiskProperties{
 		Path:      req.DiskPath,
+		Format:    req.Format,
 		Driver:    strings.ReplaceAll(strings.ToLower(req.Driver.String()), "_", "-"),
 		IopsRd:    int(req.IopsRd),
 		IopsWr:    int(req.IopsWr),
@@ -334,16 +340,92 @@ func optsFromStartDiskBackupRequest(req *pb.StartDiskBackupRequest) *machine.Dis
 		Target:      req.Target,
 		Incremental: req.Incremental,
 		ClearBitmap: req.ClearBitmap,
+		FSFreeze:    req.FsFreeze,
+		Speed:       req.Speed,
+	}
+}
+
+func optsFromStartMachineBackupRequest(req *pb.StartMachineBackupRequest) *machine.MachineBackupOptions {
+	opts := machine.MachineBackupOptions{
+		Targets:     make([]*machine.MachineBackupTarget, 0, len(req.Targets)),
+		Incremental: req.Incremental,
+		ClearBitmap: req.ClearBitmap,
+		FSFreeze:    req.FsFreeze,
+		Speed:       req.Speed,
+	}
+
+	for _, t := range req.Targets {
+		opts.Targets = append(opts.Targets, &machine.MachineBackupTarget{
+			DiskName: t.DiskName,
+			Target:   t.Target,
+		})
+	}
+
+	return &opts
+}
+
+func optsFromStartBackupExportRequest(req *pb.StartBackupExportRequest) *machine.BackupExportOptions {
+	return &machine.BackupExportOptions{
+		Disks:       req.Disks,
+		ListenAddr:  req.ListenAddr,
+		TLS:         req.Tls,
+		Incremental: req.Incremental,
+		FSFreeze:    req.FsFreeze,
+		Timeout:     time.Duration(req.Timeout) * time.Second,
+	}
+}
+
+func backupExportRequisitesToResponse(requisites *machine.BackupExportRequisites) *pb.StartBackupExportResponse {
+	resp := pb.StartBackupExportResponse{
+		TaskKey:      requisites.TaskID,
+		Exports:      make([]*pb.StartBackupExportResponse_Export, 0, len(requisites.Exports)),
+		FsConsistent: requisites.FSConsistent,
+	}
+
+	for _, e := range requisites.Exports {
+		resp.Exports = append(resp.Exports, &pb.StartBackupExportResponse_Export{
+			DiskName: e.DiskName,
+			Uri:      e.URI,
+			Bitmap:   e.Bitmap,
+		})
 	}
+
+	return &resp
 }
 
-func optsFromStartMigrationRequest(req *pb.StartMigrationRequest) *machine.MigrationOptions {
+func optsFromStartDiskRestoreRequest(req *pb.StartDiskRestoreRequest) *machine.DiskRestoreOptions {
+	return &machine.DiskRestoreOptions{
+		DiskName: req.DiskName,
+		Source:   req.Source,
+	}
+}
+
+func optsFromStartDiskMoveRequest(req *pb.StartDiskMoveRequest) *machine.DiskMoveOptions {
+	return &machine.DiskMoveOptions{
+		DiskName:     req.DiskName,
+		Target:       req.Target,
+		CreateTarget: req.CreateTarget,
+		RemoveSource: req.RemoveSource,
+		Speed:        req.Speed,
+	}
+}
+
+func optsFromStartMigrationRequest(req *pb.StartMigrationRequest, defaultTLS bool) *machine.MigrationOptions {
 	opts := machine.MigrationOptions{
 		Disks:       req.Disks,
 		CreateDisks: req.CreateDisks,
 		RemoveAfter: req.RemoveAfter,
 	}
 
+	switch req.Tls {
+	case pb_types.MigrationTLS_TLS_ON:
+		opts.TLS = true
+	case pb_types.MigrationTLS_TLS_OFF:
+		opts.TLS = false
+	default:
+		opts.TLS = defaultTLS
+	}
+
 	if req.Overrides != nil {
 		opts.Overrides = machine.MigrationOverrides{
 			Name:      req.Overrides.Name,
@@ -352,9 +434,99 @@ func optsFromStartMigrationRequest(req *pb.StartMigrationRequest) *machine.Migra
 		}
 	}
 
+	if req.Tuning != nil {
+		opts.Tuning = machine.MigrationTuning{
+			MaxBandwidth:         req.Tuning.MaxBandwidth,
+			DowntimeLimit:        req.Tuning.DowntimeLimit,
+			MultifdChannels:      req.Tuning.MultifdChannels,
+			MultifdCompression:   req.Tuning.MultifdCompression,
+			DisableXbzrle:        req.Tuning.DisableXbzrle,
+			XbzrleCacheSize:      req.Tuning.XbzrleCacheSize,
+			DisableAutoConverge:  req.Tuning.DisableAutoConverge,
+			CPUThrottleInitial:   req.Tuning.CPUThrottleInitial,
+			CPUThrottleIncrement: req.Tuning.CPUThrottleIncrement,
+			PostCopy:             req.Tuning.Postcopy,
+			PostCopyAfterPasses:  req.Tuning.PostcopyAfterPasses,
+			PostCopyAfterSeconds: req.Tuning.PostcopyAfterSeconds,
+		}
+	}
+
 	return &opts
 }
 
+func optsFromMigrationCheckRequest(req *pb.MigrationCheckRequest, defaultTLS bool) *machine.MigrationOptions {
+	// The same set of options as for the migration start
+	return optsFromStartMigrationRequest(&pb.StartMigrationRequest{
+		Name:        req.Name,
+		DstServer:   req.DstServer,
+		Disks:       req.Disks,
+		Overrides:   req.Overrides,
+		CreateDisks: req.CreateDisks,
+		Tls:         req.Tls,
+		Tuning:      req.Tuning,
+	}, defaultTLS)
+}
+
+func optsFromStartEvacuationRequest(req *pb.StartEvacuationRequest, defaultTLS bool) *machine.EvacuationOptions {
+	return &machine.EvacuationOptions{
+		DstServers:     req.DstServers,
+		Concurrency:    int(req.Concurrency),
+		WithLocalDisks: req.WithLocalDisks,
+		// Per-machine migration options
+		Migration: *optsFromStartMigrationRequest(&pb.StartMigrationRequest{
+			CreateDisks: req.CreateDisks,
+			RemoveAfter: req.RemoveAfter,
+			Tls:         req.Tls,
+			Tuning:      req.Tuning,
+		}, defaultTLS),
+	}
+}
+
+func migrationCheckResultToProto(res *machine.MigrationCheckResult) *pb.MigrationCheckResponse {
+	resp := pb.MigrationCheckResponse{
+		Blockers: make([]*pb_types.MigrationCheckIssue, 0, len(res.Blockers)),
+		Warnings: make([]*pb_types.MigrationCheckIssue, 0, len(res.Warnings)),
+	}
+
+	for _, issue := range res.Blockers {
+		resp.Blockers = append(resp.Blockers, &pb_types.MigrationCheckIssue{Subject: issue.Subject, Desc: issue.Desc})
+	}
+
+	for _, issue := range res.Warnings {
+		resp.Warnings = append(resp.Warnings, &pb_types.MigrationCheckIssue{Subject: issue.Subject, Desc: issue.Desc})
+	}
+
+	return &resp
+}
+
+func optsFromDiskSnapshotCreateRequest(req *pb.DiskSnapshotCreateRequest) *machine.DiskSnapshotOptions {
+	return &machine.DiskSnapshotOptions{
+		Name:  req.SnapshotName,
+		Disks: req.Disks,
+	}
+}
+
+func optsFromDiskSnapshotDeleteRequest(req *pb.DiskSnapshotDeleteRequest) *machine.DiskSnapshotOptions {
+	return &machine.DiskSnapshotOptions{
+		Name:  req.SnapshotName,
+		Disks: req.Disks,
+	}
+}
+
+func diskSnapshotsToProto(snapshots []*machine.DiskSnapshotInfo) []*pb_types.DiskSnapshot {
+	res := make([]*pb_types.DiskSnapshot, 0, len(snapshots))
+
+	for _, snap := range snapshots {
+		res = append(res, &pb_types.DiskSnapshot{
+			Name:    snap.Name,
+			Created: uint64(snap.Created.Unix()),
+			Disks:   snap.Disks,
+		})
+	}
+
+	return res
+}
+
 func vncRequisitesToProto(requisites *machine.VNCRequisites) *pb_types.VNCRequisites {
 	return &pb_types.VNCRequisites{
 		Password: requisites.Password,
@@ -363,3 +535,97 @@ func vncRequisitesToProto(requisites *machine.VNCRequisites) *pb_types.VNCRequis
 		WSPort:   uint32(requisites.WSPort),
 	}
 }
+
+func snapshotNames(snapshots []*kvmrun.DiskSnapshot) []string {
+	if len(snapshots) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(snapshots))
+
+	for _, snap := range snapshots {
+		names = append(names, snap.Name)
+	}
+
+	return names
+}
+
+// diskBackupStateToProto reports whether the next backup of the disk
+// can be incremental. It returns nil if the disk has never been backed up
+// with a dirty bitmap.
+func diskBackupStateToProto(vm *kvmrun.Machine, d *kvmrun.Disk) *pb_types.MachineOpts_Disk_BackupState {
+	st, err := kvmrun.GetBackupState(vm.Name, d.BaseName())
+	if err != nil {
+		return nil
+	}
+
+	res := pb_types.MachineOpts_Disk_BackupState{
+		PersistentBitmap: st.Persistent,
+		Images:           st.Images,
+	}
+
+	// Only the runtime disk has the actual bitmap properties
+	var running bool
+
+	if vm.R != nil {
+		if rd := vm.R.DiskGet(d.Path); rd != nil {
+			d = rd
+			running = true
+		}
+	}
+
+	if err := st.CheckIncremental(d, running); err == nil {
+		res.Incremental = true
+	} else {
+		res.Reason = err.Error()
+	}
+
+	return &res
+}
+
+func backupPolicyFromProto(proto *pb_types.BackupPolicy) *kvmrun.BackupPolicy {
+	if proto == nil {
+		return nil
+	}
+
+	return &kvmrun.BackupPolicy{
+		Schedule:       proto.Schedule,
+		Disks:          proto.Disks,
+		FullEvery:      int(proto.FullEvery),
+		TargetTemplate: proto.TargetTemplate,
+		KeepChains:     int(proto.KeepChains),
+		KeepDays:       int(proto.KeepDays),
+		FSFreeze:       proto.FsFreeze,
+		Disabled:       proto.Disabled,
+	}
+}
+
+func backupPolicyToProto(p *kvmrun.BackupPolicy) *pb_types.BackupPolicy {
+	return &pb_types.BackupPolicy{
+		Schedule:       p.Schedule,
+		Disks:          p.Disks,
+		FullEvery:      uint32(p.FullEvery),
+		TargetTemplate: p.TargetTemplate,
+		KeepChains:     uint32(p.KeepChains),
+		KeepDays:       uint32(p.KeepDays),
+		FsFreeze:       p.FSFreeze,
+		Disabled:       p.Disabled,
+	}
+}
+
+func backupCatalogToProto(entries []*kvmrun.BackupCatalogEntry) []*pb_types.BackupCatalogEntry {
+	res := make([]*pb_types.BackupCatalogEntry, 0, len(entries))
+
+	for _, e := range entries {
+		res = append(res, &pb_types.BackupCatalogEntry{
+			DiskName: e.DiskName,
+			Path:     e.Path,
+			Type:     e.Type,
+			Size:     e.Size,
+			TaskID:   e.TaskID,
+			Created:  uint64(e.Created.Unix()),
+		})
+	}
+
+	return res
+}
@@ -0,0 +1,46 @@
+package machines
+
+import (
+	"context"
+
+	pb "github.com/0xef53/kvmrun/api/services/machines/v2"
+
+	empty "github.com/golang/protobuf/ptypes/empty"
+)
+
+func (s *service) DiskSnapshotCreate(ctx context.Context, req *pb.DiskSnapshotCreateRequest) (*empty.Empty, error) {
+	opts := optsFromDiskSnapshotCreateRequest(req)
+
+	if err := s.ServiceServer.Machine.DiskSnapshotCreate(ctx, req.Name, opts); err != nil {
+		return nil, err
+	}
+
+	return new(empty.Empty), nil
+}
+
+func (s *service) DiskSnapshotList(ctx context.Context, req *pb.DiskSnapshotListRequest) (*pb.DiskSnapshotListResponse, error) {
+	snapshots, err := s.ServiceServer.Machine.DiskSnapshotList(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DiskSnapshotListResponse{Snapshots: diskSnapshotsToProto(snapshots)}, nil
+}
+
+func (s *service) DiskSnapshotDelete(ctx context.Context, req *pb.DiskSnapshotDeleteRequest) (*empty.Empty, error) {
+	opts := optsFromDiskSnapshotDeleteRequest(req)
+
+	if err := s.ServiceServer.Machine.DiskSnapshotDelete(ctx, req.Name, opts); err != nil {
+		return nil, err
+	}
+
+	return new(empty.Empty), nil
+}
+
+func (s *service) DiskSnapshotRevert(ctx context.Context, req *pb.DiskSnapshotRevertRequest) (*empty.Empty, error) {
+	if err := s.ServiceServer.Machine.DiskSnapshotRevert(ctx, req.Name, req.SnapshotName); err != nil {
+		return nil, err
+	}
+
+	return new(empty.Empty), nil
+}
@@ -4,7 +4,9 @@ import (
 	"fmt"
 
 	"github.com/0xef53/kvmrun/server"
+	"github.com/0xef53/kvmrun/server/blockjobs"
 	"github.com/0xef53/kvmrun/server/cloudinit"
+	"github.com/0xef53/kvmrun/server/guestagent"
 	"github.com/0xef53/kvmrun/server/hardware"
 	"github.com/0xef53/kvmrun/server/machine"
 	"github.com/0xef53/kvmrun/server/network"
@@ -16,21 +18,25 @@ import (
 type ServiceServer struct {
 	*server.Server
 
-	Machine   *machine.Server
-	System    *system.Server
-	Network   *network.Server
-	Hardware  *hardware.Server
-	CloudInit *cloudinit.Server
+	Machine    *machine.Server
+	System     *system.Server
+	Network    *network.Server
+	Hardware   *hardware.Server
+	CloudInit  *cloudinit.Server
+	GuestAgent *guestagent.Server
+	BlockJobs  *blockjobs.Server
 }
 
 func NewServiceServer(base *server.Server) (*ServiceServer, error) {
 	h := &ServiceServer{
-		Server:    base,
-		Machine:   &machine.Server{Server: base},
-		System:    &system.Server{Server: base},
-		Network:   &network.Server{Server: base},
-		Hardware:  &hardware.Server{Server: base},
-		CloudInit: &cloudinit.Server{Server: base},
+		Server:     base,
+		Machine:    &machine.Server{Server: base},
+		System:     &system.Server{Server: base},
+		Network:    &network.Server{Server: base},
+		Hardware:   &hardware.Server{Server: base},
+		CloudInit:  &cloudinit.Server{Server: base},
+		GuestAgent: &guestagent.Server{Server: base},
+		BlockJobs:  &blockjobs.Server{Server: base},
 	}
 
 	for _, s := range grpcserver.Services("kvmrun") {
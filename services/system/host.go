@@ -0,0 +1,21 @@
+package system
+
+import (
+	"context"
+
+	pb "github.com/0xef53/kvmrun/api/services/system/v2"
+
+	empty "github.com/golang/protobuf/ptypes/empty"
+)
+
+func (s *service) HostSetDrainMode(ctx context.Context, req *pb.HostSetDrainModeRequest) (*empty.Empty, error) {
+	if err := s.ServiceServer.HostSetDrained(req.Enabled); err != nil {
+		return nil, err
+	}
+
+	return new(empty.Empty), nil
+}
+
+func (s *service) HostGetDrainMode(ctx context.Context, _ *empty.Empty) (*pb.HostGetDrainModeResponse, error) {
+	return &pb.HostGetDrainModeResponse{Enabled: s.ServiceServer.HostIsDrained()}, nil
+}
@@ -16,3 +16,14 @@ func (s *service) StartIncomingMigration(ctx context.Context, req *pb.StartIncom
 
 	return &pb.StartIncomingMigrationResponse{Requisites: incomingRequisitesToProto(requisites)}, nil
 }
+
+func (s *service) CheckIncomingMigration(ctx context.Context, req *pb.CheckIncomingMigrationRequest) (*pb.CheckIncomingMigrationResponse, error) {
+	opts := optsFromCheckIncomingMigrationRequest(req)
+
+	res, err := s.ServiceServer.System.CheckIncomingMigration(ctx, req.Name, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return incomingCheckResultToProto(res), nil
+}
@@ -20,14 +20,50 @@ func appConfToProto(appConf *appconf.Config) *pb_types.AppConf {
 }
 
 func optsFromStartIncomingMigrationRequest(req *pb.StartIncomingMigrationRequest) *system.IncomingMigrationOptions {
-	return &system.IncomingMigrationOptions{
+	opts := system.IncomingMigrationOptions{
 		Manifest:     req.Manifest,
 		Disks:        req.Disks,
 		ExtraFiles:   req.ExtraFiles,
 		ListenAddr:   req.ListenAddr,
 		CreateDisks:  req.CreateDisks,
 		TurnOffAfter: req.TurnOffAfter,
+		TLS:          req.Tls,
 	}
+
+	if req.Tuning != nil {
+		opts.MultifdChannels = req.Tuning.MultifdChannels
+		opts.MultifdCompression = req.Tuning.MultifdCompression
+		opts.PostCopy = req.Tuning.Postcopy
+	}
+
+	return &opts
+}
+
+func optsFromCheckIncomingMigrationRequest(req *pb.CheckIncomingMigrationRequest) *system.IncomingCheckOptions {
+	return &system.IncomingCheckOptions{
+		Manifest:    req.Manifest,
+		Disks:       req.Disks,
+		ExtraFiles:  req.ExtraFiles,
+		CreateDisks: req.CreateDisks,
+	}
+}
+
+func incomingCheckResultToProto(res *system.IncomingCheckResult) *pb.CheckIncomingMigrationResponse {
+	resp := pb.CheckIncomingMigrationResponse{
+		Blockers:    make([]*pb_types.MigrationCheckIssue, 0, len(res.Blockers)),
+		Warnings:    make([]*pb_types.MigrationCheckIssue, 0, len(res.Warnings)),
+		QemuVersion: res.QemuVersion,
+	}
+
+	for _, issue := range res.Blockers {
+		resp.Blockers = append(resp.Blockers, &pb_types.MigrationCheckIssue{Subject: issue.Subject, Desc: issue.Desc})
+	}
+
+	for _, issue := range res.Warnings {
+		resp.Warnings = append(resp.Warnings, &pb_types.MigrationCheckIssue{Subject: issue.Subject, Desc: issue.Desc})
+	}
+
+	return &resp
 }
 
 func incomingRequisitesToProto(requisites *system.IncomingRequisites) *pb_types.IncomingMigrationRequisites {
@@ -20,7 +20,9 @@ func taskStatToProto(st *task.TaskStat) *pb_types.TaskInfo {
 	case *machine.MachineMigrationStatDetails:
 		if d != nil {
 			mi := pb_types.TaskInfo_MigrationInfo{
-				DstServer: d.DstServer,
+				DstServer:        d.DstServer,
+				PostcopyActive:   d.PostCopyActive,
+				PostcopyRequests: d.PostCopyRequests,
 			}
 
 			if d.VMState != nil {
@@ -50,6 +52,128 @@ func taskStatToProto(st *task.TaskStat) *pb_types.TaskInfo {
 				Migration: &mi,
 			}
 		}
+	case *machine.HostEvacuationStatDetails:
+		if d != nil {
+			ei := pb_types.TaskInfo_EvacuationInfo{
+				Machines: make(map[string]*pb_types.TaskInfo_EvacuationInfo_Machine),
+			}
+
+			for n, v := range d.Machines {
+				ei.Machines[n] = &pb_types.TaskInfo_EvacuationInfo_Machine{
+					DstServer: v.DstServer,
+					State:     v.State,
+					Progress:  uint32(v.Progress),
+					Error:     v.Err,
+				}
+			}
+
+			info.Stat = &pb_types.TaskInfo_Evacuation{
+				Evacuation: &ei,
+			}
+		}
+	case *machine.MachineSuspendStatDetails:
+		if d != nil {
+			si := pb_types.TaskInfo_SuspendInfo{}
+
+			if d.VMState != nil {
+				si.Vmstate = &pb_types.TaskInfo_MigrationInfo_Stat{
+					Total:       d.VMState.Total,
+					Remaining:   d.VMState.Remaining,
+					Transferred: d.VMState.Transferred,
+					Progress:    uint32(d.VMState.Progress),
+					Speed:       uint32(d.VMState.Speed),
+				}
+			}
+
+			info.Stat = &pb_types.TaskInfo_Suspend{
+				Suspend: &si,
+			}
+		}
+	case *machine.DiskBackupStatDetails:
+		if d != nil {
+			bi := pb_types.TaskInfo_BackupInfo{
+				Disks:        make(map[string]*pb_types.TaskInfo_MigrationInfo_Stat),
+				FsConsistent: d.FSConsistent,
+			}
+
+			if d.Disk != nil {
+				bi.Disks[d.DiskName] = &pb_types.TaskInfo_MigrationInfo_Stat{
+					Total:       d.Disk.Total,
+					Remaining:   d.Disk.Remaining,
+					Transferred: d.Disk.Transferred,
+					Progress:    uint32(d.Disk.Progress),
+					Speed:       uint32(d.Disk.Speed),
+				}
+			}
+
+			info.Stat = &pb_types.TaskInfo_Backup{
+				Backup: &bi,
+			}
+		}
+	case *machine.MachineBackupStatDetails:
+		if d != nil {
+			bi := pb_types.TaskInfo_BackupInfo{
+				Disks:        make(map[string]*pb_types.TaskInfo_MigrationInfo_Stat),
+				FsConsistent: d.FSConsistent,
+			}
+
+			for n, v := range d.Disks {
+				bi.Disks[n] = &pb_types.TaskInfo_MigrationInfo_Stat{
+					Total:       v.Total,
+					Remaining:   v.Remaining,
+					Transferred: v.Transferred,
+					Progress:    uint32(v.Progress),
+					Speed:       uint32(v.Speed),
+				}
+			}
+
+			info.Stat = &pb_types.TaskInfo_Backup{
+				Backup: &bi,
+			}
+		}
+	case *machine.DiskRestoreStatDetails:
+		if d != nil {
+			ri := pb_types.TaskInfo_RestoreInfo{
+				DiskName: d.DiskName,
+				Source:   d.Source,
+			}
+
+			if d.Disk != nil {
+				ri.Disk = &pb_types.TaskInfo_MigrationInfo_Stat{
+					Total:       d.Disk.Total,
+					Remaining:   d.Disk.Remaining,
+					Transferred: d.Disk.Transferred,
+					Progress:    uint32(d.Disk.Progress),
+					Speed:       uint32(d.Disk.Speed),
+				}
+			}
+
+			info.Stat = &pb_types.TaskInfo_Restore{
+				Restore: &ri,
+			}
+		}
+	case *machine.DiskMoveStatDetails:
+		if d != nil {
+			mi := pb_types.TaskInfo_DiskMoveInfo{
+				DiskName: d.DiskName,
+				Source:   d.Source,
+				Target:   d.Target,
+			}
+
+			if d.Disk != nil {
+				mi.Disk = &pb_types.TaskInfo_MigrationInfo_Stat{
+					Total:       d.Disk.Total,
+					Remaining:   d.Disk.Remaining,
+					Transferred: d.Disk.Transferred,
+					Progress:    uint32(d.Disk.Progress),
+					Speed:       uint32(d.Disk.Speed),
+				}
+			}
+
+			info.Stat = &pb_types.TaskInfo_Move{
+				Move: &mi,
+			}
+		}
 	}
 
 	return &info
@@ -48,3 +48,30 @@ func (s *service) Cancel(ctx context.Context, req *pb.CancelRequest) (*empty.Emp
 
 	return new(empty.Empty), nil
 }
+
+func (s *service) Pause(ctx context.Context, req *pb.PauseRequest) (*empty.Empty, error) {
+	err := s.ServiceServer.TaskPause(req.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(empty.Empty), nil
+}
+
+func (s *service) Resume(ctx context.Context, req *pb.ResumeRequest) (*empty.Empty, error) {
+	err := s.ServiceServer.TaskResume(req.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(empty.Empty), nil
+}
+
+func (s *service) SetSpeed(ctx context.Context, req *pb.SetSpeedRequest) (*empty.Empty, error) {
+	err := s.ServiceServer.TaskSetSpeed(req.Key, req.Speed)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(empty.Empty), nil
+}